@@ -6,8 +6,11 @@ import (
 	"axis-graphql/internal/config"
 	"axis-graphql/internal/graphql/resolvers"
 	"axis-graphql/internal/handlers"
+	"axis-graphql/internal/locale"
 	"axis-graphql/internal/logger"
+	"axis-graphql/internal/metrics"
 	"axis-graphql/internal/repository"
+	"axis-graphql/internal/repository/mock"
 	"axis-graphql/internal/svc"
 	"flag"
 	"log"
@@ -43,13 +46,23 @@ func (app *apiServer) init() {
 	// configure logger based on the configuration
 	app.log = logger.New(app.cfg)
 
+	// load language catalogs of localized messages
+	if err := locale.Load(&app.cfg.Localization, app.log); err != nil {
+		log.Fatal(err)
+		return
+	}
+
 	// make sure to pass logger and config to internals
 	repository.SetConfig(app.cfg)
 	repository.SetLogger(app.log)
+	if app.cfg.RepoCommand.Fixtures != "" {
+		app.useFixtures()
+	}
 	resolvers.SetConfig(app.cfg)
-	resolvers.SetLogger(app.log)
+	resolvers.SetLogger(logger.Module(logger.SubsystemGraphQL))
 	svc.SetConfig(app.cfg)
-	svc.SetLogger(app.log)
+	svc.SetLogger(logger.Module(logger.SubsystemIndexer))
+	svc.SetObserverLogger(logger.Module(logger.SubsystemObserver))
 
 	// make the HTTP server
 	app.makeHttpServer()
@@ -66,8 +79,10 @@ func (app *apiServer) run() {
 	// make sure to capture terminate signals
 	app.observeSignals()
 
-	// run services
-	svc.Manager().Run()
+	// run services; there is no chain to follow when serving fixtures
+	if app.cfg.RepoCommand.Fixtures == "" {
+		svc.Manager().Run()
+	}
 
 	// start responding to requests
 	app.log.Infof("welcome to Axis GraphQL API server")
@@ -83,6 +98,18 @@ func (app *apiServer) run() {
 	app.terminate()
 }
 
+// useFixtures replaces the repository with the mock repository serving synthetic data of the fixtures.
+func (app *apiServer) useFixtures() {
+	fx, err := mock.LoadFixtures(app.cfg.RepoCommand.Fixtures)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	repository.SetRepository(mock.New(fx))
+	app.log.Warningf("serving synthetic data of %s, the node and the database are not used", app.cfg.RepoCommand.Fixtures)
+}
+
 // makeHttpServer creates and configures the HTTP server to be used to serve incoming requests
 func (app *apiServer) makeHttpServer() {
 	// create request MUXer
@@ -113,14 +140,54 @@ func (app *apiServer) setupHandlers(mux *http.ServeMux) {
 		time.Second*time.Duration(app.cfg.Server.ResolverTimeout),
 		"Service timeout.",
 	)
-	mux.Handle("/api", h)
-	mux.Handle("/graphql", h)
+	mux.Handle("/api", app.compress(h))
+	mux.Handle("/graphql", app.compress(h))
 
 	// setup gas price estimator REST API resolver
-	mux.Handle("/json/gas", handlers.GasPrice(app.log))
+	mux.Handle("/json/gas", app.compress(handlers.GasPrice(app.log)))
+
+	// setup DeFi aggregates REST API resolver for external aggregators
+	mux.Handle("/json/defi", app.compress(handlers.DefiOverview(app.log)))
+
+	// serve the limited Ethereum JSON-RPC facade for legacy tools
+	if app.cfg.EthRpc.Enabled {
+		mux.Handle(app.cfg.EthRpc.Path, app.compress(handlers.EthRpc(app.log)))
+	}
 
-	// handle GraphiQL interface
-	mux.Handle("/graphi", handlers.GraphiHandler(app.cfg.Server.DomainAddress, app.log))
+	// stream call traces of a block range to analytics clients holding an admin key
+	if app.cfg.TraceExport.Enabled {
+		mux.Handle(app.cfg.TraceExport.Path, handlers.RequireAdminKey(app.cfg, app.log, handlers.TraceExport(&app.cfg.TraceExport, app.log)))
+	}
+
+	// serve files of finished account exports by their signed download links
+	if app.cfg.AccountExport.Enabled {
+		mux.Handle(app.cfg.AccountExport.Path, handlers.AccountExport(&app.cfg.AccountExport, app.log))
+	}
+
+	// stream summaries of new blocks and large transactions to public dashboards
+	if app.cfg.Firehose.Enabled {
+		mux.Handle(app.cfg.Firehose.Path, handlers.Firehose(app.cfg, app.log, app.api))
+	}
+
+	// export operational metrics to scrapers holding an admin key
+	mux.Handle("/metrics", handlers.RequireAdminKey(app.cfg, app.log, metrics.Handler()))
+
+	// handle GraphiQL playground; only authenticated clients can use it in production mode
+	if app.cfg.Playground.Enabled {
+		gh := handlers.GraphiHandler(app.cfg, app.log)
+		if app.cfg.Production.Enabled {
+			gh = handlers.RequireApiKey(app.cfg, app.log, gh)
+		}
+		mux.Handle(app.cfg.Playground.Path, gh)
+	}
+}
+
+// compress adds the response compression to the given handler, if enabled.
+func (app *apiServer) compress(h http.Handler) http.Handler {
+	if !app.cfg.Server.Compress {
+		return h
+	}
+	return handlers.NewCompressHandler(h)
 }
 
 // observeSignals setups terminate signals observation.
@@ -144,6 +211,16 @@ func (app *apiServer) observeSignals() {
 			os.Exit(0)
 		}
 	}()
+
+	// SIGUSR1 toggles the read-only maintenance mode
+	mt := make(chan os.Signal, 1)
+	signal.Notify(mt, syscall.SIGUSR1)
+	go func() {
+		for range mt {
+			app.log.Notice("maintenance mode toggle signal received")
+			resolvers.SetMaintenance(!resolvers.IsMaintenance(), nil)
+		}
+	}()
 }
 
 // terminate modules of the API server.
@@ -154,7 +231,7 @@ func (app *apiServer) terminate() {
 
 	// terminate observers, scanners and dispatchers, etc.
 	app.log.Notice("closing services")
-	if mgr := svc.Manager(); mgr != nil {
+	if mgr := svc.Manager(); mgr != nil && app.cfg.RepoCommand.Fixtures == "" {
 		mgr.Close()
 	}
 
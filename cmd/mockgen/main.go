@@ -0,0 +1,38 @@
+/*
+Package main implements the generator of the unimplemented Repository stub.
+
+The generator reads the Repository interface declaration and produces a type implementing
+every method of the interface by returning zero values and ErrNotImplemented. Mock repositories
+embed the stub and override only the methods they need, so they keep compiling as the interface grows.
+
+Usage:
+
+	mockgen [-in internal/repository/interface.go] [-out internal/repository/mock/unimplemented.go]
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	in := flag.String("in", filepath.Join("internal", "repository", "interface.go"), "path to the file declaring the interface")
+	out := flag.String("out", filepath.Join("internal", "repository", "mock", "unimplemented.go"), "path to the generated file")
+	iface := flag.String("type", "Repository", "name of the interface to implement")
+	pkg := flag.String("package", "mock", "package of the generated file")
+	stub := flag.String("stub", "Unimplemented", "name of the generated type")
+	flag.Parse()
+
+	src, err := generateStub(*in, *iface, *pkg, *stub)
+	if err != nil {
+		log.Fatalf("can not generate %s stub; %s", *iface, err.Error())
+	}
+
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("can not write %s; %s", *out, err.Error())
+	}
+	log.Printf("generated %s", *out)
+}
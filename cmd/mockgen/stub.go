@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generateStub builds the source of the stub type implementing the given interface.
+func generateStub(file string, iface string, pkg string, stub string) ([]byte, error) {
+	fs := token.NewFileSet()
+	f, err := parser.ParseFile(fs, file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	it := findInterface(f, iface)
+	if it == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", iface, file)
+	}
+
+	var body bytes.Buffer
+	used := make(map[string]bool)
+	for _, m := range it.Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+		collectPackages(ft, used)
+
+		for _, name := range m.Names {
+			if err := writeMethod(&body, fs, stub, iface, name.Name, ft); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var sb bytes.Buffer
+	sb.WriteString("// Code generated by mockgen. DO NOT EDIT.\n\n")
+	sb.WriteString("package " + pkg + "\n\n")
+	writeImports(&sb, f, used)
+	sb.WriteString(fmt.Sprintf("// %s implements the %s interface with all the methods returning\n", stub, iface))
+	sb.WriteString("// zero values and ErrNotImplemented, if the method returns an error.\n")
+	sb.WriteString(fmt.Sprintf("type %s struct{}\n\n", stub))
+	sb.Write(body.Bytes())
+	return format.Source(sb.Bytes())
+}
+
+// findInterface finds the declaration of the named interface type in the file.
+func findInterface(f *ast.File, name string) *ast.InterfaceType {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, s := range gd.Specs {
+			ts := s.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == name {
+				return it
+			}
+		}
+	}
+	return nil
+}
+
+// collectPackages collects names of packages referenced by the function type.
+func collectPackages(ft *ast.FuncType, used map[string]bool) {
+	ast.Inspect(ft, func(n ast.Node) bool {
+		if se, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := se.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+// writeImports writes the imports of the source file used by the stub.
+func writeImports(sb *bytes.Buffer, f *ast.File, used map[string]bool) {
+	list := make([]string, 0, len(used))
+	for _, is := range f.Imports {
+		p, _ := strconv.Unquote(is.Path.Value)
+		name := importName(is, p)
+		if !used[name] {
+			continue
+		}
+		if name != path.Base(p) {
+			list = append(list, name+" "+is.Path.Value)
+			continue
+		}
+		list = append(list, is.Path.Value)
+	}
+	if len(list) == 0 {
+		return
+	}
+
+	sort.Strings(list)
+	sb.WriteString("import (\n")
+	for _, l := range list {
+		sb.WriteString("\t" + l + "\n")
+	}
+	sb.WriteString(")\n\n")
+}
+
+// importName provides the name the imported package is referenced by.
+// Packages in go- prefixed folders are expected to drop the prefix.
+func importName(is *ast.ImportSpec, p string) string {
+	if is.Name != nil {
+		return is.Name.Name
+	}
+	return strings.TrimPrefix(path.Base(p), "go-")
+}
+
+// writeMethod writes the stub implementation of a single interface method.
+func writeMethod(w *bytes.Buffer, fs *token.FileSet, stub string, iface string, name string, ft *ast.FuncType) error {
+	params, err := fieldList(fs, ft.Params, nil)
+	if err != nil {
+		return err
+	}
+
+	// results are named so the zero values can be returned
+	var names []string
+	results, err := fieldList(fs, ft.Results, &names)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "// %s implements %s.%s; it's not implemented.\n", name, iface, name)
+	fmt.Fprintf(w, "func (%s) %s(%s)", stub, name, params)
+	if results != "" {
+		fmt.Fprintf(w, " (%s)", results)
+	}
+	w.WriteString(" {\n")
+	if results != "" {
+		w.WriteString("\treturn " + strings.Join(names, ", ") + "\n")
+	}
+	w.WriteString("}\n\n")
+	return nil
+}
+
+// fieldList prints the list of parameters, or results. If the names are requested,
+// the fields are named by their position and an error is replaced by ErrNotImplemented.
+func fieldList(fs *token.FileSet, fl *ast.FieldList, names *[]string) (string, error) {
+	if fl == nil {
+		return "", nil
+	}
+
+	parts := make([]string, 0, len(fl.List))
+	for _, fld := range fl.List {
+		var typ bytes.Buffer
+		if err := printer.Fprint(&typ, fs, fld.Type); err != nil {
+			return "", err
+		}
+
+		// parameters keep their names, if any
+		if names == nil {
+			if len(fld.Names) == 0 {
+				parts = append(parts, typ.String())
+				continue
+			}
+			ids := make([]string, len(fld.Names))
+			for i, id := range fld.Names {
+				ids[i] = id.Name
+			}
+			parts = append(parts, strings.Join(ids, ", ")+" "+typ.String())
+			continue
+		}
+
+		count := len(fld.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			n := fmt.Sprintf("r%d", len(*names))
+			parts = append(parts, n+" "+typ.String())
+			if typ.String() == "error" {
+				*names = append(*names, "ErrNotImplemented")
+				continue
+			}
+			*names = append(*names, n)
+		}
+	}
+	return strings.Join(parts, ", "), nil
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"log"
+	"strings"
+)
+
+// goPackage is the name of the generated Go client package.
+const goPackage = "axisclient"
+
+// goBuiltinScalars maps built-in GraphQL scalars to Go types.
+var goBuiltinScalars = map[string]string{
+	"String":  "string",
+	"ID":      "string",
+	"Int":     "int32",
+	"Float":   "float64",
+	"Boolean": "bool",
+}
+
+// goKeywords lists identifiers which can not be used as argument names.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true,
+	"if": true, "import": true, "interface": true, "map": true, "package": true, "range": true,
+	"return": true, "select": true, "struct": true, "switch": true, "type": true, "var": true,
+	"ctx": true, "vars": true, "out": true, "err": true,
+}
+
+// goClientSource is the static part of the Go client.
+const goClientSource = `package ` + goPackage + `
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Client represents a client of the GraphQL API.
+type Client struct {
+	// Endpoint is the URL of the GraphQL API.
+	Endpoint string
+
+	// ApiKey is the API key sent with requests, if any.
+	ApiKey string
+
+	// HTTP is the HTTP client used to execute requests.
+	HTTP *http.Client
+}
+
+// Error represents an error returned by the GraphQL API.
+type Error struct {
+	Message    string                 ` + "`json:\"message\"`" + `
+	Path       []interface{}          ` + "`json:\"path\"`" + `
+	Extensions map[string]interface{} ` + "`json:\"extensions\"`" + `
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NewClient creates a new client of the GraphQL API at the given endpoint.
+func NewClient(endpoint string) *Client {
+	return &Client{Endpoint: endpoint, HTTP: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Do executes the GraphQL operation and decodes its data into the output structure.
+func (c *Client) Do(ctx context.Context, query string, vars map[string]interface{}, out interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": vars})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.ApiKey != "" {
+		req.Header.Set("X-Api-Key", c.ApiKey)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	var res struct {
+		Data   json.RawMessage ` + "`json:\"data\"`" + `
+		Errors []*Error        ` + "`json:\"errors\"`" + `
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return err
+	}
+	if len(res.Errors) > 0 {
+		return res.Errors[0]
+	}
+	return json.Unmarshal(res.Data, out)
+}
+`
+
+// generateGo generates the Go client package.
+func generateGo(sch *schema, ops []*operation) map[string]string {
+	return map[string]string{
+		"go/go.mod":        "module " + goPackage + "\n\ngo 1.16\n",
+		"go/client.go":     goSource("client.go", goClientSource),
+		"go/types.go":      goSource("types.go", goTypes(sch)),
+		"go/operations.go": goSource("operations.go", goOperations(ops)),
+	}
+}
+
+// goSource formats the generated Go source; the source is kept as is if it can not be formatted.
+func goSource(name string, src string) string {
+	out, err := format.Source([]byte(src))
+	if err != nil {
+		log.Printf("can not format %s; %s", name, err.Error())
+		return src
+	}
+	return string(out)
+}
+
+// goTypes generates Go types of the schema.
+func goTypes(sch *schema) string {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by sdkgen. DO NOT EDIT.\n\npackage " + goPackage + "\n\n")
+
+	// custom scalars are transferred as strings
+	for _, t := range sch.typesOf("SCALAR") {
+		if _, ok := goBuiltinScalars[t.Name]; ok {
+			continue
+		}
+		goComment(&sb, t.Name, t.Description, "represents the "+t.Name+" type of the API.")
+		fmt.Fprintf(&sb, "type %s = string\n\n", t.Name)
+	}
+
+	for _, t := range sch.typesOf("ENUM") {
+		goComment(&sb, t.Name, t.Description, "represents the "+t.Name+" type of the API.")
+		fmt.Fprintf(&sb, "type %s string\n\n// Values of %s.\nconst (\n", t.Name, t.Name)
+		for _, v := range t.EnumValues {
+			fmt.Fprintf(&sb, "\t%s%s %s = %q\n", t.Name, goName(strings.ToLower(v.Name)), t.Name, v.Name)
+		}
+		sb.WriteString(")\n\n")
+	}
+
+	for _, t := range sch.typesOf("INPUT_OBJECT") {
+		goComment(&sb, t.Name, t.Description, "represents the "+t.Name+" type of the API.")
+		fmt.Fprintf(&sb, "type %s struct {\n", t.Name)
+		for _, f := range t.InputFields {
+			omit := ""
+			if !f.isRequired() {
+				omit = ",omitempty"
+			}
+			fmt.Fprintf(&sb, "\t%s %s `json:\"%s%s\"`\n", goName(f.Name), goType(f.Type, true), f.Name, omit)
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	for _, kind := range []string{"OBJECT", "INTERFACE"} {
+		for _, t := range sch.typesOf(kind) {
+			if t.Name == sch.QueryType.Name || t.Name == "Mutation" || t.Name == "Subscription" {
+				continue
+			}
+			goComment(&sb, t.Name, t.Description, "represents the "+t.Name+" type of the API.")
+			fmt.Fprintf(&sb, "type %s struct {\n", t.Name)
+			for _, f := range t.Fields {
+				fmt.Fprintf(&sb, "\t%s %s `json:\"%s,omitempty\"`\n", goName(f.Name), goType(f.Type, true), f.Name)
+			}
+			sb.WriteString("}\n\n")
+		}
+	}
+	return sb.String()
+}
+
+// goOperations generates Go client methods of the operations.
+func goOperations(ops []*operation) string {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by sdkgen. DO NOT EDIT.\n\npackage " + goPackage + "\n\nimport \"context\"\n\n")
+
+	for _, op := range ops {
+		fmt.Fprintf(&sb, "// %sDocument is the GraphQL document of the %s operation.\n", op.Name, op.Name)
+		fmt.Fprintf(&sb, "const %sDocument = %q\n\n", op.Name, op.Document)
+
+		params := []string{"ctx context.Context"}
+		for _, a := range op.Field.Args {
+			params = append(params, goParam(a.Name)+" "+goType(a.Type, true))
+		}
+
+		res := goType(op.Field.Type, true)
+		goComment(&sb, op.Name, op.Field.Description, fmt.Sprintf("executes the %s query of the %s group.", op.Field.Name, op.Group))
+		fmt.Fprintf(&sb, "func (c *Client) %s(%s) (%s, error) {\n", op.Name, strings.Join(params, ", "), res)
+		sb.WriteString("\tvars := map[string]interface{}{}\n")
+		for _, a := range op.Field.Args {
+			if a.Type.Kind == "NON_NULL" && a.Type.OfType.Kind != "LIST" {
+				fmt.Fprintf(&sb, "\tvars[%q] = %s\n", a.Name, goParam(a.Name))
+				continue
+			}
+			fmt.Fprintf(&sb, "\tif %s != nil {\n\t\tvars[%q] = %s\n\t}\n", goParam(a.Name), a.Name, goParam(a.Name))
+		}
+		fmt.Fprintf(&sb, "\n\tvar out struct {\n\t\tValue %s `json:\"%s\"`\n\t}\n", res, op.Field.Name)
+		fmt.Fprintf(&sb, "\terr := c.Do(ctx, %sDocument, vars, &out)\n\treturn out.Value, err\n}\n\n", op.Name)
+	}
+	return sb.String()
+}
+
+// goType provides the Go type of the referenced GraphQL type.
+// Objects are always referenced by pointers since the schema types may be recursive.
+func goType(ref *typeRef, nullable bool) string {
+	switch ref.Kind {
+	case "NON_NULL":
+		return goType(ref.OfType, false)
+	case "LIST":
+		return "[]" + goType(ref.OfType, true)
+	case "OBJECT", "INTERFACE", "INPUT_OBJECT":
+		return "*" + ref.named()
+	}
+
+	name := ref.named()
+	if bt, ok := goBuiltinScalars[name]; ok {
+		name = bt
+	}
+	if nullable {
+		return "*" + name
+	}
+	return name
+}
+
+// goName provides the exported Go name of a GraphQL field.
+func goName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// goParam provides the Go name of an operation argument.
+func goParam(name string) string {
+	if goKeywords[name] {
+		return name + "Arg"
+	}
+	return name
+}
+
+// goComment writes the doc comment of the given Go symbol; the GraphQL description
+// is used if it documents the symbol, i.e. starts with its name.
+func goComment(sb *strings.Builder, name string, desc string, intro string) {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if strings.HasPrefix(desc, name+" ") {
+		fmt.Fprintf(sb, "// %s\n", desc)
+		return
+	}
+
+	fmt.Fprintf(sb, "// %s %s\n", name, intro)
+	if desc != "" {
+		fmt.Fprintf(sb, "// %s\n", desc)
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tsPackage is the name of the generated TypeScript client package.
+const tsPackage = "axis-graphql-client"
+
+// tsBuiltinScalars maps built-in GraphQL scalars to TypeScript types.
+var tsBuiltinScalars = map[string]string{
+	"String":  "string",
+	"ID":      "string",
+	"Int":     "number",
+	"Float":   "number",
+	"Boolean": "boolean",
+}
+
+// tsClientSource is the static part of the TypeScript client.
+const tsClientSource = `/** GraphQLError represents an error returned by the GraphQL API. */
+export interface GraphQLError {
+  message: string;
+  path?: (string | number)[];
+  extensions?: Record<string, unknown>;
+}
+
+/** ApiError is thrown if the GraphQL API responds with errors. */
+export class ApiError extends Error {
+  constructor(public readonly errors: GraphQLError[]) {
+    super(errors[0].message);
+  }
+}
+
+/** AxisClient represents a client of the GraphQL API. */
+export class AxisClient {
+  constructor(private readonly endpoint: string, private readonly apiKey?: string) {}
+
+  /** request executes the GraphQL operation and provides its data. */
+  async request<T>(query: string, variables: Record<string, unknown> = {}): Promise<T> {
+    const headers: Record<string, string> = { "Content-Type": "application/json" };
+    if (this.apiKey) {
+      headers["X-Api-Key"] = this.apiKey;
+    }
+
+    const resp = await fetch(this.endpoint, {
+      method: "POST",
+      headers,
+      body: JSON.stringify({ query, variables }),
+    });
+    if (!resp.ok) {
+      throw new Error(` + "`request failed with status ${resp.status}`" + `);
+    }
+
+    const res = (await resp.json()) as { data?: T; errors?: GraphQLError[] };
+    if (res.errors && res.errors.length > 0) {
+      throw new ApiError(res.errors);
+    }
+    return res.data as T;
+  }
+`
+
+// generateTypeScript generates the TypeScript client package.
+func generateTypeScript(sch *schema, ops []*operation) map[string]string {
+	return map[string]string{
+		"ts/package.json": fmt.Sprintf(`{
+  "name": "%s",
+  "version": "1.0.0",
+  "description": "Typed client of the GraphQL API generated from the schema",
+  "main": "index.ts",
+  "types": "index.ts"
+}
+`, tsPackage),
+		"ts/index.ts": tsTypes(sch) + tsOperations(ops),
+	}
+}
+
+// tsTypes generates TypeScript types of the schema.
+func tsTypes(sch *schema) string {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by sdkgen. DO NOT EDIT.\n\n")
+
+	// custom scalars are transferred as strings
+	for _, t := range sch.typesOf("SCALAR") {
+		if _, ok := tsBuiltinScalars[t.Name]; ok {
+			continue
+		}
+		tsComment(&sb, t.Description, "")
+		fmt.Fprintf(&sb, "export type %s = string;\n\n", t.Name)
+	}
+
+	for _, t := range sch.typesOf("ENUM") {
+		values := make([]string, len(t.EnumValues))
+		for i, v := range t.EnumValues {
+			values[i] = fmt.Sprintf("%q", v.Name)
+		}
+		tsComment(&sb, t.Description, "")
+		fmt.Fprintf(&sb, "export type %s = %s;\n\n", t.Name, strings.Join(values, " | "))
+	}
+
+	for _, t := range sch.typesOf("INPUT_OBJECT") {
+		tsComment(&sb, t.Description, "")
+		fmt.Fprintf(&sb, "export interface %s {\n", t.Name)
+		for _, f := range t.InputFields {
+			opt := "?"
+			if f.isRequired() {
+				opt = ""
+			}
+			fmt.Fprintf(&sb, "  %s%s: %s;\n", f.Name, opt, tsType(f.Type, true))
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	// object fields are optional since only the selected fields are received
+	for _, kind := range []string{"OBJECT", "INTERFACE"} {
+		for _, t := range sch.typesOf(kind) {
+			if t.Name == sch.QueryType.Name || t.Name == "Mutation" || t.Name == "Subscription" {
+				continue
+			}
+			tsComment(&sb, t.Description, "")
+			fmt.Fprintf(&sb, "export interface %s {\n", t.Name)
+			for _, f := range t.Fields {
+				fmt.Fprintf(&sb, "  %s?: %s;\n", f.Name, tsType(f.Type, true))
+			}
+			sb.WriteString("}\n\n")
+		}
+	}
+	return sb.String()
+}
+
+// tsOperations generates the client class with methods of the operations.
+func tsOperations(ops []*operation) string {
+	var sb strings.Builder
+	for _, op := range ops {
+		fmt.Fprintf(&sb, "/** %sDocument is the GraphQL document of the %s operation. */\n", op.Name, op.Name)
+		fmt.Fprintf(&sb, "export const %sDocument = %q;\n\n", op.Name, op.Document)
+	}
+
+	sb.WriteString(tsClientSource)
+	for _, op := range ops {
+		vars := make([]string, len(op.Field.Args))
+		optional := true
+		for i, a := range op.Field.Args {
+			opt := "?"
+			if a.isRequired() {
+				opt = ""
+				optional = false
+			}
+			vars[i] = fmt.Sprintf("%s%s: %s", a.Name, opt, tsType(a.Type, true))
+		}
+
+		res := tsType(op.Field.Type, true)
+		sb.WriteString("\n")
+		tsComment(&sb, op.Field.Description, "  ")
+		switch {
+		case len(vars) == 0:
+			fmt.Fprintf(&sb, "  async %s(): Promise<%s> {\n", op.Field.Name, res)
+			fmt.Fprintf(&sb, "    const res = await this.request<{ %s: %s }>(%sDocument);\n", op.Field.Name, res, op.Name)
+		case optional:
+			fmt.Fprintf(&sb, "  async %s(vars: { %s } = {}): Promise<%s> {\n", op.Field.Name, strings.Join(vars, "; "), res)
+			fmt.Fprintf(&sb, "    const res = await this.request<{ %s: %s }>(%sDocument, vars);\n", op.Field.Name, res, op.Name)
+		default:
+			fmt.Fprintf(&sb, "  async %s(vars: { %s }): Promise<%s> {\n", op.Field.Name, strings.Join(vars, "; "), res)
+			fmt.Fprintf(&sb, "    const res = await this.request<{ %s: %s }>(%sDocument, vars);\n", op.Field.Name, res, op.Name)
+		}
+		fmt.Fprintf(&sb, "    return res.%s;\n  }\n", op.Field.Name)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// tsType provides the TypeScript type of the referenced GraphQL type.
+func tsType(ref *typeRef, nullable bool) string {
+	var t string
+	switch ref.Kind {
+	case "NON_NULL":
+		return tsType(ref.OfType, false)
+	case "LIST":
+		t = tsType(ref.OfType, true)
+		if strings.Contains(t, " ") {
+			t = "(" + t + ")"
+		}
+		t += "[]"
+	default:
+		t = ref.named()
+		if bt, ok := tsBuiltinScalars[t]; ok {
+			t = bt
+		}
+	}
+
+	if nullable {
+		return t + " | null"
+	}
+	return t
+}
+
+// tsComment writes the doc comment with the given indentation.
+func tsComment(sb *strings.Builder, desc string, indent string) {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if desc == "" {
+		return
+	}
+	fmt.Fprintf(sb, "%s/** %s */\n", indent, strings.ReplaceAll(desc, "*/", "* /"))
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/onsi/gomega"
+)
+
+// update signals the golden files should be re-generated instead of compared;
+// use "go test ./cmd/sdkgen -update" after an intended change of the generated clients.
+var update = flag.Bool("update", false, "update the golden files of the generated clients")
+
+// loadTestSchema loads the test schema the golden files are generated from.
+func loadTestSchema(t *testing.T) *schema {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "schema.graphql"))
+	if err != nil {
+		t.Fatalf("can not read the test schema; %s", err.Error())
+	}
+
+	parsed, err := graphql.ParseSchema(string(src), nil)
+	if err != nil {
+		t.Fatalf("can not parse the test schema; %s", err.Error())
+	}
+
+	data, err := parsed.ToJSON()
+	if err != nil {
+		t.Fatalf("can not introspect the test schema; %s", err.Error())
+	}
+
+	sch, err := decodeSchema(data)
+	if err != nil {
+		t.Fatalf("can not decode the test schema; %s", err.Error())
+	}
+	return sch
+}
+
+// checkGolden compares the generated files with their golden copies.
+func checkGolden(t *testing.T, files map[string]string) {
+	g := gomega.NewGomegaWithT(t)
+	for name, content := range files {
+		path := filepath.Join("testdata", "golden", name+".golden")
+		if *update {
+			g.Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(gomega.Succeed())
+			g.Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(gomega.Succeed())
+			continue
+		}
+
+		golden, err := ioutil.ReadFile(path)
+		g.Expect(err).To(gomega.BeNil(), "golden file %s must exist", path)
+		g.Expect(content).To(gomega.Equal(string(golden)), "generated %s must match the golden file", name)
+	}
+}
+
+func TestGenerateGoGolden(t *testing.T) {
+	sch := loadTestSchema(t)
+	checkGolden(t, generateGo(sch, sch.operations()))
+}
+
+func TestGenerateTypeScriptGolden(t *testing.T) {
+	sch := loadTestSchema(t)
+	checkGolden(t, generateTypeScript(sch, sch.operations()))
+}
+
+func TestOperationsSkipUnknownFields(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	sch := loadTestSchema(t)
+
+	names := make([]string, 0)
+	for _, op := range sch.operations() {
+		names = append(names, op.Name)
+	}
+	g.Expect(names).To(gomega.Equal([]string{"CurrentEpoch", "Epoch", "Staker", "Stakers", "DelegationsOf", "Erc20Token", "ErcTokenBalance"}))
+}
+
+func TestLoadLiveSchemaApiKey(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// the server introspects the test schema for authenticated clients only
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "schema.graphql"))
+	g.Expect(err).To(gomega.BeNil())
+	data, err := graphql.MustParseSchema(string(src), nil).ToJSON()
+	g.Expect(err).To(gomega.BeNil())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret-key" {
+			http.Error(w, "access denied", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":` + string(data) + `}`))
+	}))
+	defer srv.Close()
+
+	_, err = loadLiveSchema(srv.URL, "")
+	g.Expect(err).ToNot(gomega.BeNil())
+
+	sch, err := loadLiveSchema(srv.URL, "secret-key")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(sch.Types).ToNot(gomega.BeEmpty())
+}
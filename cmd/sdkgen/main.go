@@ -0,0 +1,80 @@
+/*
+Package main implements the client SDK generator of the API server.
+
+The generator reads the GraphQL schema, either the one bundled with the API server build,
+or the live schema of a running API server obtained by introspection, and produces typed
+client packages for Go and TypeScript. The packages contain all the schema types and
+ready to use operations for staking, DeFi and token queries.
+
+Usage:
+
+	sdkgen [-url https://api.example.com/] [-api-key KEY] [-out build/sdk] [-lang go,ts]
+
+The API key is sent with the introspection request of the live schema, so servers
+requiring a key for introspection can be used; the AXIS_API_KEY environment variable
+is used if the flag is not set, so the key does not have to show up in the shell history.
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// apiKeyEnv represents the name of the environment variable with the API key of the live schema server.
+const apiKeyEnv = "AXIS_API_KEY"
+
+func main() {
+	url := flag.String("url", "", "URL of a running API server to load the live schema from; the bundled schema is used if empty")
+	apiKey := flag.String("api-key", os.Getenv(apiKeyEnv), "API key sent with the introspection of the live schema; "+apiKeyEnv+" environment variable is used if not set")
+	out := flag.String("out", filepath.Join("build", "sdk"), "output folder of the generated client packages")
+	lang := flag.String("lang", "go,ts", "comma separated list of client languages to generate")
+	flag.Parse()
+
+	// load the schema
+	var sch *schema
+	var err error
+	if *url != "" {
+		sch, err = loadLiveSchema(*url, *apiKey)
+	} else {
+		sch, err = loadBundledSchema()
+	}
+	if err != nil {
+		log.Fatalf("can not load the schema; %s", err.Error())
+	}
+
+	ops := sch.operations()
+	for _, l := range strings.Split(*lang, ",") {
+		var files map[string]string
+		switch strings.TrimSpace(l) {
+		case "go":
+			files = generateGo(sch, ops)
+		case "ts":
+			files = generateTypeScript(sch, ops)
+		default:
+			log.Fatalf("unknown client language %s", l)
+		}
+
+		if err := writeFiles(*out, files); err != nil {
+			log.Fatalf("can not write %s client; %s", l, err.Error())
+		}
+	}
+}
+
+// writeFiles writes the generated files into the output folder.
+func writeFiles(base string, files map[string]string) error {
+	for name, content := range files {
+		path := filepath.Join(base, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+		log.Printf("generated %s", path)
+	}
+	return nil
+}
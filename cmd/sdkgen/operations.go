@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// selectionMaxDepth represents the max depth of nested objects selected by generated operations.
+const selectionMaxDepth = 3
+
+// operationGroups lists the root query fields exposed by the generated clients, grouped by the domain.
+// Fields not available in the schema are skipped.
+var operationGroups = []struct {
+	name   string
+	fields []string
+}{
+	{name: "staking", fields: []string{
+		"currentEpoch", "epoch", "sfcConfig", "lastStakerId", "stakersNum", "staker", "stakers",
+		"delegation", "delegationsOf", "delegationsByAddress", "estimateRewards", "validatorsAt", "decentralization",
+	}},
+	{name: "defi", fields: []string{
+		"defiConfiguration", "defiTokens", "defiOverview", "defiNativeToken", "fMintAccount",
+		"fMintTokenAllowance", "defiUniswapPairs", "defiUniswapAmountsOut", "defiUniswapAmountsIn", "fLendLendingPool",
+	}},
+	{name: "tokens", fields: []string{
+		"erc20Token", "erc20TokenList", "erc20Assets", "ercTotalSupply", "ercTokenBalance", "ercTokenAllowance",
+		"erc721Contract", "erc721ContractList", "erc1155Contract", "erc1155ContractList",
+	}},
+}
+
+// operation represents a generated client operation calling a root query field.
+type operation struct {
+	Group    string
+	Name     string
+	Field    *schemaField
+	Document string
+}
+
+// operations builds the client operations of the known operation groups.
+func (sch *schema) operations() []*operation {
+	root := sch.byName[sch.QueryType.Name]
+	if root == nil {
+		return nil
+	}
+
+	fields := make(map[string]*schemaField, len(root.Fields))
+	for _, f := range root.Fields {
+		fields[f.Name] = f
+	}
+
+	list := make([]*operation, 0)
+	for _, g := range operationGroups {
+		for _, name := range g.fields {
+			f, ok := fields[name]
+			if !ok {
+				continue
+			}
+			list = append(list, &operation{
+				Group:    g.name,
+				Name:     strings.ToUpper(name[:1]) + name[1:],
+				Field:    f,
+				Document: sch.document(f),
+			})
+		}
+	}
+	return list
+}
+
+// document builds the GraphQL document of the operation calling the root field.
+func (sch *schema) document(f *schemaField) string {
+	var sb strings.Builder
+	sb.WriteString("query ")
+	sb.WriteString(strings.ToUpper(f.Name[:1]) + f.Name[1:])
+
+	// declare variables for all the field arguments
+	if len(f.Args) > 0 {
+		vars := make([]string, len(f.Args))
+		args := make([]string, len(f.Args))
+		for i, a := range f.Args {
+			vars[i] = fmt.Sprintf("$%s: %s", a.Name, a.Type.String())
+			args[i] = fmt.Sprintf("%s: $%s", a.Name, a.Name)
+		}
+		sb.WriteString("(" + strings.Join(vars, ", ") + ")")
+		sb.WriteString(" { " + f.Name + "(" + strings.Join(args, ", ") + ")")
+	} else {
+		sb.WriteString(" { " + f.Name)
+	}
+
+	sb.WriteString(sch.selection(f.Type.named(), 0))
+	sb.WriteString(" }")
+	return sb.String()
+}
+
+// selection builds the selection set of the given type. Scalar fields are selected
+// and objects are followed up to the max depth; fields with arguments are not selected
+// below the root level so the generated operations stay predictable in cost.
+func (sch *schema) selection(name string, depth int) string {
+	t := sch.byName[name]
+	if t == nil || (t.Kind != "OBJECT" && t.Kind != "INTERFACE") {
+		return ""
+	}
+
+	list := make([]string, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if len(f.Args) > 0 {
+			continue
+		}
+
+		ft := sch.byName[f.Type.named()]
+		if ft == nil {
+			continue
+		}
+
+		switch ft.Kind {
+		case "SCALAR", "ENUM":
+			list = append(list, f.Name)
+		case "OBJECT", "INTERFACE":
+			if depth+1 >= selectionMaxDepth {
+				continue
+			}
+			if sub := sch.selection(ft.Name, depth+1); sub != "" {
+				list = append(list, f.Name+sub)
+			}
+		}
+	}
+
+	if len(list) == 0 {
+		return ""
+	}
+	return " { " + strings.Join(list, " ") + " }"
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	gqlSchema "axis-graphql/internal/graphql/schema"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// introspectionQuery is the query used to load the live schema of an API server.
+const introspectionQuery = `query {
+  __schema {
+    queryType { name }
+    types {
+      kind name description
+      fields { name description args { ...InputValue } type { ...TypeRef } }
+      inputFields { ...InputValue }
+      enumValues { name description }
+    }
+  }
+}
+fragment InputValue on __InputValue { name description type { ...TypeRef } defaultValue }
+fragment TypeRef on __Type { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } }`
+
+// schema represents the introspected GraphQL schema.
+type schema struct {
+	QueryType struct {
+		Name string `json:"name"`
+	} `json:"queryType"`
+	Types []*schemaType `json:"types"`
+
+	byName map[string]*schemaType
+}
+
+// schemaType represents a named type of the schema.
+type schemaType struct {
+	Kind        string         `json:"kind"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Fields      []*schemaField `json:"fields"`
+	InputFields []*schemaInput `json:"inputFields"`
+	EnumValues  []struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"enumValues"`
+}
+
+// schemaField represents a field of an object type.
+type schemaField struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Args        []*schemaInput `json:"args"`
+	Type        *typeRef       `json:"type"`
+}
+
+// schemaInput represents an argument of a field, or a field of an input type.
+type schemaInput struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         *typeRef `json:"type"`
+	DefaultValue *string  `json:"defaultValue"`
+}
+
+// typeRef represents a reference to a type, possibly wrapped into lists and non-nulls.
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   *string  `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+// loadBundledSchema loads the schema the API server is built with.
+func loadBundledSchema() (*schema, error) {
+	sch, err := graphql.ParseSchema(gqlSchema.Schema(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := sch.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	return decodeSchema(data)
+}
+
+// loadLiveSchema loads the schema of a running API server by introspection.
+// The API key, if any, is sent in the X-Api-Key header of the request.
+func loadLiveSchema(url string, apiKey string) (*schema, error) {
+	body, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+
+	client := http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection failed with status %d; %s", resp.StatusCode, string(data))
+	}
+
+	var res struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Errors) > 0 {
+		return nil, fmt.Errorf("introspection failed; %s", res.Errors[0].Message)
+	}
+	return decodeSchema(res.Data)
+}
+
+// decodeSchema decodes the introspection result into the schema structure.
+func decodeSchema(data []byte) (*schema, error) {
+	var res struct {
+		Schema *schema `json:"__schema"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if res.Schema == nil {
+		return nil, fmt.Errorf("schema not found in the introspection result")
+	}
+
+	// index types by name; introspection types are not part of the client
+	sch := res.Schema
+	sch.byName = make(map[string]*schemaType, len(sch.Types))
+	list := sch.Types[:0]
+	for _, t := range sch.Types {
+		if strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		sch.byName[t.Name] = t
+		list = append(list, t)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	sch.Types = list
+	return sch, nil
+}
+
+// typesOf provides the list of schema types of the given kind.
+func (sch *schema) typesOf(kind string) []*schemaType {
+	list := make([]*schemaType, 0)
+	for _, t := range sch.Types {
+		if t.Kind == kind {
+			list = append(list, t)
+		}
+	}
+	return list
+}
+
+// named provides the name of the type referenced, unwrapping lists and non-nulls.
+func (ref *typeRef) named() string {
+	for ref.OfType != nil {
+		ref = ref.OfType
+	}
+	if ref.Name == nil {
+		return ""
+	}
+	return *ref.Name
+}
+
+// String provides the GraphQL notation of the referenced type, i.e. [Address!]!.
+func (ref *typeRef) String() string {
+	switch ref.Kind {
+	case "NON_NULL":
+		return ref.OfType.String() + "!"
+	case "LIST":
+		return "[" + ref.OfType.String() + "]"
+	}
+	return ref.named()
+}
+
+// isRequired checks if the input value must be provided.
+func (in *schemaInput) isRequired() bool {
+	return in.Type.Kind == "NON_NULL" && in.DefaultValue == nil
+}
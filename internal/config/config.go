@@ -22,6 +22,9 @@ type Config struct {
 	// Logger configuration
 	Log Log `mapstructure:"log"`
 
+	// Auth configuration
+	Auth Auth `mapstructure:"auth"`
+
 	// Lachesis represents the node structure
 	Lachesis Lachesis `mapstructure:"node"`
 
@@ -34,9 +37,15 @@ type Config struct {
 	// Cache configuration
 	Compiler Compiler `mapstructure:"compiler"`
 
+	// LoadShedding configuration
+	LoadShedding LoadShedding `mapstructure:"shedding"`
+
 	// Repository configuration
 	Repository Repository `mapstructure:"repository"`
 
+	// Network identity configuration
+	Network Network `mapstructure:"network"`
+
 	// Staking configuration
 	Staking Staking `mapstructure:"staking"`
 
@@ -46,6 +55,54 @@ type Config struct {
 	// Governance configuration
 	Governance Governance `mapstructure:"governance"`
 
+	// On-chain contract address registry configuration
+	Registry Registry `mapstructure:"registry"`
+
+	// Notifications configuration
+	Notify Notify `mapstructure:"notify"`
+
+	// Integrity checks configuration
+	Integrity Integrity `mapstructure:"integrity"`
+
+	// Compliance screening configuration
+	Compliance Compliance `mapstructure:"compliance"`
+
+	// Production hardening mode configuration
+	Production Production `mapstructure:"production"`
+
+	// Operations priority scheduling configuration
+	Scheduler Scheduler `mapstructure:"scheduler"`
+
+	// Playground configuration
+	Playground Playground `mapstructure:"playground"`
+
+	// Ethereum JSON-RPC facade configuration
+	EthRpc EthRpc `mapstructure:"eth_rpc"`
+
+	// Call traces export configuration
+	TraceExport TraceExport `mapstructure:"trace_export"`
+
+	// Asynchronous account transactions export configuration
+	AccountExport AccountExport `mapstructure:"account_export"`
+
+	// Public Server-Sent Events firehose configuration
+	Firehose Firehose `mapstructure:"firehose"`
+
+	// Sandbox API keys configuration
+	Sandbox Sandbox `mapstructure:"sandbox"`
+
+	// Websocket subscriptions configuration
+	Subscriptions Subscriptions `mapstructure:"subscriptions"`
+
+	// Off-chain data enrichment configuration
+	Enrichment Enrichment `mapstructure:"enrichment"`
+
+	// Localization of error messages and enumeration labels
+	Localization Localization `mapstructure:"localization"`
+
+	// Legacy field and argument names compatibility configuration
+	Compat Compat `mapstructure:"compat"`
+
 	// TokenLogoFilePath contains the path to JSON file with the map
 	// of known ERC20 tokens to their logo URLs.
 	// The file will be loaded on configuration loading.
@@ -63,6 +120,7 @@ type Config struct {
 type RepoCmd struct {
 	BlockScanReScan uint64
 	RestoreStake    string
+	Fixtures        string
 }
 
 // Server represents the GraphQL server configuration
@@ -77,6 +135,19 @@ type Server struct {
 	IdleTimeout     int64    `mapstructure:"idle_timeout"`
 	HeaderTimeout   int64    `mapstructure:"header_timeout"`
 	ResolverTimeout int64    `mapstructure:"resolver_timeout"`
+	Compress        bool     `mapstructure:"compress"`
+	MaxListSize     int32    `mapstructure:"max_list_size"`
+	MaxResponseSize int      `mapstructure:"max_response_size"`
+
+	// StaleAfter represents the time without a new block after which the data served
+	// are flagged as stale in the extensions of responses and subscription events. Zero disables the flag.
+	// Cacheable responses carrying the flag are not cached for longer than this time.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+
+	// TrustedProxies represents the addresses, or CIDR ranges, of reverse proxies
+	// allowed to pass the client address in the X-Forwarded-For header;
+	// the header of other peers is ignored.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 // ServerSignature represents the signature used by this server
@@ -88,15 +159,72 @@ type ServerSignature struct {
 
 // Log represents the logger configuration
 type Log struct {
-	Level  string `mapstructure:"level"`
-	Format string `mapstructure:"format"`
+	Level      string            `mapstructure:"level"`
+	Format     string            `mapstructure:"format"`
+	Backend    string            `mapstructure:"backend"`
+	File       string            `mapstructure:"file"`
+	Subsystems map[string]string `mapstructure:"subsystems"`
+}
+
+// Auth represents the API access control configuration.
+type Auth struct {
+	Keys []ApiKey `mapstructure:"keys"`
+}
+
+// ApiKey represents an API key granting access to restricted API calls.
+type ApiKey struct {
+	Key   string `mapstructure:"key"`
+	Name  string `mapstructure:"name"`
+	Admin bool   `mapstructure:"admin"`
+
+	// Sandbox signals a self-service sandbox key; it's never loaded from the configuration.
+	Sandbox bool `mapstructure:"-"`
 }
 
 // Lachesis represents the Lachesis node access configuration
 type Lachesis struct {
 	Url string `mapstructure:"url"`
+
+	// ReconnectDelay represents the delay before the first attempt to reconnect a broken node connection;
+	// it doubles with each failed attempt up to the ReconnectMaxDelay.
+	ReconnectDelay time.Duration `mapstructure:"reconnect_delay"`
+
+	// ReconnectMaxDelay represents the max delay between attempts to reconnect the node.
+	ReconnectMaxDelay time.Duration `mapstructure:"reconnect_max_delay"`
+
+	// PollMinInterval represents the shortest interval of new blocks polling used if the node
+	// does not support subscriptions, e.g. over HTTP; the interval adapts to the observed block time.
+	PollMinInterval time.Duration `mapstructure:"poll_min_interval"`
+
+	// PollMaxInterval represents the longest interval of new blocks polling.
+	PollMaxInterval time.Duration `mapstructure:"poll_max_interval"`
+
+	// BlockGap represents the max expected time between two new blocks; a longer gap
+	// signals the chain may be halted. Zero disables the detection.
+	BlockGap time.Duration `mapstructure:"block_gap"`
+
+	// FinalityDepth represents the number of blocks on top of a block required to consider it final.
+	// Lachesis blocks are final once created, so zero is fine unless extra safety margin is wanted.
+	FinalityDepth uint64 `mapstructure:"finality_depth"`
+
+	// CallBlock represents the block contract calls read the state of, unless a block is specified;
+	// one of CallBlockLatest, CallBlockSafe and CallBlockFinalized.
+	CallBlock string `mapstructure:"call_block"`
+
+	// VerifyHeaders signals the parent linkage, time stamps and the validator signature of the atropos
+	// event of received blocks are verified before indexing, so inconsistent data of a misbehaving node
+	// are rejected. Lachesis headers do not carry signatures, the atropos event sealing the block does.
+	VerifyHeaders bool `mapstructure:"verify_headers"`
 }
 
+// Block tags of the default contract calls. The safe block lags the observed head
+// by a half of the finality depth, the finalized block by the full finality depth.
+const (
+	CallBlockLatest    = "latest"
+	CallBlockSafe      = "safe"
+	CallBlockFinalized = "finalized"
+)
+
 // Database represents the database access configuration.
 type Database struct {
 	Url    string `mapstructure:"url"`
@@ -107,6 +235,26 @@ type Database struct {
 type Cache struct {
 	Eviction time.Duration `mapstructure:"eviction"`
 	MaxSize  int           `mapstructure:"size"`
+	Bus      CacheBus      `mapstructure:"bus"`
+}
+
+// CacheBus represents the configuration of the cache invalidation bus shared
+// by API replicas running behind a load balancer.
+type CacheBus struct {
+	// Redis is the address of the Redis server used for the pub/sub,
+	// e.g. "localhost:6379"; the bus is disabled if empty.
+	Redis    string `mapstructure:"redis"`
+	Password string `mapstructure:"password"`
+	Channel  string `mapstructure:"channel"`
+}
+
+// LoadShedding represents the configuration of low priority queries
+// shedding applied when the connected node is under pressure.
+type LoadShedding struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	MaxLatency    time.Duration `mapstructure:"max_latency"`
+	MaxErrorRate  float64       `mapstructure:"max_error_rate"`
+	ProbeInterval time.Duration `mapstructure:"probe_interval"`
 }
 
 // Compiler represents the contract compilers configuration.
@@ -120,12 +268,42 @@ type Repository struct {
 	MonitorStakers bool `mapstructure:"stakers"`
 }
 
+// Network represents the identity of the blockchain network served by the API.
+type Network struct {
+	// Name represents the human readable name of the network.
+	Name string `mapstructure:"name"`
+
+	// Symbol represents the symbol of the native token of the network.
+	Symbol string `mapstructure:"symbol"`
+
+	// Decimals represents the number of decimals of the native token.
+	Decimals int32 `mapstructure:"decimals"`
+}
+
 // Staking represents the PoS Staking module configuration.
 type Staking struct {
 	SFCContract         common.Address `mapstructure:"sfc"`
 	StiContract         common.Address `mapstructure:"sti"`
 	TokenizerContract   common.Address `mapstructure:"tokenizer"`
 	TokenizedStakeToken common.Address `mapstructure:"token"`
+
+	// DustThreshold represents the amount of native tokens below which an active
+	// delegation, or its pending rewards, is considered dust not worth the transaction fee.
+	DustThreshold float64 `mapstructure:"dust_threshold"`
+}
+
+// Registry represents the configuration of the on-chain address registry resolving addresses
+// of the core contracts on startup instead of the static configuration. The registry implements
+// the fMint AddressProvider interface; contracts not registered keep the configured address.
+type Registry struct {
+	// Address represents the address of the registry contract; empty disables the discovery.
+	Address common.Address `mapstructure:"address"`
+
+	// Interval represents the period of re-resolution of the addresses; zero resolves them on startup only.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Webhook represents the URL receiving alerts on changed addresses; empty disables the alerts.
+	Webhook string `mapstructure:"webhook"`
 }
 
 // DeFi represents the DeFi and financial contracts configuration.
@@ -134,11 +312,31 @@ type DeFi struct {
 	Uniswap      DeFiUniswap `mapstructure:"uniswap"`
 	FLend        DeFiFLend   `mapstructure:"flend"`
 	PriceSymbols []string    `mapstructure:"symbols"`
+
+	// TwapWindow represents the window of the AMM TWAP price used if the price oracle
+	// lacks the price of a token; zero disables the fallback.
+	TwapWindow time.Duration `mapstructure:"twap_window"`
+
+	// TwapMinLiquidity represents the minimal value of the priced side of an AMM pair reserves
+	// in the reference denomination for the pair to be used for the TWAP price.
+	TwapMinLiquidity float64 `mapstructure:"twap_min_liquidity"`
+
+	// PriceStreamInterval represents the minimal interval between two token price updates
+	// pushed to an onPrice subscriber; subscribers may ask for longer intervals only.
+	PriceStreamInterval time.Duration `mapstructure:"price_stream_interval"`
 }
 
 // DeFiFMint represents the fMint DeFi module configuration.
 type DeFiFMint struct {
 	AddressProvider common.Address `mapstructure:"address_provider"`
+
+	// SnapshotInterval represents the period of snapshots of open fMint positions
+	// kept for the position health history; zero disables the snapshots.
+	SnapshotInterval time.Duration `mapstructure:"snapshot_interval"`
+
+	// SnapshotOnEpoch signals the positions are snapshot on each sealed epoch instead;
+	// the snapshot interval is the period of the sealed epoch checks.
+	SnapshotOnEpoch bool `mapstructure:"snapshot_on_epoch"`
 }
 
 // DeFiUniswap represents the Uniswap protocol DeFi module configuration.
@@ -166,3 +364,329 @@ type GovernanceContract struct {
 type DeFiFLend struct {
 	LendingPool common.Address `mapstructure:"lending_pool"`
 }
+
+// Notify represents the configuration of notifications sent to external services.
+type Notify struct {
+	Webhooks       Webhooks       `mapstructure:"webhooks"`
+	RewardReminder RewardReminder `mapstructure:"rewards"`
+	Collateral     Collateral     `mapstructure:"collateral"`
+	Balance        BalanceAlerts  `mapstructure:"balance"`
+	NodeAlert      NodeAlert      `mapstructure:"node"`
+}
+
+// Webhooks represents the configuration of webhook notifications delivery.
+type Webhooks struct {
+	// Secret represents the key used to sign webhook payloads using HMAC-SHA256.
+	Secret string `mapstructure:"secret"`
+
+	// MaxAttempts represents the number of delivery attempts before the notification is dead-lettered.
+	MaxAttempts int `mapstructure:"attempts"`
+
+	// RetryDelay represents the delay before the first retry; it doubles with each failed attempt.
+	RetryDelay time.Duration `mapstructure:"retry_delay"`
+
+	// Timeout represents the max duration of a single delivery attempt.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Workers represents the number of deliveries performed in parallel.
+	Workers int `mapstructure:"workers"`
+}
+
+// RewardReminder represents the configuration of reminders sent when pending
+// rewards of a watched delegation exceed the given threshold. The delegations
+// of the watch list are always watched, admins can watch others at runtime.
+type RewardReminder struct {
+	Webhook   string              `mapstructure:"webhook"`
+	Interval  time.Duration       `mapstructure:"interval"`
+	Threshold float64             `mapstructure:"threshold"`
+	Watch     []WatchedDelegation `mapstructure:"watch"`
+}
+
+// Collateral represents the configuration of the fMint liquidation monitor
+// watching collateral ratio of accounts with registered alerts.
+type Collateral struct {
+	// Interval represents the period of the collateral ratio checks; zero disables the monitor.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// BalanceAlerts represents the configuration of the balance monitor watching native
+// and token balances of accounts with registered alerts as the accounts are touched by new blocks.
+type BalanceAlerts struct {
+	// Refresh represents the period of reloading the registered alerts; zero disables the monitor.
+	Refresh time.Duration `mapstructure:"refresh"`
+}
+
+// NodeAlert represents the configuration of operator alerts
+// sent when the node connection is lost and restored,
+// and when the chain stops producing blocks and resumes.
+type NodeAlert struct {
+	// Webhook represents the URL receiving the alerts; empty disables the alerts.
+	Webhook string `mapstructure:"webhook"`
+}
+
+// WatchedDelegation represents a delegation observed by the notification services.
+type WatchedDelegation struct {
+	Address     common.Address `mapstructure:"address"`
+	ValidatorID uint64         `mapstructure:"validator"`
+}
+
+// Integrity represents the configuration of data integrity checks.
+type Integrity struct {
+	// Interval represents the period of the epoch rewards check; zero disables the check.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Depth represents the number of recent sealed epochs checked on server start.
+	Depth uint64 `mapstructure:"depth"`
+
+	// Tolerance represents the acceptable relative difference
+	// between expected and distributed epoch rewards.
+	Tolerance float64 `mapstructure:"tolerance"`
+
+	// TxRewardShare represents the share of epoch fees distributed
+	// to validators as transaction rewards; the rest is burnt, or sent to treasury.
+	TxRewardShare float64 `mapstructure:"tx_reward_share"`
+
+	// SampleInterval represents the period of the index sampling check
+	// comparing indexed blocks with the node; zero disables the check.
+	SampleInterval time.Duration `mapstructure:"sample_interval"`
+
+	// SampleSize represents the number of randomly picked blocks checked in each sampling run.
+	SampleSize int `mapstructure:"sample_size"`
+}
+
+// Compliance represents the configuration of the optional compliance hook
+// screening addresses against a list of sanctioned, or otherwise flagged addresses.
+type Compliance struct {
+	// Url represents the end-point of an external screening service; empty disables the service.
+	Url string `mapstructure:"url"`
+
+	// ApiKey represents the access key sent to the external screening service, if any.
+	ApiKey string `mapstructure:"api_key"`
+
+	// ListFile represents the path to a JSON file with local list of flagged addresses
+	// mapped to their risk category; it's used if no external service is configured.
+	ListFile string `mapstructure:"list"`
+
+	// Timeout represents the max duration of a single screening service call.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// CacheTTL represents the duration a screening result is kept before the address is screened again.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// Production represents the configuration of the production hardening mode.
+// If enabled, introspection and GraphiQL are not available to unauthenticated clients,
+// their queries are limited to the persisted queries allow list, if any,
+// and the size of API responses is capped.
+type Production struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// AllowListFile represents the path to a JSON file with the persisted queries
+	// allowed for unauthenticated clients mapped by SHA256 hash of the query.
+	AllowListFile string `mapstructure:"allowlist"`
+
+	// MaxResponseSize represents the max size of an API response in bytes.
+	MaxResponseSize int `mapstructure:"max_response"`
+
+	// AllowList is the list of persisted queries keyed by the hex encoded SHA256 hash
+	// of the query. The list will be loaded on configuration loading.
+	AllowList map[string]string
+}
+
+// Scheduler represents the configuration of the priority scheduling of GraphQL operations.
+// Operations are classified into cheap reads, heavy lists and aggregations, and bulk exports;
+// each class is executed by its own pool of workers so heavy operations can not starve cheap ones.
+type Scheduler struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// CoreWorkers represents the number of operations of wallet-critical cheap reads executed in parallel.
+	CoreWorkers int `mapstructure:"core_workers"`
+
+	// HeavyWorkers represents the number of heavy list and aggregation operations executed in parallel.
+	HeavyWorkers int `mapstructure:"heavy_workers"`
+
+	// ExportWorkers represents the number of bulk export operations executed in parallel.
+	ExportWorkers int `mapstructure:"export_workers"`
+
+	// QueueSize represents the max number of operations of a class waiting for a worker.
+	QueueSize int `mapstructure:"queue_size"`
+
+	// QueueTimeout represents the max time an operation waits for a worker before it's rejected.
+	QueueTimeout time.Duration `mapstructure:"queue_timeout"`
+}
+
+// Playground represents the configuration of the GraphiQL playground
+// served with a library of example queries.
+type Playground struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path represents the URL path the playground is served on.
+	Path string `mapstructure:"path"`
+
+	// ExamplesDir represents the path to a directory with additional example queries
+	// added to the library of the playground.
+	ExamplesDir string `mapstructure:"examples"`
+}
+
+// EthRpc represents the configuration of the limited Ethereum JSON-RPC facade
+// served from the API index, so legacy tools don't need access to the node.
+type EthRpc struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path represents the URL path the JSON-RPC facade is served on.
+	Path string `mapstructure:"path"`
+
+	// MaxBlockRange represents the max range of blocks of a single logs query.
+	MaxBlockRange uint64 `mapstructure:"max_block_range"`
+
+	// MaxLogs represents the max number of log records returned by a logs query.
+	MaxLogs int `mapstructure:"max_logs"`
+}
+
+// TraceExport represents the configuration of the bulk export of call traces
+// available to admin API keys; the connected node must have the tracing enabled.
+type TraceExport struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path represents the URL path the traces export is served on.
+	Path string `mapstructure:"path"`
+
+	// MaxBlockRange represents the max range of blocks of a single export.
+	MaxBlockRange uint64 `mapstructure:"max_block_range"`
+}
+
+// AccountExport represents the configuration of asynchronous exports of account transactions.
+// Export files are built in the background and downloaded by signed URLs.
+type AccountExport struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path represents the URL path prefix export files are downloaded from.
+	Path string `mapstructure:"path"`
+
+	// BaseUrl represents the public URL of the API server used to build download links;
+	// relative links are provided if empty.
+	BaseUrl string `mapstructure:"base_url"`
+
+	// Secret represents the key signing download URLs; it's required if exports are enabled.
+	// Export files are kept in the database, servers sharing the database must share the secret,
+	// so exports may be built and downloaded on any of them.
+	Secret string `mapstructure:"secret"`
+
+	// Retention represents the duration a finished export is available for download.
+	Retention time.Duration `mapstructure:"retention"`
+
+	// Workers represents the max number of exports built in parallel.
+	Workers int `mapstructure:"workers"`
+
+	// MaxRows represents the max number of transactions in a single export; zero means no limit.
+	MaxRows uint64 `mapstructure:"max_rows"`
+}
+
+// Firehose represents the configuration of the public stream of new blocks and large transactions
+// served as Server-Sent Events to clients not able to maintain websocket subscriptions.
+type Firehose struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path represents the URL path the stream is served on.
+	Path string `mapstructure:"path"`
+
+	// MinTrxValue represents the amount of native tokens a transaction has to transfer
+	// to be included in the stream.
+	MinTrxValue float64 `mapstructure:"min_trx_value"`
+
+	// ConnectsPerMinute represents the max number of streams a client address can open in a minute.
+	ConnectsPerMinute int `mapstructure:"connects_per_minute"`
+
+	// MaxStreamsPerAddr represents the max number of concurrent streams of a client address.
+	MaxStreamsPerAddr int `mapstructure:"max_streams_per_addr"`
+
+	// MaxStreams represents the max number of concurrent streams of all the clients.
+	MaxStreams int `mapstructure:"max_streams"`
+}
+
+// Subscriptions represents the configuration of access to websocket subscriptions.
+type Subscriptions struct {
+	// RequireKey signals subscriptions are available to clients authenticated by an API key only.
+	RequireKey bool `mapstructure:"require_key"`
+
+	// MaxPerKey represents the max number of concurrent subscriptions of an API key;
+	// zero disables the limit.
+	MaxPerKey int `mapstructure:"max_per_key"`
+
+	// MaxEventsPerMinute represents the max number of events pushed to all the subscriptions
+	// of an API key in a minute; events over the limit are dropped. Zero disables the limit.
+	MaxEventsPerMinute int `mapstructure:"max_events_per_minute"`
+}
+
+// Sandbox represents the configuration of self-service sandbox API keys
+// developers can mint without operator involvement to prototype against the API.
+type Sandbox struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// RateLimit represents the max number of requests per minute of a sandbox key.
+	RateLimit int `mapstructure:"rate_limit"`
+
+	// KeyTTL represents the duration a sandbox key is valid for.
+	KeyTTL time.Duration `mapstructure:"key_ttl"`
+
+	// MaxKeysPerClient represents the max number of valid sandbox keys
+	// minted from a single client address.
+	MaxKeysPerClient int `mapstructure:"max_keys_per_client"`
+
+	// AnonymousRateLimit represents the max number of requests per minute of a client address
+	// without an API key; anonymous clients never get more than the sandbox rate limit.
+	AnonymousRateLimit int `mapstructure:"anonymous_rate_limit"`
+}
+
+// Enrichment represents the configuration of the off-chain data enrichers
+// decorating accounts and contracts with external data.
+type Enrichment struct {
+	// Timeout represents the max duration of a single enricher call.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// CacheTTL represents the duration enrichment data are kept before they are refreshed.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+
+	// Workers represents the max number of enrichment refreshes running in parallel.
+	Workers int `mapstructure:"workers"`
+}
+
+// Localization represents the configuration of localized error messages and enumeration labels.
+// Each language is provided by a JSON catalog file named by the language code, e.g. de.json.
+type Localization struct {
+	// Dir represents the directory of the language catalogs; empty value disables the localization.
+	Dir string `mapstructure:"dir"`
+
+	// Default represents the language used if none of the languages accepted by the client is available.
+	Default string `mapstructure:"default"`
+}
+
+// Compat represents the compatibility layer mapping legacy field and argument names
+// of incoming operations to the current ones, so schema refactors can roll out
+// without breaking existing clients. Clients are warned about the legacy names used.
+type Compat struct {
+	// Fields represents the renamed fields.
+	Fields []CompatName `mapstructure:"fields"`
+
+	// Arguments represents the renamed arguments.
+	Arguments []CompatName `mapstructure:"arguments"`
+}
+
+// CompatName represents a legacy name mapped to its current name.
+type CompatName struct {
+	// Type represents the type the renamed field, or the field of the renamed argument, belongs to;
+	// the name is renamed on all types if empty.
+	Type string `mapstructure:"type"`
+
+	// Field represents the current name of the field the renamed argument belongs to.
+	Field string `mapstructure:"field"`
+
+	// Legacy represents the name used by existing clients.
+	Legacy string `mapstructure:"legacy"`
+
+	// Current represents the name used by the schema.
+	Current string `mapstructure:"current"`
+
+	// Sunset represents the date the legacy name stops being supported, if planned.
+	Sunset string `mapstructure:"sunset"`
+}
@@ -31,6 +31,16 @@ const (
 	defHeaderTimeout   = 1
 	defResolverTimeout = 30
 
+	// defMaxListSize holds the default max number of items a list can be requested with;
+	// requests over the limit are rejected with pagination hints
+	defMaxListSize = 1000
+
+	// defMaxResponseSize holds the default max size of a single operation response in bytes
+	defMaxResponseSize = 8 << 20
+
+	// defStaleAfter holds the default time without a new block after which responses are flagged stale
+	defStaleAfter = 60 * time.Second
+
 	// defServerDomain holds default API server domain address
 	defServerDomain = "localhost:16761"
 
@@ -42,9 +52,34 @@ const (
 	// defLoggingFormat holds default format of the Logger output
 	defLoggingFormat = "%{color}%{level:-8s} %{shortpkg}/%{shortfunc}%{color:reset}: %{message}"
 
+	// defLoggingBackend holds default backend of the Logger output
+	defLoggingBackend = "stderr"
+
 	// defLachesisUrl holds default Lachesis connection string
 	defLachesisUrl = "\\\\.\\pipe\\galaxy.ipc" // ~/.lachesis/data/lachesis.ipc
 
+	// defLachesisReconnectDelay holds default delay before the first node reconnect attempt
+	defLachesisReconnectDelay = time.Second
+
+	// defLachesisReconnectMaxDelay holds default max delay between node reconnect attempts
+	defLachesisReconnectMaxDelay = time.Minute
+
+	// defLachesisPollMinInterval holds default shortest interval of new blocks polling
+	defLachesisPollMinInterval = 250 * time.Millisecond
+
+	// defLachesisPollMaxInterval holds default longest interval of new blocks polling
+	defLachesisPollMaxInterval = 5 * time.Second
+
+	// defLachesisBlockGap holds default max expected time between two new blocks
+	defLachesisBlockGap = 30 * time.Second
+
+	// defLachesisFinalityDepth holds default number of blocks required on top of a final block;
+	// Lachesis blocks are final on creation
+	defLachesisFinalityDepth = 0
+
+	// defLachesisCallBlock holds default block tag of contract calls
+	defLachesisCallBlock = CallBlockLatest
+
 	// defMongoUrl holds default MongoDB connection string
 	defMongoUrl = "mongodb://localhost:27017"
 
@@ -57,6 +92,20 @@ const (
 	// defCacheMax size represents the default max size of the cache in MB
 	defCacheMaxSize = 4096
 
+	// defCacheBusChannel represents the default pub/sub channel of the cache invalidation bus
+	defCacheBusChannel = "axis-graphql:invalidate"
+
+	// defLoadSheddingMaxLatency represents the default node latency
+	// above which low priority queries are rejected
+	defLoadSheddingMaxLatency = 2 * time.Second
+
+	// defLoadSheddingMaxErrorRate represents the default ratio of failed node calls
+	// above which low priority queries are rejected
+	defLoadSheddingMaxErrorRate = 0.25
+
+	// defLoadSheddingProbeInterval represents the default interval of node latency probes
+	defLoadSheddingProbeInterval = 5 * time.Second
+
 	// defSolCompilerPath represents the default SOL compiler path
 	defSolCompilerPath = "/usr/bin/solc"
 
@@ -70,18 +119,197 @@ const (
 	// defStiContract holds deployment address of the Staker Info smart contract.
 	defStiContract = "0x92ffad75b8a942d149621a39502cdd8ad1dd57b4"
 
+	// defStakingDustThreshold represents the default amount of native tokens
+	// below which a delegation or its pending rewards are considered dust.
+	defStakingDustThreshold = 1.0
+
 	// defDefiFMintAddressProvider represents the address of the fMintAddressProvider
 	defDefiFMintAddressProvider = "0x730e27f6c52d07b1a6ab39b639b617dc566c91af"
 
+	// defDefiFMintSnapshotInterval represents the default period of fMint position snapshots
+	defDefiFMintSnapshotInterval = time.Hour
+
 	// defDefiFMintAddressProvider represents the address of the fMintAddressProvider
 	defDefiUniswapCore = EmptyAddress
 
 	// defDefiFMintAddressProvider represents the address of the fMintAddressProvider
 	defDefiUniswapRouter = EmptyAddress
 
+	// defDefiTwapWindow represents the default window of the AMM TWAP fallback price
+	defDefiTwapWindow = time.Hour
+
+	// defDefiTwapMinLiquidity represents the default minimal liquidity of an AMM pair used for the TWAP price
+	defDefiTwapMinLiquidity = 10000.0
+
+	// defDefiPriceStreamInterval represents the default minimal interval of token price subscription updates
+	defDefiPriceStreamInterval = 5 * time.Second
+
 	// defTokenLogoFilePath represents the default path to the tokens map file
 	defTokenLogoFilePath = "tokens.json"
 
+	// defWebhookAttempts represents the default number of webhook delivery attempts
+	defWebhookAttempts = 6
+
+	// defWebhookRetryDelay represents the default delay before the first webhook delivery retry
+	defWebhookRetryDelay = 30 * time.Second
+
+	// defWebhookTimeout represents the default max duration of a webhook delivery attempt
+	defWebhookTimeout = 10 * time.Second
+
+	// defWebhookWorkers represents the default number of parallel webhook deliveries
+	defWebhookWorkers = 4
+
+	// defRewardReminderInterval represents the default interval
+	// of pending rewards checks on watched delegations
+	defRewardReminderInterval = 6 * time.Hour
+
+	// defRewardReminderThreshold represents the default amount of pending rewards
+	// in AXIS tokens triggering the claim reminder
+	defRewardReminderThreshold = 100.0
+
+	// defCollateralMonitorInterval represents the default interval
+	// of collateral ratio checks on accounts with registered alerts
+	defCollateralMonitorInterval = time.Minute
+
+	// defBalanceMonitorRefresh represents the default interval
+	// of reloading registered balance alerts
+	defBalanceMonitorRefresh = time.Minute
+
+	// defComplianceTimeout represents the default max duration of a screening service call
+	defComplianceTimeout = 5 * time.Second
+
+	// defComplianceCacheTTL represents the default duration a screening result is cached
+	defComplianceCacheTTL = time.Hour
+
+	// defSchedulerCoreWorkers represents the default number of cheap read operations executed in parallel
+	defSchedulerCoreWorkers = 64
+
+	// defSchedulerHeavyWorkers represents the default number of heavy operations executed in parallel
+	defSchedulerHeavyWorkers = 8
+
+	// defSchedulerExportWorkers represents the default number of export operations executed in parallel
+	defSchedulerExportWorkers = 2
+
+	// defSchedulerQueueSize represents the default max number of operations of a class waiting for a worker
+	defSchedulerQueueSize = 256
+
+	// defSchedulerQueueTimeout represents the default max time an operation waits for a worker
+	defSchedulerQueueTimeout = 10 * time.Second
+
+	// defProductionMaxResponse represents the default max size of an API response
+	// in production hardening mode
+	defProductionMaxResponse = 4 * 1024 * 1024
+
+	// defPlaygroundPath represents the default URL path of the GraphiQL playground
+	defPlaygroundPath = "/graphi"
+
+	// defEthRpcPath represents the default URL path of the Ethereum JSON-RPC facade
+	defEthRpcPath = "/rpc"
+
+	// defEthRpcMaxBlockRange represents the default max range of blocks of a logs query
+	defEthRpcMaxBlockRange = 5000
+
+	// defEthRpcMaxLogs represents the default max number of log records of a logs query
+	defEthRpcMaxLogs = 10000
+
+	// defTraceExportPath represents the default URL path of the call traces export
+	defTraceExportPath = "/export/traces"
+
+	// defTraceExportMaxBlockRange represents the default max range of blocks of a traces export
+	defTraceExportMaxBlockRange = 1000
+
+	// defAccountExportPath represents the default URL path prefix of account export downloads
+	defAccountExportPath = "/export/account/"
+
+	// defAccountExportRetention represents the default duration a finished account export is available
+	defAccountExportRetention = 24 * time.Hour
+
+	// defAccountExportWorkers represents the default max number of account exports built in parallel
+	defAccountExportWorkers = 2
+
+	// defAccountExportMaxRows represents the default max number of transactions of an account export
+	defAccountExportMaxRows = 10000000
+
+	// defFirehosePath represents the default URL path of the public events firehose
+	defFirehosePath = "/firehose"
+
+	// defFirehoseMinTrxValue represents the default amount of native tokens of a transaction streamed by the firehose
+	defFirehoseMinTrxValue = 10000.0
+
+	// defFirehoseConnectsPerMinute represents the default max number of firehose streams opened by a client in a minute
+	defFirehoseConnectsPerMinute = 10
+
+	// defFirehoseMaxStreamsPerAddr represents the default max number of concurrent firehose streams of a client
+	defFirehoseMaxStreamsPerAddr = 2
+
+	// defFirehoseMaxStreams represents the default max number of concurrent firehose streams of all the clients
+	defFirehoseMaxStreams = 1000
+
+	// defSandboxRateLimit represents the default max number of requests per minute of a sandbox key
+	defSandboxRateLimit = 60
+
+	// defSandboxKeyTTL represents the default validity of a sandbox key
+	defSandboxKeyTTL = 7 * 24 * time.Hour
+
+	// defSandboxMaxKeysPerClient represents the default max number of valid sandbox keys of a client
+	defSandboxMaxKeysPerClient = 3
+
+	// defSandboxAnonRateLimit represents the default max number of requests per minute of an anonymous client
+	defSandboxAnonRateLimit = 30
+
+	// defSubscriptionsRequireKey signals websocket subscriptions require an API key by default
+	defSubscriptionsRequireKey = true
+
+	// defSubscriptionsMaxPerKey represents the default max number of concurrent subscriptions of an API key
+	defSubscriptionsMaxPerKey = 20
+
+	// defSubscriptionsMaxEventsPerMinute represents the default max number of events
+	// pushed to subscriptions of an API key in a minute
+	defSubscriptionsMaxEventsPerMinute = 6000
+
+	// defEnrichmentTimeout represents the default max duration of an enricher call
+	defEnrichmentTimeout = 5 * time.Second
+
+	// defEnrichmentCacheTTL represents the default duration enrichment data are cached
+	defEnrichmentCacheTTL = time.Hour
+
+	// defEnrichmentWorkers represents the default max number of parallel enrichment refreshes
+	defEnrichmentWorkers = 8
+
+	// defLocalizationDefault represents the default language of error messages and enumeration labels
+	defLocalizationDefault = "en"
+
+	// defRegistryInterval represents the default period of contract addresses re-resolution from the on-chain registry
+	defRegistryInterval = 10 * time.Minute
+
+	// defIntegrityInterval represents the default period of epoch rewards integrity check
+	defIntegrityInterval = 10 * time.Minute
+
+	// defIntegrityDepth represents the default number of recent epochs checked on start
+	defIntegrityDepth = 10
+
+	// defIntegrityTolerance represents the default acceptable relative rewards difference
+	defIntegrityTolerance = 0.01
+
+	// defIntegrityTxRewardShare represents the default share of epoch fees
+	// distributed as rewards; 20% of fees is burnt and 10% goes to treasury
+	defIntegrityTxRewardShare = 0.7
+
+	// defIntegritySampleInterval represents the default period of the index sampling check
+	defIntegritySampleInterval = 15 * time.Minute
+
+	// defIntegritySampleSize represents the default number of blocks checked in a sampling run
+	defIntegritySampleSize = 20
+
+	// defNetworkName represents the default name of the network served by the API
+	defNetworkName = "Axis MainNet"
+
+	// defNetworkSymbol represents the default symbol of the native token
+	defNetworkSymbol = "AXIS"
+
+	// defNetworkDecimals represents the default number of decimals of the native token
+	defNetworkDecimals = 18
+
 	// defBlockScanRescanDepth represents the amount of blocks re-scanned on server start
 	defBlockScanRescanDepth = 200
 )
@@ -89,6 +317,9 @@ const (
 // default list of API peers
 var defApiPeers = []string{"https://localhost:16761/api"}
 
+// defTrustedProxies holds the default list of trusted reverse proxies; none is trusted.
+var defTrustedProxies = []string{}
+
 // defCorsAllowOrigins holds CORS default allowed origins.
 var defCorsAllowOrigins = []string{"*"}
 
@@ -110,11 +341,20 @@ func applyDefaults(cfg *viper.Viper) {
 	cfg.SetDefault(keySignaturePrivateKey, defSelfPrivateKey)
 	cfg.SetDefault(keyLoggingLevel, defLoggingLevel)
 	cfg.SetDefault(keyLoggingFormat, defLoggingFormat)
+	cfg.SetDefault(keyLoggingBackend, defLoggingBackend)
 	cfg.SetDefault(keyLachesisUrl, defLachesisUrl)
+	cfg.SetDefault(keyLachesisReconnectDelay, defLachesisReconnectDelay)
+	cfg.SetDefault(keyLachesisReconnectMaxDelay, defLachesisReconnectMaxDelay)
+	cfg.SetDefault(keyLachesisPollMinInterval, defLachesisPollMinInterval)
+	cfg.SetDefault(keyLachesisPollMaxInterval, defLachesisPollMaxInterval)
+	cfg.SetDefault(keyLachesisBlockGap, defLachesisBlockGap)
+	cfg.SetDefault(keyLachesisFinalityDepth, defLachesisFinalityDepth)
+	cfg.SetDefault(keyLachesisCallBlock, defLachesisCallBlock)
 	cfg.SetDefault(keyMongoUrl, defMongoUrl)
 	cfg.SetDefault(keyMongoDatabase, defMongoDatabase)
 	cfg.SetDefault(keySolCompilerPath, defSolCompilerPath)
 	cfg.SetDefault(keyApiPeers, defApiPeers)
+	cfg.SetDefault(keyTrustedProxies, defTrustedProxies)
 	cfg.SetDefault(keyApiStateOrigin, defApiStateOrigin)
 	cfg.SetDefault(keyErc20TokenMapFilePath, defTokenLogoFilePath)
 	cfg.SetDefault(keyErc20Logos, defERC20Logo)
@@ -122,6 +362,13 @@ func applyDefaults(cfg *viper.Viper) {
 	// in-memory cache
 	cfg.SetDefault(keyCacheEvictionTime, defCacheEvictionTime)
 	cfg.SetDefault(keyCacheMaxSize, defCacheMaxSize)
+	cfg.SetDefault(keyCacheBusChannel, defCacheBusChannel)
+
+	// load shedding
+	cfg.SetDefault(keyLoadSheddingEnabled, true)
+	cfg.SetDefault(keyLoadSheddingMaxLatency, defLoadSheddingMaxLatency)
+	cfg.SetDefault(keyLoadSheddingMaxErrorRate, defLoadSheddingMaxErrorRate)
+	cfg.SetDefault(keyLoadSheddingProbeInterval, defLoadSheddingProbeInterval)
 
 	// server timeouts
 	cfg.SetDefault(keyTimeoutRead, defReadTimeout)
@@ -130,20 +377,119 @@ func applyDefaults(cfg *viper.Viper) {
 	cfg.SetDefault(keyTimeoutIdle, defIdleTimeout)
 	cfg.SetDefault(keyTimeoutResolver, defResolverTimeout)
 
+	// response size limits
+	cfg.SetDefault(keyMaxListSize, defMaxListSize)
+	cfg.SetDefault(keyMaxResponseSize, defMaxResponseSize)
+
+	// stale data flag
+	cfg.SetDefault(keyStaleAfter, defStaleAfter)
+
 	// no voting sources by default
 	cfg.SetDefault(keyVotingSources, defVotingSources)
 
 	// cors
 	cfg.SetDefault(keyCorsAllowOrigins, defCorsAllowOrigins)
+	cfg.SetDefault(keyCompress, true)
 
 	// staking configuration defaults
 	cfg.SetDefault(keyStakingSfcContract, defSfcContract)
 	cfg.SetDefault(keyStakingStiContract, defStiContract)
 	cfg.SetDefault(keyStakingTokenizerContract, EmptyAddress)
 	cfg.SetDefault(keyStakingERC20Token, EmptyAddress)
+	cfg.SetDefault(keyStakingDustThreshold, defStakingDustThreshold)
+
+	// on-chain address registry is disabled by default
+	cfg.SetDefault(keyRegistryAddress, EmptyAddress)
+	cfg.SetDefault(keyRegistryInterval, defRegistryInterval)
+
+	// notifications
+	cfg.SetDefault(keyNotifyWebhooksAttempts, defWebhookAttempts)
+	cfg.SetDefault(keyNotifyWebhooksRetryDelay, defWebhookRetryDelay)
+	cfg.SetDefault(keyNotifyWebhooksTimeout, defWebhookTimeout)
+	cfg.SetDefault(keyNotifyWebhooksWorkers, defWebhookWorkers)
+	cfg.SetDefault(keyNotifyRewardsInterval, defRewardReminderInterval)
+	cfg.SetDefault(keyNotifyRewardsThreshold, defRewardReminderThreshold)
+	cfg.SetDefault(keyNotifyCollateralInterval, defCollateralMonitorInterval)
+	cfg.SetDefault(keyNotifyBalanceRefresh, defBalanceMonitorRefresh)
+
+	// compliance screening
+	cfg.SetDefault(keyComplianceTimeout, defComplianceTimeout)
+	cfg.SetDefault(keyComplianceCacheTTL, defComplianceCacheTTL)
+
+	// production hardening
+	cfg.SetDefault(keyProductionMaxResponse, defProductionMaxResponse)
+
+	// operations scheduling
+	cfg.SetDefault(keySchedulerCoreWorkers, defSchedulerCoreWorkers)
+	cfg.SetDefault(keySchedulerHeavyWorkers, defSchedulerHeavyWorkers)
+	cfg.SetDefault(keySchedulerExportWorkers, defSchedulerExportWorkers)
+	cfg.SetDefault(keySchedulerQueueSize, defSchedulerQueueSize)
+	cfg.SetDefault(keySchedulerQueueTimeout, defSchedulerQueueTimeout)
+
+	// playground
+	cfg.SetDefault(keyPlaygroundEnabled, true)
+	cfg.SetDefault(keyPlaygroundPath, defPlaygroundPath)
+
+	// Ethereum JSON-RPC facade
+	cfg.SetDefault(keyEthRpcPath, defEthRpcPath)
+	cfg.SetDefault(keyEthRpcMaxBlockRange, defEthRpcMaxBlockRange)
+	cfg.SetDefault(keyEthRpcMaxLogs, defEthRpcMaxLogs)
+
+	// call traces export
+	cfg.SetDefault(keyTraceExportPath, defTraceExportPath)
+	cfg.SetDefault(keyTraceExportMaxBlockRange, defTraceExportMaxBlockRange)
+
+	// account transactions export
+	cfg.SetDefault(keyAccountExportPath, defAccountExportPath)
+	cfg.SetDefault(keyAccountExportRetention, defAccountExportRetention)
+	cfg.SetDefault(keyAccountExportWorkers, defAccountExportWorkers)
+	cfg.SetDefault(keyAccountExportMaxRows, defAccountExportMaxRows)
+
+	// public events firehose
+	cfg.SetDefault(keyFirehosePath, defFirehosePath)
+	cfg.SetDefault(keyFirehoseMinTrxValue, defFirehoseMinTrxValue)
+	cfg.SetDefault(keyFirehoseConnectsPerMinute, defFirehoseConnectsPerMinute)
+	cfg.SetDefault(keyFirehoseMaxStreamsPerAddr, defFirehoseMaxStreamsPerAddr)
+	cfg.SetDefault(keyFirehoseMaxStreams, defFirehoseMaxStreams)
+
+	// sandbox API keys
+	cfg.SetDefault(keySandboxRateLimit, defSandboxRateLimit)
+	cfg.SetDefault(keySandboxKeyTTL, defSandboxKeyTTL)
+	cfg.SetDefault(keySandboxMaxKeysPerClient, defSandboxMaxKeysPerClient)
+	cfg.SetDefault(keySandboxAnonRateLimit, defSandboxAnonRateLimit)
+
+	// websocket subscriptions
+	cfg.SetDefault(keySubscriptionsRequireKey, defSubscriptionsRequireKey)
+	cfg.SetDefault(keySubscriptionsMaxPerKey, defSubscriptionsMaxPerKey)
+	cfg.SetDefault(keySubscriptionsMaxEventsPerMinute, defSubscriptionsMaxEventsPerMinute)
+
+	// off-chain data enrichment
+	cfg.SetDefault(keyEnrichmentTimeout, defEnrichmentTimeout)
+	cfg.SetDefault(keyEnrichmentCacheTTL, defEnrichmentCacheTTL)
+	cfg.SetDefault(keyEnrichmentWorkers, defEnrichmentWorkers)
+
+	// localization
+	cfg.SetDefault(keyLocalizationDefault, defLocalizationDefault)
+
+	// integrity checks
+	cfg.SetDefault(keyIntegrityInterval, defIntegrityInterval)
+	cfg.SetDefault(keyIntegrityDepth, defIntegrityDepth)
+	cfg.SetDefault(keyIntegrityTolerance, defIntegrityTolerance)
+	cfg.SetDefault(keyIntegrityTxRewardShare, defIntegrityTxRewardShare)
+	cfg.SetDefault(keyIntegritySampleInterval, defIntegritySampleInterval)
+	cfg.SetDefault(keyIntegritySampleSize, defIntegritySampleSize)
+
+	// network identity
+	cfg.SetDefault(keyNetworkName, defNetworkName)
+	cfg.SetDefault(keyNetworkSymbol, defNetworkSymbol)
+	cfg.SetDefault(keyNetworkDecimals, defNetworkDecimals)
 
 	// DeFi configuration
 	cfg.SetDefault(keyDefiFMintAddressProvider, defDefiFMintAddressProvider)
+	cfg.SetDefault(keyDefiFMintSnapshotInterval, defDefiFMintSnapshotInterval)
 	cfg.SetDefault(keyDefiUniswapCore, defDefiUniswapCore)
 	cfg.SetDefault(keyDefiUniswapRouter, defDefiUniswapRouter)
+	cfg.SetDefault(keyDefiTwapWindow, defDefiTwapWindow)
+	cfg.SetDefault(keyDefiTwapMinLiquidity, defDefiTwapMinLiquidity)
+	cfg.SetDefault(keyDefiPriceStreamInterval, defDefiPriceStreamInterval)
 }
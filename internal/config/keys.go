@@ -12,6 +12,7 @@ const (
 	keyConfigCmdBlockScanEnd    = "cmd.blk_to"
 	keyConfigCmdBlockScanReScan = "cmd.rescan"
 	keyConfigCmdRestoreStake    = "cmd.fix_stake"
+	keyConfigCmdFixtures        = "cmd.fixtures"
 
 	// server related keys
 	keyBindAddress      = "server.bind"
@@ -19,6 +20,17 @@ const (
 	keyApiPeers         = "server.peers"
 	keyApiStateOrigin   = "server.origin"
 	keyCorsAllowOrigins = "server.cors_origins"
+	keyCompress         = "server.compress"
+
+	// response size related keys
+	keyMaxListSize     = "server.max_list_size"
+	keyMaxResponseSize = "server.max_response_size"
+
+	// data freshness related keys
+	keyStaleAfter = "server.stale_after"
+
+	// client address related keys
+	keyTrustedProxies = "server.trusted_proxies"
 
 	// server time out related keys
 	keyTimeoutRead     = "server.read_timeout"
@@ -32,11 +44,19 @@ const (
 	keySignaturePrivateKey = "me.pkey"
 
 	// logging related options
-	keyLoggingLevel  = "log.level"
-	keyLoggingFormat = "log.format"
+	keyLoggingLevel   = "log.level"
+	keyLoggingFormat  = "log.format"
+	keyLoggingBackend = "log.backend"
 
 	// node connection related options
-	keyLachesisUrl = "lachesis.url"
+	keyLachesisUrl               = "lachesis.url"
+	keyLachesisReconnectDelay    = "node.reconnect_delay"
+	keyLachesisReconnectMaxDelay = "node.reconnect_max_delay"
+	keyLachesisBlockGap          = "node.block_gap"
+	keyLachesisPollMinInterval   = "node.poll_min_interval"
+	keyLachesisPollMaxInterval   = "node.poll_max_interval"
+	keyLachesisFinalityDepth     = "node.finality_depth"
+	keyLachesisCallBlock         = "node.call_block"
 
 	// off-chain database related options
 	keyMongoUrl      = "db.url"
@@ -45,6 +65,13 @@ const (
 	// cache related options
 	keyCacheEvictionTime = "cache.eviction"
 	keyCacheMaxSize      = "cache.size"
+	keyCacheBusChannel   = "cache.bus.channel"
+
+	// load shedding related options
+	keyLoadSheddingEnabled       = "shedding.enabled"
+	keyLoadSheddingMaxLatency    = "shedding.max_latency"
+	keyLoadSheddingMaxErrorRate  = "shedding.max_error_rate"
+	keyLoadSheddingProbeInterval = "shedding.probe_interval"
 
 	// contract validation related
 	keySolCompilerPath = "compiler.sol"
@@ -59,9 +86,100 @@ const (
 	keyStakingStiContract       = "staking.sti"
 	keyStakingTokenizerContract = "staking.tokenizer"
 	keyStakingERC20Token        = "staking.token"
+	keyStakingDustThreshold     = "staking.dust_threshold"
+
+	// on-chain address registry
+	keyRegistryAddress  = "registry.address"
+	keyRegistryInterval = "registry.interval"
+
+	// notifications related configs
+	keyNotifyWebhooksAttempts   = "notify.webhooks.attempts"
+	keyNotifyWebhooksRetryDelay = "notify.webhooks.retry_delay"
+	keyNotifyWebhooksTimeout    = "notify.webhooks.timeout"
+	keyNotifyWebhooksWorkers    = "notify.webhooks.workers"
+	keyNotifyRewardsInterval    = "notify.rewards.interval"
+	keyNotifyRewardsThreshold   = "notify.rewards.threshold"
+	keyNotifyCollateralInterval = "notify.collateral.interval"
+	keyNotifyBalanceRefresh     = "notify.balance.refresh"
+
+	// integrity checks related configs
+	keyIntegrityInterval       = "integrity.interval"
+	keyIntegrityDepth          = "integrity.depth"
+	keyIntegrityTolerance      = "integrity.tolerance"
+	keyIntegrityTxRewardShare  = "integrity.tx_reward_share"
+	keyIntegritySampleInterval = "integrity.sample_interval"
+	keyIntegritySampleSize     = "integrity.sample_size"
+
+	// compliance screening related configs
+	keyComplianceTimeout  = "compliance.timeout"
+	keyComplianceCacheTTL = "compliance.cache_ttl"
+
+	// production hardening related configs
+	keyProductionMaxResponse = "production.max_response"
+
+	// operations scheduling related configs
+	keySchedulerCoreWorkers   = "scheduler.core_workers"
+	keySchedulerHeavyWorkers  = "scheduler.heavy_workers"
+	keySchedulerExportWorkers = "scheduler.export_workers"
+	keySchedulerQueueSize     = "scheduler.queue_size"
+	keySchedulerQueueTimeout  = "scheduler.queue_timeout"
+
+	// playground related configs
+	keyPlaygroundEnabled = "playground.enabled"
+	keyPlaygroundPath    = "playground.path"
+
+	// Ethereum JSON-RPC facade related configs
+	keyEthRpcPath          = "eth_rpc.path"
+	keyEthRpcMaxBlockRange = "eth_rpc.max_block_range"
+	keyEthRpcMaxLogs       = "eth_rpc.max_logs"
+
+	// call traces export related configs
+	keyTraceExportPath          = "trace_export.path"
+	keyTraceExportMaxBlockRange = "trace_export.max_block_range"
+
+	// account transactions export related configs
+	keyAccountExportPath      = "account_export.path"
+	keyAccountExportRetention = "account_export.retention"
+	keyAccountExportWorkers   = "account_export.workers"
+	keyAccountExportMaxRows   = "account_export.max_rows"
+
+	// public events firehose related configs
+	keyFirehosePath              = "firehose.path"
+	keyFirehoseMinTrxValue       = "firehose.min_trx_value"
+	keyFirehoseConnectsPerMinute = "firehose.connects_per_minute"
+	keyFirehoseMaxStreamsPerAddr = "firehose.max_streams_per_addr"
+	keyFirehoseMaxStreams        = "firehose.max_streams"
+
+	// sandbox API keys related configs
+	keySandboxRateLimit        = "sandbox.rate_limit"
+	keySandboxKeyTTL           = "sandbox.key_ttl"
+	keySandboxMaxKeysPerClient = "sandbox.max_keys_per_client"
+	keySandboxAnonRateLimit    = "sandbox.anonymous_rate_limit"
+
+	// websocket subscriptions related configs
+	keySubscriptionsRequireKey         = "subscriptions.require_key"
+	keySubscriptionsMaxPerKey          = "subscriptions.max_per_key"
+	keySubscriptionsMaxEventsPerMinute = "subscriptions.max_events_per_minute"
+
+	// off-chain data enrichment related configs
+	keyEnrichmentTimeout  = "enrichment.timeout"
+	keyEnrichmentCacheTTL = "enrichment.cache_ttl"
+	keyEnrichmentWorkers  = "enrichment.workers"
+
+	// localization related configs
+	keyLocalizationDefault = "localization.default"
+
+	// network identity related configs
+	keyNetworkName     = "network.name"
+	keyNetworkSymbol   = "network.symbol"
+	keyNetworkDecimals = "network.decimals"
 
 	// defi related configs
-	keyDefiFMintAddressProvider = "defi.fmint.address_provider"
-	keyDefiUniswapCore          = "defi.uniswap.core"
-	keyDefiUniswapRouter        = "defi.uniswap.router"
+	keyDefiFMintAddressProvider  = "defi.fmint.address_provider"
+	keyDefiFMintSnapshotInterval = "defi.fmint.snapshot_interval"
+	keyDefiUniswapCore           = "defi.uniswap.core"
+	keyDefiUniswapRouter         = "defi.uniswap.router"
+	keyDefiTwapWindow            = "defi.twap_window"
+	keyDefiTwapMinLiquidity      = "defi.twap_min_liquidity"
+	keyDefiPriceStreamInterval   = "defi.price_stream_interval"
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"reflect"
 )
@@ -37,14 +38,50 @@ func Load() (*Config, error) {
 	// try to load the logo map file
 	loadErc20LogMap(&config)
 
+	// try to load the persisted queries allow list
+	if err = loadPersistedQueries(&config.Production); err != nil {
+		return nil, err
+	}
+
+	// client addresses are taken from the trusted proxies only
+	for _, tp := range config.Server.TrustedProxies {
+		if _, err := ParseProxy(tp); err != nil {
+			log.Println("invalid trusted proxy", tp)
+			return nil, err
+		}
+	}
+
+	// download links of account exports must be verifiable by all the servers
+	if config.AccountExport.Enabled && config.AccountExport.Secret == "" {
+		log.Println("account exports require the download links signing secret")
+		return nil, fmt.Errorf("missing account export secret")
+	}
+
 	// return the final config
 	return &config, nil
 }
 
+// ParseProxy parses the trusted proxy address, or CIDR range, into the range of addresses.
+func ParseProxy(addr string) (*net.IPNet, error) {
+	if _, ipn, err := net.ParseCIDR(addr); err == nil {
+		return ipn, nil
+	}
+
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid proxy address %s", addr)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
 // attachCliFlags connects CLI flags to certain configuration options.
 func attachCliFlags(cfg *Config) {
 	flag.Uint64Var(&cfg.RepoCommand.BlockScanReScan, keyConfigCmdBlockScanReScan, defBlockScanRescanDepth, "How many blocks are re-scanned on the server start.")
 	flag.StringVar(&cfg.RepoCommand.RestoreStake, keyConfigCmdRestoreStake, "", "Owner of the stake to be restored.")
+	flag.StringVar(&cfg.RepoCommand.Fixtures, keyConfigCmdFixtures, "", "Path to a JSON file with synthetic data served instead of the node and the database.")
 }
 
 // readConfigFile reads the config file and provides instance
@@ -117,6 +154,31 @@ func loadErc20LogMap(cfg *Config) {
 	log.Printf("found %d ERC20 tokens", len(cfg.TokenLogo))
 }
 
+// loadPersistedQueries loads the allow list of persisted queries for the production mode.
+func loadPersistedQueries(cfg *Production) error {
+	// is there any path at all?
+	if cfg.AllowListFile == "" {
+		return nil
+	}
+
+	// read the whole file
+	data, err := ioutil.ReadFile(cfg.AllowListFile)
+	if err != nil {
+		log.Printf("can not read persisted queries file; %s", err.Error())
+		return err
+	}
+
+	// try to unmarshal the data
+	if err := json.Unmarshal(data, &cfg.AllowList); err != nil {
+		log.Printf("can not decode persisted queries file; %s", err.Error())
+		return err
+	}
+
+	// inform about queries
+	log.Printf("found %d persisted queries", len(cfg.AllowList))
+	return nil
+}
+
 // setupConfigUnmarshaler configures the Config loader to properly unmarshal
 // special types we use for the API server
 func setupConfigUnmarshaler(cfg *mapstructure.DecoderConfig) {
@@ -0,0 +1,142 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DecodedCall represents resolvable contract call decoded by the contract ABI.
+type DecodedCall struct {
+	types.DecodedCall
+}
+
+// RegisterAbi stores a custom ABI of the given contract used to decode interactions with it.
+func (rs *rootResolver) RegisterAbi(ctx context.Context, args *struct {
+	Address common.Address
+	AbiJson string
+}) (bool, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return false, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// store the ABI
+	if err := repository.R().RegisterAbi(&args.Address, args.AbiJson, key.Name, key.Admin); err != nil {
+		log.Warningf("can not register ABI of %s; %s", args.Address.String(), err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+// ApproveAbi approves the pending custom ABI of the given contract, so it is used for decoding.
+func (rs *rootResolver) ApproveAbi(ctx context.Context, args *struct{ Address common.Address }) (bool, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return false, err
+	}
+
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := repository.R().ApproveAbi(&args.Address, key.Name); err != nil {
+		log.Warningf("can not approve ABI of %s; %s", args.Address.String(), err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+// DecodedLog represents resolvable event log record decoded by the contract ABI.
+type DecodedLog struct {
+	types.DecodedLog
+}
+
+// ContractCallResult represents resolvable output of a read-only contract call.
+type ContractCallResult struct {
+	types.ContractCallResult
+}
+
+// ContractCall resolves the output of a read-only call of the given contract
+// decoded by the ABI of the contract, if known. Calls execute arbitrary contract
+// code on the node, so they are available to authenticated clients only.
+func (rs *rootResolver) ContractCall(ctx context.Context, args *struct {
+	Contract common.Address
+	Input    hexutil.Bytes
+	Block    *hexutil.Uint64
+}) (*ContractCallResult, error) {
+	if _, err := mustBeAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	var block *big.Int
+	if args.Block != nil {
+		block = new(big.Int).SetUint64(uint64(*args.Block))
+	}
+
+	res, err := repository.R().ContractCall(&args.Contract, args.Input, block)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCallResult{*res}, nil
+}
+
+// Output resolves the raw output of the call.
+func (cr *ContractCallResult) Output() hexutil.Bytes {
+	return cr.ContractCallResult.Output
+}
+
+// Call resolves the input of the call decoded by the contract ABI.
+func (cr *ContractCallResult) Call() *DecodedCall {
+	if cr.ContractCallResult.Call == nil {
+		return nil
+	}
+	return &DecodedCall{*cr.ContractCallResult.Call}
+}
+
+// Outputs resolves the output of the call decoded by the contract ABI.
+func (cr *ContractCallResult) Outputs() *[]*types.DecodedArgument {
+	if cr.ContractCallResult.Outputs == nil {
+		return nil
+	}
+	list := make([]*types.DecodedArgument, len(cr.ContractCallResult.Outputs))
+	for i := range cr.ContractCallResult.Outputs {
+		list[i] = &cr.ContractCallResult.Outputs[i]
+	}
+	return &list
+}
+
+// Index resolves the index of the log record in the block.
+func (dl *DecodedLog) Index() int32 {
+	return int32(dl.DecodedLog.Index)
+}
+
+// Args resolves the list of decoded arguments of the event.
+func (dl *DecodedLog) Args() []*types.DecodedArgument {
+	list := make([]*types.DecodedArgument, len(dl.DecodedLog.Args))
+	for i := range dl.DecodedLog.Args {
+		list[i] = &dl.DecodedLog.Args[i]
+	}
+	return list
+}
+
+// Args resolves the list of decoded arguments of the call.
+func (dc *DecodedCall) Args() []*types.DecodedArgument {
+	list := make([]*types.DecodedArgument, len(dc.DecodedCall.Args))
+	for i := range dc.DecodedCall.Args {
+		list[i] = &dc.DecodedCall.Args[i]
+	}
+	return list
+}
@@ -4,7 +4,9 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,6 +20,9 @@ const accMaxTransactionsPerRequest = 50
 type Account struct {
 	types.Account
 	cg singleflight.Group
+
+	// balance is the balance at the pinned block loaded ahead by a bulk lookup, if any
+	balance *hexutil.Big
 }
 
 // NewAccount builds new resolvable account structure.
@@ -43,11 +48,16 @@ func (rs *rootResolver) AccountsActive() (hexutil.Uint64, error) {
 	return repository.R().AccountsActive()
 }
 
-// Balance resolves total balance of the account.
-func (acc *Account) Balance() (hexutil.Big, error) {
+// Balance resolves total balance of the account at the block pinned for the query.
+func (acc *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	// loaded ahead?
+	if acc.balance != nil {
+		return *acc.balance, nil
+	}
+
 	// get the balance
 	val, err, _ := acc.cg.Do("balance", func() (interface{}, error) {
-		return repository.R().AccountBalance(&acc.Address)
+		return repository.R().AccountBalance(&acc.Address, pinnedBlock(ctx))
 	})
 
 	// can not get the balance?
@@ -58,15 +68,15 @@ func (acc *Account) Balance() (hexutil.Big, error) {
 }
 
 // TotalValue resolves account total value including delegated amount and pending rewards.
-func (acc *Account) TotalValue() (hexutil.Big, error) {
+func (acc *Account) TotalValue(ctx context.Context) (hexutil.Big, error) {
 	// get the balance
-	balance, err := acc.Balance()
+	balance, err := acc.Balance(ctx)
 	if err != nil {
 		return hexutil.Big{}, err
 	}
 
 	// try to pull the delegations details
-	delegated, rewards, err := acc.delegationsTotal()
+	delegated, rewards, err := acc.delegationsTotal(ctx)
 	if err != nil {
 		return hexutil.Big{}, err
 	}
@@ -77,9 +87,9 @@ func (acc *Account) TotalValue() (hexutil.Big, error) {
 }
 
 // TxCount resolves the number of transaction sent by the account, also known as nonce.
-func (acc *Account) TxCount() (hexutil.Uint64, error) {
+func (acc *Account) TxCount(ctx context.Context) (hexutil.Uint64, error) {
 	// get the sender by address
-	bal, err := repository.R().AccountNonce(&acc.Address)
+	bal, err := repository.R().AccountNonce(&acc.Address, pinnedBlock(ctx))
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
@@ -94,6 +104,9 @@ func (acc *Account) TxList(args struct {
 }) (*TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -114,6 +127,9 @@ func (acc *Account) Erc20TxList(args struct {
 }) (*ERC20TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -143,6 +159,9 @@ func (acc *Account) Erc721TxList(args struct {
 }) (*ERC721TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -172,6 +191,9 @@ func (acc *Account) Erc1155TxList(args struct {
 }) (*ERC1155TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -208,15 +230,24 @@ func (acc *Account) Staker() (*Staker, error) {
 
 // Delegations resolves a list of account delegations, if the account is a delegator.
 func (acc *Account) Delegations(args *struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor  *Cursor
+	Count   int32
+	OrderBy string
+	Filter  *DelegationFilter
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// pull the list
-	dl, err := repository.R().DelegationsByAddress(&acc.Address, (*string)(args.Cursor), args.Count)
+	opt, err := delegationListOptions(args.OrderBy, args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	dl, err := repository.R().DelegationsByAddress(&acc.Address, (*string)(args.Cursor), args.Count, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -243,7 +274,7 @@ func (acc *Account) Contract() (*Contract, error) {
 
 // delegationsTotal calculates total sum of delegations of the given account including
 // pending rewards for those delegations.
-func (acc *Account) delegationsTotal() (amount *big.Int, rewards *big.Int, err error) {
+func (acc *Account) delegationsTotal(ctx context.Context) (amount *big.Int, rewards *big.Int, err error) {
 	// pull all the delegations of the account
 	list, err := repository.R().DelegationsByAddressAll(&acc.Address)
 	if err != nil {
@@ -260,7 +291,7 @@ func (acc *Account) delegationsTotal() (amount *big.Int, rewards *big.Int, err e
 		}
 
 		// get pending rewards for this delegation (can be stashed)
-		rw, err := repository.R().PendingRewards(&acc.Address, dlg.ToStakerId)
+		rw, err := repository.R().PendingRewards(&acc.Address, dlg.ToStakerId, pinnedBlock(ctx))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -274,3 +305,41 @@ func (acc *Account) delegationsTotal() (amount *big.Int, rewards *big.Int, err e
 	// loop all delegations and calculate
 	return amount, rewards, nil
 }
+
+// FirstSeen resolves the time stamp of the first known activity of the account.
+func (acc *Account) FirstSeen() (*hexutil.Uint64, error) {
+	ts, err := repository.R().AccountFirstSeen(&acc.Account)
+	if err != nil || ts == 0 {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+// LastActive resolves the time stamp of the last known activity of the account.
+func (acc *Account) LastActive() *hexutil.Uint64 {
+	if acc.LastActivity == 0 {
+		return nil
+	}
+	return &acc.LastActivity
+}
+
+// TotalTxCount resolves the number of transactions involving the account
+// as observed by the API server indexer.
+func (acc *Account) TotalTxCount() hexutil.Uint64 {
+	return acc.TrxCounter
+}
+
+// Age resolves the number of seconds elapsed since the first known activity of the account.
+func (acc *Account) Age() (*hexutil.Uint64, error) {
+	ts, err := acc.FirstSeen()
+	if err != nil || ts == nil {
+		return nil, err
+	}
+
+	now := uint64(time.Now().UTC().Unix())
+	if now < uint64(*ts) {
+		return nil, nil
+	}
+	age := hexutil.Uint64(now - uint64(*ts))
+	return &age, nil
+}
@@ -0,0 +1,33 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccountActivityDay represents resolvable daily activity of an account.
+type AccountActivityDay struct {
+	types.AccountActivityDay
+}
+
+// ActivityHeatmap resolves the daily transaction counts of the account for the last year.
+func (rs *rootResolver) ActivityHeatmap(args struct{ Address common.Address }) ([]*AccountActivityDay, error) {
+	days, err := repository.R().AccountActivityHeatmap(&args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*AccountActivityDay, len(days))
+	for i, d := range days {
+		list[i] = &AccountActivityDay{*d}
+	}
+	return list, nil
+}
+
+// Count resolves the number of transactions of the day.
+func (aad *AccountActivityDay) Count() int32 {
+	return int32(aad.AccountActivityDay.Count)
+}
@@ -0,0 +1,83 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// bulkMaxItems represents the max number of addresses of a single bulk lookup.
+const bulkMaxItems = 50
+
+// ERC20Balance represents a resolvable balance of an ERC20 token.
+type ERC20Balance struct {
+	Address common.Address
+	Balance *hexutil.Big
+}
+
+// checkBulkSize validates the number of addresses of a bulk lookup.
+func checkBulkSize(count int) error {
+	if count == 0 || count > bulkMaxItems {
+		return fmt.Errorf("between 1 and %d addresses expected, %d given", bulkMaxItems, count)
+	}
+	return nil
+}
+
+// Accounts resolves blockchain accounts of the given addresses in the given order.
+// Balances of the accounts are loaded ahead in a single batch at the block pinned for the query.
+func (rs *rootResolver) Accounts(ctx context.Context, args struct{ Addresses []common.Address }) ([]*Account, error) {
+	if err := checkBulkSize(len(args.Addresses)); err != nil {
+		return nil, err
+	}
+
+	list := make([]*Account, len(args.Addresses))
+	for i := range args.Addresses {
+		acc, err := repository.R().Account(&args.Addresses[i])
+		if err != nil {
+			return nil, err
+		}
+		list[i] = NewAccount(acc)
+	}
+
+	// the balances are resolved one by one if the batch fails
+	bal, err := repository.R().AccountBalances(args.Addresses, pinnedBlock(ctx))
+	if err != nil {
+		log.Errorf("can not load balances of %d accounts; %s", len(args.Addresses), err.Error())
+		return list, nil
+	}
+	for i := range list {
+		list[i].balance = bal[i]
+	}
+	return list, nil
+}
+
+// Erc20BalancesBulk resolves balances of the given ERC20 tokens of the owner in the given order.
+// The balances are loaded in a single batch at the block pinned for the query.
+func (rs *rootResolver) Erc20BalancesBulk(ctx context.Context, args struct {
+	Owner  common.Address
+	Tokens []common.Address
+}) ([]*ERC20Balance, error) {
+	if err := checkBulkSize(len(args.Tokens)); err != nil {
+		return nil, err
+	}
+
+	bal, err := repository.R().Erc20BalancesOf(&args.Owner, args.Tokens, pinnedBlock(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*ERC20Balance, len(args.Tokens))
+	for i := range args.Tokens {
+		list[i] = &ERC20Balance{Address: args.Tokens[i], Balance: bal[i]}
+	}
+	return list, nil
+}
+
+// Token resolves the details of the token; nil if the address is not an ERC20 token.
+func (eb *ERC20Balance) Token() *ERC20Token {
+	return NewErc20Token(&eb.Address)
+}
@@ -0,0 +1,151 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// accountExportPollInterval represents the interval the state of a watched account export is checked in.
+const accountExportPollInterval = 2 * time.Second
+
+// AccountExport represents resolvable asynchronous export of account transactions.
+type AccountExport struct {
+	types.AccountExport
+}
+
+// StartAccountExport starts an asynchronous export of the account transactions
+// owned by the calling API key.
+func (rs *rootResolver) StartAccountExport(ctx context.Context, args struct{ Address common.Address }) (*AccountExport, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	// exports are not available to sandbox keys
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// bulk export, shed it if the node is under pressure
+	if err := shedLoad(queryClassExport); err != nil {
+		return nil, err
+	}
+
+	ex, err := repository.R().StartAccountExport(key.Name, &args.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountExport{*ex}, nil
+}
+
+// AccountExport resolves the state of an account export started by the calling API key.
+func (rs *rootResolver) AccountExport(ctx context.Context, args struct{ Id string }) (*AccountExport, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex := repository.R().AccountExport(key.Name, args.Id)
+	if ex == nil {
+		return nil, nil
+	}
+	return &AccountExport{*ex}, nil
+}
+
+// OnAccountExport resolves subscription to the completion of an account export
+// started by the calling API key. The stream is closed after the export finishes, or fails.
+func (rs *rootResolver) OnAccountExport(ctx context.Context, args struct{ Id string }) (<-chan *AccountExport, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.R().AccountExport(key.Name, args.Id) == nil {
+		return nil, fmt.Errorf("export %s not found", args.Id)
+	}
+
+	// the export may be built by any server sharing the database, so we watch its record
+	c := make(chan *AccountExport, 1)
+	go func() {
+		defer close(c)
+
+		ticker := time.NewTicker(accountExportPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			ex := repository.R().AccountExport(key.Name, args.Id)
+			if ex == nil {
+				return
+			}
+			if ex.Status == types.AccountExportFinished || ex.Status == types.AccountExportFailed {
+				c <- &AccountExport{*ex}
+				return
+			}
+		}
+	}()
+	return c, nil
+}
+
+// Id resolves the identifier of the export.
+func (ae *AccountExport) Id() string {
+	return ae.ID
+}
+
+// Rows resolves the number of transactions written to the export file.
+func (ae *AccountExport) Rows() hexutil.Uint64 {
+	return hexutil.Uint64(ae.AccountExport.Rows)
+}
+
+// Error resolves the reason of a failed export, if any.
+func (ae *AccountExport) Error() *string {
+	if ae.AccountExport.Error == "" {
+		return nil
+	}
+	return &ae.AccountExport.Error
+}
+
+// Created resolves the UNIX time stamp of the export request.
+func (ae *AccountExport) Created() hexutil.Uint64 {
+	return hexutil.Uint64(ae.AccountExport.Created.Unix())
+}
+
+// Finished resolves the UNIX time stamp of the export completion, if any.
+func (ae *AccountExport) Finished() *hexutil.Uint64 {
+	return unixTimeStamp(ae.AccountExport.Finished)
+}
+
+// Expires resolves the UNIX time stamp of the export file removal, if any.
+func (ae *AccountExport) Expires() *hexutil.Uint64 {
+	return unixTimeStamp(ae.AccountExport.Expires)
+}
+
+// DownloadUrl resolves the signed download link of the export file, if finished.
+func (ae *AccountExport) DownloadUrl() *string {
+	url := repository.R().AccountExportUrl(&ae.AccountExport)
+	if url == "" {
+		return nil
+	}
+	return &url
+}
+
+// unixTimeStamp converts the optional time into UNIX time stamp.
+func unixTimeStamp(t *time.Time) *hexutil.Uint64 {
+	if t == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(t.Unix())
+	return &ts
+}
@@ -0,0 +1,324 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/sync/singleflight"
+)
+
+// addressGroupsMaxPerKey represents the max number of address groups defined by a single API key.
+const addressGroupsMaxPerKey = 50
+
+// addressGroupMaxSize represents the max number of addresses in a single address group.
+const addressGroupMaxSize = 50
+
+// addressGroupMaxNameLength represents the max length of the name of an address group.
+const addressGroupMaxNameLength = 64
+
+// AddressGroup represents resolvable named group of addresses.
+type AddressGroup struct {
+	types.AddressGroup
+}
+
+// Portfolio represents resolvable aggregated state of all the addresses of a group.
+type Portfolio struct {
+	group    *types.AddressGroup
+	accounts []*Account
+	cg       singleflight.Group
+}
+
+// portfolioTotals represents the aggregated values of the accounts of a portfolio.
+type portfolioTotals struct {
+	balance   *big.Int
+	delegated *big.Int
+	rewards   *big.Int
+}
+
+// AddressGroups resolves the list of address groups defined by the calling API key.
+func (rs *rootResolver) AddressGroups(ctx context.Context) ([]*AddressGroup, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().AddressGroups(key.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*AddressGroup, len(list))
+	for i, ag := range list {
+		res[i] = &AddressGroup{*ag}
+	}
+	return res, nil
+}
+
+// CreateAddressGroup defines a new named group of addresses owned by the calling API key.
+func (rs *rootResolver) CreateAddressGroup(ctx context.Context, args struct {
+	Name      string
+	Addresses []common.Address
+}) (*AddressGroup, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if err := checkAddressGroup(&args.Name, args.Addresses); err != nil {
+		return nil, err
+	}
+
+	// check the limit
+	list, err := repository.R().AddressGroups(key.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) >= addressGroupsMaxPerKey {
+		return nil, fmt.Errorf("too many groups, at most %d groups can be defined", addressGroupsMaxPerKey)
+	}
+
+	ag, err := repository.R().CreateAddressGroup(key.Name, strings.TrimSpace(args.Name), args.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressGroup{*ag}, nil
+}
+
+// UpdateAddressGroup changes the name and/or the addresses of a group owned by the calling API key.
+func (rs *rootResolver) UpdateAddressGroup(ctx context.Context, args struct {
+	Id        string
+	Name      *string
+	Addresses *[]common.Address
+}) (*AddressGroup, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the input
+	var addrs []common.Address
+	if args.Addresses != nil {
+		addrs = *args.Addresses
+	}
+	if err := checkAddressGroup(args.Name, addrs); err != nil {
+		return nil, err
+	}
+	if args.Name != nil {
+		name := strings.TrimSpace(*args.Name)
+		args.Name = &name
+	}
+
+	ag, err := repository.R().UpdateAddressGroup(key.Name, args.Id, args.Name, addrs)
+	if err != nil {
+		return nil, err
+	}
+	if ag == nil {
+		return nil, fmt.Errorf("address group %s not found", args.Id)
+	}
+	return &AddressGroup{*ag}, nil
+}
+
+// RemoveAddressGroup removes an address group owned by the calling API key.
+func (rs *rootResolver) RemoveAddressGroup(ctx context.Context, args struct{ Id string }) (bool, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return false, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return false, err
+	}
+	return repository.R().RemoveAddressGroup(key.Name, args.Id)
+}
+
+// Portfolio resolves the aggregated state of an address group owned by the calling API key.
+func (rs *rootResolver) Portfolio(ctx context.Context, args struct{ GroupId string }) (*Portfolio, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	ag, err := repository.R().AddressGroup(key.Name, args.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if ag == nil {
+		return nil, fmt.Errorf("address group %s not found", args.GroupId)
+	}
+
+	// load accounts of the group
+	pf := Portfolio{group: ag, accounts: make([]*Account, len(ag.Addresses))}
+	for i, adr := range ag.Addresses {
+		addr := common.HexToAddress(adr)
+		acc, err := repository.R().Account(&addr)
+		if err != nil {
+			return nil, err
+		}
+		pf.accounts[i] = NewAccount(acc)
+	}
+	return &pf, nil
+}
+
+// checkAddressGroup validates the name and the addresses of an address group, if given.
+func checkAddressGroup(name *string, addrs []common.Address) error {
+	if name != nil {
+		n := strings.TrimSpace(*name)
+		if n == "" || len(n) > addressGroupMaxNameLength {
+			return fmt.Errorf("invalid group name, up to %d characters expected", addressGroupMaxNameLength)
+		}
+	}
+	if addrs != nil && (len(addrs) == 0 || len(addrs) > addressGroupMaxSize) {
+		return fmt.Errorf("invalid group size, 1 to %d addresses expected", addressGroupMaxSize)
+	}
+	return nil
+}
+
+// Id resolves the identifier of the address group.
+func (ag *AddressGroup) Id() string {
+	return ag.ID
+}
+
+// Addresses resolves the list of addresses of the group.
+func (ag *AddressGroup) Addresses() []common.Address {
+	list := make([]common.Address, len(ag.AddressGroup.Addresses))
+	for i, adr := range ag.AddressGroup.Addresses {
+		list[i] = common.HexToAddress(adr)
+	}
+	return list
+}
+
+// Created resolves the UNIX time stamp of the group creation.
+func (ag *AddressGroup) Created() hexutil.Uint64 {
+	return hexutil.Uint64(ag.AddressGroup.Created.Unix())
+}
+
+// Updated resolves the UNIX time stamp of the last change of the group.
+func (ag *AddressGroup) Updated() hexutil.Uint64 {
+	return hexutil.Uint64(ag.AddressGroup.Updated.Unix())
+}
+
+// Group resolves the address group of the portfolio.
+func (pf *Portfolio) Group() *AddressGroup {
+	return &AddressGroup{*pf.group}
+}
+
+// Accounts resolves the accounts of the portfolio.
+func (pf *Portfolio) Accounts() []*Account {
+	return pf.accounts
+}
+
+// Balance resolves the total balance of all the accounts of the portfolio.
+func (pf *Portfolio) Balance(ctx context.Context) (hexutil.Big, error) {
+	tot, err := pf.totals(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tot.balance), nil
+}
+
+// AmountDelegated resolves the total amount delegated by the accounts of the portfolio.
+func (pf *Portfolio) AmountDelegated(ctx context.Context) (hexutil.Big, error) {
+	tot, err := pf.totals(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tot.delegated), nil
+}
+
+// PendingRewards resolves the total pending rewards of the delegations of the portfolio.
+func (pf *Portfolio) PendingRewards(ctx context.Context) (hexutil.Big, error) {
+	tot, err := pf.totals(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tot.rewards), nil
+}
+
+// TotalValue resolves the total value of the portfolio including delegated amounts and pending rewards.
+func (pf *Portfolio) TotalValue(ctx context.Context) (hexutil.Big, error) {
+	tot, err := pf.totals(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	val := new(big.Int).Add(tot.balance, tot.delegated)
+	return hexutil.Big(*val.Add(val, tot.rewards)), nil
+}
+
+// Delegations resolves the list of all the delegations of the accounts of the portfolio.
+func (pf *Portfolio) Delegations() ([]*Delegation, error) {
+	list := make([]*Delegation, 0)
+	for _, acc := range pf.accounts {
+		dl, err := repository.R().DelegationsByAddressAll(&acc.Address)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dl {
+			list = append(list, NewDelegation(d))
+		}
+	}
+	return list, nil
+}
+
+// TxList resolves the list of transactions sent, or received, by any account of the portfolio.
+func (pf *Portfolio) TxList(args struct {
+	Cursor *Cursor
+	Count  int32
+}) (*TransactionList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
+	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
+
+	tl, err := repository.R().AddressGroupTransactions(pf.group, (*string)(args.Cursor), args.Count)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransactionList(tl), nil
+}
+
+// totals calculates the aggregated values of the accounts of the portfolio only once.
+func (pf *Portfolio) totals(ctx context.Context) (*portfolioTotals, error) {
+	val, err, _ := pf.cg.Do("totals", func() (interface{}, error) {
+		tot := portfolioTotals{balance: new(big.Int), delegated: new(big.Int), rewards: new(big.Int)}
+		for _, acc := range pf.accounts {
+			bal, err := acc.Balance(ctx)
+			if err != nil {
+				return nil, err
+			}
+			tot.balance.Add(tot.balance, bal.ToInt())
+
+			amount, rewards, err := acc.delegationsTotal(ctx)
+			if err != nil {
+				return nil, err
+			}
+			tot.delegated.Add(tot.delegated, amount)
+			tot.rewards.Add(tot.rewards, rewards)
+		}
+		return &tot, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*portfolioTotals), nil
+}
@@ -0,0 +1,89 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/config"
+	"context"
+)
+
+// errCodeUnauthorized represents the error code of a call rejected due to missing privileges.
+const errCodeUnauthorized = "UNAUTHORIZED"
+
+// apiKeyContextKey represents the key of the API key stored in the request context.
+type apiKeyContextKey struct{}
+
+// clientAddrContextKey represents the key of the client address stored in the request context.
+type clientAddrContextKey struct{}
+
+// unauthorizedError represents an error of an API call made without required privileges.
+type unauthorizedError struct{}
+
+// Error returns the human-readable description of the error.
+func (e unauthorizedError) Error() string {
+	return "access denied, valid API key with sufficient privileges required"
+}
+
+// Extensions provides the machine-readable code of the error to GraphQL clients.
+func (e unauthorizedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": errCodeUnauthorized}
+}
+
+// ContextWithApiKey provides a new context carrying the API key used to authenticate the request.
+func ContextWithApiKey(ctx context.Context, key *config.ApiKey) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, key)
+}
+
+// ApiKeyFromContext extracts the API key of the request from the given context, if any.
+func ApiKeyFromContext(ctx context.Context) *config.ApiKey {
+	if ctx == nil {
+		return nil
+	}
+	key, ok := ctx.Value(apiKeyContextKey{}).(*config.ApiKey)
+	if !ok {
+		return nil
+	}
+	return key
+}
+
+// ContextWithClientAddr provides a new context carrying the address of the client of the request.
+func ContextWithClientAddr(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, clientAddrContextKey{}, addr)
+}
+
+// ClientAddrFromContext extracts the address of the request client from the given context, if any.
+func ClientAddrFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	addr, _ := ctx.Value(clientAddrContextKey{}).(string)
+	return addr
+}
+
+// mustBeAuthenticated checks the request has been authenticated by a valid configured API key;
+// self-service sandbox keys are treated as anonymous.
+func mustBeAuthenticated(ctx context.Context) (*config.ApiKey, error) {
+	key := ApiKeyFromContext(ctx)
+	if key == nil || key.Sandbox {
+		return nil, unauthorizedError{}
+	}
+	return key, nil
+}
+
+// mustBeAdmin checks the request has been authenticated by an admin API key.
+func mustBeAdmin(ctx context.Context) (*config.ApiKey, error) {
+	key := ApiKeyFromContext(ctx)
+	if key == nil || !key.Admin {
+		return nil, unauthorizedError{}
+	}
+	return key, nil
+}
+
+// mustNotBeSandbox checks the request has been authenticated by an API key other than a sandbox key;
+// neither anonymous clients, nor sandbox keys are allowed to run bulk data exports.
+func mustNotBeSandbox(ctx context.Context) error {
+	key := ApiKeyFromContext(ctx)
+	if key == nil || key.Sandbox {
+		return unauthorizedError{}
+	}
+	return nil
+}
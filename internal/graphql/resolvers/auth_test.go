@@ -0,0 +1,38 @@
+package resolvers
+
+import (
+	"axis-graphql/internal/config"
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestSandboxGating(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     *config.ApiKey
+		authed  bool
+		exports bool
+	}{
+		{name: "anonymous", key: nil, authed: false, exports: false},
+		{name: "sandbox", key: &config.ApiKey{Name: "sandbox-1", Sandbox: true}, authed: false, exports: false},
+		{name: "configured", key: &config.ApiKey{Name: "partner"}, authed: true, exports: true},
+		{name: "admin", key: &config.ApiKey{Name: "ops", Admin: true}, authed: true, exports: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			ctx := context.Background()
+			if tc.key != nil {
+				ctx = ContextWithApiKey(ctx, tc.key)
+			}
+
+			_, err := mustBeAuthenticated(ctx)
+			g.Expect(err == nil).To(gomega.Equal(tc.authed), "authentication")
+			g.Expect(mustNotBeSandbox(ctx) == nil).To(gomega.Equal(tc.exports), "exports")
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// balanceAlertsMaxPerKey represents the max number of balance alerts registered by a single API key.
+const balanceAlertsMaxPerKey = 100
+
+// BalanceAlert represents resolvable account balance alert.
+type BalanceAlert struct {
+	types.BalanceAlert
+}
+
+// BalanceAlerts resolves the list of balance alerts registered by the calling API key.
+func (rs *rootResolver) BalanceAlerts(ctx context.Context) ([]*BalanceAlert, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().BalanceAlerts(&key.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*BalanceAlert, len(list))
+	for i, ba := range list {
+		res[i] = &BalanceAlert{*ba}
+	}
+	return res, nil
+}
+
+// RegisterBalanceAlert registers a new native, or ERC20 token balance alert for the given account
+// owned by the calling API key.
+func (rs *rootResolver) RegisterBalanceAlert(ctx context.Context, args struct {
+	Address common.Address
+	Token   *common.Address
+	Min     *hexutil.Big
+	Max     *hexutil.Big
+	Webhook string
+}) (*BalanceAlert, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if args.Min == nil && args.Max == nil {
+		return nil, fmt.Errorf("at least one of min and max thresholds is required")
+	}
+	if (args.Min != nil && args.Min.ToInt().Sign() < 0) || (args.Max != nil && args.Max.ToInt().Sign() < 0) {
+		return nil, fmt.Errorf("invalid threshold")
+	}
+	if args.Min != nil && args.Max != nil && args.Min.ToInt().Cmp(args.Max.ToInt()) > 0 {
+		return nil, fmt.Errorf("min threshold must not exceed max threshold")
+	}
+	if err := validateWebhookURL(args.Webhook); err != nil {
+		return nil, err
+	}
+
+	// check the limit
+	list, err := repository.R().BalanceAlerts(&key.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) >= balanceAlertsMaxPerKey {
+		return nil, fmt.Errorf("too many alerts, at most %d alerts can be registered", balanceAlertsMaxPerKey)
+	}
+
+	ba, err := repository.R().RegisterBalanceAlert(key.Name, args.Address, args.Token, args.Min.ToInt(), args.Max.ToInt(), args.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	return &BalanceAlert{*ba}, nil
+}
+
+// RemoveBalanceAlert removes a balance alert owned by the calling API key.
+func (rs *rootResolver) RemoveBalanceAlert(ctx context.Context, args struct{ Id string }) (bool, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return false, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return false, err
+	}
+	return repository.R().RemoveBalanceAlert(args.Id, key.Name)
+}
+
+// Id resolves the identifier of the alert.
+func (ba *BalanceAlert) Id() string {
+	return ba.ID
+}
+
+// Address resolves the address of the watched account.
+func (ba *BalanceAlert) Address() common.Address {
+	return common.HexToAddress(ba.BalanceAlert.Address)
+}
+
+// Token resolves the address of the watched ERC20 token, if any.
+func (ba *BalanceAlert) Token() *common.Address {
+	if ba.BalanceAlert.Token == nil {
+		return nil
+	}
+	adr := common.HexToAddress(*ba.BalanceAlert.Token)
+	return &adr
+}
+
+// Min resolves the lower threshold of the balance, if any.
+func (ba *BalanceAlert) Min() *hexutil.Big {
+	return decodeBalanceAmount(ba.BalanceAlert.Min)
+}
+
+// Max resolves the upper threshold of the balance, if any.
+func (ba *BalanceAlert) Max() *hexutil.Big {
+	return decodeBalanceAmount(ba.BalanceAlert.Max)
+}
+
+// State resolves the position of the balance relative to the thresholds.
+func (ba *BalanceAlert) State() string {
+	return types.BalanceAlertStateName(ba.BalanceAlert.State)
+}
+
+// Balance resolves the balance observed on the last crossing, or registration.
+func (ba *BalanceAlert) Balance() hexutil.Big {
+	if val := decodeBalanceAmount(&ba.BalanceAlert.Balance); val != nil {
+		return *val
+	}
+	return hexutil.Big{}
+}
+
+// Created resolves the UNIX time stamp of the alert registration.
+func (ba *BalanceAlert) Created() hexutil.Uint64 {
+	return hexutil.Uint64(ba.BalanceAlert.Created.Unix())
+}
+
+// Triggered resolves the UNIX time stamp of the last threshold crossing, if any.
+func (ba *BalanceAlert) Triggered() *hexutil.Uint64 {
+	if ba.BalanceAlert.Triggered == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(ba.BalanceAlert.Triggered.Unix())
+	return &ts
+}
+
+// decodeBalanceAmount decodes the hex encoded amount of a balance alert, if any.
+func decodeBalanceAmount(val *string) *hexutil.Big {
+	if val == nil {
+		return nil
+	}
+
+	v, err := hexutil.DecodeBig(*val)
+	if err != nil {
+		return nil
+	}
+	return (*hexutil.Big)(v)
+}
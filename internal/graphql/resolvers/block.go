@@ -35,6 +35,16 @@ func (rs *rootResolver) Block(args *struct {
 	return NewBlock(b), err
 }
 
+// IsFinalized resolves the finality of the block.
+func (blk *Block) IsFinalized() bool {
+	return repository.R().IsBlockFinalized(uint64(blk.Number))
+}
+
+// Sequence resolves the position of the block in the subscription events stream.
+func (blk *Block) Sequence() *EventSequence {
+	return &EventSequence{Block: blk.Number}
+}
+
 // Parent resolves parent block information to the given block.
 func (blk *Block) Parent() (*Block, error) {
 	// get the parent block by hash
@@ -77,10 +87,7 @@ func (blk *Block) TransactionCount() *int32 {
 	return &count
 }
 
-// TransactionCount resolves number of transactions in the block.
-func (blk *Block) Miner() *common.Address {
-	miner := blk.Miner()
-	// return *miner
-
-	return miner
+// Miner resolves the address of the beneficiary of the block.
+func (blk *Block) Miner() common.Address {
+	return blk.Block.Miner
 }
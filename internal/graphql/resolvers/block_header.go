@@ -0,0 +1,40 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockHeader represents resolvable raw block header with consensus details.
+type BlockHeader struct {
+	types.BlockHeader
+}
+
+// BlockHeader resolves raw header of the block by number directly from the node.
+func (rs *rootResolver) BlockHeader(args *struct{ Number hexutil.Uint64 }) (*BlockHeader, error) {
+	hdr, err := repository.R().BlockHeader(args.Number)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockHeader{*hdr}, nil
+}
+
+// TransactionsRoot resolves the hash of the block transactions trie.
+func (bh *BlockHeader) TransactionsRoot() common.Hash {
+	return bh.TxRoot
+}
+
+// Atropos resolves the Atropos event of the block; on Lachesis the block hash
+// is the id of the Atropos event. Null is provided if the node doesn't expose the DAG API.
+func (bh *BlockHeader) Atropos() *DagEvent {
+	ev, err := repository.R().DagEvent(&bh.Hash)
+	if err != nil {
+		log.Debugf("atropos of block #%d not available; %s", uint64(bh.Number), err.Error())
+		return nil
+	}
+	return &DagEvent{*ev}
+}
@@ -33,6 +33,11 @@ func (rs *rootResolver) Blocks(args *struct {
 	Cursor *Cursor
 	Count  int32
 }) (*BlockList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// do we have a cursor? try to decode it into an actual block number
 	var num *uint64
 	if args.Cursor != nil {
@@ -51,6 +56,9 @@ func (rs *rootResolver) Blocks(args *struct {
 
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the block list from repository
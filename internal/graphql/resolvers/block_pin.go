@@ -0,0 +1,51 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"context"
+	"math/big"
+	"sync"
+)
+
+// blockPinKey represents the key of the pinned block in the request context.
+type blockPinKey struct{}
+
+// blockPin represents the block all the state reads of a single query are made against,
+// so fields resolved within the query are consistent even if a new block lands mid-query.
+// The block is pinned lazily by the first state read of the query; it's the block
+// of the default contract calls, so the configured call block lag applies.
+//
+// Pinned reads cover account balances, staked amounts, pending and stashed rewards,
+// delegation and validator locks and ERC20 balances. Fields backed by the indexed
+// database, e.g. amountInWithdraw and withdraw requests, reflect the indexed state
+// instead; so do unlockedAmount, unlockPenalty, outstandingSAXIS
+// and tokenizerAllowedToWithdraw, which always read the latest block.
+type blockPin struct {
+	once  sync.Once
+	block *big.Int
+}
+
+// ContextWithBlockPin creates a new context pinning the block for state reads of a query.
+func ContextWithBlockPin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, blockPinKey{}, &blockPin{})
+}
+
+// pinnedBlock provides the block number pinned for the query of the given context.
+// Nil is returned, and the state of the default call block is read, if no block has been pinned.
+func pinnedBlock(ctx context.Context) *big.Int {
+	pin, ok := ctx.Value(blockPinKey{}).(*blockPin)
+	if !ok {
+		return nil
+	}
+
+	pin.once.Do(func() {
+		h, err := repository.R().CallBlockHeight()
+		if err != nil {
+			log.Errorf("can not pin block of the query; %s", err.Error())
+			return
+		}
+		pin.block = h.ToInt()
+	})
+	return pin.block
+}
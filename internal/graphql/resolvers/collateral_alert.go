@@ -0,0 +1,168 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// collateralAlertsMaxPerKey represents the max number of collateral alerts registered by a single API key.
+const collateralAlertsMaxPerKey = 100
+
+// CollateralAlert represents resolvable collateral ratio alert.
+type CollateralAlert struct {
+	types.CollateralAlert
+}
+
+// CollateralAlertEvent represents resolvable collateral ratio alert event.
+type CollateralAlertEvent struct {
+	types.CollateralAlertEvent
+	seq EventSequence
+}
+
+// Sequence resolves the position of the alert in the subscription events stream.
+func (ev *CollateralAlertEvent) Sequence() *EventSequence {
+	return &ev.seq
+}
+
+// CollateralAlerts resolves the list of collateral ratio alerts registered by the calling API key.
+func (rs *rootResolver) CollateralAlerts(ctx context.Context) ([]*CollateralAlert, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().CollateralAlerts(&key.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*CollateralAlert, len(list))
+	for i, ca := range list {
+		res[i] = &CollateralAlert{*ca}
+	}
+	return res, nil
+}
+
+// RegisterCollateralAlert registers a new collateral ratio alert for the given fMint account
+// owned by the calling API key.
+func (rs *rootResolver) RegisterCollateralAlert(ctx context.Context, args struct {
+	Address    common.Address
+	Threshold4 hexutil.Uint64
+	Webhook    *string
+}) (*CollateralAlert, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if args.Threshold4 == 0 || uint64(args.Threshold4) > uint64(1<<62) {
+		return nil, fmt.Errorf("invalid threshold")
+	}
+	if args.Webhook != nil {
+		if err := validateWebhookURL(*args.Webhook); err != nil {
+			return nil, err
+		}
+	}
+
+	// check the limit
+	list, err := repository.R().CollateralAlerts(&key.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) >= collateralAlertsMaxPerKey {
+		return nil, fmt.Errorf("too many alerts, at most %d alerts can be registered", collateralAlertsMaxPerKey)
+	}
+
+	ca, err := repository.R().RegisterCollateralAlert(key.Name, args.Address, int64(args.Threshold4), args.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	return &CollateralAlert{*ca}, nil
+}
+
+// RemoveCollateralAlert removes a collateral ratio alert owned by the calling API key.
+func (rs *rootResolver) RemoveCollateralAlert(ctx context.Context, args struct{ Id string }) (bool, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return false, err
+	}
+
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return false, err
+	}
+	return repository.R().RemoveCollateralAlert(args.Id, key.Name)
+}
+
+// Id resolves the identifier of the alert.
+func (ca *CollateralAlert) Id() string {
+	return ca.ID
+}
+
+// Address resolves the address of the watched fMint account.
+func (ca *CollateralAlert) Address() common.Address {
+	return common.HexToAddress(ca.CollateralAlert.Address)
+}
+
+// Threshold4 resolves the threshold of the collateral ratio in 4 digits.
+func (ca *CollateralAlert) Threshold4() hexutil.Uint64 {
+	return hexutil.Uint64(ca.CollateralAlert.Threshold4)
+}
+
+// Ratio4 resolves the collateral ratio observed on the last crossing, or registration.
+func (ca *CollateralAlert) Ratio4() *hexutil.Uint64 {
+	return ratio4ToUint64(ca.CollateralAlert.Ratio4)
+}
+
+// Created resolves the UNIX time stamp of the alert registration.
+func (ca *CollateralAlert) Created() hexutil.Uint64 {
+	return hexutil.Uint64(ca.CollateralAlert.Created.Unix())
+}
+
+// Triggered resolves the UNIX time stamp of the last threshold crossing, if any.
+func (ca *CollateralAlert) Triggered() *hexutil.Uint64 {
+	if ca.CollateralAlert.Triggered == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(ca.CollateralAlert.Triggered.Unix())
+	return &ts
+}
+
+// AlertId resolves the identifier of the triggered alert.
+func (ev *CollateralAlertEvent) AlertId() string {
+	return ev.AlertID
+}
+
+// Threshold4 resolves the threshold of the collateral ratio in 4 digits.
+func (ev *CollateralAlertEvent) Threshold4() hexutil.Uint64 {
+	return hexutil.Uint64(ev.CollateralAlertEvent.Threshold4)
+}
+
+// Ratio4 resolves the current collateral ratio of the account in 4 digits.
+func (ev *CollateralAlertEvent) Ratio4() *hexutil.Uint64 {
+	return ratio4ToUint64(ev.CollateralAlertEvent.Ratio4)
+}
+
+// Stamp resolves the UNIX time stamp of the threshold crossing.
+func (ev *CollateralAlertEvent) Stamp() hexutil.Uint64 {
+	return hexutil.Uint64(ev.CollateralAlertEvent.Stamp)
+}
+
+// ratio4ToUint64 converts the optional collateral ratio to its resolvable form.
+func ratio4ToUint64(r *int64) *hexutil.Uint64 {
+	if r == nil || *r < 0 {
+		return nil
+	}
+	val := hexutil.Uint64(*r)
+	return &val
+}
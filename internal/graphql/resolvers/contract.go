@@ -180,6 +180,10 @@ func updateContractFromInput(con *ContractValidationInput, sc *types.Contract) {
 // the contract as validated if the match is found. Peer API points are ringed on success
 // to notify them about the change.
 func (rs *rootResolver) ValidateContract(args *struct{ Contract ContractValidationInput }) (*Contract, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
 	// validate the input
 	if err := isValidationValid(&args.Contract); err != nil {
 		log.Errorf("can not validate contract, validation request is not valid; %s", err.Error())
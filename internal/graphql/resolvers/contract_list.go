@@ -32,8 +32,16 @@ func (rs *rootResolver) Contracts(args *struct {
 	Cursor        *Cursor
 	Count         int32
 }) (*ContractList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the contract list from repository
@@ -0,0 +1,58 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractMethodStat represents resolvable usage statistics of a contract method.
+type ContractMethodStat struct {
+	types.ContractMethodStat
+}
+
+// MethodStats resolves usage statistics of the contract methods called in the given period.
+func (con *Contract) MethodStats(args struct{ Period string }) ([]*ContractMethodStat, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().ContractMethodStats(&con.Address, args.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ContractMethodStat, len(list))
+	for i := range list {
+		res[i] = &ContractMethodStat{list[i]}
+	}
+	return res, nil
+}
+
+// Calls resolves the number of calls of the method.
+func (cms *ContractMethodStat) Calls() hexutil.Uint64 {
+	return hexutil.Uint64(cms.ContractMethodStat.Calls)
+}
+
+// Failed resolves the number of failed calls of the method.
+func (cms *ContractMethodStat) Failed() hexutil.Uint64 {
+	return hexutil.Uint64(cms.ContractMethodStat.Failed)
+}
+
+// GasUsed resolves the total amount of gas used by the calls of the method.
+func (cms *ContractMethodStat) GasUsed() hexutil.Uint64 {
+	return hexutil.Uint64(cms.ContractMethodStat.GasUsed)
+}
+
+// AvgDataSize resolves the average size of the call data in bytes.
+func (cms *ContractMethodStat) AvgDataSize() float64 {
+	return cms.ContractMethodStat.AvgDataSize
+}
+
+// MaxDataSize resolves the max size of the call data in bytes.
+func (cms *ContractMethodStat) MaxDataSize() int32 {
+	return int32(cms.ContractMethodStat.MaxDataSize)
+}
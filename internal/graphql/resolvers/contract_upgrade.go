@@ -0,0 +1,61 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractUpgrade represents a resolvable upgrade of a proxy contract.
+type ContractUpgrade struct {
+	types.ContractUpgrade
+}
+
+// IsProxy resolves if the contract is an EIP-1967 proxy.
+func (con *Contract) IsProxy() (bool, error) {
+	impl, err := con.Implementation()
+	return impl != nil, err
+}
+
+// Implementation resolves the current implementation address of an EIP-1967 proxy contract.
+func (con *Contract) Implementation() (*common.Address, error) {
+	impl, err := repository.R().ProxyImplementation(&con.Address)
+	if err != nil {
+		log.Errorf("can not resolve implementation of %s; %s", con.Address.String(), err.Error())
+		return nil, err
+	}
+	return impl, nil
+}
+
+// UpgradeHistory resolves the most recent upgrades of the proxy contract, the newest first.
+func (con *Contract) UpgradeHistory(args struct{ Count int32 }) ([]*ContractUpgrade, error) {
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
+	if args.Count <= 0 || args.Count > accMaxTransactionsPerRequest {
+		args.Count = accMaxTransactionsPerRequest
+	}
+
+	list, err := repository.R().ContractUpgrades(&con.Address, args.Count)
+	if err != nil {
+		log.Errorf("can not get upgrades of %s; %s", con.Address.String(), err.Error())
+		return nil, err
+	}
+
+	res := make([]*ContractUpgrade, len(list))
+	for i, cu := range list {
+		res[i] = &ContractUpgrade{ContractUpgrade: *cu}
+	}
+	return res, nil
+}
+
+// Transaction resolves the transaction executing the upgrade.
+func (cu *ContractUpgrade) Transaction() (*Transaction, error) {
+	tx, err := repository.R().Transaction(&cu.TrxHash)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(tx), nil
+}
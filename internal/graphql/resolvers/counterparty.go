@@ -0,0 +1,81 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// counterpartiesMaxCount represents the max number of counterparties resolved at once.
+const counterpartiesMaxCount = 100
+
+// Counterparty represents resolvable counterparty of an account.
+type Counterparty struct {
+	types.Counterparty
+}
+
+// Counterparties resolves the list of top counterparties of the given address.
+func (rs *rootResolver) Counterparties(args struct {
+	Address common.Address
+	Period  string
+	Count   int32
+}) ([]*Counterparty, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if args.Count <= 0 || args.Count > counterpartiesMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", counterpartiesMaxCount)
+	}
+
+	list, err := repository.R().Counterparties(&args.Address, args.Period, args.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Counterparty, len(list))
+	for i, cp := range list {
+		res[i] = &Counterparty{*cp}
+	}
+	return res, nil
+}
+
+// Account resolves the account of the counterparty.
+func (cp *Counterparty) Account() (*Account, error) {
+	acc, err := repository.R().Account(&cp.Address)
+	if err != nil {
+		return nil, err
+	}
+	return NewAccount(acc), nil
+}
+
+// TrxCount resolves the total number of transactions with the counterparty.
+func (cp *Counterparty) TrxCount() hexutil.Uint64 {
+	return hexutil.Uint64(cp.Counterparty.TrxCount)
+}
+
+// SentCount resolves the number of transactions sent to the counterparty.
+func (cp *Counterparty) SentCount() hexutil.Uint64 {
+	return hexutil.Uint64(cp.Counterparty.SentCount)
+}
+
+// ReceivedCount resolves the number of transactions received from the counterparty.
+func (cp *Counterparty) ReceivedCount() hexutil.Uint64 {
+	return hexutil.Uint64(cp.Counterparty.ReceivedCount)
+}
+
+// SentVolume resolves the amount of native tokens sent to the counterparty.
+func (cp *Counterparty) SentVolume() hexutil.Big {
+	return hexutil.Big(*cp.Counterparty.SentVolume)
+}
+
+// ReceivedVolume resolves the amount of native tokens received from the counterparty.
+func (cp *Counterparty) ReceivedVolume() hexutil.Big {
+	return hexutil.Big(*cp.Counterparty.ReceivedVolume)
+}
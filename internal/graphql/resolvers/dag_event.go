@@ -0,0 +1,73 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DagEvent represents resolvable Lachesis consensus event.
+type DagEvent struct {
+	types.DagEvent
+}
+
+// Validator resolves the validator who created the event.
+func (de *DagEvent) Validator() (*Staker, error) {
+	st, err := repository.R().Validator((*hexutil.Big)(new(big.Int).SetUint64(uint64(de.Creator))))
+	if err != nil {
+		return nil, err
+	}
+	return NewStaker(st), nil
+}
+
+// DagEvent resolves Lachesis consensus event by its id.
+func (rs *rootResolver) DagEvent(args *struct{ Id common.Hash }) (*DagEvent, error) {
+	ev, err := repository.R().DagEvent(&args.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &DagEvent{*ev}, nil
+}
+
+// DagHeads resolves the list of head events of the given epoch, or the current epoch.
+func (rs *rootResolver) DagHeads(args *struct{ Epoch *hexutil.Uint64 }) ([]*DagEvent, error) {
+	heads, err := repository.R().DagHeads(args.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return dagEvents(heads)
+}
+
+// ParentEvents resolves the list of parent events of the event.
+func (de *DagEvent) ParentEvents() ([]*DagEvent, error) {
+	return dagEvents(de.Parents)
+}
+
+// TransactionHashes resolves the list of hashes of transactions included in the event.
+func (de *DagEvent) TransactionHashes() ([]common.Hash, error) {
+	pl, err := repository.R().DagEventPayload(&de.Id)
+	if err != nil {
+		return nil, err
+	}
+	if pl.Transactions == nil {
+		return []common.Hash{}, nil
+	}
+	return pl.Transactions, nil
+}
+
+// dagEvents loads the list of DAG events by their ids.
+func dagEvents(ids []common.Hash) ([]*DagEvent, error) {
+	list := make([]*DagEvent, len(ids))
+	for i := range ids {
+		ev, err := repository.R().DagEvent(&ids[i])
+		if err != nil {
+			return nil, err
+		}
+		list[i] = &DagEvent{*ev}
+	}
+	return list, nil
+}
@@ -0,0 +1,66 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Decentralization represents resolvable stake distribution metrics of an epoch validator set.
+type Decentralization struct {
+	types.Decentralization
+}
+
+// Decentralization resolves stake distribution metrics of the validator set of the given sealed epoch.
+func (rs *rootResolver) Decentralization(args *struct{ Epoch *hexutil.Uint64 }) (*Decentralization, error) {
+	dc, err := repository.R().Decentralization(args.Epoch)
+	if err != nil {
+		log.Errorf("can not get decentralization metrics; %s", err.Error())
+		return nil, err
+	}
+	return &Decentralization{Decentralization: *dc}, nil
+}
+
+// Validators resolves the number of validators in the epoch validator set.
+func (dc *Decentralization) Validators() int32 {
+	return int32(len(dc.Stakes))
+}
+
+// TopShare resolves the share of the top N validators on the total stake.
+func (dc *Decentralization) TopShare(args struct{ N int32 }) float64 {
+	n := int(args.N)
+	if n < 0 {
+		n = 0
+	}
+	if n > len(dc.Stakes) {
+		n = len(dc.Stakes)
+	}
+
+	sum := new(big.Int)
+	for _, st := range dc.Stakes[:n] {
+		sum.Add(sum, st)
+	}
+	return dc.share(sum)
+}
+
+// Hhi resolves the Herfindahl-Hirschman index of the stake distribution.
+func (dc *Decentralization) Hhi() float64 {
+	var hhi float64
+	for _, st := range dc.Stakes {
+		s := dc.share(st)
+		hhi += s * s
+	}
+	return hhi
+}
+
+// share calculates the share of the given amount on the total stake.
+func (dc *Decentralization) share(val *big.Int) float64 {
+	if dc.TotalStake.ToInt().Sign() == 0 {
+		return 0
+	}
+	s, _ := new(big.Float).Quo(new(big.Float).SetInt(val), new(big.Float).SetInt(dc.TotalStake.ToInt())).Float64()
+	return s
+}
@@ -0,0 +1,32 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DecodedTransaction represents resolvable raw transaction decoded without sending it.
+type DecodedTransaction struct {
+	types.DecodedTransaction
+}
+
+// DecodeTransaction resolves raw RLP encoded transaction decoded without sending it.
+func (rs *rootResolver) DecodeTransaction(args *struct{ RawRlp hexutil.Bytes }) (*DecodedTransaction, error) {
+	dt, err := repository.R().DecodeTransaction(args.RawRlp)
+	if err != nil {
+		log.Debugf("can not decode raw transaction; %s", err.Error())
+		return nil, err
+	}
+	return &DecodedTransaction{*dt}, nil
+}
+
+// Call resolves the input data of the transaction decoded by a known ABI.
+func (dt *DecodedTransaction) Call() *DecodedCall {
+	if dt.DecodedTransaction.Call == nil {
+		return nil
+	}
+	return &DecodedCall{*dt.DecodedTransaction.Call}
+}
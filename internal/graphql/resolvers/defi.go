@@ -4,6 +4,7 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -49,15 +50,28 @@ func (rs *rootResolver) DefiNativeToken() *ERC20Token {
 }
 
 // Price resolves the value of the token in ref. denomination
-// using on-chain price oracle.
+// using on-chain price oracle, or the AMM TWAP fallback.
 func (dt *DefiToken) Price() (hexutil.Big, error) {
-	return repository.R().DefiTokenPrice(&dt.Address)
+	pri, err := repository.R().DefiTokenPriceSourced(&dt.Address)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return pri.Price, nil
+}
+
+// PriceSource resolves the source of the token price.
+func (dt *DefiToken) PriceSource() (string, error) {
+	pri, err := repository.R().DefiTokenPriceSourced(&dt.Address)
+	if err != nil {
+		return "", err
+	}
+	return pri.Source, nil
 }
 
 // AvailableBalance resolves the total amount of ERC20 tokens
 // available to the specified token holder.
-func (dt *DefiToken) AvailableBalance(args *struct{ Owner common.Address }) (hexutil.Big, error) {
-	return repository.R().Erc20BalanceOf(&dt.Address, &args.Owner)
+func (dt *DefiToken) AvailableBalance(ctx context.Context, args *struct{ Owner common.Address }) (hexutil.Big, error) {
+	return repository.R().Erc20BalanceOf(&dt.Address, &args.Owner, pinnedBlock(ctx))
 }
 
 // Allowance resolves the total amount of ERC20 tokens unlocked
@@ -0,0 +1,12 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+)
+
+// DefiOverview resolves network-wide aggregates of the DeFi modules.
+func (rs *rootResolver) DefiOverview() (*types.DefiOverview, error) {
+	return repository.R().DefiOverview()
+}
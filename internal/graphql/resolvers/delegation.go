@@ -4,6 +4,7 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 	"math/big"
 	"strings"
 	"time"
@@ -64,10 +65,10 @@ func (del Delegation) ToStakerId() hexutil.Big {
 	return *del.Delegation.ToStakerId
 }
 
-// Amount returns total delegated amount for the delegator.
-func (del Delegation) Amount() (hexutil.Big, error) {
+// Amount returns total delegated amount for the delegator at the block pinned for the query.
+func (del Delegation) Amount(ctx context.Context) (hexutil.Big, error) {
 	// get the base amount delegated
-	base, err := repository.R().DelegationAmountStaked(&del.Address, del.Delegation.ToStakerId)
+	base, err := repository.R().DelegationAmountStaked(&del.Address, del.Delegation.ToStakerId, pinnedBlock(ctx))
 	if err != nil {
 		return hexutil.Big{}, err
 	}
@@ -95,6 +96,7 @@ func (del Delegation) pendingWithdrawalsValue() (*big.Int, error) {
 }
 
 // AmountInWithdraw returns total delegated amount in pending withdrawals for the delegator.
+// The amount comes from the indexed withdraw requests and ignores the block pinned for the query.
 func (del Delegation) AmountInWithdraw() (hexutil.Big, error) {
 	val, err := del.pendingWithdrawalsValue()
 	if err != nil {
@@ -103,9 +105,9 @@ func (del Delegation) AmountInWithdraw() (hexutil.Big, error) {
 	return (hexutil.Big)(*val), nil
 }
 
-// PendingRewards resolves pending rewards for the delegator account.
-func (del Delegation) PendingRewards() (types.PendingRewards, error) {
-	r, err := repository.R().PendingRewards(&del.Address, del.Delegation.ToStakerId)
+// PendingRewards resolves pending rewards for the delegator account at the block pinned for the query.
+func (del Delegation) PendingRewards(ctx context.Context) (types.PendingRewards, error) {
+	r, err := repository.R().PendingRewards(&del.Address, del.Delegation.ToStakerId, pinnedBlock(ctx))
 	if err != nil {
 		return types.PendingRewards{}, err
 	}
@@ -121,6 +123,25 @@ func (del Delegation) ClaimedReward() (hexutil.Big, error) {
 	return (hexutil.Big)(*val), nil
 }
 
+// StashedRewards resolves the amount of rewards stashed in the SFC contract for the delegation.
+func (del Delegation) StashedRewards(ctx context.Context) (hexutil.Big, error) {
+	val, err := repository.R().StashedRewards(&del.Address, (*big.Int)(del.Delegation.ToStakerId), pinnedBlock(ctx))
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return (hexutil.Big)(*val), nil
+}
+
+// RewardSplit resolves the split of claimed rewards between the rewards
+// paid to the delegator account and the rewards re-staked into the delegation.
+func (del Delegation) RewardSplit() (*types.RewardSplit, error) {
+	claimed, restaked, err := repository.R().RewardsClaimedSplit(&del.Address, (*big.Int)(del.Delegation.ToStakerId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.RewardSplit{Claimed: (hexutil.Big)(*claimed), Restaked: (hexutil.Big)(*restaked)}, nil
+}
+
 // WithdrawRequests resolves partial withdraw requests of the delegator.
 func (del Delegation) WithdrawRequests(args struct {
 	Cursor *Cursor
@@ -128,6 +149,9 @@ func (del Delegation) WithdrawRequests(args struct {
 }) ([]WithdrawRequest, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// pull list of withdrawals
@@ -153,6 +177,9 @@ func (del Delegation) RewardClaims(args struct {
 }) (*RewardClaimList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// pull list of withdrawals
@@ -166,10 +193,10 @@ func (del Delegation) RewardClaims(args struct {
 }
 
 // DelegationLock returns information about delegation lock
-func (del Delegation) DelegationLock() (*types.DelegationLock, error) {
+func (del Delegation) DelegationLock(ctx context.Context) (*types.DelegationLock, error) {
 	// load the delegations lock only once
 	dl, err, _ := del.cg.Do("lock", func() (interface{}, error) {
-		return repository.R().DelegationLock(&del.Address, del.Delegation.ToStakerId)
+		return repository.R().DelegationLock(&del.Address, del.Delegation.ToStakerId, pinnedBlock(ctx))
 	})
 	if err != nil {
 		return nil, err
@@ -178,8 +205,8 @@ func (del Delegation) DelegationLock() (*types.DelegationLock, error) {
 }
 
 // IsDelegationLocked signals if the delegation is locked right now.
-func (del Delegation) IsDelegationLocked() (bool, error) {
-	lock, err := del.DelegationLock()
+func (del Delegation) IsDelegationLocked(ctx context.Context) (bool, error) {
+	lock, err := del.DelegationLock(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -192,8 +219,8 @@ func (del Delegation) IsFluidStakingActive() (bool, error) {
 }
 
 // LockedUntil resolves the end time of delegation.
-func (del Delegation) LockedUntil() (hexutil.Uint64, error) {
-	lock, err := del.DelegationLock()
+func (del Delegation) LockedUntil(ctx context.Context) (hexutil.Uint64, error) {
+	lock, err := del.DelegationLock(ctx)
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
@@ -205,8 +232,8 @@ func (del Delegation) LockedUntil() (hexutil.Uint64, error) {
 }
 
 // LockDuration resolves the original duration of the active delegation lock.
-func (del Delegation) LockDuration() (hexutil.Uint64, error) {
-	lock, err := del.DelegationLock()
+func (del Delegation) LockDuration(ctx context.Context) (hexutil.Uint64, error) {
+	lock, err := del.DelegationLock(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -217,8 +244,8 @@ func (del Delegation) LockDuration() (hexutil.Uint64, error) {
 }
 
 // LockedFromEpoch resolves the epoch om which the lock has been created.
-func (del Delegation) LockedFromEpoch() (hexutil.Uint64, error) {
-	lock, err := del.DelegationLock()
+func (del Delegation) LockedFromEpoch(ctx context.Context) (hexutil.Uint64, error) {
+	lock, err := del.DelegationLock(ctx)
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
@@ -230,8 +257,8 @@ func (del Delegation) LockedFromEpoch() (hexutil.Uint64, error) {
 }
 
 // LockedAmount resolves the total amount of delegation locked.
-func (del Delegation) LockedAmount() (hexutil.Big, error) {
-	lock, err := del.DelegationLock()
+func (del Delegation) LockedAmount(ctx context.Context) (hexutil.Big, error) {
+	lock, err := del.DelegationLock(ctx)
 	if err != nil {
 		return hexutil.Big{}, err
 	}
@@ -0,0 +1,42 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NextActions resolves the list of actions recommended to the delegator on its delegations.
+func (rs *rootResolver) NextActions(args *struct{ Address common.Address }) ([]*types.DelegationAction, error) {
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+	return repository.R().DelegationNextActions(&args.Address)
+}
+
+// IsWithdrawableNow signals if the delegation has a pending withdraw request
+// past the withdrawal period, which can be withdrawn right now.
+func (del Delegation) IsWithdrawableNow() (bool, error) {
+	wl, err := repository.R().WithdrawableRequests(&del.Address, del.Delegation.ToStakerId)
+	if err != nil {
+		return false, err
+	}
+	return len(wl) > 0, nil
+}
+
+// IsLockExpired signals if the delegation lock ended while the stake is still marked as locked.
+func (del Delegation) IsLockExpired(ctx context.Context) (bool, error) {
+	lock, err := del.DelegationLock(ctx)
+	if err != nil {
+		return false, err
+	}
+	return lock.IsExpired(), nil
+}
+
+// IsDust signals if the active amount of the delegation is below the dust threshold.
+func (del Delegation) IsDust() bool {
+	return del.Delegation.AmountDelegated != nil && repository.R().DelegationIsDust(del.Delegation.AmountDelegated.ToInt())
+}
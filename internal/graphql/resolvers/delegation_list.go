@@ -62,18 +62,55 @@ func (dle *DelegationListEdge) Cursor() Cursor {
 	return Cursor(dle.Delegation.ID)
 }
 
+// DelegationFilter represents a filter narrowing a list of delegations.
+type DelegationFilter struct {
+	LockedOnly bool
+	ActiveOnly bool
+}
+
+// delegationListOptions builds the list options of the given ordering and filter.
+// Ordering by pending rewards pulls the rewards from the node, so it's shed under pressure.
+func delegationListOptions(orderBy string, filter *DelegationFilter) (*types.DelegationListOptions, error) {
+	if orderBy == types.DelegationOrderPendingRewards {
+		if err := shedLoad(queryClassHeavyList); err != nil {
+			return nil, err
+		}
+	}
+
+	opt := types.DelegationListOptions{OrderBy: orderBy}
+	if filter != nil {
+		opt.LockedOnly = filter.LockedOnly
+		opt.ActiveOnly = filter.ActiveOnly
+	}
+	return &opt, nil
+}
+
 // DelegationsOf resolves a list of delegations information of a staker.
 func (rs *rootResolver) DelegationsOf(args *struct {
-	Staker hexutil.Big
-	Cursor *Cursor
-	Count  int32
+	Staker  hexutil.Big
+	Cursor  *Cursor
+	Count   int32
+	OrderBy string
+	Filter  *DelegationFilter
 }) (*DelegationList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list
-	dl, err := repository.R().DelegationsOfValidator(&args.Staker, (*string)(args.Cursor), args.Count)
+	opt, err := delegationListOptions(args.OrderBy, args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	dl, err := repository.R().DelegationsOfValidator(&args.Staker, (*string)(args.Cursor), args.Count, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -87,13 +124,22 @@ func (rs *rootResolver) DelegationsByAddress(args *struct {
 	Address common.Address
 	Cursor  *Cursor
 	Count   int32
+	OrderBy string
+	Filter  *DelegationFilter
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of delegations
-	dl, err := repository.R().DelegationsByAddress(&args.Address, (*string)(args.Cursor), args.Count)
+	opt, err := delegationListOptions(args.OrderBy, args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	dl, err := repository.R().DelegationsByAddress(&args.Address, (*string)(args.Cursor), args.Count, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,17 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+)
+
+// Enrichments resolves the off-chain data tags of the account.
+func (acc *Account) Enrichments() []types.EnrichmentTag {
+	return repository.R().Enrichments(&acc.Address)
+}
+
+// Enrichments resolves the off-chain data tags of the contract.
+func (con *Contract) Enrichments() []types.EnrichmentTag {
+	return repository.R().Enrichments(&con.Address)
+}
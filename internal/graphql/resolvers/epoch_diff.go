@@ -0,0 +1,84 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EpochDiff represents resolvable changes of the validator set and the supply between two sealed epochs.
+type EpochDiff struct {
+	types.EpochDiff
+}
+
+// EpochValidatorDiff represents a resolvable change of the stake received by a validator.
+type EpochValidatorDiff struct {
+	types.EpochValidatorDiff
+}
+
+// EpochDiff resolves the changes of the validator set and the supply between the given sealed epochs.
+func (rs *rootResolver) EpochDiff(args *struct {
+	A hexutil.Uint64
+	B hexutil.Uint64
+}) (*EpochDiff, error) {
+	diff, err := repository.R().EpochDiff(args.A, args.B)
+	if err != nil {
+		log.Errorf("can not compare epochs #%d and #%d; %s", uint64(args.A), uint64(args.B), err.Error())
+		return nil, err
+	}
+	return &EpochDiff{EpochDiff: *diff}, nil
+}
+
+// TotalStakeDelta resolves the change of the total stake of the validator set.
+func (ed *EpochDiff) TotalStakeDelta() hexutil.Big {
+	return bigDelta(&ed.TotalStakeFrom, &ed.TotalStakeTo)
+}
+
+// TotalSupplyDelta resolves the change of the total supply.
+func (ed *EpochDiff) TotalSupplyDelta() hexutil.Big {
+	return bigDelta(&ed.TotalSupplyFrom, &ed.TotalSupplyTo)
+}
+
+// Validators resolves the stake changes of the validators sorted from the largest absolute change.
+func (ed *EpochDiff) Validators() []*EpochValidatorDiff {
+	list := make([]*EpochValidatorDiff, len(ed.EpochDiff.Validators))
+	for i, vd := range ed.EpochDiff.Validators {
+		list[i] = &EpochValidatorDiff{EpochValidatorDiff: *vd}
+	}
+	return list
+}
+
+// Added resolves the identifiers of the validators which joined the validator set.
+func (ed *EpochDiff) Added() []hexutil.Big {
+	list := make([]hexutil.Big, 0)
+	for _, vd := range ed.EpochDiff.Validators {
+		if vd.IsNew {
+			list = append(list, vd.ValidatorId)
+		}
+	}
+	return list
+}
+
+// Removed resolves the identifiers of the validators which left the validator set.
+func (ed *EpochDiff) Removed() []hexutil.Big {
+	list := make([]hexutil.Big, 0)
+	for _, vd := range ed.EpochDiff.Validators {
+		if vd.IsRemoved {
+			list = append(list, vd.ValidatorId)
+		}
+	}
+	return list
+}
+
+// StakeDelta resolves the change of the stake received by the validator.
+func (vd *EpochValidatorDiff) StakeDelta() hexutil.Big {
+	return bigDelta(&vd.StakeFrom, &vd.StakeTo)
+}
+
+// bigDelta calculates the difference of the given values.
+func bigDelta(from *hexutil.Big, to *hexutil.Big) hexutil.Big {
+	return hexutil.Big(*new(big.Int).Sub(to.ToInt(), from.ToInt()))
+}
@@ -0,0 +1,46 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EpochValidator represents a resolvable member of an epoch validator set.
+type EpochValidator struct {
+	types.EpochValidator
+	totalStake *big.Int
+}
+
+// ValidatorsAt resolves the validator set of the given sealed epoch.
+func (rs *rootResolver) ValidatorsAt(args *struct{ Epoch hexutil.Uint64 }) ([]*EpochValidator, error) {
+	list, err := repository.R().EpochValidators(args.Epoch)
+	if err != nil {
+		log.Errorf("can not get validators of epoch #%d; %s", uint64(args.Epoch), err.Error())
+		return nil, err
+	}
+
+	// sum the stake of the set so we can calculate weights
+	total := new(big.Int)
+	for _, ev := range list {
+		total.Add(total, ev.ReceivedStake.ToInt())
+	}
+
+	res := make([]*EpochValidator, len(list))
+	for i, ev := range list {
+		res[i] = &EpochValidator{EpochValidator: *ev, totalStake: total}
+	}
+	return res, nil
+}
+
+// Weight resolves the share of the validator on the total stake of the epoch validator set.
+func (ev *EpochValidator) Weight() float64 {
+	if ev.totalStake == nil || ev.totalStake.Sign() == 0 {
+		return 0
+	}
+	w, _ := new(big.Float).Quo(new(big.Float).SetInt(ev.ReceivedStake.ToInt()), new(big.Float).SetInt(ev.totalStake)).Float64()
+	return w
+}
@@ -4,6 +4,7 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -48,11 +49,11 @@ func (rs *rootResolver) ErcTotalSupply(args *struct{ Token common.Address }) (he
 
 // ErcTokenBalance resolves the current available balance of the specified token
 // for the specified owner.
-func (rs *rootResolver) ErcTokenBalance(args *struct {
+func (rs *rootResolver) ErcTokenBalance(ctx context.Context, args *struct {
 	Owner common.Address
 	Token common.Address
 }) (hexutil.Big, error) {
-	return repository.R().Erc20BalanceOf(&args.Token, &args.Owner)
+	return repository.R().Erc20BalanceOf(&args.Token, &args.Owner, pinnedBlock(ctx))
 }
 
 // ErcTokenAllowance resolves the current amount of ERC20 tokens unlocked
@@ -71,8 +72,8 @@ func (token *ERC20Token) TotalSupply() (hexutil.Big, error) {
 }
 
 // BalanceOf resolves the available balance of the given ERC20 token to a user.
-func (token *ERC20Token) BalanceOf(args *struct{ Owner common.Address }) (hexutil.Big, error) {
-	return repository.R().Erc20BalanceOf(&token.Address, &args.Owner)
+func (token *ERC20Token) BalanceOf(ctx context.Context, args *struct{ Owner common.Address }) (hexutil.Big, error) {
+	return repository.R().Erc20BalanceOf(&token.Address, &args.Owner, pinnedBlock(ctx))
 }
 
 // Allowance resolves the unlocked allowance of the given ERC20 token from the owner to spender.
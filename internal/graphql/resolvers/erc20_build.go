@@ -0,0 +1,43 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BuildErc20ApproveTx prepares an unsigned ERC20 transaction approving the spender
+// to transfer the given amount of tokens of the owner.
+func (rs *rootResolver) BuildErc20ApproveTx(args *struct {
+	Owner   common.Address
+	Token   common.Address
+	Spender common.Address
+	Amount  string
+}) (*types.PreparedTransaction, error) {
+	tx, err := repository.R().BuildErc20ApproveTx(&args.Owner, &args.Token, &args.Spender, args.Amount)
+	if err != nil {
+		log.Debugf("can not build ERC20 %s approval of %s to %s; %s", args.Token.String(), args.Owner.String(), args.Spender.String(), err.Error())
+		return nil, err
+	}
+	return tx, nil
+}
+
+// BuildErc20TransferTx prepares an unsigned ERC20 transaction sending the given amount
+// of tokens to the recipient, either from the sender's balance, or from the owner's balance
+// using the sender's allowance.
+func (rs *rootResolver) BuildErc20TransferTx(args *struct {
+	Sender    common.Address
+	Token     common.Address
+	Recipient common.Address
+	Amount    string
+	Owner     *common.Address
+}) (*types.PreparedTransaction, error) {
+	tx, err := repository.R().BuildErc20TransferTx(&args.Sender, &args.Token, args.Owner, &args.Recipient, args.Amount)
+	if err != nil {
+		log.Debugf("can not build ERC20 %s transfer of %s to %s; %s", args.Token.String(), args.Sender.String(), args.Recipient.String(), err.Error())
+		return nil, err
+	}
+	return tx, nil
+}
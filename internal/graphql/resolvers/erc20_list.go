@@ -11,6 +11,9 @@ import (
 func (rs *rootResolver) Erc20TokenList(args struct{ Count int32 }) ([]*ERC20Token, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of addresses of active tokens
@@ -35,6 +38,9 @@ func (rs *rootResolver) Erc20Assets(args struct {
 }) ([]*ERC20Token, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of addresses of active tokens for the owner
@@ -55,7 +61,7 @@ func (rs *rootResolver) Erc20Assets(args struct {
 // ownsErc20Asset checks if the given owner has any tokens of the given ERC20.
 func (rs *rootResolver) ownsErc20Asset(token *common.Address, owner *common.Address) bool {
 	// get the balance for the owner
-	val, err := repository.R().Erc20BalanceOf(token, owner)
+	val, err := repository.R().Erc20BalanceOf(token, owner, nil)
 	if err != nil {
 		log.Errorf("token %s balance can not be loaded for %s; %s", token.String(), owner.String(), err.Error())
 		return false
@@ -9,6 +9,9 @@ import (
 func (rs *rootResolver) Erc721ContractList(args struct{ Count int32 }) ([]*ERC721Contract, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of addresses of active tokens
@@ -17,8 +17,16 @@ func (rs *rootResolver) Erc20Transactions(args struct {
 	Account *common.Address
 	TxType  *string
 }) (*ERC20TransactionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -47,8 +55,16 @@ func (rs *rootResolver) Erc721Transactions(args struct {
 	Account *common.Address
 	TxType  *string
 }) (*ERC721TransactionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
@@ -77,8 +93,16 @@ func (rs *rootResolver) Erc1155Transactions(args struct {
 	Account *common.Address
 	TxType  *string
 }) (*ERC1155TransactionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get the transaction hash list from repository
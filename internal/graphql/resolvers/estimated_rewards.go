@@ -50,7 +50,7 @@ func (rs *rootResolver) estimateRewardsByAddress(addr *common.Address, ep *types
 	log.Debugf("calculating rewards estimation for address [%s]", acc.Address.String())
 
 	// get the address balance
-	balance, err := repository.R().AccountBalance(&acc.Address)
+	balance, err := repository.R().AccountBalance(&acc.Address, nil)
 	if err != nil {
 		log.Errorf("can not get balance for address [%s]", acc.Address.String())
 		return EstimatedRewards{}, fmt.Errorf("address balance not found")
@@ -62,9 +62,11 @@ func (rs *rootResolver) estimateRewardsByAddress(addr *common.Address, ep *types
 }
 
 // EstimateRewards resolves reward estimation for the given address or amount staked.
+// If the epoch is specified, the reward parameters effective at the epoch are used.
 func (rs *rootResolver) EstimateRewards(args *struct {
 	Address *common.Address
 	Amount  *hexutil.Uint64
+	Epoch   *hexutil.Uint64
 }) (EstimatedRewards, error) {
 	// at least one of the parameters must be present
 	if args == nil || (args.Address == nil && args.Amount == nil) {
@@ -72,27 +74,48 @@ func (rs *rootResolver) EstimateRewards(args *struct {
 		return EstimatedRewards{}, fmt.Errorf("missing both address and amount")
 	}
 
+	// get the epoch and the total stake the estimation is based on
+	ep, total, err := estimationEpoch(args.Epoch)
+	if err != nil {
+		return EstimatedRewards{}, err
+	}
+
+	// if address is specified, pull the estimation from it
+	if args.Address != nil {
+		return rs.estimateRewardsByAddress(args.Address, ep, total)
+	}
+	return NewEstimatedRewards(ep, args.Amount, total), nil
+}
+
+// estimationEpoch provides the epoch and the total staked amount the reward estimation is based on.
+// The parameters snapshot of a past epoch is used if the epoch is specified,
+// the latest sealed epoch and the current total stake are used otherwise.
+func estimationEpoch(id *hexutil.Uint64) (*types.Epoch, *hexutil.Big, error) {
+	if id != nil {
+		ep, err := repository.R().Epoch(id)
+		if err != nil {
+			log.Errorf("can not get epoch #%d information; %s", uint64(*id), err.Error())
+			return nil, nil, fmt.Errorf("epoch #%d not found", uint64(*id))
+		}
+		return ep, &ep.StakeTotalAmount, nil
+	}
+
 	// get the latest sealed epoch
 	// the data could be delayed behind the real-time sealed epoch due to caching,
 	// but we don't need that precise reflection here
 	ep, err := repository.R().CurrentSealedEpoch()
 	if err != nil {
 		log.Errorf("can not get the current sealed epoch information; %s", err.Error())
-		return EstimatedRewards{}, fmt.Errorf("current sealed epoch not found")
+		return nil, nil, fmt.Errorf("current sealed epoch not found")
 	}
 
 	// get the current total staked amount
 	total, err := repository.R().TotalStaked()
 	if err != nil {
 		log.Errorf("can not get the current total staked amount; %s", err.Error())
-		return EstimatedRewards{}, fmt.Errorf("current total staked amount not found")
+		return nil, nil, fmt.Errorf("current total staked amount not found")
 	}
-
-	// if address is specified, pull the estimation from it
-	if args.Address != nil {
-		return rs.estimateRewardsByAddress(args.Address, ep, total)
-	}
-	return NewEstimatedRewards(ep, args.Amount, total), nil
+	return ep, total, nil
 }
 
 // canCalculateRewards checks if the reward can actually be calculated
@@ -0,0 +1,118 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// FeesPaidReport represents resolvable report of transaction fees paid by an address.
+type FeesPaidReport struct {
+	types.FeesPaidReport
+}
+
+// FeesPaidPeriod represents resolvable fees paid by an address in a period.
+type FeesPaidPeriod struct {
+	types.FeesPaidPeriod
+}
+
+// FeesPaidContract represents resolvable fees paid to interact with a contract.
+type FeesPaidContract struct {
+	types.FeesPaidContract
+}
+
+// FeesPaid resolves a report of transaction fees paid by the given address.
+func (rs *rootResolver) FeesPaid(args struct {
+	Address    common.Address
+	From       *string
+	To         *string
+	Resolution string
+}) (*FeesPaidReport, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// check the resolution
+	if args.Resolution != types.FeesPaidResolutionMonth && args.Resolution != types.FeesPaidResolutionDay {
+		return nil, fmt.Errorf("unknown resolution %s", args.Resolution)
+	}
+
+	// get the date range
+	from, to, err := trxVolumeRange(struct {
+		From *string
+		To   *string
+	}{From: args.From, To: args.To})
+	if err != nil {
+		return nil, err
+	}
+
+	// load the report
+	rep, err := repository.R().FeesPaid(&args.Address, from, to, args.Resolution)
+	if err != nil {
+		return nil, err
+	}
+	return &FeesPaidReport{*rep}, nil
+}
+
+// From resolves the beginning of the reported range.
+func (fpr *FeesPaidReport) From() string {
+	return fpr.FeesPaidReport.From.Format("2006-01-02")
+}
+
+// To resolves the end of the reported range.
+func (fpr *FeesPaidReport) To() string {
+	return fpr.FeesPaidReport.To.Format("2006-01-02")
+}
+
+// TotalFee resolves the total amount of fees paid in the range.
+func (fpr *FeesPaidReport) TotalFee() hexutil.Big {
+	return hexutil.Big(*fpr.Fee)
+}
+
+// TrxCount resolves the total number of transactions sent in the range.
+func (fpr *FeesPaidReport) TrxCount() hexutil.Uint64 {
+	return hexutil.Uint64(fpr.FeesPaidReport.TrxCount)
+}
+
+// Periods resolves the list of periods of the report.
+func (fpr *FeesPaidReport) Periods() []*FeesPaidPeriod {
+	list := make([]*FeesPaidPeriod, len(fpr.FeesPaidReport.Periods))
+	for i, p := range fpr.FeesPaidReport.Periods {
+		list[i] = &FeesPaidPeriod{*p}
+	}
+	return list
+}
+
+// Fee resolves the amount of fees paid in the period.
+func (fpp *FeesPaidPeriod) Fee() hexutil.Big {
+	return hexutil.Big(*fpp.FeesPaidPeriod.Fee)
+}
+
+// TrxCount resolves the number of transactions sent in the period.
+func (fpp *FeesPaidPeriod) TrxCount() hexutil.Uint64 {
+	return hexutil.Uint64(fpp.FeesPaidPeriod.TrxCount)
+}
+
+// Contracts resolves the breakdown of the fees paid in the period by recipients.
+func (fpp *FeesPaidPeriod) Contracts() []*FeesPaidContract {
+	list := make([]*FeesPaidContract, len(fpp.FeesPaidPeriod.Contracts))
+	for i, c := range fpp.FeesPaidPeriod.Contracts {
+		list[i] = &FeesPaidContract{*c}
+	}
+	return list
+}
+
+// Fee resolves the amount of fees paid to interact with the contract.
+func (fpc *FeesPaidContract) Fee() hexutil.Big {
+	return hexutil.Big(*fpc.FeesPaidContract.Fee)
+}
+
+// TrxCount resolves the number of transactions sent to the contract.
+func (fpc *FeesPaidContract) TrxCount() hexutil.Uint64 {
+	return hexutil.Uint64(fpc.FeesPaidContract.TrxCount)
+}
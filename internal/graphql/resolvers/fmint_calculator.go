@@ -0,0 +1,32 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxMintable resolves the max amount of the token the fMint account can mint
+// without dropping below the minimal collateral to debt ratio.
+func (rs *rootResolver) MaxMintable(args *struct {
+	Address common.Address
+	Token   common.Address
+}) (hexutil.Big, error) {
+	return repository.R().FMintMaxMintable(&args.Address, &args.Token)
+}
+
+// RepayRequiredForRatio resolves the debt the fMint account has to repay
+// to reach the given collateral to debt ratio.
+func (rs *rootResolver) RepayRequiredForRatio(args *struct {
+	Address     common.Address
+	TargetRatio hexutil.Uint64
+	Token       *common.Address
+}) (hexutil.Big, error) {
+	if args.TargetRatio == 0 || uint64(args.TargetRatio) > uint64(1<<62) {
+		return hexutil.Big{}, fmt.Errorf("invalid target ratio")
+	}
+	return repository.R().FMintRepayRequired(&args.Address, int64(args.TargetRatio), args.Token)
+}
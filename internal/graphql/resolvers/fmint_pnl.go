@@ -0,0 +1,101 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// fMintNativeDecimalsCorrection represents the correction applied to fUSD values
+// to get the native token amount from the native token price; both use 18 decimals.
+var fMintNativeDecimalsCorrection = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// FMintAccountPnL represents resolvable profit and loss of an fMint account.
+type FMintAccountPnL struct {
+	types.FMintAccountPnL
+}
+
+// FMintPositionPnL represents resolvable profit and loss of a single fMint position.
+type FMintPositionPnL struct {
+	types.FMintTokenPnL
+}
+
+// Pnl resolves the profit and loss of the fMint account.
+func (fac *FMintAccount) Pnl() (*FMintAccountPnL, error) {
+	pnl, err := repository.R().FMintAccountPnL(&fac.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &FMintAccountPnL{FMintAccountPnL: *pnl}, nil
+}
+
+// Total resolves the total profit and loss of the account after fees.
+func (pnl *FMintAccountPnL) Total() hexutil.Big {
+	val := new(big.Int).Add(pnl.Realized.ToInt(), pnl.Unrealized.ToInt())
+	return hexutil.Big(*val.Sub(val, pnl.Fees.ToInt()))
+}
+
+// RealizedNative resolves the realized profit and loss in native tokens.
+func (pnl *FMintAccountPnL) RealizedNative() *hexutil.Big {
+	return pnl.native(pnl.Realized.ToInt())
+}
+
+// UnrealizedNative resolves the unrealized profit and loss in native tokens.
+func (pnl *FMintAccountPnL) UnrealizedNative() *hexutil.Big {
+	return pnl.native(pnl.Unrealized.ToInt())
+}
+
+// FeesNative resolves the fees paid in native tokens.
+func (pnl *FMintAccountPnL) FeesNative() *hexutil.Big {
+	return pnl.native(pnl.Fees.ToInt())
+}
+
+// TotalNative resolves the total profit and loss after fees in native tokens.
+func (pnl *FMintAccountPnL) TotalNative() *hexutil.Big {
+	total := pnl.Total()
+	return pnl.native(total.ToInt())
+}
+
+// Positions resolves the list of positions of the account.
+func (pnl *FMintAccountPnL) Positions() []*FMintPositionPnL {
+	list := make([]*FMintPositionPnL, len(pnl.FMintAccountPnL.Positions))
+	for i, p := range pnl.FMintAccountPnL.Positions {
+		list[i] = &FMintPositionPnL{FMintTokenPnL: *p}
+	}
+	return list
+}
+
+// native converts the given fUSD value to native tokens using the current native token price.
+func (pnl *FMintAccountPnL) native(val *big.Int) *hexutil.Big {
+	// no price, no conversion
+	if pnl.NativePrice.ToInt().Sign() == 0 {
+		return nil
+	}
+
+	res := new(big.Int).Mul(val, fMintNativeDecimalsCorrection)
+	return (*hexutil.Big)(res.Quo(res, pnl.NativePrice.ToInt()))
+}
+
+// TokenAddress resolves the address of the position token.
+func (pp *FMintPositionPnL) TokenAddress() common.Address {
+	return pp.FMintTokenPnL.Token
+}
+
+// Token resolves the DeFi token of the position.
+func (pp *FMintPositionPnL) Token() (*DefiToken, error) {
+	tk, err := repository.R().DefiToken(&pp.FMintTokenPnL.Token)
+	if err != nil {
+		return nil, err
+	}
+	return NewDefiToken(tk), nil
+}
+
+// Total resolves the total profit and loss of the position after fees.
+func (pp *FMintPositionPnL) Total() hexutil.Big {
+	val := new(big.Int).Add(pp.Realized.ToInt(), pp.Unrealized.ToInt())
+	return hexutil.Big(*val.Sub(val, pp.Fees.ToInt()))
+}
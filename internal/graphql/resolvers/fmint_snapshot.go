@@ -0,0 +1,61 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// positionHistoryMaxDays represents the max number of days of fMint position history available.
+const positionHistoryMaxDays = 366
+
+// FMintPositionSnapshot represents a resolvable snapshot of an fMint position.
+type FMintPositionSnapshot struct {
+	types.FMintPositionSnapshot
+}
+
+// PositionHistory resolves snapshots of the fMint position of the given address
+// taken in the given number of recent days, the oldest first.
+func (rs *rootResolver) PositionHistory(args struct {
+	Address common.Address
+	Days    int32
+}) ([]*FMintPositionSnapshot, error) {
+	if args.Days <= 0 || args.Days > positionHistoryMaxDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", positionHistoryMaxDays)
+	}
+
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().FMintPositionHistory(&args.Address, int(args.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*FMintPositionSnapshot, len(list))
+	for i, ps := range list {
+		out[i] = &FMintPositionSnapshot{*ps}
+	}
+	return out, nil
+}
+
+// Stamp resolves the time stamp of the snapshot.
+func (ps *FMintPositionSnapshot) Stamp() hexutil.Uint64 {
+	return hexutil.Uint64(ps.FMintPositionSnapshot.Stamp.Unix())
+}
+
+// Ratio4 resolves the collateral to debt ratio of the position in 4 digits.
+func (ps *FMintPositionSnapshot) Ratio4() *hexutil.Uint64 {
+	return ratio4ToUint64(ps.FMintPositionSnapshot.Ratio4)
+}
+
+// MinRatio4 resolves the minimal collateral to debt ratio of the fMint contract at the time of the snapshot.
+func (ps *FMintPositionSnapshot) MinRatio4() hexutil.Uint64 {
+	return hexutil.Uint64(ps.FMintPositionSnapshot.MinRatio4)
+}
@@ -0,0 +1,133 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// fMintTrxTypeNames maps fMint transaction types to their API names.
+var fMintTrxTypeNames = map[int32]string{
+	types.FMintTrxTypeDeposit:  "DEPOSIT",
+	types.FMintTrxTypeWithdraw: "WITHDRAW",
+	types.FMintTrxTypeMint:     "MINT",
+	types.FMintTrxTypeRepay:    "REPAY",
+}
+
+// FMintTransaction represents a resolvable fMint position change.
+type FMintTransaction struct {
+	types.FMintTransaction
+}
+
+// FMintTransactionList represents resolvable list of fMint transaction edges structure.
+type FMintTransactionList struct {
+	types.FMintTransactionList
+}
+
+// FMintTransactionListEdge represents a single edge of an fMint transaction list structure.
+type FMintTransactionListEdge struct {
+	Trx *FMintTransaction
+}
+
+// NewFMintTransaction creates a new instance of resolvable fMint transaction.
+func NewFMintTransaction(t *types.FMintTransaction) *FMintTransaction {
+	return &FMintTransaction{FMintTransaction: *t}
+}
+
+// NewFMintTransactionList builds new resolvable list of fMint transactions.
+func NewFMintTransactionList(tl *types.FMintTransactionList) *FMintTransactionList {
+	return &FMintTransactionList{FMintTransactionList: *tl}
+}
+
+// History resolves the list of fMint position changes of the account.
+func (fac *FMintAccount) History(args struct {
+	Cursor *Cursor
+	Count  int32
+}) (*FMintTransactionList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	// pull the list
+	tl, err := repository.R().FMintTransactions(&fac.Address, (*string)(args.Cursor), args.Count)
+	if err != nil {
+		return nil, err
+	}
+	return NewFMintTransactionList(tl), nil
+}
+
+// Type resolves the type of the fMint transaction.
+func (trx *FMintTransaction) Type() string {
+	return fMintTrxTypeNames[trx.FMintTransaction.Type]
+}
+
+// Token resolves the DeFi token involved in the fMint transaction.
+func (trx *FMintTransaction) Token() (*DefiToken, error) {
+	tk, err := repository.R().DefiToken(&trx.TokenAddress)
+	if err != nil {
+		return nil, err
+	}
+	return NewDefiToken(tk), nil
+}
+
+// Price resolves the oracle price of the token at the time of the fMint transaction, if known.
+func (trx *FMintTransaction) Price() *hexutil.Big {
+	if trx.FMintTransaction.Price.ToInt().Sign() == 0 {
+		return nil
+	}
+	return &trx.FMintTransaction.Price
+}
+
+// Transaction resolves an instance of the transaction executing the fMint call.
+func (trx *FMintTransaction) Transaction() (*Transaction, error) {
+	tx, err := repository.R().Transaction(&trx.TrxHash)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(tx), nil
+}
+
+// TotalCount resolves the total number of fMint transactions in the list.
+func (txl *FMintTransactionList) TotalCount() hexutil.Big {
+	val := (*hexutil.Big)(new(big.Int).SetUint64(txl.Total))
+	return *val
+}
+
+// PageInfo resolves the current page information for the fMint transaction list.
+func (txl *FMintTransactionList) PageInfo() (*ListPageInfo, error) {
+	// do we have any items?
+	if txl.Collection == nil || len(txl.Collection) == 0 {
+		return NewListPageInfo(nil, nil, false, false)
+	}
+
+	// get the first and last elements
+	first := Cursor(txl.Collection[0].Pk())
+	last := Cursor(txl.Collection[len(txl.Collection)-1].Pk())
+	return NewListPageInfo(&first, &last, !txl.IsEnd, !txl.IsStart)
+}
+
+// Edges resolves list of edges for the fMint transaction list.
+func (txl *FMintTransactionList) Edges() []*FMintTransactionListEdge {
+	// do we have any items? return empty list if not
+	if txl.Collection == nil || len(txl.Collection) == 0 {
+		return make([]*FMintTransactionListEdge, 0)
+	}
+
+	// make the list
+	edges := make([]*FMintTransactionListEdge, len(txl.Collection))
+	for i, c := range txl.Collection {
+		edges[i] = &FMintTransactionListEdge{Trx: NewFMintTransaction(c)}
+	}
+	return edges
+}
+
+// Cursor resolves the fMint transaction cursor in the edges list.
+func (tle *FMintTransactionListEdge) Cursor() Cursor {
+	return Cursor(tle.Trx.Pk())
+}
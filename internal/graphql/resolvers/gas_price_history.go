@@ -0,0 +1,69 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DailyGasPrice represents resolvable daily gas price distribution.
+type DailyGasPrice struct {
+	types.DailyGasPrice
+}
+
+// GasPriceHistory resolves list of daily gas price distributions of the network transactions.
+func (rs *rootResolver) GasPriceHistory(args struct {
+	From *string
+	To   *string
+}) ([]*DailyGasPrice, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// get the date range
+	from, to, err := trxVolumeRange(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// load data
+	dg, err := repository.R().GasPriceHistory(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*DailyGasPrice, len(dg))
+	for i, v := range dg {
+		list[i] = &DailyGasPrice{*v}
+	}
+	return list, nil
+}
+
+// Count resolves the number of transactions of the day.
+func (dgp *DailyGasPrice) Count() int32 {
+	return int32(dgp.DailyGasPrice.Count)
+}
+
+// P25 resolves the 25th percentile of the gas price in WEI.
+func (dgp *DailyGasPrice) P25() hexutil.Big {
+	return gasPriceToWei(dgp.DailyGasPrice.P25)
+}
+
+// P50 resolves the median gas price in WEI.
+func (dgp *DailyGasPrice) P50() hexutil.Big {
+	return gasPriceToWei(dgp.DailyGasPrice.P50)
+}
+
+// P90 resolves the 90th percentile of the gas price in WEI.
+func (dgp *DailyGasPrice) P90() hexutil.Big {
+	return gasPriceToWei(dgp.DailyGasPrice.P90)
+}
+
+// gasPriceToWei converts the gas price in GWei x100 units to WEI.
+func gasPriceToWei(val int64) hexutil.Big {
+	return hexutil.Big(*new(big.Int).Mul(big.NewInt(val), types.TransactionGasCorrection))
+}
@@ -85,6 +85,9 @@ func (gc *GovernanceContract) Proposals(args *struct {
 }) (*GovernanceProposalList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the list of all proposals
@@ -34,8 +34,16 @@ func (rs *rootResolver) GovProposals(args struct {
 	Count      int32
 	ActiveOnly bool
 }) (*GovernanceProposalList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// prep list of governance contracts we are interested in
@@ -0,0 +1,93 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/svc"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// IntegrityReport represents resolvable summary of data integrity checks.
+type IntegrityReport struct {
+	types.IntegrityReport
+}
+
+// IntegrityCheck represents resolvable state of a data integrity check.
+type IntegrityCheck struct {
+	types.IntegrityCheckState
+}
+
+// IntegrityIssue represents resolvable discrepancy found by a data integrity check.
+type IntegrityIssue struct {
+	types.IntegrityIssue
+}
+
+// IntegrityReport resolves the summary of data integrity checks performed by the server.
+func (rs *rootResolver) IntegrityReport(ctx context.Context) (*IntegrityReport, error) {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return &IntegrityReport{*svc.Manager().IntegrityReport()}, nil
+}
+
+// Checks resolves the list of integrity checks performed.
+func (ir *IntegrityReport) Checks() []*IntegrityCheck {
+	list := make([]*IntegrityCheck, len(ir.IntegrityReport.Checks))
+	for i, c := range ir.IntegrityReport.Checks {
+		list[i] = &IntegrityCheck{c}
+	}
+	return list
+}
+
+// Issues resolves the list of the recent integrity issues found.
+func (ir *IntegrityReport) Issues() []*IntegrityIssue {
+	list := make([]*IntegrityIssue, len(ir.IntegrityReport.Issues))
+	for i, is := range ir.IntegrityReport.Issues {
+		list[i] = &IntegrityIssue{is}
+	}
+	return list
+}
+
+// LastRun resolves the time stamp of the last run of the check.
+func (ic *IntegrityCheck) LastRun() hexutil.Uint64 {
+	return hexutil.Uint64(ic.IntegrityCheckState.LastRun.Unix())
+}
+
+// Checked resolves the number of checks performed.
+func (ic *IntegrityCheck) Checked() hexutil.Uint64 {
+	return hexutil.Uint64(ic.IntegrityCheckState.Checked)
+}
+
+// Failed resolves the number of checks failed.
+func (ic *IntegrityCheck) Failed() hexutil.Uint64 {
+	return hexutil.Uint64(ic.IntegrityCheckState.Failed)
+}
+
+// Detected resolves the time stamp of the issue detection.
+func (is *IntegrityIssue) Detected() hexutil.Uint64 {
+	return hexutil.Uint64(is.IntegrityIssue.Detected.Unix())
+}
+
+// recomputeMaxClaims represents the max number of reward claims recomputed at once.
+const recomputeMaxClaims = 100
+
+// RecomputeDelegationRewards resolves the recomputation of the recent reward claims of the delegation
+// from the archive state compared with the indexed values.
+func (rs *rootResolver) RecomputeDelegationRewards(ctx context.Context, args struct {
+	Address common.Address
+	Staker  hexutil.Big
+	Count   int32
+}) (*types.RewardRecomputationReport, error) {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if args.Count <= 0 || args.Count > recomputeMaxClaims {
+		return nil, fmt.Errorf("count must be between 1 and %d", recomputeMaxClaims)
+	}
+	return svc.Manager().RecomputeDelegationRewards(&args.Address, &args.Staker, args.Count)
+}
@@ -29,6 +29,15 @@ type ApiResolver interface {
 	// Account resolves blockchain account by address.
 	Account(struct{ Address common.Address }) (*Account, error)
 
+	// Accounts resolves blockchain accounts of the given addresses.
+	Accounts(context.Context, struct{ Addresses []common.Address }) ([]*Account, error)
+
+	// ScreenAddresses resolves compliance screening results of the given addresses.
+	ScreenAddresses(*struct {
+		Addresses   []common.Address
+		FlaggedOnly bool
+	}) ([]*RiskFlag, error)
+
 	// Contracts resolves list of blockchain smart contracts encapsulated in a listable structure.
 	Contracts(*struct {
 		ValidatedOnly bool
@@ -47,6 +56,15 @@ type ApiResolver interface {
 		Hash   *common.Hash
 	}) (*Block, error)
 
+	// BlockHeader resolves raw header of the block by number directly from the node.
+	BlockHeader(*struct{ Number hexutil.Uint64 }) (*BlockHeader, error)
+
+	// DagEvent resolves Lachesis consensus event by its id.
+	DagEvent(*struct{ Id common.Hash }) (*DagEvent, error)
+
+	// DagHeads resolves the list of head events of the given epoch, or the current epoch.
+	DagHeads(*struct{ Epoch *hexutil.Uint64 }) ([]*DagEvent, error)
+
 	// Blocks resolves list of blockchain blocks encapsulated in a listable structure.
 	Blocks(*struct {
 		Cursor *Cursor
@@ -58,16 +76,36 @@ type ApiResolver interface {
 
 	// Transactions resolves list of blockchain transactions encapsulated in a listable structure.
 	Transactions(*struct {
-		Cursor *Cursor
-		Count  int32
+		Cursor    *Cursor
+		Count     int32
+		FromBlock *hexutil.Uint64
+		ToBlock   *hexutil.Uint64
 	}) (*TransactionList, error)
 
 	// OnBlock resolves subscription to new blocks' event broadcast.
 	OnBlock(ctx context.Context) <-chan *Block
 
+	// OnBlockFinalized resolves subscription to blocks becoming final.
+	OnBlockFinalized(ctx context.Context) <-chan *Block
+
 	// OnTransaction resolves subscription to new transactions' event broadcast.
 	OnTransaction(ctx context.Context) <-chan *Transaction
 
+	// OnCollateralAlert resolves subscription to collateral ratio alerts of the calling API key.
+	OnCollateralAlert(ctx context.Context) (<-chan *CollateralAlertEvent, error)
+
+	// OnFees resolves subscription to fee trend of new blocks.
+	OnFees(ctx context.Context) <-chan *FeeTrend
+
+	// OnPrice resolves subscription to the price updates of the given token.
+	OnPrice(ctx context.Context, args struct {
+		Token       common.Address
+		MinInterval *int32
+	}) <-chan *TokenPriceUpdate
+
+	// OnAccountExport resolves subscription to the completion of an account export of the calling API key.
+	OnAccountExport(ctx context.Context, args struct{ Id string }) (<-chan *AccountExport, error)
+
 	// CurrentEpoch resolves id of the current epoch.
 	CurrentEpoch() (hexutil.Uint64, error)
 
@@ -97,9 +135,11 @@ type ApiResolver interface {
 
 	// DelegationsOf a list of delegations information of a staker.
 	DelegationsOf(*struct {
-		Staker hexutil.Big
-		Cursor *Cursor
-		Count  int32
+		Staker  hexutil.Big
+		Cursor  *Cursor
+		Count   int32
+		OrderBy string
+		Filter  *DelegationFilter
 	}) (*DelegationList, error)
 
 	// DelegationsByAddress a list of own delegations by the account address.
@@ -107,6 +147,8 @@ type ApiResolver interface {
 		Address common.Address
 		Cursor  *Cursor
 		Count   int32
+		OrderBy string
+		Filter  *DelegationFilter
 	}) (*DelegationList, error)
 
 	// Price resolves price details of the AXIS blockchain token for the given target symbols.
@@ -124,10 +166,21 @@ type ApiResolver interface {
 		Data  *string
 	}) (*hexutil.Uint64, error)
 
+	// DecodeTransaction resolves raw RLP encoded transaction decoded without sending it.
+	DecodeTransaction(*struct{ RawRlp hexutil.Bytes }) (*DecodedTransaction, error)
+
+	// ContractCall resolves the output of a read-only call of the given contract.
+	ContractCall(ctx context.Context, args *struct {
+		Contract common.Address
+		Input    hexutil.Bytes
+		Block    *hexutil.Uint64
+	}) (*ContractCallResult, error)
+
 	// EstimateRewards resolves reward estimation for the given address or amount staked.
 	EstimateRewards(*struct {
 		Address *common.Address
 		Amount  *hexutil.Uint64
+		Epoch   *hexutil.Uint64
 	}) (EstimatedRewards, error)
 
 	// SfcRewardsCollectedAmount resolves the amount of collected rewards
@@ -148,6 +201,9 @@ type ApiResolver interface {
 	// DefiTokens resolves list of DeFi tokens available for the DeFi functions.
 	DefiTokens() ([]*DefiToken, error)
 
+	// DefiOverview resolves network-wide aggregates of the DeFi modules.
+	DefiOverview() (*types.DefiOverview, error)
+
 	// DefiUniswapPairs resolves a list of all pairs managed by the Uniswap core.
 	DefiUniswapPairs() []*UniswapPair
 
@@ -165,6 +221,15 @@ type ApiResolver interface {
 		Tokens    []common.Address
 	}) ([]hexutil.Big, error)
 
+	// QuoteSwap resolves the best swap route between tokens with the encoded router call.
+	QuoteSwap(*struct {
+		TokenIn   common.Address
+		TokenOut  common.Address
+		AmountIn  hexutil.Big
+		Recipient *common.Address
+		Slippage  float64
+	}) (*SwapQuote, error)
+
 	// DefiUniswapQuoteLiquidity resolves a list of optimal amounts of tokens
 	// to be added to both sides of a pair on addLiquidity call.
 	DefiUniswapQuoteLiquidity(*struct {
@@ -175,6 +240,12 @@ type ApiResolver interface {
 	// FMintAccount resolves details of a specified DeFi account.
 	FMintAccount(*struct{ Owner common.Address }) (*FMintAccount, error)
 
+	// PositionHistory resolves snapshots of the fMint position of the given address.
+	PositionHistory(args struct {
+		Address common.Address
+		Days    int32
+	}) ([]*FMintPositionSnapshot, error)
+
 	// FMintTokenAllowance resolves the amount of ERC20 tokens unlocked
 	// by the token owner for DeFi/fMint protocol operations.
 	FMintTokenAllowance(args *struct {
@@ -182,6 +253,21 @@ type ApiResolver interface {
 		Token common.Address
 	}) (hexutil.Big, error)
 
+	// MaxMintable resolves the max amount of the token the fMint account can mint
+	// without dropping below the minimal collateral to debt ratio.
+	MaxMintable(*struct {
+		Address common.Address
+		Token   common.Address
+	}) (hexutil.Big, error)
+
+	// RepayRequiredForRatio resolves the debt the fMint account has to repay
+	// to reach the given collateral to debt ratio.
+	RepayRequiredForRatio(*struct {
+		Address     common.Address
+		TargetRatio hexutil.Uint64
+		Token       *common.Address
+	}) (hexutil.Big, error)
+
 	// Erc20Token resolves an instance of ERC20 token if available.
 	Erc20Token(*struct{ Token common.Address }) *ERC20Token
 
@@ -196,11 +282,17 @@ type ApiResolver interface {
 
 	// ErcTokenBalance resolves the current available balance of the specified token
 	// for the specified owner.
-	ErcTokenBalance(args *struct {
+	ErcTokenBalance(ctx context.Context, args *struct {
 		Owner common.Address
 		Token common.Address
 	}) (hexutil.Big, error)
 
+	// Erc20BalancesBulk resolves balances of the given ERC20 tokens of the owner.
+	Erc20BalancesBulk(ctx context.Context, args struct {
+		Owner  common.Address
+		Tokens []common.Address
+	}) ([]*ERC20Balance, error)
+
 	// ErcTotalSupply resolves the current total supply of the specified token.
 	ErcTotalSupply(args *struct{ Token common.Address }) (hexutil.Big, error)
 
@@ -232,6 +324,13 @@ type ApiResolver interface {
 		To   *string
 	}) ([]*DailyTrxVolume, error)
 
+	// GasPriceHistory resolves list of daily gas price distributions
+	// of the network transactions.
+	GasPriceHistory(args struct {
+		From *string
+		To   *string
+	}) ([]*DailyGasPrice, error)
+
 	// TrxSpeed resolves the recent speed of the network in transactions processed per second.
 	TrxSpeed(args struct {
 		Range int32
@@ -244,6 +343,261 @@ type ApiResolver interface {
 		To    *string
 	}) (float64, error)
 
+	// FeesPaid resolves a report of transaction fees paid by the given address.
+	FeesPaid(args struct {
+		Address    common.Address
+		From       *string
+		To         *string
+		Resolution string
+	}) (*FeesPaidReport, error)
+
+	// Counterparties resolves the list of top counterparties of the given address.
+	Counterparties(args struct {
+		Address common.Address
+		Period  string
+		Count   int32
+	}) ([]*Counterparty, error)
+
+	// StakeFlows resolves the list of the largest net stake movements between validators.
+	StakeFlows(args struct {
+		Period string
+		Count  int32
+	}) ([]*StakeFlow, error)
+
+	// LogLevels resolves the current log levels of the logging subsystems.
+	LogLevels(ctx context.Context) ([]*LogLevel, error)
+
+	// SetLogLevel adjusts the log level of the given logging subsystem.
+	SetLogLevel(ctx context.Context, args *struct {
+		Subsystem string
+		Level     string
+	}) (*LogLevel, error)
+
+	// Maintenance resolves the current state of the read-only maintenance mode.
+	Maintenance() *MaintenanceState
+
+	// SetMaintenance switches the read-only maintenance mode on, or off.
+	SetMaintenance(ctx context.Context, args *struct {
+		Enabled bool
+		Reason  *string
+	}) (*MaintenanceState, error)
+
+	// IntegrityReport resolves the summary of data integrity checks performed by the server.
+	IntegrityReport(ctx context.Context) (*IntegrityReport, error)
+
+	// WebhookDeliveries resolves the most recent webhook delivery log records.
+	WebhookDeliveries(ctx context.Context, args struct {
+		Status *string
+		Count  int32
+	}) ([]*WebhookDelivery, error)
+
+	// NodeInfo resolves diagnostic information about the blockchain node backing the API.
+	NodeInfo(ctx context.Context) (*NodeInfo, error)
+
+	// SubscriptionUsage resolves the current websocket subscriptions usage.
+	SubscriptionUsage(ctx context.Context) ([]*SubscriptionUsage, error)
+
+	// SubscriptionDiagnostics resolves the delivery failures of websocket subscriptions.
+	SubscriptionDiagnostics(ctx context.Context) ([]*SubscriptionDiagnostics, error)
+
+	// Status resolves the state of the API server components for the public status page.
+	Status() (*Status, error)
+
+	// Reindexes resolves the state of the rebuilds of all the collections.
+	Reindexes(context.Context) ([]*Reindex, error)
+
+	// StartReindex starts the rebuild of the given indexed collection in a shadow collection.
+	StartReindex(context.Context, *struct{ Collection string }) (*Reindex, error)
+
+	// OpenStatusIncident publishes a new incident marker on the status page.
+	OpenStatusIncident(ctx context.Context, args *struct {
+		Severity string
+		Title    string
+		Message  *string
+	}) (*StatusIncident, error)
+
+	// ResolveStatusIncident marks the incident marker of the status page resolved.
+	ResolveStatusIncident(ctx context.Context, args *struct {
+		Id      string
+		Message *string
+	}) (*StatusIncident, error)
+
+	// EnumLabels resolves localized labels of values of the given enumeration.
+	EnumLabels(context.Context, *struct{ Enum string }) ([]*EnumLabel, error)
+
+	// Network resolves the identity of the blockchain network served by the API.
+	Network() (*Network, error)
+
+	// ActivityHeatmap resolves the daily transaction counts of the account for the last year.
+	ActivityHeatmap(args struct{ Address common.Address }) ([]*AccountActivityDay, error)
+
+	// UpgradeReadiness resolves the share of the active stake on each validator node version.
+	UpgradeReadiness(args struct{ RequiredVersion *string }) (*UpgradeReadiness, error)
+
+	// NetworkMetrics resolves the observed production of new blocks by the network.
+	NetworkMetrics() *NetworkMetrics
+
+	// RegisterAbi stores a custom ABI of the given contract used to decode interactions with it.
+	RegisterAbi(ctx context.Context, args *struct {
+		Address common.Address
+		AbiJson string
+	}) (bool, error)
+
+	// ApproveAbi approves the pending custom ABI of the given contract, so it is used for decoding.
+	ApproveAbi(ctx context.Context, args *struct{ Address common.Address }) (bool, error)
+
+	// WatchDelegationRewards adds the delegation to the watch list of the pending rewards reminder.
+	WatchDelegationRewards(ctx context.Context, args *struct {
+		Address     common.Address
+		ValidatorId hexutil.Big
+	}) (bool, error)
+
+	// UnwatchDelegationRewards removes the delegation from the watch list of the pending rewards reminder.
+	UnwatchDelegationRewards(ctx context.Context, args *struct {
+		Address     common.Address
+		ValidatorId hexutil.Big
+	}) (bool, error)
+
+	// BuildMintSAXISTx prepares an unsigned transaction minting sAXIS tokens
+	// for the locked delegation to the given validator.
+	BuildMintSAXISTx(*struct {
+		Delegator   common.Address
+		ValidatorId hexutil.Big
+	}) (*types.PreparedTransaction, error)
+
+	// BuildBurnSAXISTx prepares an unsigned transaction burning the given amount
+	// of sAXIS tokens minted for the delegation to the given validator.
+	BuildBurnSAXISTx(*struct {
+		Delegator   common.Address
+		ValidatorId hexutil.Big
+		Amount      hexutil.Big
+	}) (*types.PreparedTransaction, error)
+
+	// SAXISSupply resolves the daily history of sAXIS tokens outstanding in the given number of recent days.
+	SAXISSupply(args struct{ Days int32 }) ([]*SAXISSupply, error)
+
+	// SAXISCollateral resolves the amount of sAXIS tokens outstanding by validator.
+	SAXISCollateral() ([]*SAXISCollateral, error)
+
+	// BuildErc20ApproveTx prepares an unsigned ERC20 transaction approving the spender
+	// to transfer the given amount of tokens of the owner.
+	BuildErc20ApproveTx(*struct {
+		Owner   common.Address
+		Token   common.Address
+		Spender common.Address
+		Amount  string
+	}) (*types.PreparedTransaction, error)
+
+	// BuildErc20TransferTx prepares an unsigned ERC20 transaction sending the given amount
+	// of tokens to the recipient.
+	BuildErc20TransferTx(*struct {
+		Sender    common.Address
+		Token     common.Address
+		Recipient common.Address
+		Amount    string
+		Owner     *common.Address
+	}) (*types.PreparedTransaction, error)
+
+	// NextActions resolves the list of actions recommended to the delegator on its delegations.
+	NextActions(*struct{ Address common.Address }) ([]*types.DelegationAction, error)
+
+	// UpcomingEvents resolves the known future time-based events of the given addresses.
+	UpcomingEvents(*struct{ Addresses []common.Address }) ([]*types.CalendarEvent, error)
+
+	// ValidateStakeAction checks the intended stake action against the SFC contract constraints.
+	ValidateStakeAction(*struct {
+		Action      string
+		Address     common.Address
+		ValidatorID hexutil.Big
+		Amount      hexutil.Big
+		Duration    *hexutil.Uint64
+	}) (*StakeActionValidation, error)
+
+	// CollateralAlerts resolves the list of collateral ratio alerts registered by the calling API key.
+	CollateralAlerts(ctx context.Context) ([]*CollateralAlert, error)
+
+	// RegisterCollateralAlert registers a new collateral ratio alert for the given fMint account.
+	RegisterCollateralAlert(ctx context.Context, args struct {
+		Address    common.Address
+		Threshold4 hexutil.Uint64
+		Webhook    *string
+	}) (*CollateralAlert, error)
+
+	// RemoveCollateralAlert removes a collateral ratio alert owned by the calling API key.
+	RemoveCollateralAlert(ctx context.Context, args struct{ Id string }) (bool, error)
+
+	// BalanceAlerts resolves the list of balance alerts registered by the calling API key.
+	BalanceAlerts(ctx context.Context) ([]*BalanceAlert, error)
+
+	// RegisterBalanceAlert registers a new native, or token balance alert for the given account.
+	RegisterBalanceAlert(ctx context.Context, args struct {
+		Address common.Address
+		Token   *common.Address
+		Min     *hexutil.Big
+		Max     *hexutil.Big
+		Webhook string
+	}) (*BalanceAlert, error)
+
+	// RemoveBalanceAlert removes a balance alert owned by the calling API key.
+	RemoveBalanceAlert(ctx context.Context, args struct{ Id string }) (bool, error)
+
+	// AccountExport resolves the state of an account export started by the calling API key.
+	AccountExport(ctx context.Context, args struct{ Id string }) (*AccountExport, error)
+
+	// StartAccountExport starts an asynchronous export of the account transactions.
+	StartAccountExport(ctx context.Context, args struct{ Address common.Address }) (*AccountExport, error)
+
+	// AddressGroups resolves the list of address groups defined by the calling API key.
+	AddressGroups(ctx context.Context) ([]*AddressGroup, error)
+
+	// CreateAddressGroup defines a new named group of addresses owned by the calling API key.
+	CreateAddressGroup(ctx context.Context, args struct {
+		Name      string
+		Addresses []common.Address
+	}) (*AddressGroup, error)
+
+	// UpdateAddressGroup changes the name and/or the addresses of a group owned by the calling API key.
+	UpdateAddressGroup(ctx context.Context, args struct {
+		Id        string
+		Name      *string
+		Addresses *[]common.Address
+	}) (*AddressGroup, error)
+
+	// RemoveAddressGroup removes an address group owned by the calling API key.
+	RemoveAddressGroup(ctx context.Context, args struct{ Id string }) (bool, error)
+
+	// Portfolio resolves the aggregated state of an address group owned by the calling API key.
+	Portfolio(ctx context.Context, args struct{ GroupId string }) (*Portfolio, error)
+
+	// CreateSandboxKey mints a new sandbox API key with limited privileges for the calling client.
+	CreateSandboxKey(ctx context.Context) (*SandboxKey, error)
+
+	// ValidatorsAt resolves the validator set of the given sealed epoch.
+	ValidatorsAt(*struct{ Epoch hexutil.Uint64 }) ([]*EpochValidator, error)
+
+	// Decentralization resolves stake distribution metrics of the validator set of the given sealed epoch.
+	Decentralization(*struct{ Epoch *hexutil.Uint64 }) (*Decentralization, error)
+
+	// EpochDiff resolves the changes of the validator set and the supply between the given sealed epochs.
+	EpochDiff(*struct {
+		A hexutil.Uint64
+		B hexutil.Uint64
+	}) (*EpochDiff, error)
+
+	// ValidatorEarnings resolves earnings of the given validator in the given range of sealed epochs.
+	ValidatorEarnings(*struct {
+		Id         hexutil.Big
+		From       *hexutil.Uint64
+		To         *hexutil.Uint64
+		Resolution string
+	}) (*ValidatorEarnings, error)
+
+	// CreateValidatorEstimate resolves the projected income of a prospective validator.
+	CreateValidatorEstimate(*struct {
+		SelfStake          hexutil.Big
+		ExpectedDelegation hexutil.Big
+	}) (*ValidatorEstimate, error)
+
 	// Close terminates resolver broadcast management.
 	Close()
 }
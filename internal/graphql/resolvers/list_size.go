@@ -0,0 +1,51 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import "fmt"
+
+// errCodeListTooLarge represents the error code of a list requested over the size limit.
+const errCodeListTooLarge = "LIST_TOO_LARGE"
+
+// listTooLargeError represents an error of a list requested with too many items.
+// The suggested count tells the client how to page through the list instead.
+type listTooLargeError struct {
+	requested int32
+	maxCount  int32
+	suggested int32
+}
+
+// Error returns the human-readable description of the error.
+func (e listTooLargeError) Error() string {
+	return fmt.Sprintf("requested %d items, at most %d items can be requested; use count %d and continue with the cursor of the last edge",
+		e.requested, e.maxCount, e.suggested)
+}
+
+// Extensions provides the machine-readable code and pagination hints of the error to GraphQL clients.
+func (e listTooLargeError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":      errCodeListTooLarge,
+		"requested": e.requested,
+		"maxCount":  e.maxCount,
+		"pagination": map[string]interface{}{
+			"count":  e.suggested,
+			"cursor": "cursor of the last edge received",
+		},
+	}
+}
+
+// checkListSize rejects a list requested with more items than the configured max list size.
+// The page size of the list is suggested to the client as the count to be used.
+// The count can be either positive or negative; the sign controls the loading direction.
+func checkListSize(count int32, pageSize uint32) error {
+	if cfg == nil || cfg.Server.MaxListSize <= 0 {
+		return nil
+	}
+	if count <= cfg.Server.MaxListSize && count >= -cfg.Server.MaxListSize {
+		return nil
+	}
+
+	// suggest the page size in the requested direction
+	suggested := listLimitCount(count, pageSize)
+	log.Debugf("list of %d items rejected, max %d allowed", count, cfg.Server.MaxListSize)
+	return listTooLargeError{requested: count, maxCount: cfg.Server.MaxListSize, suggested: suggested}
+}
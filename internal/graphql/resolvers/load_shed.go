@@ -0,0 +1,58 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+)
+
+const (
+	// queryClassCore represents queries required by wallets to stay functional.
+	// These are never shed.
+	queryClassCore = iota
+
+	// queryClassHeavyList represents scrollable lists and aggregations
+	// putting a significant load on the node and the database.
+	queryClassHeavyList
+
+	// queryClassExport represents bulk data exports and reports.
+	queryClassExport
+)
+
+// ErrCodeRetryLater represents the error code of a query rejected by load shedding,
+// or by the operations scheduler of the server.
+const ErrCodeRetryLater = "RETRY_LATER"
+
+// retryLaterError represents an error of a low priority query
+// rejected due to the connected node being under pressure.
+type retryLaterError struct{}
+
+// Error returns the human-readable description of the error.
+func (e retryLaterError) Error() string {
+	return "the server is under heavy load, please retry later"
+}
+
+// Extensions provides the machine-readable code of the error to GraphQL clients.
+func (e retryLaterError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": ErrCodeRetryLater}
+}
+
+// shedLoad checks if a query of the given class should be processed
+// and returns RETRY_LATER error if it should be rejected instead.
+func shedLoad(class int) error {
+	// core queries are always processed
+	if class == queryClassCore {
+		return nil
+	}
+
+	// heavy queries are not processed during maintenance
+	if err := mustNotBeInMaintenance(); err != nil {
+		return err
+	}
+
+	// is the node struggling?
+	if repository.R().IsNodeUnderPressure() {
+		log.Warningf("query of class %d rejected due to node pressure", class)
+		return retryLaterError{}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/locale"
+	"context"
+)
+
+// EnumLabel represents a resolvable localized label of an enumeration value.
+type EnumLabel struct {
+	locale.EnumLabel
+}
+
+// EnumLabels resolves human-readable labels of values of the given enumeration
+// in the language accepted by the client.
+func (rs *rootResolver) EnumLabels(ctx context.Context, args *struct{ Enum string }) ([]*EnumLabel, error) {
+	labels, err := locale.Labels(locale.LanguageFromContext(ctx), args.Enum)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*EnumLabel, len(labels))
+	for i, l := range labels {
+		list[i] = &EnumLabel{l}
+	}
+	return list, nil
+}
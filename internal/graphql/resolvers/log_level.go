@@ -0,0 +1,48 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/logger"
+	"context"
+	"strings"
+)
+
+// LogLevel represents resolvable log level of a logging subsystem.
+type LogLevel struct {
+	Subsystem string
+	Level     string
+}
+
+// LogLevels resolves the current log levels of the logging subsystems.
+func (rs *rootResolver) LogLevels(ctx context.Context) ([]*LogLevel, error) {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	lvl := logger.Levels()
+	list := make([]*LogLevel, 0, len(lvl))
+	for _, name := range logger.Subsystems() {
+		list = append(list, &LogLevel{Subsystem: name, Level: lvl[name]})
+	}
+	return list, nil
+}
+
+// SetLogLevel adjusts the log level of the given logging subsystem.
+func (rs *rootResolver) SetLogLevel(ctx context.Context, args *struct {
+	Subsystem string
+	Level     string
+}) (*LogLevel, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the level
+	if err := logger.SetLevel(args.Subsystem, args.Level); err != nil {
+		return nil, err
+	}
+
+	log.Noticef("log level of %s set to %s by %s", args.Subsystem, args.Level, key.Name)
+	name := strings.ToLower(args.Subsystem)
+	return &LogLevel{Subsystem: name, Level: logger.Levels()[name]}, nil
+}
@@ -0,0 +1,110 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// errCodeMaintenance represents the error code of a request rejected in read-only maintenance mode.
+const errCodeMaintenance = "MAINTENANCE"
+
+// MaintenanceState represents resolvable state of the read-only maintenance mode.
+type MaintenanceState struct {
+	Enabled bool
+	Reason  *string
+	Since   *hexutil.Uint64
+}
+
+// maintenance represents the current state of the read-only maintenance mode.
+var maintenance struct {
+	sync.RWMutex
+	state MaintenanceState
+}
+
+// maintenanceError represents an error of a mutation, or a heavy query
+// rejected due to the server being in read-only maintenance mode.
+type maintenanceError struct {
+	reason *string
+}
+
+// Error returns the human-readable description of the error.
+func (e maintenanceError) Error() string {
+	if e.reason != nil {
+		return "the server is in read-only maintenance mode; " + *e.reason
+	}
+	return "the server is in read-only maintenance mode, please retry later"
+}
+
+// Extensions provides the machine-readable code of the error to GraphQL clients.
+func (e maintenanceError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": errCodeMaintenance}
+}
+
+// SetMaintenance switches the read-only maintenance mode on, or off.
+// In maintenance mode mutations and heavy queries are rejected,
+// core queries stay online.
+func SetMaintenance(on bool, reason *string) {
+	maintenance.Lock()
+	defer maintenance.Unlock()
+
+	// no change
+	if maintenance.state.Enabled == on {
+		return
+	}
+
+	if !on {
+		maintenance.state = MaintenanceState{}
+		log.Notice("read-only maintenance mode disabled")
+		return
+	}
+
+	now := hexutil.Uint64(time.Now().UTC().Unix())
+	maintenance.state = MaintenanceState{Enabled: true, Reason: reason, Since: &now}
+	log.Notice("read-only maintenance mode enabled")
+}
+
+// IsMaintenance signals if the read-only maintenance mode is enabled.
+func IsMaintenance() bool {
+	maintenance.RLock()
+	defer maintenance.RUnlock()
+	return maintenance.state.Enabled
+}
+
+// mustNotBeInMaintenance returns MAINTENANCE error if the read-only maintenance mode is enabled.
+func mustNotBeInMaintenance() error {
+	maintenance.RLock()
+	defer maintenance.RUnlock()
+
+	if maintenance.state.Enabled {
+		return maintenanceError{reason: maintenance.state.Reason}
+	}
+	return nil
+}
+
+// Maintenance resolves the current state of the read-only maintenance mode.
+func (rs *rootResolver) Maintenance() *MaintenanceState {
+	maintenance.RLock()
+	defer maintenance.RUnlock()
+
+	st := maintenance.state
+	return &st
+}
+
+// SetMaintenance switches the read-only maintenance mode on, or off.
+func (rs *rootResolver) SetMaintenance(ctx context.Context, args *struct {
+	Enabled bool
+	Reason  *string
+}) (*MaintenanceState, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Noticef("maintenance mode switch to %t requested by %s", args.Enabled, key.Name)
+	SetMaintenance(args.Enabled, args.Reason)
+	return rs.Maintenance(), nil
+}
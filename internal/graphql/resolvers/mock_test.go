@@ -0,0 +1,55 @@
+package resolvers
+
+import (
+	"axis-graphql/internal/config"
+	gqlSchema "axis-graphql/internal/graphql/schema"
+	"axis-graphql/internal/logger"
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/repository/mock"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/onsi/gomega"
+)
+
+// TestResolversWithFixtures resolves queries end to end against the mock repository.
+func TestResolversWithFixtures(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	fx, err := mock.LoadFixtures("../../../doc/example.fixtures.json")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	repository.SetRepository(mock.New(fx))
+
+	c := new(config.Config)
+	c.Log.Format = "%{message}"
+	SetConfig(c)
+	SetLogger(logger.New(c))
+
+	schema := graphql.MustParseSchema(gqlSchema.Schema(), &rootResolver{}, graphql.UseFieldResolvers())
+	res := schema.Exec(context.Background(), `{
+		block(number: 2) { number hash txList { hash from to value } }
+		account(address: "0x0000000000000000000000000000000000001001") { balance txList(count: 10) { totalCount edges { cursor } } }
+		staker(id: "0x2") { id totalStake }
+	}`, "", nil)
+	g.Expect(res.Errors).To(gomega.BeEmpty())
+
+	var out struct {
+		Block struct {
+			Number string
+			TxList []struct{ Hash string }
+		}
+		Account struct {
+			Balance string
+			TxList  struct{ TotalCount string }
+		}
+		Staker struct{ TotalStake string }
+	}
+	g.Expect(json.Unmarshal(res.Data, &out)).To(gomega.Succeed())
+	g.Expect(out.Block.Number).To(gomega.Equal("0x2"))
+	g.Expect(out.Block.TxList).To(gomega.HaveLen(2))
+	g.Expect(out.Account.Balance).To(gomega.Equal("0x8ac7230489e80000"))
+	g.Expect(out.Account.TxList.TotalCount).To(gomega.Equal("0x2"))
+	g.Expect(out.Staker.TotalStake).To(gomega.Equal("0x6c6b935b8bbd400000"))
+}
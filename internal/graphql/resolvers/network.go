@@ -0,0 +1,22 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+)
+
+// Network represents resolvable identity of the blockchain network.
+type Network struct {
+	types.NetworkIdentity
+}
+
+// Network resolves the identity of the blockchain network served by the API.
+func (rs *rootResolver) Network() (*Network, error) {
+	ni, err := repository.R().Network()
+	if err != nil {
+		log.Errorf("network identity not available; %s", err.Error())
+		return nil, err
+	}
+	return &Network{NetworkIdentity: *ni}, nil
+}
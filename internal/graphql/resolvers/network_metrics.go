@@ -0,0 +1,61 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NetworkMetrics represents resolvable block production metrics of the network.
+type NetworkMetrics struct {
+	types.BlockProduction
+}
+
+// NetworkMetrics resolves the observed production of new blocks by the network.
+func (rs *rootResolver) NetworkMetrics() *NetworkMetrics {
+	return &NetworkMetrics{BlockProduction: repository.R().BlockProduction()}
+}
+
+// LastBlock resolves the number of the last observed block.
+func (nm *NetworkMetrics) LastBlock() hexutil.Uint64 {
+	return hexutil.Uint64(nm.BlockProduction.LastBlock)
+}
+
+// LastBlockTime resolves the time stamp the last block has been observed.
+func (nm *NetworkMetrics) LastBlockTime() hexutil.Uint64 {
+	if nm.BlockProduction.LastBlockTime.IsZero() {
+		return 0
+	}
+	return hexutil.Uint64(nm.BlockProduction.LastBlockTime.Unix())
+}
+
+// HeadAge resolves the number of seconds since the last block has been observed.
+func (nm *NetworkMetrics) HeadAge() hexutil.Uint64 {
+	if nm.BlockProduction.LastBlockTime.IsZero() {
+		return 0
+	}
+	return hexutil.Uint64(time.Since(nm.BlockProduction.LastBlockTime).Seconds())
+}
+
+// BlockGapThreshold resolves the max expected number of seconds between two blocks.
+func (nm *NetworkMetrics) BlockGapThreshold() hexutil.Uint64 {
+	return hexutil.Uint64(nm.Threshold.Seconds())
+}
+
+// GapsDetected resolves the number of abnormal gaps detected.
+func (nm *NetworkMetrics) GapsDetected() hexutil.Uint64 {
+	return hexutil.Uint64(nm.Gaps)
+}
+
+// LastGap resolves the duration of the last finished abnormal gap in seconds.
+func (nm *NetworkMetrics) LastGap() hexutil.Uint64 {
+	return hexutil.Uint64(nm.BlockProduction.LastGap.Seconds())
+}
+
+// LongestGap resolves the duration of the longest finished abnormal gap in seconds.
+func (nm *NetworkMetrics) LongestGap() hexutil.Uint64 {
+	return hexutil.Uint64(nm.BlockProduction.LongestGap.Seconds())
+}
@@ -0,0 +1,94 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NodeInfo represents resolvable diagnostic information about the connected node.
+type NodeInfo struct {
+	types.NodeInfo
+	health types.NodeHealth
+}
+
+// NodeInfo resolves diagnostic information about the blockchain node backing the API.
+func (rs *rootResolver) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	if _, err := mustBeAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	ni, err := repository.R().NodeInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &NodeInfo{NodeInfo: *ni, health: repository.R().NodeHealth()}, nil
+}
+
+// ChainId resolves the id of the chain the node is connected to.
+func (ni *NodeInfo) ChainId() hexutil.Big {
+	return hexutil.Big(*ni.ChainID)
+}
+
+// PeerCount resolves the number of peers connected to the node.
+func (ni *NodeInfo) PeerCount() hexutil.Uint64 {
+	return hexutil.Uint64(ni.NodeInfo.PeerCount)
+}
+
+// CurrentBlock resolves the block the node has processed.
+func (ni *NodeInfo) CurrentBlock() hexutil.Uint64 {
+	return hexutil.Uint64(ni.NodeInfo.CurrentBlock)
+}
+
+// HighestBlock resolves the highest block known to the node.
+func (ni *NodeInfo) HighestBlock() hexutil.Uint64 {
+	return hexutil.Uint64(ni.NodeInfo.HighestBlock)
+}
+
+// Latency resolves the smoothed round trip time of the node calls in milliseconds.
+func (ni *NodeInfo) Latency() int32 {
+	return int32(ni.health.Latency.Milliseconds())
+}
+
+// ErrorRate resolves the smoothed ratio of failed node calls.
+func (ni *NodeInfo) ErrorRate() float64 {
+	return ni.health.ErrorRate
+}
+
+// NodeCallClass represents resolvable responsiveness of a class of the node calls.
+type NodeCallClass struct {
+	Name string
+	types.NodeCallHealth
+}
+
+// CallClasses resolves the responsiveness of the node calls by their class.
+func (ni *NodeInfo) CallClasses() []*NodeCallClass {
+	list := make([]*NodeCallClass, 0, len(ni.health.Classes))
+	for name, ch := range ni.health.Classes {
+		list = append(list, &NodeCallClass{Name: name, NodeCallHealth: ch})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Latency resolves the smoothed round trip time of the calls in milliseconds.
+func (nc *NodeCallClass) Latency() int32 {
+	return int32(nc.NodeCallHealth.Latency.Milliseconds())
+}
+
+// Calls resolves the total number of calls observed.
+func (nc *NodeCallClass) Calls() hexutil.Uint64 {
+	return hexutil.Uint64(nc.NodeCallHealth.Calls)
+}
+
+// UnderPressure resolves the load shedding state of the server.
+func (ni *NodeInfo) UnderPressure() bool {
+	return repository.R().IsNodeUnderPressure()
+}
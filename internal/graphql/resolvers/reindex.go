@@ -0,0 +1,82 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Reindex represents resolvable state of a collection rebuild in a shadow collection.
+type Reindex struct {
+	types.Reindex
+}
+
+// Reindexes resolves the state of the rebuilds of all the collections.
+func (rs *rootResolver) Reindexes(ctx context.Context) ([]*Reindex, error) {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	list, err := repository.R().Reindexes()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Reindex, len(list))
+	for i, ri := range list {
+		res[i] = &Reindex{*ri}
+	}
+	return res, nil
+}
+
+// StartReindex starts the rebuild of the given indexed collection in a shadow collection.
+func (rs *rootResolver) StartReindex(ctx context.Context, args *struct{ Collection string }) (*Reindex, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ri, err := repository.R().StartReindex(args.Collection)
+	if err != nil {
+		log.Errorf("can not start reindex of %s; %s", args.Collection, err.Error())
+		return nil, err
+	}
+
+	log.Noticef("reindex of %s started by %s", args.Collection, key.Name)
+	return &Reindex{*ri}, nil
+}
+
+// Copied resolves the number of documents copied into the shadow collection.
+func (ri *Reindex) Copied() hexutil.Uint64 {
+	return hexutil.Uint64(ri.Reindex.Copied)
+}
+
+// Started resolves the UNIX time stamp of the reindex start.
+func (ri *Reindex) Started() hexutil.Uint64 {
+	return hexutil.Uint64(ri.Reindex.Started.Unix())
+}
+
+// Updated resolves the UNIX time stamp of the last progress of the reindex.
+func (ri *Reindex) Updated() hexutil.Uint64 {
+	return hexutil.Uint64(ri.Reindex.Updated.Unix())
+}
+
+// Finished resolves the UNIX time stamp of the switch, or the failure, of the reindex.
+func (ri *Reindex) Finished() *hexutil.Uint64 {
+	if ri.Reindex.Finished == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(ri.Reindex.Finished.Unix())
+	return &ts
+}
+
+// Owner resolves the API server instance building the shadow collection, if any.
+func (ri *Reindex) Owner() *string {
+	if ri.Reindex.Owner == "" || !ri.IsActive() {
+		return nil
+	}
+	return &ri.Reindex.Owner
+}
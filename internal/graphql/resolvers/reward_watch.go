@@ -0,0 +1,39 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// WatchDelegationRewards adds the delegation to the watch list of the pending rewards reminder.
+func (rs *rootResolver) WatchDelegationRewards(ctx context.Context, args *struct {
+	Address     common.Address
+	ValidatorId hexutil.Big
+}) (bool, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := repository.R().AddRewardWatch(&args.Address, args.ValidatorId.ToInt().Uint64(), false, key.Name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnwatchDelegationRewards removes the delegation from the watch list of the pending rewards reminder.
+// False is returned if the delegation has not been watched.
+func (rs *rootResolver) UnwatchDelegationRewards(ctx context.Context, args *struct {
+	Address     common.Address
+	ValidatorId hexutil.Big
+}) (bool, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return false, err
+	}
+	return repository.R().RemoveRewardWatch(&args.Address, args.ValidatorId.ToInt().Uint64(), key.Name)
+}
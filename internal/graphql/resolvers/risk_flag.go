@@ -0,0 +1,83 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// screenMaxAddressesPerRequest represents the max number of addresses screened in one query.
+const screenMaxAddressesPerRequest = 100
+
+// RiskFlag represents resolvable compliance screening result.
+type RiskFlag struct {
+	types.RiskFlag
+}
+
+// NewRiskFlag creates a new resolvable compliance screening result.
+func NewRiskFlag(fl *types.RiskFlag) *RiskFlag {
+	return &RiskFlag{RiskFlag: *fl}
+}
+
+// ScreenAddresses resolves compliance screening results of the given addresses.
+func (rs *rootResolver) ScreenAddresses(args *struct {
+	Addresses   []common.Address
+	FlaggedOnly bool
+}) ([]*RiskFlag, error) {
+	if len(args.Addresses) > screenMaxAddressesPerRequest {
+		return nil, fmt.Errorf("too many addresses, max %d allowed", screenMaxAddressesPerRequest)
+	}
+
+	// screening not available
+	list := make([]*RiskFlag, 0)
+	if !repository.R().IsComplianceEnabled() {
+		return list, nil
+	}
+
+	// screen the addresses
+	for i := range args.Addresses {
+		fl, err := repository.R().AccountRiskFlag(&args.Addresses[i])
+		if err != nil {
+			return nil, err
+		}
+		if args.FlaggedOnly && !fl.Flagged {
+			continue
+		}
+		list = append(list, NewRiskFlag(fl))
+	}
+	return list, nil
+}
+
+// RiskFlag resolves the compliance screening result of the account.
+func (acc *Account) RiskFlag() (*RiskFlag, error) {
+	fl, err := repository.R().AccountRiskFlag(&acc.Address)
+	if err != nil || fl == nil {
+		return nil, err
+	}
+	return NewRiskFlag(fl), nil
+}
+
+// Category resolves the risk category of a flagged address.
+func (fl *RiskFlag) Category() *string {
+	if fl.RiskFlag.Category == "" {
+		return nil
+	}
+	return &fl.RiskFlag.Category
+}
+
+// Reason resolves the detail of the flag, if any.
+func (fl *RiskFlag) Reason() *string {
+	if fl.RiskFlag.Reason == "" {
+		return nil
+	}
+	return &fl.RiskFlag.Reason
+}
+
+// Checked resolves the time stamp of the screening.
+func (fl *RiskFlag) Checked() hexutil.Uint64 {
+	return hexutil.Uint64(fl.RiskFlag.Checked.Unix())
+}
@@ -37,11 +37,35 @@ type rootResolver struct {
 	blockSubscribers   map[string]*subscriptOnBlock
 	onBlockEvents      chan *types.Block
 
+	// finalized blocks subscriptions management
+	subscribeOnFinalized   chan *subscriptOnBlock
+	unsubscribeOnFinalized chan string
+	finalizedSubscribers   map[string]*subscriptOnBlock
+
 	// transaction subscriptions management
 	subscribeOnTrx   chan *subscriptOnTrx
 	unsubscribeOnTrx chan string
 	trxSubscribers   map[string]*subscriptOnTrx
 	onTrxEvents      chan *types.Transaction
+
+	// collateral alert subscriptions management
+	subscribeOnCollateral   chan *subscriptOnCollateral
+	unsubscribeOnCollateral chan string
+	collateralSubscribers   map[string]*subscriptOnCollateral
+	onCollateralEvents      chan *types.CollateralAlertEvent
+
+	// fee trend subscriptions management
+	subscribeOnFees   chan *subscriptOnFees
+	unsubscribeOnFees chan string
+	feesSubscribers   map[string]*subscriptOnFees
+
+	// token price subscriptions management
+	subscribeOnPrice   chan *subscriptOnPrice
+	unsubscribeOnPrice chan string
+	priceSubscribers   map[string]*subscriptOnPrice
+
+	// sequence of events not bound to a block; managed by the run loop
+	headSeq EventSequence
 }
 
 // log represents the logger to be used by the repository.
@@ -83,11 +107,32 @@ func New() ApiResolver {
 		blockSubscribers:   make(map[string]*subscriptOnBlock, subscriptionInitialCapacity),
 		onBlockEvents:      make(chan *types.Block, onBlockChannelCapacity),
 
+		// finalized block subscription basics; fed by the block events
+		subscribeOnFinalized:   make(chan *subscriptOnBlock, subscriptionQueueCapacity),
+		unsubscribeOnFinalized: make(chan string, subscriptionQueueCapacity),
+		finalizedSubscribers:   make(map[string]*subscriptOnBlock, subscriptionInitialCapacity),
+
 		// block events subscription basics
 		subscribeOnTrx:   make(chan *subscriptOnTrx, subscriptionQueueCapacity),
 		unsubscribeOnTrx: make(chan string, subscriptionQueueCapacity),
 		trxSubscribers:   make(map[string]*subscriptOnTrx, subscriptionInitialCapacity),
 		onTrxEvents:      make(chan *types.Transaction, onBlockChannelCapacity),
+
+		// collateral alert events subscription basics
+		subscribeOnCollateral:   make(chan *subscriptOnCollateral, subscriptionQueueCapacity),
+		unsubscribeOnCollateral: make(chan string, subscriptionQueueCapacity),
+		collateralSubscribers:   make(map[string]*subscriptOnCollateral, subscriptionInitialCapacity),
+		onCollateralEvents:      make(chan *types.CollateralAlertEvent, onCollateralChannelCapacity),
+
+		// fee trend subscription basics; fed by the block events
+		subscribeOnFees:   make(chan *subscriptOnFees, subscriptionQueueCapacity),
+		unsubscribeOnFees: make(chan string, subscriptionQueueCapacity),
+		feesSubscribers:   make(map[string]*subscriptOnFees, subscriptionInitialCapacity),
+
+		// token price subscription basics
+		subscribeOnPrice:   make(chan *subscriptOnPrice, subscriptionQueueCapacity),
+		unsubscribeOnPrice: make(chan string, subscriptionQueueCapacity),
+		priceSubscribers:   make(map[string]*subscriptOnPrice, subscriptionInitialCapacity),
 	}
 
 	// pass subscription data source channels to the service manager
@@ -95,6 +140,7 @@ func New() ApiResolver {
 	sm := svc.Manager()
 	sm.SetBlockChannel(rs.onBlockEvents)
 	sm.SetTrxChannel(rs.onTrxEvents)
+	sm.SetCollateralAlertChannel(rs.onCollateralEvents)
 
 	// handle broadcast and subscriptions in a separate routine
 	rs.wg.Add(1)
@@ -134,20 +180,51 @@ func (rs *rootResolver) run() {
 		case id := <-rs.unsubscribeOnBlock:
 			delete(rs.blockSubscribers, id)
 
+		case id := <-rs.unsubscribeOnFinalized:
+			delete(rs.finalizedSubscribers, id)
+
 		case id := <-rs.unsubscribeOnTrx:
 			delete(rs.trxSubscribers, id)
 
+		case id := <-rs.unsubscribeOnCollateral:
+			delete(rs.collateralSubscribers, id)
+
+		case id := <-rs.unsubscribeOnFees:
+			delete(rs.feesSubscribers, id)
+
+		case id := <-rs.unsubscribeOnPrice:
+			delete(rs.priceSubscribers, id)
+
 		case sub := <-rs.subscribeOnBlock:
 			rs.addBlockSubscriber(sub)
 
+		case sub := <-rs.subscribeOnFinalized:
+			rs.addFinalizedSubscriber(sub)
+
 		case sub := <-rs.subscribeOnTrx:
 			rs.addTrxSubscriber(sub)
 
+		case sub := <-rs.subscribeOnCollateral:
+			rs.addCollateralSubscriber(sub)
+
+		case sub := <-rs.subscribeOnFees:
+			rs.addFeesSubscriber(sub)
+
+		case sub := <-rs.subscribeOnPrice:
+			rs.addPriceSubscriber(sub)
+
 		case evt := <-rs.onBlockEvents:
+			rs.headSeq = EventSequence{Block: evt.Number}
 			rs.dispatchOnBlock(evt)
+			rs.dispatchOnFinalized(evt)
+			rs.dispatchOnFees(evt)
+			rs.dispatchOnPrice(evt)
 
 		case evt := <-rs.onTrxEvents:
 			rs.dispatchOnTransaction(evt)
+
+		case evt := <-rs.onCollateralEvents:
+			rs.dispatchOnCollateral(evt)
 		}
 	}
 }
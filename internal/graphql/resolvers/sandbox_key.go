@@ -0,0 +1,49 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SandboxKey represents resolvable newly minted sandbox API key.
+type SandboxKey struct {
+	types.SandboxKey
+	Key string
+}
+
+// CreateSandboxKey mints a new sandbox API key with limited privileges for the calling client.
+func (rs *rootResolver) CreateSandboxKey(ctx context.Context) (*SandboxKey, error) {
+	if !cfg.Sandbox.Enabled {
+		return nil, fmt.Errorf("sandbox keys are not available")
+	}
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
+	client := ClientAddrFromContext(ctx)
+	if client == "" {
+		return nil, fmt.Errorf("client address not available")
+	}
+
+	sk, key, err := repository.R().CreateSandboxKey(client)
+	if err != nil {
+		log.Warningf("can not create sandbox key for %s; %s", client, err.Error())
+		return nil, err
+	}
+	return &SandboxKey{SandboxKey: *sk, Key: key}, nil
+}
+
+// Expires resolves the UNIX time stamp of the key expiration.
+func (sk *SandboxKey) Expires() hexutil.Uint64 {
+	return hexutil.Uint64(sk.SandboxKey.Expires.Unix())
+}
+
+// RateLimit resolves the max number of requests per minute allowed to the key.
+func (sk *SandboxKey) RateLimit() int32 {
+	return int32(cfg.Sandbox.RateLimit)
+}
@@ -0,0 +1,39 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BuildMintSAXISTx prepares an unsigned transaction minting sAXIS tokens
+// for the locked delegation to the given validator.
+func (rs *rootResolver) BuildMintSAXISTx(args *struct {
+	Delegator   common.Address
+	ValidatorId hexutil.Big
+}) (*types.PreparedTransaction, error) {
+	tx, err := repository.R().BuildMintSAXISTx(&args.Delegator, &args.ValidatorId)
+	if err != nil {
+		log.Debugf("can not build sAXIS mint of %s to %d; %s", args.Delegator.String(), args.ValidatorId.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+	return tx, nil
+}
+
+// BuildBurnSAXISTx prepares an unsigned transaction burning the given amount
+// of sAXIS tokens minted for the delegation to the given validator.
+func (rs *rootResolver) BuildBurnSAXISTx(args *struct {
+	Delegator   common.Address
+	ValidatorId hexutil.Big
+	Amount      hexutil.Big
+}) (*types.PreparedTransaction, error) {
+	tx, err := repository.R().BuildBurnSAXISTx(&args.Delegator, &args.ValidatorId, &args.Amount)
+	if err != nil {
+		log.Debugf("can not build sAXIS burn of %s to %d; %s", args.Delegator.String(), args.ValidatorId.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,95 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// sAXISHistoryMaxDays represents the max number of days of sAXIS supply history available.
+const sAXISHistoryMaxDays = 365
+
+// tokenizerMaxEventsPerRequest represents the max number of tokenizer events of an account in one query.
+const tokenizerMaxEventsPerRequest = 100
+
+// SAXISSupply represents a resolvable daily record of sAXIS tokens outstanding.
+type SAXISSupply struct {
+	types.SAXISSupply
+}
+
+// SAXISCollateral represents a resolvable amount of sAXIS tokens outstanding against a validator.
+type SAXISCollateral struct {
+	types.SAXISCollateral
+}
+
+// SAXISSupply resolves the daily history of sAXIS tokens outstanding in the given number of recent days.
+func (rs *rootResolver) SAXISSupply(args struct{ Days int32 }) ([]*SAXISSupply, error) {
+	if args.Days <= 0 || args.Days > sAXISHistoryMaxDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", sAXISHistoryMaxDays)
+	}
+
+	list, err := repository.R().SAXISSupply(int(args.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*SAXISSupply, len(list))
+	for i, sp := range list {
+		out[i] = &SAXISSupply{*sp}
+	}
+	return out, nil
+}
+
+// SAXISCollateral resolves the amount of sAXIS tokens outstanding by validator.
+func (rs *rootResolver) SAXISCollateral() ([]*SAXISCollateral, error) {
+	list, err := repository.R().SAXISCollateral()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*SAXISCollateral, len(list))
+	for i, col := range list {
+		out[i] = &SAXISCollateral{*col}
+	}
+	return out, nil
+}
+
+// TokenizerHistory resolves the most recent sAXIS mints and burns of the account.
+func (acc *Account) TokenizerHistory(args struct{ Count int32 }) ([]*types.TokenizerEvent, error) {
+	if args.Count <= 0 || args.Count > tokenizerMaxEventsPerRequest {
+		return nil, fmt.Errorf("count must be between 1 and %d", tokenizerMaxEventsPerRequest)
+	}
+	return repository.R().TokenizerEvents(&acc.Address, args.Count)
+}
+
+// Day resolves the time stamp of the beginning of the day (UTC).
+func (sp *SAXISSupply) Day() hexutil.Uint64 {
+	return hexutil.Uint64(sp.SAXISSupply.Day.Unix())
+}
+
+// Staker resolves the validator the sAXIS tokens are collateralized by.
+func (col *SAXISCollateral) Staker() (*Staker, error) {
+	st, err := repository.R().Validator(&col.ValidatorId)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaker(st), nil
+}
+
+// Ratio resolves the share of the validator total stake tokenized by the outstanding sAXIS.
+func (col *SAXISCollateral) Ratio() (float64, error) {
+	st, err := repository.R().Validator(&col.ValidatorId)
+	if err != nil {
+		return 0, err
+	}
+	if st.TotalStake == nil || st.TotalStake.ToInt().Sign() <= 0 {
+		return 0, nil
+	}
+
+	r, _ := new(big.Float).Quo(new(big.Float).SetInt(col.Outstanding.ToInt()), new(big.Float).SetInt(st.TotalStake.ToInt())).Float64()
+	return r, nil
+}
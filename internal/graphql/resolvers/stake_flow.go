@@ -0,0 +1,91 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// stakeFlowsMaxCount represents the max number of stake flows resolved at once.
+const stakeFlowsMaxCount = 100
+
+// StakeFlow represents resolvable net stake movement between validators.
+type StakeFlow struct {
+	types.StakeFlow
+}
+
+// StakeFlows resolves the list of the largest net stake movements between validators.
+func (rs *rootResolver) StakeFlows(args struct {
+	Period string
+	Count  int32
+}) ([]*StakeFlow, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if args.Count <= 0 || args.Count > stakeFlowsMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", stakeFlowsMaxCount)
+	}
+
+	list, err := repository.R().StakeFlows(args.Period, args.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*StakeFlow, len(list))
+	for i, sf := range list {
+		res[i] = &StakeFlow{*sf}
+	}
+	return res, nil
+}
+
+// FromId resolves the id of the validator the stake moved from.
+func (sf *StakeFlow) FromId() hexutil.Big {
+	return hexutil.Big(*new(big.Int).SetUint64(sf.FromValidator))
+}
+
+// From resolves the validator the stake moved from.
+func (sf *StakeFlow) From() (*Staker, error) {
+	id := sf.FromId()
+	st, err := repository.R().Validator(&id)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaker(st), nil
+}
+
+// ToId resolves the id of the validator the stake moved to.
+func (sf *StakeFlow) ToId() hexutil.Big {
+	return hexutil.Big(*new(big.Int).SetUint64(sf.ToValidator))
+}
+
+// To resolves the validator the stake moved to.
+func (sf *StakeFlow) To() (*Staker, error) {
+	id := sf.ToId()
+	st, err := repository.R().Validator(&id)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaker(st), nil
+}
+
+// Amount resolves the net amount of stake moved.
+func (sf *StakeFlow) Amount() hexutil.Big {
+	return hexutil.Big(*sf.StakeFlow.Amount)
+}
+
+// Moves resolves the number of moves between the validators.
+func (sf *StakeFlow) Moves() hexutil.Uint64 {
+	return hexutil.Uint64(sf.StakeFlow.Moves)
+}
+
+// Delegators resolves the number of delegators moving the stake.
+func (sf *StakeFlow) Delegators() hexutil.Uint64 {
+	return hexutil.Uint64(sf.StakeFlow.Delegators)
+}
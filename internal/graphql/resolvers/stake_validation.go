@@ -0,0 +1,47 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StakeActionValidation represents resolvable result of a stake action validation.
+type StakeActionValidation struct {
+	Errors []types.StakeValidationError
+}
+
+// ValidateStakeAction checks the intended stake action against the SFC contract constraints.
+func (rs *rootResolver) ValidateStakeAction(args *struct {
+	Action      string
+	Address     common.Address
+	ValidatorID hexutil.Big
+	Amount      hexutil.Big
+	Duration    *hexutil.Uint64
+}) (*StakeActionValidation, error) {
+	act := types.StakeAction{
+		Action:      strings.ToUpper(args.Action),
+		Address:     args.Address,
+		ValidatorID: args.ValidatorID,
+		Amount:      args.Amount,
+	}
+	if args.Duration != nil {
+		act.Duration = *args.Duration
+	}
+
+	list, err := repository.R().ValidateStakeAction(&act)
+	if err != nil {
+		log.Debugf("can not validate %s of %s to %d; %s", act.Action, act.Address.String(), act.ValidatorID.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+	return &StakeActionValidation{Errors: list}, nil
+}
+
+// Valid resolves the flag of the stake action passing all the constraints.
+func (sav *StakeActionValidation) Valid() bool {
+	return len(sav.Errors) == 0
+}
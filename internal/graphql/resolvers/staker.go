@@ -4,6 +4,7 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 	"math/big"
 	"time"
 
@@ -41,15 +42,24 @@ func NewStaker(st *types.Validator) *Staker {
 
 // Delegations resolves list of delegations associated with the staker.
 func (st Staker) Delegations(args struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor  *Cursor
+	Count   int32
+	OrderBy string
+	Filter  *DelegationFilter
 }) (*DelegationList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
 	// get delegations
-	dl, err := repository.R().DelegationsOfValidator(&st.Id, (*string)(args.Cursor), args.Count)
+	opt, err := delegationListOptions(args.OrderBy, args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	dl, err := repository.R().DelegationsOfValidator(&st.Id, (*string)(args.Cursor), args.Count, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -63,10 +73,10 @@ func (st Staker) StakerInfo() *types.StakerInfo {
 }
 
 // DelegationLock returns information about validator lock.
-func (st Staker) DelegationLock() (*types.DelegationLock, error) {
+func (st Staker) DelegationLock(ctx context.Context) (*types.DelegationLock, error) {
 	// load the delegations lock only once
 	dl, err, _ := st.cg.Do(stakerCallGroupLock, func() (interface{}, error) {
-		return repository.R().DelegationLock(&st.StakerAddress, &st.Id)
+		return repository.R().DelegationLock(&st.StakerAddress, &st.Id, pinnedBlock(ctx))
 	})
 	if err != nil {
 		return nil, err
@@ -75,8 +85,8 @@ func (st Staker) DelegationLock() (*types.DelegationLock, error) {
 }
 
 // IsStakeLocked signals if the stake is locked right now.
-func (st Staker) IsStakeLocked() (bool, error) {
-	lock, err := st.DelegationLock()
+func (st Staker) IsStakeLocked(ctx context.Context) (bool, error) {
+	lock, err := st.DelegationLock(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -84,9 +94,9 @@ func (st Staker) IsStakeLocked() (bool, error) {
 }
 
 // LockedUntil resolves the end time of delegation.
-func (st Staker) LockedUntil() (hexutil.Uint64, error) {
+func (st Staker) LockedUntil(ctx context.Context) (hexutil.Uint64, error) {
 	// get the lock detail
-	lock, err := st.DelegationLock()
+	lock, err := st.DelegationLock(ctx)
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
@@ -98,8 +108,8 @@ func (st Staker) LockedUntil() (hexutil.Uint64, error) {
 }
 
 // LockedFromEpoch resolves the epoch om which the lock has been created.
-func (st Staker) LockedFromEpoch() (hexutil.Uint64, error) {
-	lock, err := st.DelegationLock()
+func (st Staker) LockedFromEpoch(ctx context.Context) (hexutil.Uint64, error) {
+	lock, err := st.DelegationLock(ctx)
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
@@ -133,7 +143,7 @@ func (st Staker) WithdrawRequests() ([]WithdrawRequest, error) {
 func (st Staker) Stake() (hexutil.Big, error) {
 	// load the delegations lock only once
 	dl, err, _ := st.cg.Do(stakerCallGroupStake, func() (interface{}, error) {
-		return repository.R().DelegationAmountStaked(&st.StakerAddress, &st.Id)
+		return repository.R().DelegationAmountStaked(&st.StakerAddress, &st.Id, nil)
 	})
 	if err != nil {
 		return hexutil.Big{}, err
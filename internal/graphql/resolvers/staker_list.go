@@ -2,44 +2,24 @@ package resolvers
 
 import (
 	"axis-graphql/internal/repository"
-	"math/big"
 	"sort"
-
-	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Stakers resolves a list of staker information from SFC smart contract.
+// The list is served from the in-memory snapshot of validators.
 func (rs *rootResolver) Stakers() ([]*Staker, error) {
-	// get the number
-	num, err := repository.R().LastValidatorId()
+	vals, err := repository.R().Validators()
 	if err != nil {
-		log.Errorf("can not get the highest staker id; %s", err.Error())
+		log.Errorf("can not get the list of validators; %s", err.Error())
 		return nil, err
 	}
 
 	// make the list
-	list := make([]*Staker, 0)
-	for i := uint64(1); i <= num; i++ {
-		// extract the staker info
-		st, err := repository.R().Validator((*hexutil.Big)(new(big.Int).SetUint64(i)))
-		if err != nil {
-			log.Criticalf("can not extract staker #%d information; %s", i, err.Error())
-			continue
-		}
-
-		// staker not valid?
-		if st.Id.ToInt().Uint64() == 0 {
-			log.Debugf("staker #%d has invalid ID", i)
-			continue
-		}
-
-		// store the staker into the list
+	list := make([]*Staker, 0, len(vals))
+	for _, st := range vals {
 		list = append(list, NewStaker(st))
 	}
 
-	// inform
-	log.Debugf("found %d stakers", len(list))
-
 	// sort the list by total amount delegated and return the result
 	sort.Sort(StakesByTotalStaked(list))
 	return list, nil
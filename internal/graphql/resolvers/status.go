@@ -0,0 +1,223 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Overall states of the API server reported by the status page.
+const (
+	statusOperational = "operational"
+	statusDegraded    = "degraded"
+	statusOutage      = "outage"
+)
+
+// statusMaxSyncLag represents the number of blocks the indexer can stay behind the node
+// before the service is reported degraded.
+const statusMaxSyncLag = 100
+
+// statusIncidentsCount represents the number of the most recent incidents reported by the status page.
+const statusIncidentsCount = 10
+
+// Status represents resolvable state of the API server components driving the public status page.
+type Status struct {
+	Updated       hexutil.Uint64
+	Sync          StatusSync
+	Node          StatusNode
+	Subscriptions StatusSubscriptions
+	Storage       StatusStorage
+	Maintenance   bool
+	incidents     []*types.StatusIncident
+}
+
+// StatusSync represents the synchronization state of the blockchain indexer.
+type StatusSync struct {
+	IndexedBlock hexutil.Uint64
+	NodeBlock    *hexutil.Uint64
+	Lag          hexutil.Uint64
+	Stalled      bool
+}
+
+// StatusNode represents the health of the connected blockchain node.
+type StatusNode struct {
+	Available     bool
+	Latency       int32
+	ErrorRate     float64
+	UnderPressure bool
+}
+
+// StatusSubscriptions represents the health of the websocket subscriptions.
+type StatusSubscriptions struct {
+	Active  int32
+	Dropped hexutil.Uint64
+}
+
+// StatusStorage represents the health of the persistent storage.
+type StatusStorage struct {
+	Available bool
+	Latency   *int32
+}
+
+// StatusIncident represents a resolvable incident marker of the status page.
+type StatusIncident struct {
+	types.StatusIncident
+}
+
+// Status resolves the state of the API server components for the public status page.
+func (rs *rootResolver) Status() (*Status, error) {
+	st := Status{
+		Updated:     hexutil.Uint64(time.Now().UTC().Unix()),
+		Maintenance: IsMaintenance(),
+	}
+
+	// sync status of the indexer against the node
+	bp := repository.R().BlockProduction()
+	st.Sync.Stalled = bp.Stalled
+	if lkb, err := repository.R().LastKnownBlock(); err == nil {
+		st.Sync.IndexedBlock = hexutil.Uint64(lkb)
+	}
+
+	nh := repository.R().NodeHealth()
+	st.Node = StatusNode{
+		Latency:       int32(nh.Latency.Milliseconds()),
+		ErrorRate:     nh.ErrorRate,
+		UnderPressure: repository.R().IsNodeUnderPressure(),
+	}
+	if height, err := repository.R().BlockHeight(); err == nil {
+		top := hexutil.Uint64(height.ToInt().Uint64())
+		st.Node.Available = true
+		st.Sync.NodeBlock = &top
+		if top > st.Sync.IndexedBlock {
+			st.Sync.Lag = top - st.Sync.IndexedBlock
+		}
+	} else {
+		log.Errorf("node not available for status; %s", err.Error())
+	}
+
+	active, dropped := subUsage.totals()
+	st.Subscriptions = StatusSubscriptions{Active: int32(active), Dropped: hexutil.Uint64(dropped)}
+
+	if lat, err := repository.R().StorageHealth(); err == nil {
+		ms := int32(lat.Milliseconds())
+		st.Storage = StatusStorage{Available: true, Latency: &ms}
+	} else {
+		log.Errorf("storage not available for status; %s", err.Error())
+	}
+
+	// the incidents are not available if the storage is down
+	if st.Storage.Available {
+		list, err := repository.R().StatusIncidents(statusIncidentsCount)
+		if err != nil {
+			return nil, err
+		}
+		st.incidents = list
+	}
+	return &st, nil
+}
+
+// Overall resolves the overall state of the API server; one of operational, degraded and outage.
+func (st *Status) Overall() string {
+	if !st.Node.Available || !st.Storage.Available || st.Sync.Stalled {
+		return statusOutage
+	}
+
+	state := statusOperational
+	if st.Node.UnderPressure || st.Maintenance || st.Sync.Lag > statusMaxSyncLag {
+		state = statusDegraded
+	}
+
+	// open incidents published by operators
+	for _, si := range st.incidents {
+		if si.Resolved != nil {
+			continue
+		}
+		switch si.Severity {
+		case types.StatusIncidentCritical:
+			return statusOutage
+		case types.StatusIncidentMajor:
+			state = statusDegraded
+		}
+	}
+	return state
+}
+
+// Incidents resolves the most recent incident markers, the latest first.
+func (st *Status) Incidents() []*StatusIncident {
+	list := make([]*StatusIncident, len(st.incidents))
+	for i, si := range st.incidents {
+		list[i] = &StatusIncident{StatusIncident: *si}
+	}
+	return list
+}
+
+// OpenStatusIncident publishes a new incident marker on the status page.
+func (rs *rootResolver) OpenStatusIncident(ctx context.Context, args *struct {
+	Severity string
+	Title    string
+	Message  *string
+}) (*StatusIncident, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch args.Severity {
+	case types.StatusIncidentMinor, types.StatusIncidentMajor, types.StatusIncidentCritical:
+	default:
+		return nil, fmt.Errorf("unknown incident severity %s", args.Severity)
+	}
+
+	si, err := repository.R().OpenStatusIncident(args.Severity, args.Title, args.Message)
+	if err != nil {
+		log.Errorf("can not open status incident; %s", err.Error())
+		return nil, err
+	}
+
+	log.Noticef("status incident %s opened by %s; %s", si.ID, key.Name, si.Title)
+	return &StatusIncident{StatusIncident: *si}, nil
+}
+
+// ResolveStatusIncident marks the incident marker of the status page resolved.
+func (rs *rootResolver) ResolveStatusIncident(ctx context.Context, args *struct {
+	Id      string
+	Message *string
+}) (*StatusIncident, error) {
+	key, err := mustBeAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := repository.R().ResolveStatusIncident(args.Id, args.Message)
+	if err != nil {
+		log.Errorf("can not resolve status incident %s; %s", args.Id, err.Error())
+		return nil, err
+	}
+
+	log.Noticef("status incident %s resolved by %s", si.ID, key.Name)
+	return &StatusIncident{StatusIncident: *si}, nil
+}
+
+// Id resolves the identifier of the incident.
+func (si *StatusIncident) Id() string {
+	return si.ID
+}
+
+// Started resolves the UNIX time stamp of the incident start.
+func (si *StatusIncident) Started() hexutil.Uint64 {
+	return hexutil.Uint64(si.StatusIncident.Started.Unix())
+}
+
+// Resolved resolves the UNIX time stamp of the incident resolution, if resolved.
+func (si *StatusIncident) Resolved() *hexutil.Uint64 {
+	if si.StatusIncident.Resolved == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(si.StatusIncident.Resolved.Unix())
+	return &ts
+}
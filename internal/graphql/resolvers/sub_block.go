@@ -12,8 +12,10 @@ const onBlockChannelCapacity = 500
 
 // subscriptOnBlock represents reference to a subscriber to onBlock events broadcast.
 type subscriptOnBlock struct {
+	client string
 	stop   <-chan struct{}
 	events chan<- *Block
+	order  *subscriptionOrder
 }
 
 // OnBlock resolves subscription to new blocks event broadcast.
@@ -23,8 +25,10 @@ func (rs *rootResolver) OnBlock(ctx context.Context) <-chan *Block {
 
 	// subscribe to event dispatch
 	rs.subscribeOnBlock <- &subscriptOnBlock{
+		client: subscriptionClient(ctx),
 		stop:   ctx.Done(),
 		events: c,
+		order:  newSubscriptionOrder(),
 	}
 	return c
 }
@@ -49,31 +53,36 @@ func (rs *rootResolver) dispatchOnBlock(blk *types.Block) {
 
 	// broadcast the event in separate go routines so we don't block here
 	for id, sub := range rs.blockSubscribers {
-		go rs.notifyOnBlock(block, sub, id)
+		go rs.notifyOnBlock(block, sub, id, sub.order.ticket())
 	}
 }
 
-// notifyOnBlock broadcasts onBlock event to given subscriber.
-func (rs *rootResolver) notifyOnBlock(block *Block, sub *subscriptOnBlock, id string) {
-	// check if the context isn't already closed in which case we just unsub and leave
-	select {
-	case <-sub.stop:
-		rs.unsubscribeOnBlock <- id
-		return
-	default:
-	}
+// notifyOnBlock broadcasts onBlock event to given subscriber in the order of dispatch.
+func (rs *rootResolver) notifyOnBlock(block *Block, sub *subscriptOnBlock, id string, t uint64) {
+	sub.order.deliver(t, block.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnBlock <- id
+			return false
+		default:
+		}
 
-	// broadcast
-	select {
-	case <-sub.stop:
-		// just unsub on broken context
-		rs.unsubscribeOnBlock <- id
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnBlock <- id
 
-	case sub.events <- block:
-		// push the block to subscriber
+		case sub.events <- block:
+			// push the block to subscriber
+			return true
 
-	case <-time.After(time.Second):
-		// timeout reached without response? just remove the subscriber
-		rs.unsubscribeOnBlock <- id
-	}
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onBlock")
+			rs.unsubscribeOnBlock <- id
+		}
+		return false
+	})
 }
@@ -0,0 +1,99 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+)
+
+// onCollateralChannelCapacity is the number of collateral alert events held in memory for being broadcast to subscriber.
+const onCollateralChannelCapacity = 100
+
+// subscriptOnCollateral represents reference to a subscriber to onCollateralAlert events broadcast.
+type subscriptOnCollateral struct {
+	client string
+	owner  string
+	stop   <-chan struct{}
+	events chan<- *CollateralAlertEvent
+	order  *subscriptionOrder
+}
+
+// OnCollateralAlert resolves subscription to collateral ratio alerts
+// registered by the calling API key.
+func (rs *rootResolver) OnCollateralAlert(ctx context.Context) (<-chan *CollateralAlertEvent, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the stream
+	c := make(chan *CollateralAlertEvent, onCollateralChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnCollateral <- &subscriptOnCollateral{
+		owner:  key.Name,
+		client: subscriptionClient(ctx),
+		stop:   ctx.Done(),
+		events: c,
+		order:  newSubscriptionOrder(),
+	}
+	return c, nil
+}
+
+// addCollateralSubscriber adds a new subscription to onCollateralAlert events.
+func (rs *rootResolver) addCollateralSubscriber(sub *subscriptOnCollateral) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.collateralSubscribers[id] = sub
+	} else {
+		// log critical issue
+		log.Critical("can not generate UUID for new onCollateralAlert subscriber")
+		log.Critical(err)
+	}
+}
+
+// dispatchOnCollateral dispatches onCollateralAlert event to subscribers of the alert owner.
+// Alerts are not bound to a block; they are sequenced after the last block received.
+func (rs *rootResolver) dispatchOnCollateral(evt *types.CollateralAlertEvent) {
+	rs.headSeq.Index++
+	ev := &CollateralAlertEvent{CollateralAlertEvent: *evt, seq: rs.headSeq}
+
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.collateralSubscribers {
+		if sub.owner == evt.Owner {
+			go rs.notifyOnCollateral(ev, sub, id, sub.order.ticket())
+		}
+	}
+}
+
+// notifyOnCollateral broadcasts onCollateralAlert event to given subscriber in the order of dispatch.
+func (rs *rootResolver) notifyOnCollateral(ev *CollateralAlertEvent, sub *subscriptOnCollateral, id string, t uint64) {
+	sub.order.deliver(t, ev.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnCollateral <- id
+			return false
+		default:
+		}
+
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnCollateral <- id
+
+		case sub.events <- ev:
+			// push the event to subscriber
+			return true
+
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onCollateralAlert")
+			rs.unsubscribeOnCollateral <- id
+		}
+		return false
+	})
+}
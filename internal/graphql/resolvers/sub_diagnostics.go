@@ -0,0 +1,187 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/metrics"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// SubscriptionClientClosed represents the disconnect reason of a subscription closed by the client.
+	SubscriptionClientClosed = "CLIENT_CLOSED"
+
+	// SubscriptionStreamEnded represents the disconnect reason of a subscription ended by the server.
+	SubscriptionStreamEnded = "STREAM_ENDED"
+
+	// subscriptionBufferOverrun represents the disconnect reason of a subscription removed
+	// from the broadcast because the client did not take the events in time.
+	subscriptionBufferOverrun = "BUFFER_OVERRUN"
+)
+
+// subscriptionDiagnosticsRetention represents the time the diagnostics of a client without
+// active subscriptions are kept since the last failure of the client.
+const subscriptionDiagnosticsRetention = 24 * time.Hour
+
+// subscriptionFailureStat represents the number of failures of a kind and the time of the last one.
+type subscriptionFailureStat struct {
+	count uint64
+	last  time.Time
+}
+
+// subscriptionDiagnostics represents the delivery failures of subscriptions of a single client.
+type subscriptionDiagnostics struct {
+	since       time.Time
+	updated     time.Time
+	rejected    uint64
+	dropped     subscriptionFailureStat
+	overruns    map[string]*subscriptionFailureStat
+	disconnects map[string]*subscriptionFailureStat
+}
+
+// SubscriptionDiagnostics represents resolvable delivery failures of subscriptions of a client.
+type SubscriptionDiagnostics struct {
+	Client      string
+	Since       hexutil.Uint64
+	Rejected    hexutil.Uint64
+	Dropped     hexutil.Uint64
+	LastDropped *hexutil.Uint64
+	Overruns    []*SubscriptionFailure
+	Disconnects []*SubscriptionFailure
+}
+
+// SubscriptionFailure represents resolvable number of subscription failures of a kind.
+type SubscriptionFailure struct {
+	Name  string
+	Count hexutil.Uint64
+	Last  hexutil.Uint64
+}
+
+// diagnostics provides the diagnostics record of the given client; the lock must be held.
+func (t *subscriptionUsageTracker) diagnostics(client string) *subscriptionDiagnostics {
+	now := time.Now().UTC()
+	dg, ok := t.diag[client]
+	if !ok {
+		dg = &subscriptionDiagnostics{
+			since:       now,
+			overruns:    make(map[string]*subscriptionFailureStat),
+			disconnects: make(map[string]*subscriptionFailureStat),
+		}
+		t.diag[client] = dg
+	}
+	dg.updated = now
+	return dg
+}
+
+// dropped registers an event of the client dropped over the throughput limit; the lock must be held.
+func (t *subscriptionUsageTracker) dropped(client string) {
+	dg := t.diagnostics(client)
+	dg.dropped.count++
+	dg.dropped.last = dg.updated
+	metrics.Counter("subscriptions/dropped").Inc(1)
+}
+
+// overrun registers an event of the given subscription the client did not take in time.
+// The broadcast removes such a subscriber, so the overrun is also its disconnect.
+func (t *subscriptionUsageTracker) overrun(client string, subscription string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	dg := t.diagnostics(client)
+	dg.overruns[subscription] = dg.overruns[subscription].add(dg.updated)
+	dg.disconnects[subscriptionBufferOverrun] = dg.disconnects[subscriptionBufferOverrun].add(dg.updated)
+
+	metrics.Counter(fmt.Sprintf("subscriptions/overruns/%s", subscription)).Inc(1)
+	metrics.Counter(fmt.Sprintf("subscriptions/disconnects/%s", subscriptionBufferOverrun)).Inc(1)
+}
+
+// SubscriptionDisconnected registers a subscription of the client of the context closed for the given reason.
+func SubscriptionDisconnected(ctx context.Context, reason string) {
+	client := subscriptionClient(ctx)
+
+	subUsage.lock.Lock()
+	defer subUsage.lock.Unlock()
+
+	dg := subUsage.diagnostics(client)
+	dg.disconnects[reason] = dg.disconnects[reason].add(dg.updated)
+	metrics.Counter(fmt.Sprintf("subscriptions/disconnects/%s", reason)).Inc(1)
+}
+
+// add registers a new failure at the given time; a new stat is created if needed.
+func (fs *subscriptionFailureStat) add(ts time.Time) *subscriptionFailureStat {
+	if fs == nil {
+		fs = new(subscriptionFailureStat)
+	}
+	fs.count++
+	fs.last = ts
+	return fs
+}
+
+// diagnosticsOf provides the diagnostics of the given client, or of all the clients, if none given.
+// Diagnostics of clients without active subscriptions and without recent failures are forgotten.
+func (t *subscriptionUsageTracker) diagnosticsOf(client *string) []*SubscriptionDiagnostics {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	list := make([]*SubscriptionDiagnostics, 0, len(t.diag))
+	for name, dg := range t.diag {
+		if _, active := t.clients[name]; !active && time.Since(dg.updated) > subscriptionDiagnosticsRetention {
+			delete(t.diag, name)
+			continue
+		}
+		if client != nil && *client != name {
+			continue
+		}
+
+		sd := SubscriptionDiagnostics{
+			Client:      name,
+			Since:       hexutil.Uint64(dg.since.Unix()),
+			Rejected:    hexutil.Uint64(dg.rejected),
+			Dropped:     hexutil.Uint64(dg.dropped.count),
+			Overruns:    subscriptionFailures(dg.overruns),
+			Disconnects: subscriptionFailures(dg.disconnects),
+		}
+		if dg.dropped.count > 0 {
+			last := hexutil.Uint64(dg.dropped.last.Unix())
+			sd.LastDropped = &last
+		}
+		list = append(list, &sd)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Client < list[j].Client
+	})
+	return list
+}
+
+// subscriptionFailures converts the given failure stats to a list sorted by name.
+func subscriptionFailures(stats map[string]*subscriptionFailureStat) []*SubscriptionFailure {
+	list := make([]*SubscriptionFailure, 0, len(stats))
+	for name, fs := range stats {
+		list = append(list, &SubscriptionFailure{Name: name, Count: hexutil.Uint64(fs.count), Last: hexutil.Uint64(fs.last.Unix())})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// SubscriptionDiagnostics resolves the delivery failures of websocket subscriptions, so integrators
+// can find out why their clients miss events. Admin API keys see the diagnostics of all the clients,
+// other keys see their own diagnostics only.
+func (rs *rootResolver) SubscriptionDiagnostics(ctx context.Context) ([]*SubscriptionDiagnostics, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Admin {
+		return subUsage.diagnosticsOf(nil), nil
+	}
+	return subUsage.diagnosticsOf(&key.Name), nil
+}
@@ -0,0 +1,156 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// onFeesChannelCapacity is the number of fee trend events held in memory for being broadcast to subscriber.
+const onFeesChannelCapacity = 100
+
+// subscriptOnFees represents reference to a subscriber to onFees events broadcast.
+type subscriptOnFees struct {
+	client string
+	stop   <-chan struct{}
+	events chan<- *FeeTrend
+	order  *subscriptionOrder
+}
+
+// FeeTrend represents resolvable fee information of a new block.
+type FeeTrend struct {
+	BlockNumber  hexutil.Uint64
+	TimeStamp    hexutil.Uint64
+	BaseFee      *hexutil.Big
+	GasLimit     hexutil.Uint64
+	GasUsed      hexutil.Uint64
+	GasUsedRatio float64
+	SuggestedTip hexutil.Big
+}
+
+// OnFees resolves subscription to fee trend of new blocks.
+func (rs *rootResolver) OnFees(ctx context.Context) <-chan *FeeTrend {
+	// make the stream
+	c := make(chan *FeeTrend, onFeesChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnFees <- &subscriptOnFees{
+		client: subscriptionClient(ctx),
+		stop:   ctx.Done(),
+		events: c,
+		order:  newSubscriptionOrder(),
+	}
+	return c
+}
+
+// addFeesSubscriber adds a new subscription to onFees events.
+func (rs *rootResolver) addFeesSubscriber(sub *subscriptOnFees) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.feesSubscribers[id] = sub
+	} else {
+		// log critical issue
+		log.Critical("can not generate UUID for new onFees subscriber")
+		log.Critical(err)
+	}
+}
+
+// dispatchOnFees dispatches onFees event of the new block to registered subscribers.
+func (rs *rootResolver) dispatchOnFees(blk *types.Block) {
+	if len(rs.feesSubscribers) == 0 {
+		return
+	}
+
+	// copy the subscribers so the map is not shared with the broadcast;
+	// the delivery slots are reserved here to keep the order of blocks
+	subs := make(map[string]*subscriptOnFees, len(rs.feesSubscribers))
+	tickets := make(map[string]uint64, len(rs.feesSubscribers))
+	for id, sub := range rs.feesSubscribers {
+		subs[id] = sub
+		tickets[id] = sub.order.ticket()
+	}
+
+	// the suggested tip needs the node, so we don't block here
+	go rs.broadcastFees(blk, subs, tickets)
+}
+
+// broadcastFees builds the fee trend of the block and broadcasts it to given subscribers.
+func (rs *rootResolver) broadcastFees(blk *types.Block, subs map[string]*subscriptOnFees, tickets map[string]uint64) {
+	ft := NewFeeTrend(blk)
+	for id, sub := range subs {
+		go rs.notifyOnFees(ft, sub, id, tickets[id])
+	}
+}
+
+// notifyOnFees broadcasts onFees event to given subscriber in the order of dispatch.
+func (rs *rootResolver) notifyOnFees(ft *FeeTrend, sub *subscriptOnFees, id string, t uint64) {
+	sub.order.deliver(t, ft.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnFees <- id
+			return false
+		default:
+		}
+
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnFees <- id
+
+		case sub.events <- ft:
+			// push the fee trend to subscriber
+			return true
+
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onFees")
+			rs.unsubscribeOnFees <- id
+		}
+		return false
+	})
+}
+
+// Sequence resolves the position of the fee trend in the subscription events stream.
+func (ft *FeeTrend) Sequence() *EventSequence {
+	return &EventSequence{Block: ft.BlockNumber}
+}
+
+// NewFeeTrend builds the fee trend of the given block. The suggested tip is the part
+// of the current gas price above the base fee of the block.
+func NewFeeTrend(blk *types.Block) *FeeTrend {
+	ft := FeeTrend{
+		BlockNumber: blk.Number,
+		TimeStamp:   blk.TimeStamp,
+		BaseFee:     blk.BaseFee,
+		GasLimit:    blk.GasLimit,
+		GasUsed:     blk.GasUsed,
+	}
+	if blk.GasLimit > 0 {
+		ft.GasUsedRatio = float64(blk.GasUsed) / float64(blk.GasLimit)
+	}
+
+	// get the current gas price
+	price, err := repository.R().GasPrice()
+	if err != nil {
+		log.Errorf("suggested tip of block #%d not available; %s", uint64(blk.Number), err.Error())
+		return &ft
+	}
+
+	tip := new(big.Int).Set(price.ToInt())
+	if blk.BaseFee != nil {
+		tip.Sub(tip, blk.BaseFee.ToInt())
+		if tip.Sign() < 0 {
+			tip.SetInt64(0)
+		}
+	}
+	ft.SuggestedTip = hexutil.Big(*tip)
+	return &ft
+}
@@ -0,0 +1,117 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OnBlockFinalized resolves subscription to blocks becoming final.
+func (rs *rootResolver) OnBlockFinalized(ctx context.Context) <-chan *Block {
+	// make the stream
+	c := make(chan *Block, onBlockChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnFinalized <- &subscriptOnBlock{
+		client: subscriptionClient(ctx),
+		stop:   ctx.Done(),
+		events: c,
+		order:  newSubscriptionOrder(),
+	}
+	return c
+}
+
+// addFinalizedSubscriber adds a new subscription to onBlockFinalized events.
+func (rs *rootResolver) addFinalizedSubscriber(sub *subscriptOnBlock) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.finalizedSubscribers[id] = sub
+	} else {
+		// log critical issue
+		log.Critical("can not generate UUID for new onBlockFinalized subscriber")
+		log.Critical(err)
+	}
+}
+
+// dispatchOnFinalized dispatches the block finalized by the new block to registered subscribers.
+// The new block itself is final if no finality depth is configured.
+func (rs *rootResolver) dispatchOnFinalized(blk *types.Block) {
+	if len(rs.finalizedSubscribers) == 0 {
+		return
+	}
+
+	depth := repository.R().FinalityDepth()
+	if depth > 0 && uint64(blk.Number) < depth {
+		return
+	}
+
+	// copy the subscribers so the map is not shared with the broadcast;
+	// the delivery slots are reserved here to keep the order of blocks
+	subs := make(map[string]*subscriptOnBlock, len(rs.finalizedSubscribers))
+	tickets := make(map[string]uint64, len(rs.finalizedSubscribers))
+	for id, sub := range rs.finalizedSubscribers {
+		subs[id] = sub
+		tickets[id] = sub.order.ticket()
+	}
+
+	if depth == 0 {
+		rs.broadcastFinalized(NewBlock(blk), subs, tickets)
+		return
+	}
+
+	// the finalized block needs to be loaded, so we don't block here
+	go func() {
+		num := hexutil.Uint64(uint64(blk.Number) - depth)
+		fin, err := repository.R().BlockByNumber(&num)
+		if err != nil {
+			log.Errorf("finalized block #%d not available; %s", uint64(num), err.Error())
+			for id, sub := range subs {
+				go sub.order.skip(tickets[id])
+			}
+			return
+		}
+		rs.broadcastFinalized(NewBlock(fin), subs, tickets)
+	}()
+}
+
+// broadcastFinalized broadcasts the finalized block to given subscribers.
+func (rs *rootResolver) broadcastFinalized(block *Block, subs map[string]*subscriptOnBlock, tickets map[string]uint64) {
+	for id, sub := range subs {
+		go rs.notifyOnFinalized(block, sub, id, tickets[id])
+	}
+}
+
+// notifyOnFinalized broadcasts onBlockFinalized event to given subscriber in the order of dispatch.
+func (rs *rootResolver) notifyOnFinalized(block *Block, sub *subscriptOnBlock, id string, t uint64) {
+	sub.order.deliver(t, block.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnFinalized <- id
+			return false
+		default:
+		}
+
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnFinalized <- id
+
+		case sub.events <- block:
+			// push the block to subscriber
+			return true
+
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onBlockFinalized")
+			rs.unsubscribeOnFinalized <- id
+		}
+		return false
+	})
+}
@@ -0,0 +1,179 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// onPriceChannelCapacity is the number of price updates held in memory for being broadcast to subscriber.
+const onPriceChannelCapacity = 10
+
+// subscriptOnPrice represents reference to a subscriber to onPrice events broadcast.
+type subscriptOnPrice struct {
+	client   string
+	stop     <-chan struct{}
+	events   chan<- *TokenPriceUpdate
+	token    common.Address
+	interval time.Duration
+	order    *subscriptionOrder
+
+	// next is the earliest time of the next price check; managed by the resolver run loop
+	next time.Time
+
+	// last is the last price pushed to the subscriber
+	mu   sync.Mutex
+	last *hexutil.Big
+}
+
+// TokenPriceUpdate represents resolvable update of a token price.
+type TokenPriceUpdate struct {
+	Token       common.Address
+	Price       hexutil.Big
+	Source      string
+	BlockNumber hexutil.Uint64
+	TimeStamp   hexutil.Uint64
+}
+
+// OnPrice resolves subscription to the price updates of the given token. The price is checked
+// on new blocks, so oracle updates are picked up as soon as they are mined; an update is pushed
+// only if the price changed and the minimal interval since the last check passed.
+func (rs *rootResolver) OnPrice(ctx context.Context, args struct {
+	Token       common.Address
+	MinInterval *int32
+}) <-chan *TokenPriceUpdate {
+	// the interval can not go below the configured minimum
+	interval := cfg.DeFi.PriceStreamInterval
+	if args.MinInterval != nil && time.Duration(*args.MinInterval)*time.Second > interval {
+		interval = time.Duration(*args.MinInterval) * time.Second
+	}
+
+	// make the stream
+	c := make(chan *TokenPriceUpdate, onPriceChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnPrice <- &subscriptOnPrice{
+		client:   subscriptionClient(ctx),
+		stop:     ctx.Done(),
+		events:   c,
+		token:    args.Token,
+		interval: interval,
+		order:    newSubscriptionOrder(),
+	}
+	return c
+}
+
+// addPriceSubscriber adds a new subscription to onPrice events.
+func (rs *rootResolver) addPriceSubscriber(sub *subscriptOnPrice) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.priceSubscribers[id] = sub
+	} else {
+		// log critical issue
+		log.Critical("can not generate UUID for new onPrice subscriber")
+		log.Critical(err)
+	}
+}
+
+// dispatchOnPrice checks prices of tokens of the subscribers due for an update on the new block.
+func (rs *rootResolver) dispatchOnPrice(blk *types.Block) {
+	if len(rs.priceSubscribers) == 0 {
+		return
+	}
+
+	// collect due subscribers by the token, so each price is loaded only once;
+	// the delivery slots are reserved here to keep the order of blocks
+	now := time.Now()
+	due := make(map[common.Address]map[string]*subscriptOnPrice)
+	tickets := make(map[string]uint64)
+	for id, sub := range rs.priceSubscribers {
+		if now.Before(sub.next) {
+			continue
+		}
+		sub.next = now.Add(sub.interval)
+
+		if _, ok := due[sub.token]; !ok {
+			due[sub.token] = make(map[string]*subscriptOnPrice)
+		}
+		due[sub.token][id] = sub
+		tickets[id] = sub.order.ticket()
+	}
+
+	// the price needs the node, so we don't block here
+	for token, subs := range due {
+		go rs.broadcastPrice(blk, token, subs, tickets)
+	}
+}
+
+// broadcastPrice loads the price of the token and broadcasts it to given subscribers.
+func (rs *rootResolver) broadcastPrice(blk *types.Block, token common.Address, subs map[string]*subscriptOnPrice, tickets map[string]uint64) {
+	pri, err := repository.R().DefiTokenPriceSourced(&token)
+	if err != nil {
+		log.Errorf("price of %s not available; %s", token.String(), err.Error())
+		for id, sub := range subs {
+			go sub.order.skip(tickets[id])
+		}
+		return
+	}
+
+	pu := &TokenPriceUpdate{
+		Token:       token,
+		Price:       pri.Price,
+		Source:      pri.Source,
+		BlockNumber: blk.Number,
+		TimeStamp:   blk.TimeStamp,
+	}
+	for id, sub := range subs {
+		go rs.notifyOnPrice(pu, sub, id, tickets[id])
+	}
+}
+
+// notifyOnPrice broadcasts onPrice event to given subscriber in the order of dispatch, if the price changed.
+func (rs *rootResolver) notifyOnPrice(pu *TokenPriceUpdate, sub *subscriptOnPrice, id string, t uint64) {
+	sub.order.deliver(t, pu.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnPrice <- id
+			return false
+		default:
+		}
+
+		// skip the same price
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		if sub.last != nil && sub.last.ToInt().Cmp(pu.Price.ToInt()) == 0 {
+			return true
+		}
+
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnPrice <- id
+
+		case sub.events <- pu:
+			// push the price to subscriber
+			sub.last = &pu.Price
+			return true
+
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onPrice")
+			rs.unsubscribeOnPrice <- id
+		}
+		return false
+	})
+}
+
+// Sequence resolves the position of the price update in the subscription events stream.
+func (pu *TokenPriceUpdate) Sequence() *EventSequence {
+	return &EventSequence{Block: pu.BlockNumber}
+}
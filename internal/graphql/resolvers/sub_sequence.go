@@ -0,0 +1,84 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EventSequence represents the position of a subscription event in the chain.
+// Events of a subscription are delivered with strictly increasing sequence
+// so consumers can deduplicate events and detect gaps after re-subscribing.
+type EventSequence struct {
+	Block hexutil.Uint64
+	Index hexutil.Uint64
+}
+
+// after checks if the sequence follows the given one.
+func (es *EventSequence) after(o *EventSequence) bool {
+	return es.Block > o.Block || (es.Block == o.Block && es.Index > o.Index)
+}
+
+// subscriptionOrder keeps events of a single subscriber in the order they were dispatched by the resolver.
+// The resolver run loop issues a ticket for each dispatched event; the broadcast routines deliver
+// events strictly in the order of their tickets, regardless of the order they finish preparing the event.
+type subscriptionOrder struct {
+	mu     sync.Mutex
+	turn   *sync.Cond
+	issued uint64
+	next   uint64
+	last   *EventSequence
+	broken bool
+}
+
+// newSubscriptionOrder creates a new delivery order of a subscriber.
+func newSubscriptionOrder() *subscriptionOrder {
+	so := new(subscriptionOrder)
+	so.turn = sync.NewCond(&so.mu)
+	return so
+}
+
+// ticket reserves the delivery slot of a newly dispatched event.
+// It must be called from the resolver run loop to follow the dispatch order.
+func (so *subscriptionOrder) ticket() uint64 {
+	so.mu.Lock()
+	defer so.mu.Unlock()
+
+	t := so.issued
+	so.issued++
+	return t
+}
+
+// deliver waits for the turn of the given ticket and sends the event with the given sequence
+// using the send function. An event not following the last delivered sequence is dropped;
+// a failed send breaks the order and all the following events are dropped as well.
+// Events without a sequence, e.g. pending transactions, are not checked.
+func (so *subscriptionOrder) deliver(t uint64, seq *EventSequence, send func() bool) {
+	so.mu.Lock()
+	for so.next != t {
+		so.turn.Wait()
+	}
+	skip := so.broken || send == nil
+	if !skip && seq != nil && so.last != nil && !seq.after(so.last) {
+		log.Warningf("subscription event #%d/%d out of order after #%d/%d, dropped", uint64(seq.Block), uint64(seq.Index), uint64(so.last.Block), uint64(so.last.Index))
+		skip = true
+	}
+	so.mu.Unlock()
+
+	ok := skip || send()
+
+	so.mu.Lock()
+	if !skip && seq != nil {
+		so.last = seq
+	}
+	so.broken = so.broken || !ok
+	so.next++
+	so.turn.Broadcast()
+	so.mu.Unlock()
+}
+
+// skip releases the slot of the given ticket without delivering any event.
+func (so *subscriptionOrder) skip(t uint64) {
+	so.deliver(t, nil, nil)
+}
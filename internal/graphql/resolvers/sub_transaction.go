@@ -12,8 +12,10 @@ const onTrxChannelCapacity = 500
 
 // subscriptOnTrx represents reference to a subscriber to onTransaction events broadcast.
 type subscriptOnTrx struct {
+	client string
 	stop   <-chan struct{}
 	events chan<- *Transaction
+	order  *subscriptionOrder
 }
 
 // OnBlock resolves subscription to new blocks event broadcast.
@@ -23,8 +25,10 @@ func (rs *rootResolver) OnTransaction(ctx context.Context) <-chan *Transaction {
 
 	// subscribe to event dispatch
 	rs.subscribeOnTrx <- &subscriptOnTrx{
+		client: subscriptionClient(ctx),
 		stop:   ctx.Done(),
 		events: c,
+		order:  newSubscriptionOrder(),
 	}
 
 	return c
@@ -50,31 +54,36 @@ func (rs *rootResolver) dispatchOnTransaction(trx *types.Transaction) {
 
 	// broadcast the event in separate go routines so we don't block here
 	for id, sub := range rs.trxSubscribers {
-		go rs.notifyOnTransaction(transaction, sub, id)
+		go rs.notifyOnTransaction(transaction, sub, id, sub.order.ticket())
 	}
 }
 
-// notifyOnTransaction broadcasts onBlock event to given subscriber.
-func (rs *rootResolver) notifyOnTransaction(trx *Transaction, sub *subscriptOnTrx, id string) {
-	// check if the context isn't already closed in which case we just unsub and leave
-	select {
-	case <-sub.stop:
-		rs.unsubscribeOnTrx <- id
-		return
-	default:
-	}
+// notifyOnTransaction broadcasts onTransaction event to given subscriber in the order of dispatch.
+func (rs *rootResolver) notifyOnTransaction(trx *Transaction, sub *subscriptOnTrx, id string, t uint64) {
+	sub.order.deliver(t, trx.Sequence(), func() bool {
+		// check if the context isn't already closed in which case we just unsub and leave
+		select {
+		case <-sub.stop:
+			rs.unsubscribeOnTrx <- id
+			return false
+		default:
+		}
 
-	// broadcast
-	select {
-	case <-sub.stop:
-		// just unsub on broken context
-		rs.unsubscribeOnTrx <- id
+		// broadcast
+		select {
+		case <-sub.stop:
+			// just unsub on broken context
+			rs.unsubscribeOnTrx <- id
 
-	case sub.events <- trx:
-		// push the block to subscriber
+		case sub.events <- trx:
+			// push the transaction to subscriber
+			return true
 
-	case <-time.After(time.Second):
-		// timeout reached without response? just remove the subscriber
-		rs.unsubscribeOnTrx <- id
-	}
+		case <-time.After(time.Second):
+			// timeout reached without response? just remove the subscriber
+			subUsage.overrun(sub.client, "onTransaction")
+			rs.unsubscribeOnTrx <- id
+		}
+		return false
+	})
 }
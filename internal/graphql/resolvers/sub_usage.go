@@ -0,0 +1,204 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// errCodeSubscriptionLimit represents the error code of a subscription rejected over the per-key limit.
+const errCodeSubscriptionLimit = "SUBSCRIPTION_LIMIT"
+
+// subscriptionLimitError represents an error of a subscription opened over the per-key limit.
+type subscriptionLimitError struct {
+	active int
+	limit  int
+}
+
+// Error returns the human-readable description of the error.
+func (e subscriptionLimitError) Error() string {
+	return fmt.Sprintf("%d subscriptions already active, at most %d concurrent subscriptions allowed", e.active, e.limit)
+}
+
+// Extensions provides the machine-readable code and the limit of the error to GraphQL clients.
+func (e subscriptionLimitError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": errCodeSubscriptionLimit, "active": e.active, "limit": e.limit}
+}
+
+// subscriptionClientUsage represents the subscriptions usage of a single client.
+type subscriptionClientUsage struct {
+	active  int
+	window  int64
+	events  int
+	dropped uint64
+}
+
+// subscriptionUsageTracker keeps track of active subscriptions and events pushed to them per client.
+// Events are counted in fixed one minute windows. Delivery failures are kept in the diagnostics
+// of the client even after the client leaves, so they can be inspected after the fact.
+type subscriptionUsageTracker struct {
+	lock    sync.Mutex
+	clients map[string]*subscriptionClientUsage
+	diag    map[string]*subscriptionDiagnostics
+}
+
+// subUsage is the tracker of subscriptions usage shared by all the websocket connections.
+var subUsage = &subscriptionUsageTracker{
+	clients: make(map[string]*subscriptionClientUsage),
+	diag:    make(map[string]*subscriptionDiagnostics),
+}
+
+// SubscriptionUsage represents resolvable subscriptions usage of a client.
+type SubscriptionUsage struct {
+	Client    string
+	Active    int32
+	MaxActive int32
+	Events    int32
+	MaxEvents int32
+	Dropped   hexutil.Uint64
+}
+
+// subscriptionClient identifies the client of the subscription by the API key used,
+// or by the client address if the subscription is not authenticated, or uses a sandbox key.
+func subscriptionClient(ctx context.Context) string {
+	if key := ApiKeyFromContext(ctx); key != nil && !key.Sandbox {
+		return key.Name
+	}
+	return "anonymous@" + ClientAddrFromContext(ctx)
+}
+
+// AcquireSubscription registers a new subscription of the client of the context.
+// The subscription is rejected if the client would go over the concurrent subscriptions limit.
+// The returned function must be called once the subscription is closed.
+func AcquireSubscription(ctx context.Context) (func(), error) {
+	client := subscriptionClient(ctx)
+
+	subUsage.lock.Lock()
+	defer subUsage.lock.Unlock()
+
+	cu, ok := subUsage.clients[client]
+	if !ok {
+		cu = new(subscriptionClientUsage)
+		subUsage.clients[client] = cu
+	}
+	if cfg.Subscriptions.MaxPerKey > 0 && cu.active >= cfg.Subscriptions.MaxPerKey {
+		subUsage.diagnostics(client).rejected++
+		return nil, subscriptionLimitError{active: cu.active, limit: cfg.Subscriptions.MaxPerKey}
+	}
+	cu.active++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { subUsage.release(client) })
+	}, nil
+}
+
+// release removes a closed subscription of the client.
+func (t *subscriptionUsageTracker) release(client string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	cu, ok := t.clients[client]
+	if !ok {
+		return
+	}
+	cu.active--
+
+	// forget idle clients; the event window is lost, but a new subscription starts one anyway
+	if cu.active <= 0 {
+		delete(t.clients, client)
+	}
+}
+
+// AllowSubscriptionEvent registers an event pushed to a subscription of the client of the context
+// and checks it fits into the events throughput limit of the client.
+func AllowSubscriptionEvent(ctx context.Context) bool {
+	client := subscriptionClient(ctx)
+
+	subUsage.lock.Lock()
+	defer subUsage.lock.Unlock()
+
+	cu, ok := subUsage.clients[client]
+	if !ok {
+		return true
+	}
+
+	// new window resets the counter
+	now := time.Now().Unix() / 60
+	if now != cu.window {
+		cu.window = now
+		cu.events = 0
+	}
+
+	if cfg.Subscriptions.MaxEventsPerMinute > 0 && cu.events >= cfg.Subscriptions.MaxEventsPerMinute {
+		cu.dropped++
+		subUsage.dropped(client)
+		return false
+	}
+	cu.events++
+	return true
+}
+
+// totals provides the number of active subscriptions and dropped events of all the clients.
+func (t *subscriptionUsageTracker) totals() (int, uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var active int
+	var dropped uint64
+	for _, cu := range t.clients {
+		active += cu.active
+		dropped += cu.dropped
+	}
+	return active, dropped
+}
+
+// usage provides the current subscriptions usage of the given client, or of all the clients, if none given.
+func (t *subscriptionUsageTracker) usage(client *string) []*SubscriptionUsage {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now().Unix() / 60
+	list := make([]*SubscriptionUsage, 0, len(t.clients))
+	for name, cu := range t.clients {
+		if client != nil && *client != name {
+			continue
+		}
+
+		su := SubscriptionUsage{
+			Client:    name,
+			Active:    int32(cu.active),
+			MaxActive: int32(cfg.Subscriptions.MaxPerKey),
+			MaxEvents: int32(cfg.Subscriptions.MaxEventsPerMinute),
+			Dropped:   hexutil.Uint64(cu.dropped),
+		}
+		if cu.window == now {
+			su.Events = int32(cu.events)
+		}
+		list = append(list, &su)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Active > list[j].Active || (list[i].Active == list[j].Active && list[i].Client < list[j].Client)
+	})
+	return list
+}
+
+// SubscriptionUsage resolves the current websocket subscriptions usage. Admin API keys
+// see the usage of all the clients, other keys see their own usage only.
+func (rs *rootResolver) SubscriptionUsage(ctx context.Context) ([]*SubscriptionUsage, error) {
+	key, err := mustBeAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Admin {
+		return subUsage.usage(nil), nil
+	}
+	return subUsage.usage(&key.Name), nil
+}
@@ -0,0 +1,38 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SwapQuote represents resolvable best route of a token swap.
+type SwapQuote struct {
+	types.SwapQuote
+}
+
+// QuoteSwap resolves the best swap route between tokens with the encoded router call.
+func (rs *rootResolver) QuoteSwap(args *struct {
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  hexutil.Big
+	Recipient *common.Address
+	Slippage  float64
+}) (*SwapQuote, error) {
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	sq, err := repository.R().UniswapQuoteSwap(&args.TokenIn, &args.TokenOut, args.AmountIn.ToInt(), args.Recipient, args.Slippage)
+	if err != nil {
+		log.Errorf("can not quote swap of %s to %s; %s", args.TokenIn.String(), args.TokenOut.String(), err.Error())
+		return nil, err
+	}
+	if sq == nil {
+		return nil, nil
+	}
+	return &SwapQuote{SwapQuote: *sq}, nil
+}
@@ -0,0 +1,85 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// taxReportCsvHeader represents the header row of the CSV form of the tax report.
+var taxReportCsvHeader = []string{"date", "type", "validator", "transaction", "amount", "price", "value", "currency"}
+
+// TaxReport represents resolvable staking rewards tax report.
+type TaxReport struct {
+	types.TaxReport
+}
+
+// TaxReport resolves the staking rewards tax report of the delegator for the given year.
+func (rs *rootResolver) TaxReport(ctx context.Context, args struct {
+	Address  common.Address
+	Year     int32
+	Currency string
+}) (*TaxReport, error) {
+	// exports are not available to anonymous clients and sandbox keys
+	if err := mustNotBeSandbox(ctx); err != nil {
+		return nil, err
+	}
+
+	// bulk export, shed it if the node is under pressure
+	if err := shedLoad(queryClassExport); err != nil {
+		return nil, err
+	}
+
+	rep, err := repository.R().TaxReport(&args.Address, args.Year, args.Currency)
+	if err != nil {
+		log.Errorf("can not build tax report of %s; %s", args.Address.String(), err.Error())
+		return nil, err
+	}
+	return &TaxReport{*rep}, nil
+}
+
+// Csv resolves the tax report in CSV format ready for download.
+func (tr *TaxReport) Csv() (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(taxReportCsvHeader); err != nil {
+		return "", err
+	}
+
+	for _, e := range tr.Entries {
+		if err := w.Write([]string{
+			time.Unix(int64(e.TimeStamp), 0).UTC().Format(time.RFC3339),
+			e.Type,
+			e.ValidatorId.ToInt().String(),
+			e.TrxHash.String(),
+			weiToDecimal(e.Amount.ToInt()),
+			strconv.FormatFloat(e.Price, 'f', -1, 64),
+			strconv.FormatFloat(e.FiatValue, 'f', 2, 64),
+			tr.Currency,
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// weiToDecimal formats the WEI amount as an exact decimal number of native tokens.
+func weiToDecimal(val *big.Int) string {
+	whole, frac := new(big.Int).QuoRem(val, big.NewInt(1e18), new(big.Int))
+	if frac.Sign() == 0 {
+		return whole.String()
+	}
+	return strings.TrimRight(fmt.Sprintf("%s.%018s", whole.String(), frac.String()), "0")
+}
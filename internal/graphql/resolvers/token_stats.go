@@ -0,0 +1,69 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TokenStats represents a resolvable transfer activity of a token in a period.
+type TokenStats struct {
+	types.TokenStats
+	token common.Address
+}
+
+// TokenDailyStats represents a resolvable transfer activity of a token in a single day.
+type TokenDailyStats struct {
+	types.TokenDailyStats
+}
+
+// Stats resolves transfer counts, unique senders and receivers and the volume of the token in the given period.
+func (token *ERC20Token) Stats(args struct{ Period string }) (*TokenStats, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	st, err := repository.R().TokenStats(&token.Address, args.Period)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenStats{TokenStats: *st, token: token.Address}, nil
+}
+
+// Since resolves the time stamp of the beginning of the period (UTC).
+func (ts *TokenStats) Since() hexutil.Uint64 {
+	return hexutil.Uint64(ts.TokenStats.Since.Unix())
+}
+
+// Velocity resolves the volume of the period relative to the current total supply of the token.
+func (ts *TokenStats) Velocity() (float64, error) {
+	supply, err := repository.R().Erc20TotalSupply(&ts.token)
+	if err != nil {
+		return 0, err
+	}
+	if supply.ToInt().Sign() == 0 {
+		return 0, nil
+	}
+
+	v, _ := new(big.Float).Quo(new(big.Float).SetInt(ts.Volume.ToInt()), new(big.Float).SetInt(supply.ToInt())).Float64()
+	return v, nil
+}
+
+// Daily resolves the transfer activity of the token by days; days without any transfer are omitted.
+func (ts *TokenStats) Daily() []*TokenDailyStats {
+	out := make([]*TokenDailyStats, len(ts.TokenStats.Daily))
+	for i, d := range ts.TokenStats.Daily {
+		out[i] = &TokenDailyStats{*d}
+	}
+	return out
+}
+
+// Day resolves the time stamp of the beginning of the day (UTC).
+func (tds *TokenDailyStats) Day() hexutil.Uint64 {
+	return hexutil.Uint64(tds.TokenDailyStats.Day.Unix())
+}
@@ -0,0 +1,71 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// tokenSupplyMaxDays represents the max number of days of token supply changes available.
+const tokenSupplyMaxDays = 365
+
+// TokenSupplyChange represents a resolvable daily change of a token supply.
+type TokenSupplyChange struct {
+	types.TokenSupplyChange
+}
+
+// MintBurnHistory resolves the list of mints and burns of the token.
+func (token *ERC20Token) MintBurnHistory(args struct {
+	Cursor *Cursor
+	Count  int32
+}) (*ERC20TransactionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
+	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
+
+	tl, err := repository.R().TokenSupplyTransactions(&token.Address, (*string)(args.Cursor), args.Count)
+	if err != nil {
+		return nil, err
+	}
+	return NewERC20TransactionList(tl), nil
+}
+
+// SupplyChanges resolves daily amounts of the token minted and burned in the given number of recent days.
+func (token *ERC20Token) SupplyChanges(args struct{ Days int32 }) ([]*TokenSupplyChange, error) {
+	if args.Days <= 0 || args.Days > tokenSupplyMaxDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", tokenSupplyMaxDays)
+	}
+
+	list, err := repository.R().TokenSupplyChanges(&token.Address, int(args.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*TokenSupplyChange, len(list))
+	for i, ch := range list {
+		out[i] = &TokenSupplyChange{*ch}
+	}
+	return out, nil
+}
+
+// Day resolves the time stamp of the beginning of the day (UTC).
+func (tsc *TokenSupplyChange) Day() hexutil.Uint64 {
+	return hexutil.Uint64(tsc.TokenSupplyChange.Day.Unix())
+}
+
+// Net resolves the net change of the token supply in the day; negative if more tokens were burned.
+func (tsc *TokenSupplyChange) Net() hexutil.Big {
+	return hexutil.Big(*new(big.Int).Sub(tsc.Minted.ToInt(), tsc.Burned.ToInt()))
+}
@@ -38,6 +38,10 @@ func (rs *rootResolver) Transaction(args *struct{ Hash common.Hash }) (*Transact
 
 // SendTransaction sends raw signed and RLP encoded transaction to the block chain.
 func (rs *rootResolver) SendTransaction(args *struct{ Tx hexutil.Bytes }) (*Transaction, error) {
+	if err := mustNotBeInMaintenance(); err != nil {
+		return nil, err
+	}
+
 	// get the transaction from repository
 	trx, err := repository.R().SendTransaction(args.Tx)
 	if err != nil {
@@ -48,6 +52,22 @@ func (rs *rootResolver) SendTransaction(args *struct{ Tx hexutil.Bytes }) (*Tran
 	return NewTransaction(trx), nil
 }
 
+// Sequence resolves the position of the transaction in the subscription events stream;
+// pending transactions don't have any.
+func (trx *Transaction) Sequence() *EventSequence {
+	if trx.BlockNumber == nil {
+		return nil
+	}
+
+	seq := EventSequence{Block: *trx.BlockNumber}
+	if trx.TrxIndex != nil {
+		seq.Index = hexutil.Uint64(*trx.TrxIndex)
+	} else if trx.Index != nil {
+		seq.Index = *trx.Index
+	}
+	return &seq
+}
+
 // Sender resolves sender's account of the transaction.
 func (trx *Transaction) Sender() (*Account, error) {
 	// get the sender by address
@@ -91,6 +111,14 @@ func (trx *Transaction) Block() (*Block, error) {
 	return NewBlock(blk), nil
 }
 
+// IsFinalized resolves the finality of the block the transaction is bundled in.
+func (trx *Transaction) IsFinalized() bool {
+	if trx.BlockNumber == nil {
+		return false
+	}
+	return repository.R().IsBlockFinalized(uint64(*trx.BlockNumber))
+}
+
 // tokenTransactions loads list of all token transaction related to this transaction call.
 func (trx *Transaction) tokenTransactions() ([]*types.TokenTransaction, error) {
 	// call for it only once
@@ -174,3 +202,34 @@ func (trx *Transaction) Erc1155Transactions() ([]*ERC1155Transaction, error) {
 	}
 	return list, nil
 }
+
+// DecodedInput resolves the transaction input decoded by the ABI of the recipient contract.
+func (trx *Transaction) DecodedInput() (*DecodedCall, error) {
+	// contract deployments and plain transfers have nothing to decode
+	if trx.To == nil || len(trx.InputData) < 4 {
+		return nil, nil
+	}
+
+	dc, err := repository.R().DecodeCall(trx.To, trx.InputData)
+	if err != nil || dc == nil {
+		return nil, err
+	}
+	return &DecodedCall{*dc}, nil
+}
+
+// DecodedLogs resolves the event log records of the transaction decoded by the ABI
+// of the emitting contracts. Records of contracts with unknown ABI are skipped.
+func (trx *Transaction) DecodedLogs() ([]*DecodedLog, error) {
+	list := make([]*DecodedLog, 0)
+	for i := range trx.Logs {
+		dl, err := repository.R().DecodeLog(&trx.Logs[i])
+		if err != nil {
+			log.Debugf("can not decode log #%d of %s; %s", i, trx.Hash.String(), err.Error())
+			continue
+		}
+		if dl != nil {
+			list = append(list, &DecodedLog{*dl})
+		}
+	}
+	return list, nil
+}
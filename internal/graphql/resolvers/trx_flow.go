@@ -24,6 +24,11 @@ func (rs *rootResolver) TrxVolume(args struct {
 	From *string
 	To   *string
 }) ([]*DailyTrxVolume, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// get the date range
 	from, to, err := trxVolumeRange(args)
 	if err != nil {
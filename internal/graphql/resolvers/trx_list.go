@@ -29,15 +29,31 @@ func NewTransactionList(txs *types.TransactionList) *TransactionList {
 
 // Transactions resolves list of blockchain transactions encapsulated in a listable structure.
 func (rs *rootResolver) Transactions(args *struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor    *Cursor
+	Count     int32
+	FromBlock *hexutil.Uint64
+	ToBlock   *hexutil.Uint64
 }) (*TransactionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
-	// get the transaction hash list from repository
-	txs, err := repository.R().Transactions((*string)(args.Cursor), args.Count)
+	// get the transaction list from repository, limited to the block range if requested
+	var txs *types.TransactionList
+	var err error
+	if args.FromBlock != nil || args.ToBlock != nil {
+		txs, err = repository.R().TransactionsInBlockRange(args.FromBlock, args.ToBlock, (*string)(args.Cursor), args.Count)
+	} else {
+		txs, err = repository.R().Transactions((*string)(args.Cursor), args.Count)
+	}
 	if err != nil {
 		log.Errorf("can not get transactions list; %s", err.Error())
 		return nil, err
@@ -59,8 +75,8 @@ func (tl *TransactionList) PageInfo() (*ListPageInfo, error) {
 	}
 
 	// get the first and last elements
-	first := Cursor(tl.Collection[0].Hash.String())
-	last := Cursor(tl.Collection[len(tl.Collection)-1].Hash.String())
+	first := Cursor(types.TransactionCursor(tl.Collection[0]))
+	last := Cursor(types.TransactionCursor(tl.Collection[len(tl.Collection)-1]))
 	return NewListPageInfo(&first, &last, !tl.IsEnd, !tl.IsStart)
 }
 
@@ -77,7 +93,7 @@ func (tl *TransactionList) Edges() []*TransactionListEdge {
 		// make the element
 		edges[i] = &TransactionListEdge{
 			Transaction: NewTransaction(t),
-			Cursor:      Cursor(t.Hash.String()),
+			Cursor:      Cursor(types.TransactionCursor(t)),
 		}
 	}
 	return edges
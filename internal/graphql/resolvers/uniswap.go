@@ -4,6 +4,7 @@ package resolvers
 import (
 	"axis-graphql/internal/repository"
 	"axis-graphql/internal/types"
+	"context"
 	"fmt"
 	"time"
 
@@ -380,8 +381,8 @@ func (up *UniswapPair) TotalSupply() (hexutil.Big, error) {
 }
 
 // ShareOf resolves the total amount of a share of the given user on the given Uniswap pair.
-func (up *UniswapPair) ShareOf(args *struct{ User common.Address }) (hexutil.Big, error) {
-	return repository.R().Erc20BalanceOf(&up.PairAddress, &args.User)
+func (up *UniswapPair) ShareOf(ctx context.Context, args *struct{ User common.Address }) (hexutil.Big, error) {
+	return repository.R().Erc20BalanceOf(&up.PairAddress, &args.User, pinnedBlock(ctx))
 }
 
 // LastKValue resolves the last value of the pool control coefficient.
@@ -48,8 +48,16 @@ func (rs *rootResolver) DefiUniswapActions(args *struct {
 	PairAddress *common.Address
 	ActionType  *int32
 }) (*UniswapActionList, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
+	if err := checkListSize(args.Count, listMaxEdgesPerRequest); err != nil {
+		return nil, err
+	}
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 	if args.ActionType == nil {
 		var t int32 = -1
@@ -0,0 +1,24 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// upcomingEventsMaxAddresses represents the max number of addresses watched in one query.
+const upcomingEventsMaxAddresses = 20
+
+// UpcomingEvents resolves the known future time-based events of the given addresses.
+func (rs *rootResolver) UpcomingEvents(args *struct{ Addresses []common.Address }) ([]*types.CalendarEvent, error) {
+	if len(args.Addresses) > upcomingEventsMaxAddresses {
+		return nil, fmt.Errorf("too many addresses, max %d allowed", upcomingEventsMaxAddresses)
+	}
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+	return repository.R().UpcomingEvents(args.Addresses)
+}
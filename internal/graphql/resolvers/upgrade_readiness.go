@@ -0,0 +1,57 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+)
+
+// UpgradeReadiness represents resolvable distribution of the active stake on validator node versions.
+type UpgradeReadiness struct {
+	types.UpgradeReadiness
+}
+
+// NodeVersionStake represents resolvable stake of validators running a node version.
+type NodeVersionStake struct {
+	types.NodeVersionStake
+	total *big.Int
+}
+
+// UpgradeReadiness resolves the share of the active stake on each validator node version.
+func (rs *rootResolver) UpgradeReadiness(args struct{ RequiredVersion *string }) (*UpgradeReadiness, error) {
+	ur, err := repository.R().UpgradeReadiness(args.RequiredVersion)
+	if err != nil {
+		log.Errorf("can not get upgrade readiness; %s", err.Error())
+		return nil, err
+	}
+	return &UpgradeReadiness{UpgradeReadiness: *ur}, nil
+}
+
+// ReadyShare resolves the share of the stake running the required version on the total stake.
+func (ur *UpgradeReadiness) ReadyShare() float64 {
+	return stakeShare(ur.ReadyStake.ToInt(), ur.TotalStake.ToInt())
+}
+
+// Versions resolves the list of node versions with their stake.
+func (ur *UpgradeReadiness) Versions() []*NodeVersionStake {
+	list := make([]*NodeVersionStake, len(ur.UpgradeReadiness.Versions))
+	for i, nvs := range ur.UpgradeReadiness.Versions {
+		list[i] = &NodeVersionStake{NodeVersionStake: *nvs, total: ur.TotalStake.ToInt()}
+	}
+	return list
+}
+
+// Share resolves the share of the version stake on the total stake.
+func (nvs *NodeVersionStake) Share() float64 {
+	return stakeShare(nvs.Stake.ToInt(), nvs.total)
+}
+
+// stakeShare calculates the share of the given amount on the total stake.
+func stakeShare(val *big.Int, total *big.Int) float64 {
+	if total.Sign() == 0 {
+		return 0
+	}
+	s, _ := new(big.Float).Quo(new(big.Float).SetInt(val), new(big.Float).SetInt(total)).Float64()
+	return s
+}
@@ -0,0 +1,44 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+)
+
+// ValidatorChange represents a resolvable management change of a validator.
+type ValidatorChange struct {
+	types.ValidatorChange
+}
+
+// Changes resolves the most recent management changes of the staker, the newest first.
+func (st Staker) Changes(args struct{ Count int32 }) ([]*ValidatorChange, error) {
+	// the list is always loaded from the newest change
+	if err := checkListSize(args.Count, accMaxTransactionsPerRequest); err != nil {
+		return nil, err
+	}
+	if args.Count <= 0 || args.Count > accMaxTransactionsPerRequest {
+		args.Count = accMaxTransactionsPerRequest
+	}
+
+	list, err := repository.R().ValidatorChanges(&st.Id, args.Count)
+	if err != nil {
+		log.Errorf("can not get changes of validator #%d; %s", st.Id.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+
+	res := make([]*ValidatorChange, len(list))
+	for i, vc := range list {
+		res[i] = &ValidatorChange{ValidatorChange: *vc}
+	}
+	return res, nil
+}
+
+// Transaction resolves the transaction executing the change.
+func (vc *ValidatorChange) Transaction() (*Transaction, error) {
+	tx, err := repository.R().Transaction(&vc.TrxHash)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransaction(tx), nil
+}
@@ -0,0 +1,121 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// validatorEarningsDefaultEpochs represents the number of epochs reported if the range is not specified.
+const validatorEarningsDefaultEpochs = 30
+
+// ValidatorEarnings represents resolvable validator earnings report.
+type ValidatorEarnings struct {
+	types.ValidatorEarnings
+}
+
+// ValidatorEarningsPeriod represents resolvable validator earnings in a period.
+type ValidatorEarningsPeriod struct {
+	types.ValidatorEarningsPeriod
+}
+
+// ValidatorEarnings resolves earnings of the given validator in the given range of sealed epochs.
+func (rs *rootResolver) ValidatorEarnings(args *struct {
+	Id         hexutil.Big
+	From       *hexutil.Uint64
+	To         *hexutil.Uint64
+	Resolution string
+}) (*ValidatorEarnings, error) {
+	// low priority query, shed it if the node is under pressure
+	if err := shedLoad(queryClassHeavyList); err != nil {
+		return nil, err
+	}
+
+	// check the resolution
+	if args.Resolution != types.ValidatorEarningsResolutionEpoch && args.Resolution != types.ValidatorEarningsResolutionDay {
+		return nil, fmt.Errorf("unknown resolution %s", args.Resolution)
+	}
+
+	// get the epoch range; the latest sealed epochs by default
+	to := args.To
+	if to == nil {
+		ep, err := repository.R().CurrentSealedEpoch()
+		if err != nil {
+			return nil, err
+		}
+		to = &ep.Id
+	}
+	from := args.From
+	if from == nil {
+		val := hexutil.Uint64(1)
+		if *to > validatorEarningsDefaultEpochs {
+			val = *to - validatorEarningsDefaultEpochs + 1
+		}
+		from = &val
+	}
+
+	ve, err := repository.R().ValidatorEarnings(&args.Id, *from, *to, args.Resolution)
+	if err != nil {
+		log.Errorf("can not get earnings of validator #%d; %s", args.Id.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+	return &ValidatorEarnings{*ve}, nil
+}
+
+// SelfStake resolves the self-stake of the validator.
+func (ve *ValidatorEarnings) SelfStake() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEarnings.SelfStake)
+}
+
+// SelfStakeRewards resolves the total amount of rewards of the self-stake.
+func (ve *ValidatorEarnings) SelfStakeRewards() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEarnings.SelfStakeRewards)
+}
+
+// Commission resolves the total amount of commission earned from delegators.
+func (ve *ValidatorEarnings) Commission() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEarnings.Commission)
+}
+
+// OriginatedFee resolves the total amount of fees originated by the validator.
+func (ve *ValidatorEarnings) OriginatedFee() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEarnings.OriginatedFee)
+}
+
+// Total resolves the total earnings of the validator.
+func (ve *ValidatorEarnings) Total() hexutil.Big {
+	return hexutil.Big(*new(big.Int).Add(ve.ValidatorEarnings.SelfStakeRewards, ve.ValidatorEarnings.Commission))
+}
+
+// Periods resolves the list of periods of the report.
+func (ve *ValidatorEarnings) Periods() []*ValidatorEarningsPeriod {
+	list := make([]*ValidatorEarningsPeriod, len(ve.ValidatorEarnings.Periods))
+	for i, p := range ve.ValidatorEarnings.Periods {
+		list[i] = &ValidatorEarningsPeriod{*p}
+	}
+	return list
+}
+
+// SelfStakeRewards resolves the amount of rewards of the self-stake in the period.
+func (vep *ValidatorEarningsPeriod) SelfStakeRewards() hexutil.Big {
+	return hexutil.Big(*vep.ValidatorEarningsPeriod.SelfStakeRewards)
+}
+
+// Commission resolves the amount of commission earned from delegators in the period.
+func (vep *ValidatorEarningsPeriod) Commission() hexutil.Big {
+	return hexutil.Big(*vep.ValidatorEarningsPeriod.Commission)
+}
+
+// OriginatedFee resolves the amount of fees originated by the validator in the period.
+func (vep *ValidatorEarningsPeriod) OriginatedFee() hexutil.Big {
+	return hexutil.Big(*vep.ValidatorEarningsPeriod.OriginatedFee)
+}
+
+// Total resolves the total earnings of the validator in the period.
+func (vep *ValidatorEarningsPeriod) Total() hexutil.Big {
+	return hexutil.Big(*new(big.Int).Add(vep.ValidatorEarningsPeriod.SelfStakeRewards, vep.ValidatorEarningsPeriod.Commission))
+}
@@ -0,0 +1,105 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidatorEstimate represents resolvable projected income of a prospective validator.
+type ValidatorEstimate struct {
+	types.ValidatorEstimate
+}
+
+// CreateValidatorEstimate resolves the projected income of a prospective validator
+// with the given self-stake and the expected amount of received delegations.
+func (rs *rootResolver) CreateValidatorEstimate(args *struct {
+	SelfStake          hexutil.Big
+	ExpectedDelegation hexutil.Big
+}) (*ValidatorEstimate, error) {
+	ve, err := repository.R().ValidatorEstimate(args.SelfStake.ToInt(), args.ExpectedDelegation.ToInt())
+	if err != nil {
+		log.Errorf("can not estimate validator income; %s", err.Error())
+		return nil, err
+	}
+	return &ValidatorEstimate{*ve}, nil
+}
+
+// SelfStake resolves the self-stake of the estimate.
+func (ve *ValidatorEstimate) SelfStake() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.SelfStake)
+}
+
+// ExpectedDelegation resolves the expected amount of received delegations.
+func (ve *ValidatorEstimate) ExpectedDelegation() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.ExpectedDelegation)
+}
+
+// MinSelfStake resolves the minimal self-stake required to launch a validator.
+func (ve *ValidatorEstimate) MinSelfStake() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.MinSelfStake)
+}
+
+// MeetsMinSelfStake resolves if the self-stake is enough to launch a validator.
+func (ve *ValidatorEstimate) MeetsMinSelfStake() bool {
+	return ve.ValidatorEstimate.SelfStake.Cmp(ve.ValidatorEstimate.MinSelfStake) >= 0
+}
+
+// DelegationLimit resolves the max total stake of the validator allowed by the self-stake.
+func (ve *ValidatorEstimate) DelegationLimit() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.DelegationLimit)
+}
+
+// EffectiveDelegation resolves the expected delegation capped by the delegation limit.
+func (ve *ValidatorEstimate) EffectiveDelegation() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.EffectiveDelegation)
+}
+
+// SaturationHeadroom resolves the amount of delegations the validator could still receive
+// on top of the expected delegation before reaching the delegation limit.
+func (ve *ValidatorEstimate) SaturationHeadroom() hexutil.Big {
+	val := new(big.Int).Sub(ve.ValidatorEstimate.DelegationLimit, ve.ValidatorEstimate.SelfStake)
+	val.Sub(val, ve.ValidatorEstimate.ExpectedDelegation)
+	if val.Sign() < 0 {
+		return hexutil.Big{}
+	}
+	return hexutil.Big(*val)
+}
+
+// Saturated resolves if the expected delegation exceeds the delegation limit.
+func (ve *ValidatorEstimate) Saturated() bool {
+	return ve.ValidatorEstimate.EffectiveDelegation.Cmp(ve.ValidatorEstimate.ExpectedDelegation) < 0
+}
+
+// CommissionRate resolves the share of delegator rewards taken by the validator.
+func (ve *ValidatorEstimate) CommissionRate() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.CommissionRate)
+}
+
+// SelfStakeRewards resolves the projected epoch rewards of the self-stake.
+func (ve *ValidatorEstimate) SelfStakeRewards() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.SelfStakeRewards)
+}
+
+// Commission resolves the projected epoch commission earned from delegators.
+func (ve *ValidatorEstimate) Commission() hexutil.Big {
+	return hexutil.Big(*ve.ValidatorEstimate.Commission)
+}
+
+// EpochIncome resolves the projected total income of the validator in an epoch.
+func (ve *ValidatorEstimate) EpochIncome() hexutil.Big {
+	return hexutil.Big(*new(big.Int).Add(ve.ValidatorEstimate.SelfStakeRewards, ve.ValidatorEstimate.Commission))
+}
+
+// DailyIncome resolves the projected total income of the validator per day.
+func (ve *ValidatorEstimate) DailyIncome() hexutil.Big {
+	if ve.ValidatorEstimate.EpochDuration == 0 {
+		return hexutil.Big{}
+	}
+	val := new(big.Int).Add(ve.ValidatorEstimate.SelfStakeRewards, ve.ValidatorEstimate.Commission)
+	val.Mul(val, new(big.Int).SetUint64(erwSecondsInDay))
+	return hexutil.Big(*val.Div(val, new(big.Int).SetUint64(uint64(ve.ValidatorEstimate.EpochDuration))))
+}
@@ -0,0 +1,37 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+)
+
+// ValidatorExit represents resolvable exit status of a validator.
+type ValidatorExit struct {
+	types.ValidatorExit
+}
+
+// Exit resolves the exit status of the staker and the timeline of pending un-delegations against it.
+func (st Staker) Exit() (*ValidatorExit, error) {
+	ve, err := repository.R().ValidatorExit(&st.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorExit{*ve}, nil
+}
+
+// Reasons resolves the reasons of the validator deactivation decoded from the SFC status bits.
+func (ve *ValidatorExit) Reasons() []string {
+	list := make([]string, 0)
+	status := uint64(ve.Status)
+	if status&sfcStatusWithdrawn != 0 {
+		list = append(list, "WITHDRAWN")
+	}
+	if status&sfcStatusOffline != 0 {
+		list = append(list, "OFFLINE")
+	}
+	if status&sfcStatusDoubleSign != 0 {
+		list = append(list, "DOUBLE_SIGN")
+	}
+	return list
+}
@@ -0,0 +1,76 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/svc"
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// webhookDeliveriesMaxCount represents the max number of webhook deliveries resolved at once.
+const webhookDeliveriesMaxCount = 200
+
+// WebhookDelivery represents resolvable webhook delivery log record.
+type WebhookDelivery struct {
+	types.WebhookDelivery
+}
+
+// WebhookDeliveries resolves the most recent webhook delivery log records.
+func (rs *rootResolver) WebhookDeliveries(ctx context.Context, args struct {
+	Status *string
+	Count  int32
+}) ([]*WebhookDelivery, error) {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// check the input
+	if args.Count <= 0 || args.Count > webhookDeliveriesMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", webhookDeliveriesMaxCount)
+	}
+
+	list, err := repository.R().WebhookDeliveries(args.Status, args.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*WebhookDelivery, len(list))
+	for i, wd := range list {
+		res[i] = &WebhookDelivery{*wd}
+	}
+	return res, nil
+}
+
+// Id resolves the identifier of the delivery.
+func (wd *WebhookDelivery) Id() string {
+	return wd.ID
+}
+
+// Created resolves the UNIX time stamp of the notification creation.
+func (wd *WebhookDelivery) Created() hexutil.Uint64 {
+	return hexutil.Uint64(wd.WebhookDelivery.Created.Unix())
+}
+
+// Updated resolves the UNIX time stamp of the last delivery attempt.
+func (wd *WebhookDelivery) Updated() hexutil.Uint64 {
+	return hexutil.Uint64(wd.WebhookDelivery.Updated.Unix())
+}
+
+// NextAttempt resolves the UNIX time stamp of the scheduled delivery retry, if any.
+func (wd *WebhookDelivery) NextAttempt() *hexutil.Uint64 {
+	if wd.WebhookDelivery.NextAttempt == nil {
+		return nil
+	}
+	ts := hexutil.Uint64(wd.WebhookDelivery.NextAttempt.Unix())
+	return &ts
+}
+
+// validateWebhookURL checks if the given URL can be registered as an alert webhook.
+// Webhooks must not target loopback, private, link-local, or metadata addresses.
+func validateWebhookURL(raw string) error {
+	return svc.ValidateWebhookURL(raw)
+}
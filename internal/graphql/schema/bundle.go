@@ -92,8 +92,13 @@ type DefiToken {
 
     # price represents the value of the token in ref. denomination.
     # We use fUSD tokens as the synth reference value.
+    # If the price oracle lacks the price, the time weighted average price
+    # of an AMM pair is used, see priceSource.
     price: BigInt!
 
+    # priceSource represents the provenance of the price value.
+    priceSource: DefiPriceSource!
+
     # priceDecimals is the number of decimals used on the price
     # field to properly handle value calculations without loosing precision.
     priceDecimals: Int!
@@ -128,6 +133,19 @@ enum DefiTokenBalanceType {
     DEBT
 }
 
+# DefiPriceSource represents the source of a DeFi token price.
+enum DefiPriceSource {
+    # ORACLE is the price provided by the on-chain price oracle.
+    ORACLE
+
+    # AMM_TWAP is the time weighted average price calculated
+    # from the indexed reserves of the deepest sufficiently liquid AMM pair.
+    AMM_TWAP
+
+    # NONE signals the price is not available from any source.
+    NONE
+}
+
 # Erc20TransactionType represents a type of transaction.
 enum Erc20TransactionType {
     TRANSFER
@@ -291,8 +309,15 @@ type StakerInfo {
 
     "Contact represents a link to contact to the staker."
     contact: String
+
+    "NodeVersion represents the client version the staker publishes for its validator node."
+    nodeVersion: String
 }
 # ListPageInfo contains information about a sequential access list page.
+# It's shared by all the cursor based lists of the API. To get the next page,
+# pass the <last> cursor with positive count, to get the previous page,
+# pass the <first> cursor with negative count. The edge identified
+# by the cursor is not included in the requested page.
 type ListPageInfo {
     # First is the cursor of the first edge of the edges list. null for empty list.
     first: Cursor
@@ -356,6 +381,16 @@ type Transaction {
     # is a contract address.
     inputData: Bytes!
 
+    # decodedInput is the input data decoded by the ABI of the recipient contract.
+    # The ABI of a validated contract source, or a custom ABI registered
+    # by the registerAbi mutation, is used. Null if the ABI is not known,
+    # or the called method is not recognized.
+    decodedInput: DecodedCall
+
+    # decodedLogs is the list of event log records of the transaction decoded by the ABI
+    # of the emitting contracts. Records of contracts with unknown ABI are not included.
+    decodedLogs: [DecodedLog!]!
+
     # BlockHash is the hash of the block this transaction was assigned to.
     # Null if the transaction is pending.
     blockHash: Bytes32
@@ -368,6 +403,10 @@ type Transaction {
     # the transaction is pending.
     block: Block
 
+    # isFinalized signals the block of the transaction is final;
+    # pending transactions are not final.
+    isFinalized: Boolean!
+
     # Status is the return status of the transaction. This will be 1 if the
     # transaction succeeded, or 0 if it failed (due to a revert, or due to
     # running out of gas). If the transaction has not yet been processed, this
@@ -389,6 +428,10 @@ type Transaction {
     # erc1155Transactions provides list of ERC-1155 NFT transactions executed in the scope
     # of this blockchain transaction call.
     erc1155Transactions: [ERC1155Transaction!]!
+
+    # sequence is the position of the transaction in subscription event streams.
+    # This will be null if the transaction is in a pending pool.
+    sequence: EventSequence
 }
 
 # Block is an Opera block chain block.
@@ -402,6 +445,9 @@ type Block {
     # Parent is the parent block of this block.
     parent: Block
 
+    # Miner is the producer of block
+    miner: Address!
+
     # TransactionCount is the number of transactions in this block.
     transactionCount: Int
 
@@ -420,6 +466,12 @@ type Block {
 
     # txList is a list of transactions assigned to the block.
     txList: [Transaction!]!
+
+    # isFinalized signals the block is final and will not be reverted.
+    isFinalized: Boolean!
+
+    # sequence is the position of the block in subscription event streams.
+    sequence: EventSequence!
 }
 
 # ERC721Contract represents a generic ERC721 non-fungible tokens (NFT) contract.
@@ -520,6 +572,99 @@ type ERC20Token {
 
     # totalDebt represents total amount of borrowed/minted tokens on fMint.
     totalDebt: BigInt!
+
+    # mintBurnHistory represents the list of mints and burns of the token.
+    # Mints are transfers from the zero address, burns are transfers
+    # into the zero address or the conventional 0x...dEaD burn address.
+    mintBurnHistory(cursor: Cursor, count: Int = 25): ERC20TransactionList!
+
+    # supplyChanges represents daily amounts of the token minted and burned
+    # in the given number of recent days, up to 365. Days without any change are omitted.
+    supplyChanges(days: Int = 30): [TokenSupplyChange!]!
+
+    # stats represents transfer counts, unique senders and receivers and the volume
+    # of the token in the given period; "day", "week", "month" or "year".
+    # Mints and burns are not counted as transfers.
+    stats(period: String = "month"): TokenStats!
+}
+
+# TokenSupplyChange represents the change of a token supply in a single day.
+type TokenSupplyChange {
+    # day is the time stamp of the beginning of the day (UTC).
+    day: Long!
+
+    # minted is the amount of tokens minted in the day.
+    minted: BigInt!
+
+    # burned is the amount of tokens burned in the day.
+    burned: BigInt!
+
+    # net is the net change of the supply; negative if more tokens were burned than minted.
+    net: BigInt!
+
+    # mints is the number of mint transactions in the day.
+    mints: Int!
+
+    # burns is the number of burn transactions in the day.
+    burns: Int!
+}
+
+# TokenStats represents the transfer activity of a token in a period of recent days.
+type TokenStats {
+    # period is the name of the period covered.
+    period: String!
+
+    # since is the time stamp of the beginning of the period (UTC).
+    since: Long!
+
+    # transfers is the number of transfers in the period.
+    transfers: Int!
+
+    # senders is the number of unique addresses sending the token in the period.
+    senders: Int!
+
+    # receivers is the number of unique addresses receiving the token in the period.
+    receivers: Int!
+
+    # volume is the total amount of tokens transferred in the period.
+    volume: BigInt!
+
+    # velocity is the volume of the period relative to the current total supply of the token.
+    velocity: Float!
+
+    # daily is the transfer activity by days; days without any transfer are omitted.
+    daily: [TokenDailyStats!]!
+}
+
+# TokenDailyStats represents the transfer activity of a token in a single day.
+type TokenDailyStats {
+    # day is the time stamp of the beginning of the day (UTC).
+    day: Long!
+
+    # transfers is the number of transfers in the day.
+    transfers: Int!
+
+    # senders is the number of unique addresses sending the token in the day.
+    senders: Int!
+
+    # receivers is the number of unique addresses receiving the token in the day.
+    receivers: Int!
+
+    # volume is the total amount of tokens transferred in the day.
+    volume: BigInt!
+}
+
+# ERC20Balance represents the balance of an ERC20 token of an owner.
+type ERC20Balance {
+    # address is the address of the token contract.
+    address: Address!
+
+    # token represents the details of the token; null if the address is not an ERC20 token.
+    token: ERC20Token
+
+    # balance is the amount of tokens owned; null if not available,
+    # i.e. the address does not implement the ERC20 interface.
+    balance: BigInt
 }
 
 # DelegationList is a list of delegations edges provided by sequential access request.
@@ -546,6 +691,23 @@ type DelegationListEdge {
     delegation: Delegation!
 }
 
+# DelegationOrderBy represents the ordering of a list of delegations.
+# The highest amounts and rewards and the most recent delegations go first.
+enum DelegationOrderBy {
+    CREATED_TIME
+    AMOUNT
+    PENDING_REWARDS
+}
+
+# DelegationFilter represents a filter narrowing a list of delegations.
+input DelegationFilter {
+    "Include only delegations with locked stake."
+    lockedOnly: Boolean = false
+
+    "Include only delegations with non-zero active amount."
+    activeOnly: Boolean = false
+}
+
 # Delegation represents a delegation on Opera block chain.
 type Delegation {
     # Address of the delegator account.
@@ -576,6 +738,14 @@ type Delegation {
     # Pending rewards for the delegation in WEI.
     pendingRewards: PendingRewards!
 
+    # Amount of rewards stashed in the SFC contract for the delegation in WEI.
+    # Stashed rewards were already calculated by the contract, but not claimed yet.
+    stashedRewards: BigInt!
+
+    # Split of the claimed rewards between the rewards paid
+    # to the delegator account and the rewards re-staked into the delegation.
+    rewardSplit: RewardSplit!
+
     # List of withdraw requests of the delegation,
     # sorted fro the newest to the oldest requests.
     withdrawRequests(cursor: Cursor, count: Int = 25): [WithdrawRequest!]!
@@ -606,6 +776,18 @@ type Delegation {
     # is lower than demanded amount to undelegate.
     lockedAmount: BigInt!
 
+    # isLockExpired indicates the lock of the delegation ended, but the stake
+    # is still marked as locked. Such a stake can be re-locked, or unlocked without penalty.
+    isLockExpired: Boolean!
+
+    # isWithdrawableNow indicates the delegation has a pending withdraw request
+    # past the withdrawal period, which can be withdrawn right now.
+    isWithdrawableNow: Boolean!
+
+    # isDust indicates the active amount of the delegation is positive,
+    # but below the dust threshold configured on the server.
+    isDust: Boolean!
+
     # unlockedAmount represents the amount
     # of delegation stake available for undelegate.
     unlockedAmount: BigInt!
@@ -624,6 +806,34 @@ type Delegation {
     tokenizerAllowedToWithdraw: Boolean!
 }
 
+# RewardSplit represents the cumulative split of claimed rewards of a delegation.
+type RewardSplit {
+    # Total amount of rewards claimed to the delegator account in WEI.
+    claimed: BigInt!
+
+    # Total amount of rewards re-staked into the delegation in WEI.
+    restaked: BigInt!
+}
+
+# DelegationAction represents an action recommended to a delegator on one of its delegations.
+type DelegationAction {
+    # action is the type of the recommended action, one of CLAIM, WITHDRAW,
+    # RELOCK and UNDELEGATE_DUST.
+    action: String!
+
+    # validatorId is the identifier of the validator the delegation belongs to.
+    validatorId: BigInt!
+
+    # amount is the amount of tokens involved in the action in WEI.
+    amount: BigInt!
+
+    # withdrawRequestId is the identifier of the withdraw request to be finalized; WITHDRAW only.
+    withdrawRequestId: BigInt
+
+    # reason is the human readable explanation of the recommendation.
+    reason: String!
+}
+
 # PendingRewards represents a detail of pending rewards for staking and delegations
 type PendingRewards {
     # address of the delegation the reward belongs to.
@@ -739,6 +949,32 @@ type Contract {
 
     "Timestamp is the unix timestamp at which this smart contract was deployed."
     timestamp: Long!
+
+    "IsProxy signals if the contract is an EIP-1967 proxy."
+    isProxy: Boolean!
+
+    "Implementation is the current implementation address of an EIP-1967 proxy. Null if not a proxy."
+    implementation: Address
+
+    """
+    UpgradeHistory is the list of the most recent upgrades of the proxy contract,
+    the newest first.
+    """
+    upgradeHistory(count: Int = 25): [ContractUpgrade!]!
+
+    """
+    MethodStats is the usage statistics of the contract methods called
+    in the given period, one of "day", "week" and "month"; the most used
+    method goes first. Calls without a method selector are not included.
+    """
+    methodStats(period: String = "week"): [ContractMethodStat!]!
+
+    """
+    Enrichments is the list of off-chain data tags of the contract
+    provided by enrichers registered on the API server. Tags are collected
+    in background, so they may not be available on the first request.
+    """
+    enrichments: [EnrichmentTag!]!
 }
 
 # ContractValidationInput represents a set of data sent from client
@@ -775,6 +1011,30 @@ input ContractValidationInput {
     sourceCode: String!
 }
 
+"ContractMethodStat represents usage statistics of a contract method in a time period."
+type ContractMethodStat {
+    "Selector is the hex encoded 4 bytes method selector of the call data."
+    selector: String!
+
+    "Name is the method signature resolved from the contract ABI, if known."
+    name: String
+
+    "Calls is the number of calls of the method."
+    calls: Long!
+
+    "Failed is the number of failed calls of the method."
+    failed: Long!
+
+    "GasUsed is the total amount of gas used by the calls."
+    gasUsed: Long!
+
+    "AvgDataSize is the average size of the call data in bytes."
+    avgDataSize: Float!
+
+    "MaxDataSize is the max size of the call data in bytes."
+    maxDataSize: Int!
+}
+
 # ContractList is a list of smart contract edges provided by sequential access request.
 type ContractList {
     # Edges contains provided edges of the sequential list.
@@ -829,6 +1089,11 @@ scalar Long
 scalar Bytes
 
 # Cursor is a string representing position in a sequential list of edges.
+# Cursors are opaque to clients and should be passed back unmodified.
+# A cursor identifies the position of an edge, not its offset from the list top,
+# so paging from a cursor is stable when new edges are added to the list.
+# Transaction cursors are composed of the block number and the index
+# of the transaction in the block, i.e. "4567890:3".
 scalar Cursor
 
 # CurrentState represents the current active state
@@ -993,7 +1258,7 @@ type Staker {
     # List of delegations of this staker. Cursor is used to obtain specific slice
     # of the staker's delegations. The most recent delegations
     # are provided if cursor is omitted.
-    delegations(cursor: Cursor, count: Int = 25):DelegationList!
+    delegations(cursor: Cursor, count: Int = 25, orderBy: DelegationOrderBy = CREATED_TIME, filter: DelegationFilter):DelegationList!
 
     # Status is a binary encoded status of the staker.
     # Ok = 0, bin 1 = Fork Detected, bin 256 = Validator Offline
@@ -1001,6 +1266,14 @@ type Staker {
 
     # StakerInfo represents extended staker information from smart contract.
     stakerInfo: StakerInfo
+
+    # List of the most recent management changes of the staker, the newest first.
+    # Delegators can audit changes of the staker address, status and metadata here.
+    changes(count: Int = 25): [ValidatorChange!]!
+
+    # exit represents the deactivation status of the staker, the time its stake
+    # becomes withdrawable and the pending un-delegations against it.
+    exit: ValidatorExit!
 }
 
 # ERC1155TransactionList is a list of ERC1155 transaction edges provided by sequential access request.
@@ -1073,6 +1346,14 @@ type FMintAccount {
     # inside the reward distribution and can be pushed into
     # the system to distribute them among eligible accounts.
     canPushNewRewards: Boolean!
+
+    # history represents the list of all position changes
+    # of the account on the fMint protocol.
+    history(cursor: Cursor, count: Int = 25): FMintTransactionList!
+
+    # pnl represents the realized and unrealized profit and loss
+    # of the account positions on the fMint protocol.
+    pnl: FMintAccountPnL!
 }
 
 # FMintTokenBalance represents a balance of a specific DeFi token
@@ -1096,6 +1377,132 @@ type FMintTokenBalance {
     value: BigInt!
 }
 
+# FMintTransactionType represents the type of an fMint position change.
+enum FMintTransactionType {
+    DEPOSIT
+    WITHDRAW
+    MINT
+    REPAY
+}
+
+# FMintTransaction represents a single position change on the fMint protocol.
+type FMintTransaction {
+    # userAddress represents the address of the fMint account.
+    userAddress: Address!
+
+    # tokenAddress represents the address of the token involved.
+    tokenAddress: Address!
+
+    # token represents the detail of the token involved.
+    token: DefiToken!
+
+    # type represents the type of the position change.
+    type: FMintTransactionType!
+
+    # amount represents the amount of tokens involved.
+    amount: BigInt!
+
+    # fee represents the fee paid on the position change.
+    fee: BigInt!
+
+    # price represents the oracle price of the token at the time
+    # of the position change, if known.
+    price: BigInt
+
+    # trxHash represents the hash of the transaction executing the change.
+    trxHash: Bytes32!
+
+    # transaction represents the transaction executing the change.
+    transaction: Transaction!
+
+    # timeStamp represents the time of the position change in Unix epoch.
+    timeStamp: Long!
+}
+
+# FMintTransactionList is a list of fMint transaction edges provided by sequential access request.
+type FMintTransactionList {
+    # Edges contains provided edges of the sequential list.
+    edges: [FMintTransactionListEdge!]!
+
+    # TotalCount is the maximum number of fMint transactions available for sequential access.
+    totalCount: BigInt!
+
+    # PageInfo is an information about the current page of fMint transaction edges.
+    pageInfo: ListPageInfo!
+}
+
+# FMintTransactionListEdge is a single edge in a sequential list of fMint transactions.
+type FMintTransactionListEdge {
+    cursor: Cursor!
+    trx: FMintTransaction!
+}
+
+# FMintAccountPnL represents the profit and loss of an fMint account.
+# Values are denominated in fUSD, native values are denominated
+# in native tokens at the current price. Position changes are valued
+# at the price observed when the change was indexed.
+type FMintAccountPnL {
+    # realized represents the profit, or loss, of the closed positions.
+    realized: BigInt!
+
+    # unrealized represents the profit, or loss, of the open positions
+    # at the current price.
+    unrealized: BigInt!
+
+    # fees represents the total amount of fees paid.
+    fees: BigInt!
+
+    # total represents the total profit, or loss, after fees.
+    total: BigInt!
+
+    # realizedNative represents the realized value in native tokens.
+    realizedNative: BigInt
+
+    # unrealizedNative represents the unrealized value in native tokens.
+    unrealizedNative: BigInt
+
+    # feesNative represents the fees paid in native tokens.
+    feesNative: BigInt
+
+    # totalNative represents the total value in native tokens.
+    totalNative: BigInt
+
+    # positions represents the list of individual positions of the account.
+    positions: [FMintPositionPnL!]!
+}
+
+# FMintPositionPnL represents the profit and loss of a single fMint position.
+# Collateral positions gain on price increase, debt positions gain on price decrease.
+type FMintPositionPnL {
+    # type represents the side of the position.
+    type: DefiTokenBalanceType!
+
+    # tokenAddress represents the address of the position token.
+    tokenAddress: Address!
+
+    # token represents the detail of the position token.
+    token: DefiToken!
+
+    # amount represents the amount of tokens in the open position.
+    amount: BigInt!
+
+    # costBasis represents the value of the open position
+    # at the average entry price in fUSD.
+    costBasis: BigInt!
+
+    # realized represents the profit, or loss, of the closed part in fUSD.
+    realized: BigInt!
+
+    # unrealized represents the profit, or loss, of the open part in fUSD.
+    unrealized: BigInt!
+
+    # fees represents the fees paid on the position in fUSD.
+    fees: BigInt!
+
+    # total represents the total profit, or loss, after fees in fUSD.
+    total: BigInt!
+}
+
 # DefiSettings represents the set of current settings and limits
 # applied to DeFi operations.
 type DefiSettings {
@@ -1517,7 +1924,7 @@ type RewardClaim {
     trxHash: Bytes32!
 }
 # Price represents price information of core Opera token
-type Price {
+type Price @cacheControl(maxAge: 30) {
     "Source unit symbol."
     fromSymbol: String!
 
@@ -1720,6 +2127,21 @@ type Account {
     # txCount represents number of transaction sent from the account (Nonce).
     txCount: Long!
 
+    # totalTxCount represents number of transactions sent, or received by the account
+    # as observed by the API server indexer.
+    totalTxCount: Long!
+
+    # firstSeen is the UNIX time stamp of the first known activity of the account.
+    # It's null for accounts without any known transaction.
+    firstSeen: Long
+
+    # lastActive is the UNIX time stamp of the last known activity of the account.
+    # It's null for accounts without any known transaction.
+    lastActive: Long
+
+    # age is the number of seconds elapsed since the first known activity of the account.
+    age: Long
+
     # txList represents list of transactions of the account in form of TransactionList.
     txList(cursor:Cursor, count:Int!): TransactionList!
 
@@ -1736,10 +2158,23 @@ type Account {
     staker: Staker
 
     # List of delegations of the account, if the account is a delegator.
-    delegations(cursor:Cursor, count:Int = 25): DelegationList!
+    delegations(cursor:Cursor, count:Int = 25, orderBy: DelegationOrderBy = CREATED_TIME, filter: DelegationFilter): DelegationList!
+
+    # tokenizerHistory is the list of the most recent sAXIS mints and burns
+    # of the account, the newest first. The count is limited to 100.
+    tokenizerHistory(count: Int = 25): [TokenizerEvent!]!
 
     # Details about smart contract, if the account is a smart contract.
     contract: Contract
+
+    # riskFlag is the compliance screening result of the account address.
+    # It's null if the compliance screening is not enabled on the API server.
+    riskFlag: RiskFlag
+
+    # enrichments is the list of off-chain data tags of the account
+    # provided by enrichers registered on the API server. Tags are collected
+    # in background, so they may not be available on the first request.
+    enrichments: [EnrichmentTag!]!
 }
 
 # GovernanceContract represents basic information
@@ -1975,151 +2410,2049 @@ type GovernanceVote {
     # presented.
     choices: [Long!]!
 }
-# Root schema definition
-schema {
-    query: Query
-    mutation: Mutation
-    subscription: Subscription
-}
+# FeesPaidReport represents a report of transaction fees paid by an address
+# in a time range, i.e. for the purpose of the fee reimbursement.
+type FeesPaidReport {
+    # address is the address the fees are reported for.
+    address: Address!
 
-# Entry points for querying the API
-type Query {
-    # version represents the API server version responding to your requests.
-    version: String!
+    # from is the beginning of the reported range in format YYYY-MM-DD.
+    from: String!
 
-    # State represents the current state of the blockchain and network.
-    state: CurrentState!
+    # to is the end of the reported range in format YYYY-MM-DD.
+    to: String!
 
-    # sfcConfig provides the current configuration
-    # of the SFC contract managing the block chain staking economy.
-    sfcConfig: SfcConfig!
+    # totalFee is the total amount of fees paid in the range in WEI units.
+    totalFee: BigInt!
 
-    # Total number of accounts active on the AXIS blockchain.
-    accountsActive:Long!
+    # trxCount is the total number of transactions sent in the range.
+    trxCount: Long!
 
-    # Get an Account information by hash address.
-    account(address:Address!):Account!
+    # periods is the list of periods of the report with fees paid in them.
+    # Periods without any transaction are not listed.
+    periods: [FeesPaidPeriod!]!
+}
 
-    # Get list of Contracts with at most <count> edges.
-    # If <count> is positive, return edges after the cursor,
-    # if negative, return edges before the cursor.
-    # For undefined cursor, positive <count> starts the list from top,
-    # negative <count> starts the list from bottom.
-    # ValidatedOnly specifies if the list should contain all the Contracts,
-    # or just contracts with validated byte code and available source/ABI.
-    contracts(validatedOnly: Boolean = false, cursor:Cursor, count:Int!):ContractList!
+# FeesPaidPeriod represents fees paid by an address in a single period.
+type FeesPaidPeriod {
+    # period identifies the period; YYYY-MM for monthly and YYYY-MM-DD
+    # for daily resolution.
+    period: String!
 
-    # Get block information by number or by hash.
-    # If neither is provided, the most recent block is given.
-    block(number:Long, hash: Bytes32):Block
+    # fee is the amount of fees paid in the period in WEI units.
+    fee: BigInt!
 
-    # Get list of Blocks with at most <count> edges.
-    # If <count> is positive, return edges after the cursor,
-    # if negative, return edges before the cursor.
-    # For undefined cursor, positive <count> starts the list from top,
-    # negative <count> starts the list from bottom.
-    blocks(cursor:Cursor, count:Int!):BlockList!
+    # trxCount is the number of transactions sent in the period.
+    trxCount: Long!
 
-    # Get transaction information for given transaction hash.
-    transaction(hash:Bytes32!):Transaction
+    # contracts is the breakdown of the fees paid in the period
+    # by the recipient of the transactions.
+    contracts: [FeesPaidContract!]!
+}
 
-    # Get list of Transactions with at most <count> edges.
-    # If <count> is positive, return edges after the cursor,
-    # if negative, return edges before the cursor.
-    # For undefined cursor, positive <count> starts the list from top,
-    # negative <count> starts the list from bottom.
-    transactions(cursor:Cursor, count:Int!):TransactionList!
+# FeesPaidContract represents fees paid to interact with a specific
+# contract, or recipient, in a period.
+type FeesPaidContract {
+    # address of the recipient; null for contract creation transactions.
+    address: Address
 
-    # Get filtered list of ERC20 Transactions.
-    erc20Transactions(cursor:Cursor, count:Int = 25, token: Address, account: Address, txType: String): ERC20TransactionList!
+    # fee is the amount of fees paid in WEI units.
+    fee: BigInt!
 
-    # Get filtered list of ERC721 Transactions.
-    erc721Transactions(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, account: Address, txType: String): ERC721TransactionList!
+    # trxCount is the number of transactions sent.
+    trxCount: Long!
+}
 
-    # Get filtered list of ERC1155 Transactions.
-    erc1155Transactions(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, account: Address, txType: String): ERC1155TransactionList!
+# LogLevel represents the log level of an API server logging subsystem.
+type LogLevel {
+    # subsystem is the name of the logging subsystem.
+    subsystem: String!
 
-    # Get the id of the current epoch of the AXIS blockchain.
-    currentEpoch:Long!
+    # level is the current log level of the subsystem.
+    level: String!
+}
 
-    # Get information about specified epoch. Returns current epoch information
-    # if id is not provided.
-    epoch(id: Long): Epoch!
+# DecodedCall represents a contract call decoded by the contract ABI.
+type DecodedCall {
+    # method is the name of the called contract method.
+    method: String!
 
-    # Get a scrollable list of epochs sorted from the last one back by default.
-    epochs(cursor: Cursor, count: Int = 25): EpochList!
+    # signature is the canonical signature of the called method,
+    # i.e. transfer(address,uint256)
+    signature: String!
 
-    # The last staker id in AXIS blockchain.
-    lastStakerId: Long!
+    # args is the list of decoded call arguments.
+    args: [DecodedArgument!]!
+}
 
-    # The number of stakers in AXIS blockchain.
-    stakersNum: Long!
+# DecodedArgument represents a single decoded argument of a contract call.
+type DecodedArgument {
+    # name is the name of the argument as declared by the ABI.
+    name: String!
 
-    # Staker information. The staker is loaded either by numeric ID,
-    # or by address. null if none is provided.
-    staker(id: BigInt, address: Address): Staker
+    # type is the ABI type of the argument, i.e. uint256
+    type: String!
 
-    # List of staker information from SFC smart contract.
-    stakers: [Staker!]!
+    # value is the human readable value of the argument;
+    # numbers are in decimal, byte arrays are hex encoded.
+    value: String!
+}
 
-    # The list of delegations for the given staker ID.
-    # Cursor is used to obtain specific slice of the staker's delegations.
-    # The most recent delegations are provided if cursor is omitted.
-    delegationsOf(staker:BigInt!, cursor: Cursor, count: Int = 25): DelegationList!
+# DecodedLog represents an event log record decoded by the ABI of the emitting contract.
+type DecodedLog {
+    # address is the address of the contract emitting the event.
+    address: Address!
 
-    # Get the details of a specific delegation by it's delegator address
-    # and staker the delegation belongs to.
-    delegation(address:Address!, staker: BigInt!): Delegation
+    # index is the index of the log record in the block.
+    index: Int!
 
-    # Get the list of all delegations by it's delegator address.
-    delegationsByAddress(address:Address!, cursor: Cursor, count: Int = 25): DelegationList!
+    # event is the name of the event.
+    event: String!
 
-    # Returns the current price per gas in WEI units.
-    gasPrice: Long!
+    # signature is the canonical signature of the event,
+    # i.e. Transfer(address,address,uint256)
+    signature: String!
 
-    # estimateGas returns the estimated amount of gas required
-    # for the transaction described by the parameters of the call.
-    estimateGas(from: Address, to: Address, value: BigInt, data: String): Long
+    # args is the list of decoded event arguments.
+    args: [DecodedArgument!]!
+}
 
-    # Get price details of the AXIS blockchain token for the given target symbols.
-    price(to:String!):Price!
+# ContractCallResult represents the output of a read-only contract call.
+type ContractCallResult {
+    # output is the raw output of the call.
+    output: Bytes!
 
-    # Get calculated staking rewards for an account or given
-    # staking amount in AXIS tokens.
-    # At least one of the address and amount parameters must be provided.
-    # If you provide both, the address takes precedence and the amount is ignored.
-    estimateRewards(address:Address, amount:Long):EstimatedRewards!
+    # call is the input of the call decoded by the contract ABI;
+    # null if the ABI is not known, or the called method is not recognized.
+    call: DecodedCall
 
-    # sfcRewardsCollectedAmount provides an amount of rewards collected based on given
-    # filtering options, which are all optional. If no filter option is passed,
-    # the total amount of collected rewards is being presented.
-    sfcRewardsCollectedAmount(delegator: Address, staker: BigInt, since: Long, until: Long): BigInt!
+    # outputs is the output of the call decoded by the contract ABI;
+    # null if the output could not be decoded.
+    outputs: [DecodedArgument!]
+}
 
-    # defiConfiguration exposes the current DeFi contract setup.
-    defiConfiguration:DefiSettings!
+# IntegrityReport represents the summary of data integrity checks
+# performed by the API server on the indexed and node provided data.
+type IntegrityReport {
+    # checks is the list of integrity checks performed.
+    checks: [IntegrityCheck!]!
 
-    # defiTokens represents a list of all available DeFi tokens.
-    defiTokens:[DefiToken!]!
+    # issues is the list of the recent discrepancies found,
+    # the most recent issue goes first.
+    issues: [IntegrityIssue!]!
+}
 
-    # defiNativeToken represents the information about the native token
-    # wrapper ERC20 contract. Returns NULL if the native token wraper
-    # is not available.
-    defiNativeToken: ERC20Token
+# IntegrityCheck represents the state of a data integrity check.
+type IntegrityCheck {
+    # name is the name of the check.
+    name: String!
 
-    # fMintAccount provides DeFi/fMint information about an account on fMint protocol.
-    fMintAccount(owner: Address!):FMintAccount!
+    # lastRun is the UNIX time stamp of the last run of the check.
+    lastRun: Long!
 
-    # fMintTokenAllowance resolves the amount of ERC20 tokens unlocked
-    # by the token owner for DeFi/fMint operations.
-    fMintTokenAllowance(owner: Address!, token: Address!):BigInt!
+    # checked is the number of subjects checked.
+    checked: Long!
 
-    # fMintUserTokens resolves a list of pairs of fMint users and their tokens
-    # used for a specified purpose.
-    fMintUserTokens(purpose:FMintUserTokenPurpose=FMINT_COLLATERAL):[FMintUserToken!]!
+    # failed is the number of subjects which failed the check.
+    failed: Long!
+}
+
+# IntegrityIssue represents a discrepancy found by a data integrity check.
+type IntegrityIssue {
+    # check is the name of the check which found the issue.
+    check: String!
+
+    # subject identifies the data which failed the check, i.e. "epoch #1234"
+    subject: String!
+
+    # expected is the value expected by the check.
+    expected: String!
+
+    # actual is the value found.
+    actual: String!
+
+    # detail provides additional information about the inputs of the check.
+    detail: String!
+
+    # detected is the UNIX time stamp of the issue detection.
+    detected: Long!
+}
+
+# RewardRecomputationReport represents the result of the reward history
+# recomputation of a delegation from the archive state.
+type RewardRecomputationReport {
+    # address is the delegator address.
+    address: Address!
+
+    # validatorId is the identifier of the validator of the delegation.
+    validatorId: BigInt!
+
+    # checked is the number of reward claims recomputed.
+    checked: Int!
+
+    # mismatched is the number of reward claims which don't match the recomputed value.
+    mismatched: Int!
+
+    # entries is the list of recomputed reward claims, the most recent claim goes first.
+    entries: [RewardRecomputation!]!
+}
+
+# RewardRecomputation represents a reward claim derived from the SFC events
+# compared with the pending rewards read from the archive state before the claim.
+type RewardRecomputation {
+    # claimTrx is the hash of the claim transaction.
+    claimTrx: Bytes32!
+
+    # blockNumber is the number of the block of the claim.
+    blockNumber: Long!
+
+    # isDelegated signals the rewards were re-staked.
+    isDelegated: Boolean!
+
+    # indexed is the amount of rewards derived from the SFC events.
+    indexed: BigInt!
+
+    # recomputed is the amount of pending rewards in the state before the claim.
+    recomputed: BigInt!
+
+    # match signals the values are within the configured integrity tolerance.
+    match: Boolean!
+}
+
+# BlockHeader represents raw header of a block as provided by the Lachesis node
+# including consensus specific details. The header is never cached
+# and it's always loaded from the node directly.
+type BlockHeader {
+    # Number is the number of the block.
+    number: Long!
+
+    # Hash is the unique hash of the block; on Lachesis it's also
+    # the id of the Atropos event which confirmed the block.
+    hash: Bytes32!
+
+    # ParentHash is the hash of the parent block.
+    parentHash: Bytes32!
+
+    # Miner is the address of the beneficiary of the block.
+    miner: Address!
+
+    # StateRoot is the hash of the state trie root after the block.
+    stateRoot: Bytes32!
+
+    # TransactionsRoot is the hash of the block transactions trie root.
+    transactionsRoot: Bytes32!
+
+    # ReceiptsRoot is the hash of the block receipts trie root.
+    receiptsRoot: Bytes32!
+
+    # GasLimit is the maximum gas allowed in this block.
+    gasLimit: Long!
+
+    # GasUsed is the actual total gas used by all transactions in this block.
+    gasUsed: Long!
+
+    # Timestamp is the unix timestamp of the block.
+    timestamp: Long!
+
+    # TimestampNano is the timestamp of the block in nanoseconds,
+    # if provided by the node.
+    timestampNano: Long
+
+    # Epoch is the consensus epoch of the block, if provided by the node.
+    epoch: Long
+
+    # ExtraData is the extra data field of the block.
+    extraData: Bytes!
+
+    # Atropos is the consensus event which confirmed the block. It's available
+    # only if the node exposes the DAG API namespace, null otherwise.
+    atropos: DagEvent
+}
+
+# DagEvent represents a consensus event of the Lachesis DAG.
+type DagEvent {
+    # id is the unique identifier (hash) of the event.
+    id: Bytes32!
+
+    # epoch is the epoch the event belongs to.
+    epoch: Long!
+
+    # seq is the sequence number of the event within events of the creator.
+    seq: Long!
+
+    # frame is the consensus frame of the event.
+    frame: Long!
+
+    # creator is the ID of the validator who created the event.
+    creator: Long!
+
+    # validator is the validator who created the event.
+    validator: Staker
+
+    # lamport is the Lamport time of the event.
+    lamport: Long!
+
+    # creationTime is the creation time of the event in nanoseconds.
+    creationTime: Long!
+
+    # medianTime is the median time of the event in nanoseconds.
+    medianTime: Long!
+
+    # parents is the list of ids of the parent events.
+    parents: [Bytes32!]!
+
+    # parentEvents is the list of the parent events.
+    parentEvents: [DagEvent!]!
+
+    # transactionHashes is the list of hashes of transactions included in the event.
+    transactionHashes: [Bytes32!]!
+}
+
+# NodeInfo represents diagnostic information about the blockchain node
+# backing the API server.
+type NodeInfo {
+    # version is the client version string of the node.
+    version: String!
+
+    # chainId is the id of the chain the node is connected to.
+    chainId: BigInt!
+
+    # peerCount is the number of peers connected to the node.
+    peerCount: Long!
+
+    # syncing signals if the node is catching up with the network.
+    syncing: Boolean!
+
+    # currentBlock is the block the node has processed.
+    currentBlock: Long!
+
+    # highestBlock is the highest block known to the node.
+    highestBlock: Long!
+
+    # latency is the smoothed round trip time of the node calls in milliseconds.
+    latency: Int!
+
+    # errorRate is the smoothed ratio of failed node calls in <0, 1> range.
+    errorRate: Float!
+
+    # callClasses is the responsiveness of the node calls by their class; "state" for contract
+    # calls, "trace" for transaction traces and "chain" for other chain data.
+    callClasses: [NodeCallClass!]!
+
+    # underPressure signals if the low priority queries are being shed
+    # due to the node responsiveness.
+    underPressure: Boolean!
+}
+
+# NodeCallClass represents the observed responsiveness of a class of the node calls.
+type NodeCallClass {
+    # name is the name of the class of the node calls.
+    name: String!
+
+    # latency is the smoothed round trip time of the calls in milliseconds.
+    latency: Int!
+
+    # errorRate is the smoothed ratio of failed calls in <0, 1> range.
+    errorRate: Float!
+
+    # calls is the total number of calls observed.
+    calls: Long!
+}
+
+# PreparedTransaction represents an unsigned transaction built by the API server.
+# The client is expected to review the transaction, sign it and submit it
+# to the block chain using the sendTransaction mutation.
+type PreparedTransaction {
+    # Address of the sender expected to sign the transaction.
+    from: Address!
+
+    # Address of the recipient contract.
+    to: Address!
+
+    # ABI encoded call data of the transaction.
+    data: Bytes!
+
+    # Amount of native tokens sent with the transaction in WEI.
+    value: BigInt!
+
+    # Estimated amount of gas required by the transaction.
+    gas: Long!
+
+    # Suggested gas price in WEI.
+    gasPrice: BigInt!
+
+    # Nonce of the transaction from the sender account.
+    nonce: Long!
+
+    # Amount of tokens affected by the transaction,
+    # e.g. the amount of sAXIS minted or burned.
+    amount: BigInt!
+}
+
+# Represents a member of the validator set of an epoch.
+type EpochValidator {
+    # Identifier of the epoch.
+    epoch: Long!
+
+    # Identifier of the validator.
+    validatorId: BigInt!
+
+    # Total amount of stake received by the validator in the epoch.
+    # This includes the validator's self stake and all the delegations.
+    receivedStake: BigInt!
+
+    # Share of the validator on the total stake of the epoch validator set
+    # in the range of <0, 1>.
+    weight: Float!
+
+    # Accumulated fee of transactions originated by the validator in the epoch.
+    originatedFee: BigInt!
+
+    # Accumulated uptime of the validator in seconds.
+    uptime: Long!
+}
+
+# Counterparty represents an address an account interacted with
+# along with the number and the volume of the interactions.
+type Counterparty {
+    # Address of the counterparty.
+    address: Address!
+
+    # Account detail of the counterparty.
+    account: Account!
+
+    # Total number of transactions between the account and the counterparty.
+    trxCount: Long!
+
+    # Number of transactions sent by the account to the counterparty.
+    sentCount: Long!
+
+    # Number of transactions received by the account from the counterparty.
+    receivedCount: Long!
+
+    # Amount of native tokens sent by the account to the counterparty in WEI.
+    sentVolume: BigInt!
+
+    # Amount of native tokens received by the account from the counterparty in WEI.
+    receivedVolume: BigInt!
+}
+
+# WebhookDelivery represents the delivery log record of a webhook notification.
+# Webhook payloads are signed by HMAC-SHA256 with the configured secret,
+# the hex encoded signature is sent in the X-Webhook-Signature header.
+type WebhookDelivery {
+    # id is the identifier of the delivery sent in the X-Webhook-Delivery header.
+    id: String!
+
+    # url is the address of the webhook.
+    url: String!
+
+    # event is the name of the notification event.
+    event: String!
+
+    # payload is the JSON encoded notification payload.
+    payload: String!
+
+    # status is the delivery status, one of "pending", "retrying", "delivered" and "dead".
+    status: String!
+
+    # attempts is the number of delivery attempts made.
+    attempts: Int!
+
+    # lastError is the error of the last failed delivery attempt.
+    lastError: String
+
+    # lastCode is the HTTP status code received on the last delivery attempt.
+    lastCode: Int
+
+    # created is the UNIX time stamp of the notification creation.
+    created: Long!
+
+    # updated is the UNIX time stamp of the last delivery attempt.
+    updated: Long!
+
+    # nextAttempt is the UNIX time stamp of the scheduled delivery retry.
+    nextAttempt: Long
+}
+
+# CollateralAlert represents a collateral to debt ratio threshold
+# registered for an fMint account.
+type CollateralAlert {
+    # id is the unique identifier of the alert.
+    id: String!
+
+    # address is the address of the watched fMint account.
+    address: Address!
+
+    # threshold4 is the collateral ratio threshold in 4 digits,
+    # e.g. value 30000 = 3.0x.
+    threshold4: Long!
+
+    # webhook is the URL the alerts are delivered to, if any.
+    webhook: String
+
+    # below signals the collateral ratio is below the threshold.
+    below: Boolean!
+
+    # ratio4 is the collateral ratio in 4 digits observed on the last
+    # threshold crossing, or on the registration. NULL if the account has no debt.
+    ratio4: Long
+
+    # created is the UNIX time stamp of the alert registration.
+    created: Long!
+
+    # triggered is the UNIX time stamp of the last threshold crossing, if any.
+    triggered: Long
+}
+
+# CollateralAlertEvent represents a notification about collateral ratio
+# of an fMint account crossing the threshold of a registered alert.
+type CollateralAlertEvent {
+    # alertId is the identifier of the triggered alert.
+    alertId: String!
+
+    # address is the address of the fMint account.
+    address: Address!
+
+    # threshold4 is the collateral ratio threshold in 4 digits.
+    threshold4: Long!
+
+    # ratio4 is the current collateral ratio in 4 digits.
+    # NULL if the account has no debt.
+    ratio4: Long
+
+    # below signals the ratio dropped below the threshold;
+    # false if it rose above it.
+    below: Boolean!
+
+    # belowMinimum signals the ratio is below the minimal collateral ratio
+    # of the fMint protocol and the account is in liquidation risk.
+    belowMinimum: Boolean!
+
+    # stamp is the UNIX time stamp of the threshold crossing.
+    stamp: Long!
+
+    # sequence is the position of the alert in the subscription event stream.
+    # Alerts are not bound to a block, they follow the last block
+    # received by the server with a growing index.
+    sequence: EventSequence!
+}
+
+# DefiOverview represents network-wide aggregates of the DeFi modules.
+# All the values are denominated in fUSD with 18 decimals.
+type DefiOverview {
+    # stakingTvl is the value of the native tokens staked in SFC.
+    stakingTvl: BigInt!
+
+    # fMintTvl is the value of the collateral locked in fMint.
+    fMintTvl: BigInt!
+
+    # ammTvl is the value of the reserves of the known AMM pairs.
+    # Pairs with only one token priced by the oracle are valued
+    # by doubling the priced side; other pairs are not included.
+    ammTvl: BigInt!
+
+    # tvl is the total value locked across all the modules.
+    tvl: BigInt!
+
+    # volume24h is the AMM swap volume of the last 24 hours.
+    volume24h: BigInt!
+
+    # volume7d is the AMM swap volume of the last 7 days.
+    volume7d: BigInt!
+
+    # updated is the UNIX time stamp of the aggregates calculation.
+    updated: Long!
+}
+
+# RiskFlag represents the result of a compliance screening of an address
+# against a list of sanctioned, or otherwise flagged addresses.
+type RiskFlag {
+    # address is the screened address.
+    address: Address!
+
+    # flagged signals the address is sanctioned, or otherwise flagged.
+    flagged: Boolean!
+
+    # category is the risk category of a flagged address, e.g. "sanctions".
+    category: String
+
+    # reason is an optional human readable detail of the flag.
+    reason: String
+
+    # source identifies the screening source which provided the result.
+    source: String!
+
+    # checked is the UNIX time stamp of the screening.
+    checked: Long!
+}
+
+# MaintenanceState represents the state of the read-only maintenance mode of the API server.
+type MaintenanceState {
+    # enabled signals the read-only maintenance mode is on.
+    enabled: Boolean!
+
+    # reason is an optional description of the maintenance.
+    reason: String
+
+    # since is the UNIX time stamp of the maintenance start.
+    since: Long
+}
+
+# Decentralization represents stake distribution metrics
+# of the validator set of a sealed epoch.
+type Decentralization {
+    # Identifier of the epoch.
+    epoch: Long!
+
+    # Number of validators in the epoch validator set.
+    validators: Int!
+
+    # Total stake of the epoch validator set.
+    totalStake: BigInt!
+
+    # Nakamoto coefficient is the minimal number of validators controlling
+    # more than one third of the total stake, which is enough to halt the consensus.
+    nakamotoCoefficient: Int!
+
+    # Share of the top <n> validators by stake on the total stake
+    # of the epoch validator set in the range of <0, 1>.
+    topShare(n: Int = 10): Float!
+
+    # Herfindahl-Hirschman index of the stake distribution in the range of <0, 1>;
+    # lower values represent better distributed stake.
+    hhi: Float!
+}
+
+# ValidatorChangeType represents the type of a validator management change.
+enum ValidatorChangeType {
+    # CREATED is the validator creation; the value is the validator address.
+    CREATED
+
+    # PUBKEY is the validator public key set on the validator creation.
+    PUBKEY
+
+    # STATUS is a change of the validator status; the value is the new status code.
+    STATUS
+
+    # DEACTIVATED is the validator deactivation; the value is the deactivation epoch.
+    DEACTIVATED
+
+    # METADATA is an update of the validator metadata; the value is the new metadata URL.
+    METADATA
+}
+
+# ValidatorChange represents a management change of a validator
+# captured from the SFC and the staker info contract events.
+type ValidatorChange {
+    # Identifier of the validator.
+    validatorId: BigInt!
+
+    # Type of the change.
+    type: ValidatorChangeType!
+
+    # The new value after the change.
+    value: String!
+
+    # The previous value of the same type, if known.
+    previous: String
+
+    # Hash of the transaction executing the change.
+    trxHash: Bytes32!
+
+    # The transaction executing the change.
+    transaction: Transaction!
+
+    # Number of the block containing the change.
+    blockNumber: Long!
+
+    # Time stamp of the change in Unix epoch.
+    timeStamp: Long!
+}
+
+# DecodedTransaction represents a raw transaction decoded without sending it
+# to the block chain.
+type DecodedTransaction {
+    # hash is the hash of the transaction; null for unsigned transactions.
+    hash: Bytes32
+
+    # type is the EIP-2718 type of the transaction envelope;
+    # 0 = legacy, 1 = access list, 2 = dynamic fee.
+    type: Long!
+
+    # chainId is the id of the chain the transaction is valid for, if known.
+    chainId: BigInt
+
+    # signed signals the transaction carries a signature.
+    signed: Boolean!
+
+    # from is the sender recovered from the signature; null for unsigned transactions.
+    from: Address
+
+    # to is the recipient of the transaction; null for contract deployments.
+    to: Address
+
+    # nonce is the sequence number of the transaction of the sender.
+    nonce: Long!
+
+    # gas is the gas limit of the transaction.
+    gas: Long!
+
+    # gasPrice is the gas price in WEI; the fee cap for dynamic fee transactions.
+    gasPrice: BigInt!
+
+    # maxFeePerGas is the fee cap of dynamic fee transactions in WEI.
+    maxFeePerGas: BigInt
+
+    # maxPriorityFeePerGas is the tip cap of dynamic fee transactions in WEI.
+    maxPriorityFeePerGas: BigInt
+
+    # value is the amount of WEI transferred by the transaction.
+    value: BigInt!
+
+    # inputData is the input data of the transaction.
+    inputData: Bytes!
+
+    # call is the input data decoded by the ABI of the recipient contract,
+    # or by a well known ABI (SFC, ERC20, ERC721); null if not known.
+    call: DecodedCall
+}
+
+# FeeTrend represents fee information of a new block
+# for fee-sensitive clients.
+type FeeTrend {
+    # blockNumber is the number of the block.
+    blockNumber: Long!
+
+    # timestamp is the UNIX time stamp of the block.
+    timestamp: Long!
+
+    # baseFee is the base fee per gas of the block in WEI.
+    # NULL if the chain does not provide the base fee.
+    baseFee: BigInt
+
+    # gasLimit is the maximum gas allowed in the block.
+    gasLimit: Long!
+
+    # gasUsed is the total gas used by transactions of the block.
+    gasUsed: Long!
+
+    # gasUsedRatio is the ratio of the used gas to the gas limit of the block.
+    gasUsedRatio: Float!
+
+    # suggestedTip is the suggested priority fee per gas in WEI
+    # derived from the current gas price above the base fee.
+    suggestedTip: BigInt!
+
+    # sequence is the position of the fee trend in the subscription event stream.
+    sequence: EventSequence!
+}
+
+# StakeActionValidation represents the result of a staking operation
+# check against the SFC contract constraints.
+type StakeActionValidation {
+    # valid signals the operation is expected to pass all the checked constraints.
+    valid: Boolean!
+
+    # errors is the list of violated constraints; empty if the operation is valid.
+    errors: [StakeValidationError!]!
+}
+
+# StakeValidationError represents a single SFC constraint violated by a staking operation.
+type StakeValidationError {
+    # code is the machine readable identifier of the violated constraint,
+    # e.g. VALIDATOR_SATURATED, or INVALID_LOCK_DURATION.
+    code: String!
+
+    # field is the name of the offending argument, if any.
+    field: String
+
+    # message is the human readable description of the violation.
+    message: String!
+}
+
+# SandboxKey represents a newly minted self-service sandbox API key.
+type SandboxKey {
+    # key is the API key; it's provided only once, keep it safe.
+    key: String!
+
+    # name is the public identifier of the key.
+    name: String!
+
+    # expires is the UNIX time stamp of the key expiration.
+    expires: Long!
+
+    # rateLimit is the max number of requests per minute allowed to the key.
+    rateLimit: Int!
+}
+
+# DailyGasPrice represents a distribution of gas prices
+# paid by transactions on the network on specific day.
+type DailyGasPrice {
+    # day represents the day of the aggregation in format YYYY-MM-DD
+    # i.e. 2021-01-23 for January 23rd, 2021
+    day: String!
+
+    # count represents the number of transactions of the day.
+    count: Int!
+
+    # p25 represents the 25th percentile of the gas price in WEI.
+    p25: BigInt!
+
+    # p50 represents the median gas price in WEI.
+    p50: BigInt!
+
+    # p90 represents the 90th percentile of the gas price in WEI.
+    p90: BigInt!
+}
+
+# ContractUpgrade represents an upgrade of an EIP-1967 proxy contract
+# captured from the Upgraded and BeaconUpgraded events of the proxy.
+type ContractUpgrade {
+    # Address of the proxy contract.
+    proxy: Address!
+
+    # Address of the new implementation; the new beacon for beacon upgrades.
+    implementation: Address!
+
+    # Is this an upgrade of the beacon of a beacon proxy.
+    isBeacon: Boolean!
+
+    # Hash of the transaction executing the upgrade.
+    trxHash: Bytes32!
+
+    # The transaction executing the upgrade.
+    transaction: Transaction!
+
+    # Number of the block containing the upgrade.
+    blockNumber: Long!
+
+    # Time stamp of the upgrade in Unix epoch.
+    timeStamp: Long!
+}
+
+# TaxReportEntry represents a single staking rewards claim, or restake
+# valued in the fiat currency at the day of the claim.
+type TaxReportEntry {
+    # Type of the entry; CLAIM for rewards claimed to the account balance,
+    # RESTAKE for rewards re-staked into the delegation.
+    type: String!
+
+    # Time stamp of the claim in Unix epoch.
+    timeStamp: Long!
+
+    # Identifier of the validator the rewards were claimed from.
+    validatorId: BigInt!
+
+    # Hash of the claim transaction.
+    trxHash: Bytes32!
+
+    # Amount of rewards in WEI units.
+    amount: BigInt!
+
+    # Daily price of the native token in the report currency.
+    price: Float!
+
+    # Value of the rewards in the report currency.
+    fiatValue: Float!
+}
+
+# TaxReport represents staking rewards realized by a delegator in a calendar year.
+type TaxReport {
+    # Address of the delegator.
+    address: Address!
+
+    # The calendar year of the report.
+    year: Int!
+
+    # Fiat currency symbol of the report values.
+    currency: String!
+
+    # List of reward claims and restakes, the oldest first.
+    entries: [TaxReportEntry!]!
+
+    # Total amount of rewards in WEI units.
+    totalAmount: BigInt!
+
+    # Total value of rewards in the report currency.
+    totalFiatValue: Float!
+
+    # Signals the report contains only the oldest 10,000 claims of the year;
+    # the totals cover the included entries only.
+    truncated: Boolean!
+
+    # The report in CSV format ready for download.
+    csv: String!
+}
+
+# EnrichmentTag represents a piece of off-chain data decorating an account,
+# or a contract provided by an external enricher, e.g. a KYC tag,
+# a dApp name, or an audit status.
+type EnrichmentTag {
+    # source is the name of the enricher which provided the tag.
+    source: String!
+
+    # key identifies the kind of the data, e.g. "dapp" or "audit".
+    key: String!
+
+    # value is the data itself.
+    value: String!
+}
+
+# TokenPriceUpdate represents an update of an ERC20 token price.
+type TokenPriceUpdate {
+    # token is the address of the priced ERC20 token.
+    token: Address!
+
+    # price is the current value of the token in the reference denomination
+    # of the DeFi price oracle.
+    price: BigInt!
+
+    # source is the source of the price.
+    source: DefiPriceSource!
+
+    # blockNumber is the number of the block the price was checked on.
+    blockNumber: Long!
+
+    # timeStamp is the time stamp of the block the price was checked on.
+    timeStamp: Long!
+
+    # sequence is the position of the update in the subscription event stream.
+    sequence: EventSequence!
+}
+
+# NetworkMetrics represents the observed production of new blocks by the network.
+type NetworkMetrics {
+    # lastBlock is the number of the last observed block.
+    lastBlock: Long!
+
+    # lastBlockTime is the UNIX time stamp the last block has been observed.
+    lastBlockTime: Long!
+
+    # headAge is the number of seconds since the last block has been observed.
+    headAge: Long!
+
+    # blockGapThreshold is the max expected number of seconds between two blocks.
+    blockGapThreshold: Long!
+
+    # stalled signals no block has been observed for longer than the threshold,
+    # the chain may be halted.
+    stalled: Boolean!
+
+    # gapsDetected is the number of abnormal gaps detected since the server started.
+    gapsDetected: Long!
+
+    # lastGap is the duration of the last finished abnormal gap in seconds.
+    lastGap: Long!
+
+    # longestGap is the duration of the longest finished abnormal gap in seconds.
+    longestGap: Long!
+}
+
+# AccountActivityDay represents the number of transactions of an account on specific day.
+type AccountActivityDay {
+    # day is the date in format YYYY-MM-DD.
+    day: String!
+
+    # count is the number of transactions of the account on the day.
+    count: Int!
+}
+
+# UpgradeReadiness represents the distribution of the stake of active validators
+# on the validator node client versions published in the staker info metadata.
+type UpgradeReadiness {
+    # Total stake of active validators.
+    totalStake: BigInt!
+
+    # The node version the readiness is evaluated against, if any.
+    requiredVersion: String
+
+    # Share of the total stake running the required version, or newer,
+    # in the range of <0, 1>; validators not publishing the version are not counted.
+    # Zero is provided if the required version is not specified.
+    readyShare: Float!
+
+    # List of node versions sorted by the stake from the largest.
+    # Validators not publishing the version are grouped under the "unknown" version.
+    versions: [NodeVersionStake!]!
+}
+
+# NodeVersionStake represents the stake of active validators running a node version.
+type NodeVersionStake {
+    # The node client version.
+    version: String!
+
+    # Number of active validators running the version.
+    validators: Int!
+
+    # Total stake of the validators running the version.
+    stake: BigInt!
+
+    # Share of the stake on the total stake of active validators in the range of <0, 1>.
+    share: Float!
+}
+
+# SwapQuote represents the best route of a token swap through Uniswap pairs.
+type SwapQuote {
+    # List of tokens the swap goes through, starting with the input token
+    # and ending with the output token.
+    path: [Address!]!
+
+    # List of Uniswap pairs used by the swap hops.
+    pairs: [Address!]!
+
+    # The input amount of the swap.
+    amountIn: BigInt!
+
+    # The expected output amount of the swap.
+    amountOut: BigInt!
+
+    # The minimal output amount accepted by the router call
+    # derived from the slippage tolerance.
+    amountOutMin: BigInt!
+
+    # Relative difference between the mid-price of the route pairs
+    # and the execution price of the swap in the range of <0, 1>.
+    priceImpact: Float!
+
+    # Address of the Uniswap router contract to be called.
+    router: Address!
+
+    # Time stamp (UNIX seconds) the router call is valid until.
+    deadline: Long!
+
+    # Encoded swapExactTokensForTokens call of the router contract;
+    # available only if the recipient of the swap is provided.
+    callData: Bytes
+}
+
+# Network represents the identity of the blockchain network served by the API.
+type Network @cacheControl(maxAge: 3600) {
+    # The id of the chain used to sign transactions.
+    chainId: BigInt!
+
+    # Human readable name of the network.
+    name: String!
+
+    # Symbol of the native token of the network.
+    symbol: String!
+
+    # Number of decimals of the native token.
+    decimals: Int!
+
+    # Address of the SFC staking contract.
+    sfcContract: Address!
+
+    # Address of the stake tokenizer contract, if deployed.
+    tokenizerContract: Address
+
+    # Address of the fMint core contract, if deployed.
+    fMintContract: Address
+
+    # Hash of the genesis block.
+    genesisHash: Bytes32!
+
+    # Time stamp (UNIX seconds) of the genesis block.
+    genesisTime: Long!
+}
+
+# SubscriptionUsage represents the websocket subscriptions usage of a client.
+type SubscriptionUsage {
+    # Name of the API key of the client; unauthenticated clients
+    # are identified by their address.
+    client: String!
+
+    # Number of active subscriptions of the client.
+    active: Int!
+
+    # Max number of concurrent subscriptions of a client; zero if not limited.
+    maxActive: Int!
+
+    # Number of events pushed to the subscriptions of the client in the current minute.
+    events: Int!
+
+    # Max number of events pushed to the subscriptions of a client in a minute;
+    # zero if not limited. Events over the limit are dropped.
+    maxEvents: Int!
+
+    # Number of events dropped over the limit since the client subscribed.
+    dropped: Long!
+}
+
+# SubscriptionDiagnostics represents the delivery failures of websocket subscriptions of a client.
+# Diagnostics of a client are kept for 24 hours after its last failure, even if the client left.
+type SubscriptionDiagnostics {
+    # Name of the API key of the client; unauthenticated clients
+    # are identified by their address.
+    client: String!
+
+    # Time stamp of the first failure recorded for the client.
+    since: Long!
+
+    # Number of subscriptions rejected over the concurrent subscriptions limit.
+    rejected: Long!
+
+    # Number of events dropped over the events throughput limit.
+    dropped: Long!
+
+    # Time stamp of the last event dropped over the limit, if any.
+    lastDropped: Long
+
+    # Events the client did not take in time, by subscription, i.e. onBlock.
+    # The subscription stops receiving events after an overrun; it has to be opened again.
+    overruns: [SubscriptionFailure!]!
+
+    # Closed subscriptions by the reason; CLIENT_CLOSED, STREAM_ENDED, or BUFFER_OVERRUN.
+    disconnects: [SubscriptionFailure!]!
+}
+
+# SubscriptionFailure represents the number of subscription failures of a kind.
+type SubscriptionFailure {
+    # Name of the subscription, or of the disconnect reason.
+    name: String!
+
+    # Number of failures.
+    count: Long!
+
+    # Time stamp of the last failure.
+    last: Long!
+}
+
+# BalanceAlert represents min and/or max thresholds of the native,
+# or an ERC20 token balance registered for an account.
+type BalanceAlert {
+    # id is the unique identifier of the alert.
+    id: String!
+
+    # address is the address of the watched account.
+    address: Address!
+
+    # token is the address of the watched ERC20 token;
+    # NULL if the native balance is watched.
+    token: Address
+
+    # min is the lower threshold of the balance, if any.
+    min: BigInt
+
+    # max is the upper threshold of the balance, if any.
+    max: BigInt
+
+    # webhook is the URL the alerts are delivered to.
+    webhook: String!
+
+    # state is the position of the balance relative to the thresholds;
+    # one of "below", "within" and "above".
+    state: String!
+
+    # balance is the balance observed on the last threshold crossing, or on the registration.
+    balance: BigInt!
+
+    # created is the UNIX time stamp of the alert registration.
+    created: Long!
+
+    # triggered is the UNIX time stamp of the last threshold crossing, if any.
+    triggered: Long
+}
+
+# CacheControlScope represents the audience a cached response may be shared with.
+enum CacheControlScope {
+    # PUBLIC responses may be stored by shared caches, e.g. a CDN.
+    PUBLIC
+
+    # PRIVATE responses may be stored by the client only.
+    PRIVATE
+}
+
+# cacheControl provides the cache hint of a field, or of all the fields returning the type.
+# The maxAge is in seconds. Queries sent using HTTP GET are answered with Cache-Control
+# header of the lowest max age and the most restrictive scope of all the resolved fields;
+# a response containing a root field without a hint is not cacheable.
+directive @cacheControl(maxAge: Int, scope: CacheControlScope) on FIELD_DEFINITION | OBJECT
+
+# ValidatorEarnings represents earnings of a validator in a range of sealed epochs,
+# i.e. the data of the profit and loss overview of the validator operator.
+type ValidatorEarnings {
+    # validatorId is the identifier of the validator.
+    validatorId: BigInt!
+
+    # fromEpoch is the first reported epoch.
+    fromEpoch: Long!
+
+    # toEpoch is the last reported epoch.
+    toEpoch: Long!
+
+    # resolution is the aggregation period of the report; "epoch", or "day".
+    resolution: String!
+
+    # selfStake is the current self-stake of the validator
+    # the self-stake rewards are derived from.
+    selfStake: BigInt!
+
+    # selfStakeRewards is the total amount of rewards of the self-stake in the range.
+    selfStakeRewards: BigInt!
+
+    # commission is the total amount of commission earned from rewards of delegators in the range.
+    commission: BigInt!
+
+    # originatedFee is the total amount of fees of transactions originated by the validator in the range.
+    originatedFee: BigInt!
+
+    # total is the total earnings of the validator in the range,
+    # i.e. the self-stake rewards and the commission.
+    total: BigInt!
+
+    # periods is the list of periods of the report.
+    periods: [ValidatorEarningsPeriod!]!
+}
+
+# ValidatorEarningsPeriod represents earnings of a validator in a single period.
+type ValidatorEarningsPeriod {
+    # date is the date of the end of the period in format YYYY-MM-DD.
+    date: String!
+
+    # fromEpoch is the first epoch of the period.
+    fromEpoch: Long!
+
+    # toEpoch is the last epoch of the period.
+    toEpoch: Long!
+
+    # end is the UNIX time stamp of the end of the last epoch of the period.
+    end: Long!
+
+    # selfStakeRewards is the amount of rewards of the self-stake.
+    selfStakeRewards: BigInt!
+
+    # commission is the amount of commission earned from rewards of delegators.
+    commission: BigInt!
+
+    # originatedFee is the amount of fees of transactions originated by the validator.
+    originatedFee: BigInt!
+
+    # total is the total earnings of the validator in the period.
+    total: BigInt!
+}
+
+# AccountExport represents an asynchronous export of account transactions.
+# The export file is built in the background; once finished, the file
+# is available for download by a signed link until the export expires.
+type AccountExport {
+    # id is the unique identifier of the export.
+    id: String!
+
+    # address is the address of the exported account.
+    address: Address!
+
+    # status is the state of the export;
+    # one of "PENDING", "RUNNING", "FINISHED" and "FAILED".
+    status: String!
+
+    # rows is the number of transactions written to the export file.
+    rows: Long!
+
+    # error is the reason of a failed export, if any.
+    error: String
+
+    # created is the UNIX time stamp of the export request.
+    created: Long!
+
+    # finished is the UNIX time stamp of the export completion, if finished.
+    finished: Long
+
+    # expires is the UNIX time stamp the export file is removed at, if finished.
+    expires: Long
+
+    # downloadUrl is the signed link of the CSV export file, available if finished.
+    # The link does not require an API key and is valid until the export expires.
+    downloadUrl: String
+}
+
+# AddressGroup represents a named group of addresses linked together
+# by an API client, e.g. the wallets of a single user.
+type AddressGroup {
+    # id is the unique identifier of the group.
+    id: String!
+
+    # name is the name of the group given by its owner.
+    name: String!
+
+    # addresses is the list of addresses of the group.
+    addresses: [Address!]!
+
+    # created is the UNIX time stamp of the group creation.
+    created: Long!
+
+    # updated is the UNIX time stamp of the last change of the group.
+    updated: Long!
+}
+
+# Portfolio represents the aggregated state of all the addresses of an address group.
+type Portfolio {
+    # group is the address group of the portfolio.
+    group: AddressGroup!
+
+    # accounts is the list of accounts of the group for per-address details.
+    accounts: [Account!]!
+
+    # balance is the total balance of all the accounts in WEI.
+    balance: BigInt!
+
+    # amountDelegated is the total amount actively delegated by the accounts in WEI.
+    amountDelegated: BigInt!
+
+    # pendingRewards is the total amount of pending rewards of the delegations in WEI.
+    pendingRewards: BigInt!
+
+    # totalValue is the total value of the portfolio including
+    # the delegated amounts and pending rewards in WEI.
+    totalValue: BigInt!
+
+    # delegations is the list of all the delegations of the accounts.
+    delegations: [Delegation!]!
+
+    # txList is the list of transactions sent, or received, by any account of the group.
+    txList(cursor: Cursor, count: Int = 25): TransactionList!
+}
+
+# EventSequence represents the position of a subscription event in the chain.
+# Events of a subscription are delivered in the order they were produced
+# with strictly increasing sequence; an event out of order is never delivered.
+# The delivery is "at least once" across re-subscriptions: a consumer re-subscribing
+# and back-filling missed blocks from queries may see an event again and should
+# deduplicate by the sequence. Compare the block first, the index second.
+type EventSequence {
+    # block is the number of the block the event belongs to.
+    block: Long!
+
+    # index is the position of the event inside the block,
+    # e.g. the index of a transaction in the block.
+    index: Long!
+}
+
+# ValidatorExitReason represents a reason of a validator deactivation.
+enum ValidatorExitReason {
+    # the validator withdrew its stake
+    WITHDRAWN
+
+    # the validator was offline for too long
+    OFFLINE
+
+    # the validator was caught double signing
+    DOUBLE_SIGN
+}
+
+# ValidatorExit represents the exit of a validator from the network
+# and the projected withdrawal timeline of the stake delegated to it.
+# The SFC withdrawal period of a stake starts on its un-delegation,
+# or on the validator deactivation, whichever comes first.
+type ValidatorExit {
+    # isDeactivated signals the validator was deactivated.
+    isDeactivated: Boolean!
+
+    # reasons of the deactivation decoded from the SFC status bits.
+    reasons: [ValidatorExitReason!]!
+
+    # deactivatedEpoch is the epoch the validator was deactivated in; zero if active.
+    deactivatedEpoch: Long!
+
+    # deactivatedTime is the time stamp of the validator deactivation; zero if active.
+    deactivatedTime: Long!
+
+    # withdrawableEpoch is the epoch since which the deactivated stake can be withdrawn.
+    # Null for active validators.
+    withdrawableEpoch: Long
+
+    # withdrawableTime is the time stamp since which the deactivated stake can be withdrawn.
+    # Both the epoch and the time must pass. Null for active validators.
+    withdrawableTime: Long
+
+    # isWithdrawable signals the withdrawal period of the deactivated stake passed.
+    isWithdrawable: Boolean!
+
+    # pendingCount is the number of pending un-delegations against the validator.
+    pendingCount: Long!
+
+    # pendingAmount is the total amount of pending un-delegations in WEI.
+    pendingAmount: BigInt!
+
+    # timeline is the list of up to 50 pending un-delegations
+    # ordered by the time they become withdrawable.
+    timeline: [ValidatorExitStep!]!
+}
+
+# ValidatorExitStep represents a pending un-delegation on the exit timeline of a validator.
+type ValidatorExitStep {
+    # address of the delegator.
+    address: Address!
+
+    # withdrawRequestID is the identifier of the un-delegation withdraw request.
+    withdrawRequestID: BigInt!
+
+    # amount of the un-delegation in WEI.
+    amount: BigInt!
+
+    # requestedTime is the time stamp of the un-delegation.
+    requestedTime: Long!
+
+    # withdrawableTime is the projected time stamp the un-delegated stake becomes withdrawable;
+    # the epochs part of the withdrawal period is not included.
+    withdrawableTime: Long!
+}
+
+# EnumLabel represents a localized human-readable label of an enumeration value.
+# Error messages are localized the same way, by the Accept-Language header,
+# while the machine codes in the error extensions never change.
+type EnumLabel {
+    # value is the enumeration value as used by the API.
+    value: String!
+
+    # label is the human-readable label of the value.
+    label: String!
+}
+
+# TokenizerEvent represents a mint, or a burn of sAXIS tokens by the SFC Tokenizer.
+type TokenizerEvent {
+    # type is the type of the event, either MINT, or BURN.
+    type: String!
+
+    # account is the address of the delegator receiving minted, or burning the tokens.
+    account: Address!
+
+    # validatorId is the id of the validator the tokenized stake is delegated to.
+    # It's null if the SFC Tokenizer was not called directly by the transaction.
+    validatorId: BigInt
+
+    # amount is the amount of sAXIS tokens minted, or burned.
+    amount: BigInt!
+
+    # trxHash is the hash of the transaction emitting the event.
+    trxHash: Bytes32!
+
+    # blockNumber is the number of the block of the event.
+    blockNumber: Long!
+
+    # timeStamp is the time stamp of the block of the event.
+    timeStamp: Long!
+}
+
+# SAXISSupply represents the daily record of sAXIS tokens outstanding.
+type SAXISSupply {
+    # day is the time stamp of the beginning of the day (UTC).
+    day: Long!
+
+    # minted is the amount of sAXIS tokens minted in the day.
+    minted: BigInt!
+
+    # burned is the amount of sAXIS tokens burned in the day.
+    burned: BigInt!
+
+    # outstanding is the amount of sAXIS tokens outstanding at the end of the day.
+    outstanding: BigInt!
+}
+
+# SAXISCollateral represents the amount of sAXIS tokens outstanding against stake delegated to a validator.
+type SAXISCollateral {
+    # validatorId is the id of the validator.
+    validatorId: BigInt!
+
+    # staker is the validator details.
+    staker: Staker!
+
+    # outstanding is the amount of sAXIS tokens outstanding.
+    outstanding: BigInt!
+
+    # ratio is the share of the validator total stake tokenized by the outstanding sAXIS.
+    ratio: Float!
+}
+
+# EpochDiff represents the changes of the validator set and the supply
+# between two sealed epochs.
+type EpochDiff {
+    # from is the identifier of the base epoch.
+    from: Long!
+
+    # to is the identifier of the compared epoch.
+    to: Long!
+
+    # totalStakeFrom is the total stake of the validator set of the base epoch.
+    totalStakeFrom: BigInt!
+
+    # totalStakeTo is the total stake of the validator set of the compared epoch.
+    totalStakeTo: BigInt!
+
+    # totalStakeDelta is the change of the total stake of the validator set.
+    totalStakeDelta: BigInt!
+
+    # totalSupplyFrom is the total supply at the end of the base epoch.
+    totalSupplyFrom: BigInt!
+
+    # totalSupplyTo is the total supply at the end of the compared epoch.
+    totalSupplyTo: BigInt!
+
+    # totalSupplyDelta is the change of the total supply.
+    totalSupplyDelta: BigInt!
+
+    # added is the list of validators which joined the validator set.
+    added: [BigInt!]!
+
+    # removed is the list of validators which left the validator set.
+    removed: [BigInt!]!
+
+    # validators is the list of stake changes of the validators
+    # sorted from the largest absolute change.
+    validators: [EpochValidatorDiff!]!
+}
+
+# EpochValidatorDiff represents the change of the stake received by a validator
+# between two sealed epochs.
+type EpochValidatorDiff {
+    # validatorId is the identifier of the validator.
+    validatorId: BigInt!
+
+    # stakeFrom is the stake received in the base epoch; zero if not in the set.
+    stakeFrom: BigInt!
+
+    # stakeTo is the stake received in the compared epoch; zero if not in the set.
+    stakeTo: BigInt!
+
+    # stakeDelta is the change of the received stake.
+    stakeDelta: BigInt!
+
+    # isNew signals the validator joined the validator set.
+    isNew: Boolean!
+
+    # isRemoved signals the validator left the validator set.
+    isRemoved: Boolean!
+}
+
+# Status represents the state of the API server components
+# suitable to drive a public status page.
+type Status {
+    # overall is the overall state of the API server;
+    # one of "operational", "degraded" and "outage".
+    overall: String!
+
+    # updated is the UNIX time stamp of the status.
+    updated: Long!
+
+    # sync is the synchronization state of the blockchain indexer.
+    sync: StatusSync!
+
+    # node is the health of the connected blockchain node.
+    node: StatusNode!
+
+    # subscriptions is the health of the websocket subscriptions.
+    subscriptions: StatusSubscriptions!
+
+    # storage is the health of the persistent storage.
+    storage: StatusStorage!
+
+    # maintenance signals the read-only maintenance mode is on.
+    maintenance: Boolean!
+
+    # incidents is the list of the most recent incident markers
+    # published by operators, the latest first.
+    incidents: [StatusIncident!]!
+}
+
+# StatusSync represents the synchronization state of the blockchain indexer.
+type StatusSync {
+    # indexedBlock is the number of the last block indexed by the API server.
+    indexedBlock: Long!
+
+    # nodeBlock is the number of the last block of the node, if available.
+    nodeBlock: Long
+
+    # lag is the number of blocks the indexer is behind the node.
+    lag: Long!
+
+    # stalled signals no new block has been observed for an abnormal time.
+    stalled: Boolean!
+}
+
+# StatusNode represents the health of the connected blockchain node.
+type StatusNode {
+    # available signals the node responds to the API server.
+    available: Boolean!
+
+    # latency is the smoothed round trip time of the node calls in milliseconds.
+    latency: Int!
+
+    # errorRate is the smoothed ratio of failed node calls.
+    errorRate: Float!
+
+    # underPressure signals heavy queries are being shed to protect the node.
+    underPressure: Boolean!
+}
+
+# StatusSubscriptions represents the health of the websocket subscriptions.
+type StatusSubscriptions {
+    # active is the number of active subscriptions.
+    active: Int!
+
+    # dropped is the number of events dropped over the rate limits.
+    dropped: Long!
+}
+
+# StatusStorage represents the health of the persistent storage.
+type StatusStorage {
+    # available signals the database responds to the API server.
+    available: Boolean!
+
+    # latency is the round trip time of the database check in milliseconds.
+    latency: Int
+}
+
+# StatusIncident represents an incident marker published by operators on the status page.
+type StatusIncident {
+    # id is the unique identifier of the incident.
+    id: String!
+
+    # severity is the severity of the incident; one of "minor", "major" and "critical".
+    severity: String!
+
+    # title is the short description of the incident.
+    title: String!
+
+    # message is the optional detail of the incident.
+    message: String
+
+    # started is the UNIX time stamp of the incident start.
+    started: Long!
+
+    # resolved is the UNIX time stamp of the incident resolution, if resolved.
+    resolved: Long
+}
+
+# ValidatorEstimate represents the projected income of a prospective validator
+# with the given self-stake and the expected amount of received delegations.
+# The reward rate and the total stake of the latest sealed epoch are used
+# and the validator is assumed to have full uptime.
+type ValidatorEstimate {
+    # epoch is the sealed epoch the reward parameters are taken from.
+    epoch: Long!
+
+    # epochDuration is the duration of the epoch in seconds.
+    epochDuration: Long!
+
+    # selfStake is the self-stake of the estimate in WEI units.
+    selfStake: BigInt!
+
+    # expectedDelegation is the expected amount of received delegations in WEI units.
+    expectedDelegation: BigInt!
+
+    # minSelfStake is the minimal self-stake required to launch a validator.
+    minSelfStake: BigInt!
+
+    # meetsMinSelfStake signals the self-stake is enough to launch a validator.
+    meetsMinSelfStake: Boolean!
+
+    # delegationLimit is the max total stake of the validator, including the self-stake,
+    # allowed by the max delegated ratio of the SFC contract.
+    delegationLimit: BigInt!
+
+    # effectiveDelegation is the expected delegation capped by the delegation limit;
+    # the income is projected from this amount.
+    effectiveDelegation: BigInt!
+
+    # saturationHeadroom is the amount of delegations the validator could still receive
+    # on top of the expected delegation before reaching the delegation limit.
+    saturationHeadroom: BigInt!
+
+    # saturated signals the expected delegation exceeds the delegation limit.
+    saturated: Boolean!
+
+    # commissionRate is the share of delegator rewards taken by the validator
+    # as a multiplier number with 18 decimals.
+    commissionRate: BigInt!
+
+    # selfStakeRewards is the projected epoch rewards of the self-stake.
+    selfStakeRewards: BigInt!
+
+    # commission is the projected epoch commission earned from delegators.
+    commission: BigInt!
+
+    # epochIncome is the projected total income of the validator in an epoch.
+    epochIncome: BigInt!
+
+    # dailyIncome is the projected total income of the validator per day.
+    dailyIncome: BigInt!
+}
+
+# CalendarEvent represents a known future time-based event relevant to a watched address,
+# e.g. a stake lock expiration, or a governance voting deadline.
+type CalendarEvent {
+    # type is the type of the event, one of LOCK_EXPIRATION, WITHDRAWAL_AVAILABLE,
+    # VOTING_OPENS, VOTING_MAY_END and VOTING_MUST_END.
+    type: String!
+
+    # time is the UNIX time stamp the event happens at.
+    time: Long!
+
+    # address is the watched address the event relates to;
+    # null for governance events shared by all the addresses.
+    address: Address
+
+    # validatorId is the validator of the delegation involved; delegation events only.
+    validatorId: BigInt
+
+    # amount is the amount of tokens involved in WEI; delegation events only.
+    amount: BigInt
+
+    # withdrawRequestId is the identifier of the withdraw request; WITHDRAWAL_AVAILABLE only.
+    withdrawRequestId: BigInt
+
+    # governance is the address of the Governance contract of the proposal; governance events only.
+    governance: Address
+
+    # proposalId is the identifier of the proposal; governance events only.
+    proposalId: BigInt
+
+    # title is a human readable description of the event.
+    title: String!
+}
+
+# Reindex represents the rebuild of an indexed collection in a shadow collection.
+# The API keeps serving from the live collection while the shadow one is built;
+# the shadow collection atomically replaces the live one once it catches up.
+type Reindex {
+    # collection is the name of the rebuilt collection.
+    collection: String!
+
+    # state is the state of the rebuild; one of "building", "catch-up", "switching", "switched" and "failed".
+    state: String!
+
+    # copied is the number of documents copied into the shadow collection.
+    copied: Long!
+
+    # started is the UNIX time stamp of the rebuild start.
+    started: Long!
+
+    # updated is the UNIX time stamp of the last progress of the rebuild.
+    updated: Long!
+
+    # finished is the UNIX time stamp of the switch, or the failure, of the rebuild.
+    finished: Long
+
+    # error is the reason of the failure of the rebuild, if failed.
+    error: String
+
+    # owner identifies the API server instance building the shadow collection, if in progress.
+    owner: String
+}
+
+# FMintPositionSnapshot represents the state of an open fMint position at a point in time.
+type FMintPositionSnapshot {
+    # address is the address of the fMint account.
+    address: Address!
+
+    # epoch is the id of the latest sealed epoch at the time of the snapshot.
+    epoch: Long!
+
+    # stamp is the UNIX time stamp of the snapshot.
+    stamp: Long!
+
+    # collateralValue is the value of the collateral in ref. denomination (fUSD).
+    collateralValue: BigInt!
+
+    # debtValue is the value of the debt in ref. denomination (fUSD).
+    debtValue: BigInt!
+
+    # ratio4 is the collateral to debt ratio in 4 digits.
+    ratio4: Long
+
+    # minRatio4 is the minimal collateral to debt ratio in 4 digits allowed
+    # by the fMint contract at the time of the snapshot; positions below it can be liquidated.
+    minRatio4: Long!
+}
+
+# StakeFlow represents the net movement of stake from one validator to another.
+type StakeFlow {
+    # Id of the validator the stake moved from.
+    fromId: BigInt!
+
+    # Detail of the validator the stake moved from.
+    from: Staker!
+
+    # Id of the validator the stake moved to.
+    toId: BigInt!
+
+    # Detail of the validator the stake moved to.
+    to: Staker!
+
+    # Net amount of stake moved in WEI.
+    amount: BigInt!
+
+    # Number of moves between the validators in both directions.
+    moves: Long!
+
+    # Number of delegators moving the stake between the validators.
+    delegators: Long!
+}
+
+# Root schema definition
+schema {
+    query: Query
+    mutation: Mutation
+    subscription: Subscription
+}
+
+# Entry points for querying the API
+type Query {
+    # version represents the API server version responding to your requests.
+    version: String! @cacheControl(maxAge: 300)
+
+    # State represents the current state of the blockchain and network.
+    state: CurrentState! @cacheControl(maxAge: 5)
+
+    # sfcConfig provides the current configuration
+    # of the SFC contract managing the block chain staking economy.
+    sfcConfig: SfcConfig! @cacheControl(maxAge: 60)
+
+    # Total number of accounts active on the AXIS blockchain.
+    accountsActive:Long!
+
+    # Get an Account information by hash address.
+    account(address:Address!):Account!
+
+    # Get accounts of the given addresses in the given order; up to 50 addresses.
+    # Balances of the accounts are loaded in a single batch.
+    accounts(addresses: [Address!]!): [Account!]!
+
+    # screenAddresses provides compliance screening results of the given addresses.
+    # If flaggedOnly is set, only flagged addresses are included in the result.
+    # The list is empty if the compliance screening is not enabled on the API server.
+    screenAddresses(addresses:[Address!]!, flaggedOnly: Boolean = false):[RiskFlag!]!
+
+    # Get list of Contracts with at most <count> edges.
+    # If <count> is positive, return edges after the cursor,
+    # if negative, return edges before the cursor.
+    # For undefined cursor, positive <count> starts the list from top,
+    # negative <count> starts the list from bottom.
+    # ValidatedOnly specifies if the list should contain all the Contracts,
+    # or just contracts with validated byte code and available source/ABI.
+    contracts(validatedOnly: Boolean = false, cursor:Cursor, count:Int!):ContractList!
+
+    # Get block information by number or by hash.
+    # If neither is provided, the most recent block is given.
+    block(number:Long, hash: Bytes32):Block
+
+    # Get raw block header with consensus details by number.
+    # The header is always loaded from the node directly, it's never cached.
+    blockHeader(number:Long!):BlockHeader
+
+    # Get Lachesis consensus event by its id. The node has to expose the DAG API namespace.
+    dagEvent(id: Bytes32!):DagEvent
+
+    # Get the list of head events of the DAG for the given epoch.
+    # If the epoch is not specified, the current epoch is used.
+    dagHeads(epoch: Long):[DagEvent!]!
+
+    # Get list of Blocks with at most <count> edges.
+    # If <count> is positive, return edges after the cursor,
+    # if negative, return edges before the cursor.
+    # For undefined cursor, positive <count> starts the list from top,
+    # negative <count> starts the list from bottom.
+    blocks(cursor:Cursor, count:Int!):BlockList!
+
+    # Get transaction information for given transaction hash.
+    transaction(hash:Bytes32!):Transaction
+
+    # Get list of Transactions with at most <count> edges.
+    # If <count> is positive, return edges after the cursor,
+    # if negative, return edges before the cursor.
+    # For undefined cursor, positive <count> starts the list from top,
+    # negative <count> starts the list from bottom.
+    # The list can be limited to transactions of the given block range,
+    # both <fromBlock> and <toBlock> boundaries are inclusive and optional.
+    # Transaction cursors are composed of the block number and the transaction index,
+    # so they remain valid as new blocks arrive and across chain reorganizations.
+    transactions(cursor:Cursor, count:Int!, fromBlock: Long, toBlock: Long):TransactionList!
+
+    # Get filtered list of ERC20 Transactions.
+    erc20Transactions(cursor:Cursor, count:Int = 25, token: Address, account: Address, txType: String): ERC20TransactionList!
+
+    # Get filtered list of ERC721 Transactions.
+    erc721Transactions(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, account: Address, txType: String): ERC721TransactionList!
+
+    # Get filtered list of ERC1155 Transactions.
+    erc1155Transactions(cursor:Cursor, count:Int = 25, token: Address, tokenId: BigInt, account: Address, txType: String): ERC1155TransactionList!
+
+    # Get the id of the current epoch of the AXIS blockchain.
+    currentEpoch:Long! @cacheControl(maxAge: 10)
+
+    # Get information about specified epoch. Returns current epoch information
+    # if id is not provided.
+    epoch(id: Long): Epoch! @cacheControl(maxAge: 30)
+
+    # Get a scrollable list of epochs sorted from the last one back by default.
+    epochs(cursor: Cursor, count: Int = 25): EpochList! @cacheControl(maxAge: 30)
+
+    # Get the validator set of the given sealed epoch including the stake weights
+    # of the validators as captured by the SFC epoch snapshot.
+    validatorsAt(epoch: Long!): [EpochValidator!]! @cacheControl(maxAge: 300)
+
+    # Get decentralization metrics of the validator set of the given sealed epoch,
+    # e.g. the stake share of the top validators and the Nakamoto coefficient.
+    # The latest sealed epoch is used if the epoch is not provided.
+    decentralization(epoch: Long): Decentralization!
+
+    # Get per-validator stake changes, joined and removed validators and the supply change
+    # between the sealed epochs a and b; useful to monitor unusual stake movements.
+    epochDiff(a: Long!, b: Long!): EpochDiff! @cacheControl(maxAge: 300)
+
+    # validatorEarnings provides self-stake rewards, commission earned from delegators
+    # and originated fees of the given validator in the given range of sealed epochs
+    # aggregated per epoch, or per day. The last 30 sealed epochs are reported
+    # if the range is not specified; at most 500 epochs can be reported at once.
+    validatorEarnings(id: BigInt!, from: Long, to: Long, resolution: String = "epoch"): ValidatorEarnings!
+
+    # createValidatorEstimate projects the epoch income, the self-stake requirement
+    # and the saturation headroom of a prospective validator with the given self-stake
+    # and the expected amount of received delegations, both in WEI units.
+    createValidatorEstimate(selfStake: BigInt!, expectedDelegation: BigInt!): ValidatorEstimate! @cacheControl(maxAge: 60)
+
+    # Get the share of the active stake on each validator node client version
+    # as published by validators in their staker info metadata. If the required version
+    # is provided, the share of the stake already running the version, or newer, is calculated.
+    upgradeReadiness(requiredVersion: String): UpgradeReadiness!
+
+    # The last staker id in AXIS blockchain.
+    lastStakerId: Long! @cacheControl(maxAge: 30)
+
+    # The number of stakers in AXIS blockchain.
+    stakersNum: Long! @cacheControl(maxAge: 30)
+
+    # Staker information. The staker is loaded either by numeric ID,
+    # or by address. null if none is provided.
+    staker(id: BigInt, address: Address): Staker @cacheControl(maxAge: 30)
+
+    # List of staker information from SFC smart contract.
+    stakers: [Staker!]! @cacheControl(maxAge: 30)
+
+    # The list of delegations for the given staker ID.
+    # Cursor is used to obtain specific slice of the staker's delegations.
+    # The most recent delegations are provided if cursor is omitted.
+    # Ordering by pending rewards is available for lists of up to 500 delegations.
+    delegationsOf(staker:BigInt!, cursor: Cursor, count: Int = 25, orderBy: DelegationOrderBy = CREATED_TIME, filter: DelegationFilter): DelegationList!
+
+    # Get the details of a specific delegation by it's delegator address
+    # and staker the delegation belongs to.
+    delegation(address:Address!, staker: BigInt!): Delegation
+
+    # Get the list of all delegations by it's delegator address.
+    delegationsByAddress(address:Address!, cursor: Cursor, count: Int = 25, orderBy: DelegationOrderBy = CREATED_TIME, filter: DelegationFilter): DelegationList!
+
+    # nextActions provides the list of actions recommended to the delegator
+    # on its delegations, e.g. to power wallet to-do lists. Pending rewards below
+    # the dust threshold are not recommended for claim.
+    nextActions(address: Address!): [DelegationAction!]!
+
+    # upcomingEvents provides the known future time-based events of the given addresses,
+    # i.e. delegation lock expirations and withdrawal availability, and the voting deadlines
+    # of open governance proposals, sorted by time, e.g. to render a wallet calendar.
+    # Up to 20 addresses can be watched at once.
+    upcomingEvents(addresses: [Address!]!): [CalendarEvent!]!
+
+    # sAXISSupply provides the daily history of sAXIS tokens minted, burned
+    # and outstanding in the given number of recent days, up to 365 days.
+    sAXISSupply(days: Int = 30): [SAXISSupply!]!
+
+    # sAXISCollateral provides the amount of sAXIS tokens outstanding
+    # by validator, the largest first. Tokens minted through intermediate
+    # contracts are not attributed to a validator.
+    sAXISCollateral: [SAXISCollateral!]!
+
+    # Returns the current price per gas in WEI units.
+    gasPrice: Long! @cacheControl(maxAge: 5)
+
+    # estimateGas returns the estimated amount of gas required
+    # for the transaction described by the parameters of the call.
+    estimateGas(from: Address, to: Address, value: BigInt, data: String): Long
+
+    # decodeTransaction decodes raw RLP encoded transaction without sending it
+    # to the block chain. Both signed and unsigned transactions are accepted;
+    # the sender is recovered from the signature of signed transactions.
+    decodeTransaction(rawRlp: Bytes!): DecodedTransaction!
+
+    # contractCall executes a read-only call of the given contract with the given input data
+    # and provides the output decoded by the ABI of the contract, if known. The ABI of a validated
+    # contract source, or a custom ABI registered by the registerAbi mutation, is used.
+    # The state of the given block is read; the default call block is used if not specified,
+    # or if the block is more recent than the call block. The gas of the call is capped.
+    # Requires an API key.
+    contractCall(contract: Address!, input: Bytes!, block: Long): ContractCallResult!
+
+    # Get price details of the AXIS blockchain token for the given target symbols.
+    price(to:String!):Price!
+
+    # Get calculated staking rewards for an account or given
+    # staking amount in AXIS tokens.
+    # At least one of the address and amount parameters must be provided.
+    # If you provide both, the address takes precedence and the amount is ignored.
+    # The reward parameters and the total stake effective at the given sealed epoch
+    # are used if the epoch is provided; the latest sealed epoch is used otherwise.
+    estimateRewards(address:Address, amount:Long, epoch:Long):EstimatedRewards!
+
+    # sfcRewardsCollectedAmount provides an amount of rewards collected based on given
+    # filtering options, which are all optional. If no filter option is passed,
+    # the total amount of collected rewards is being presented.
+    sfcRewardsCollectedAmount(delegator: Address, staker: BigInt, since: Long, until: Long): BigInt!
+
+    # defiConfiguration exposes the current DeFi contract setup.
+    defiConfiguration:DefiSettings! @cacheControl(maxAge: 60)
+
+    # defiTokens represents a list of all available DeFi tokens.
+    defiTokens:[DefiToken!]! @cacheControl(maxAge: 60)
+
+    # defiOverview provides network-wide aggregates of the DeFi modules,
+    # e.g. total value locked and AMM swap volumes. The aggregates are
+    # refreshed regularly; the same data are available on /json/defi end-point.
+    defiOverview: DefiOverview!
+
+    # defiNativeToken represents the information about the native token
+    # wrapper ERC20 contract. Returns NULL if the native token wraper
+    # is not available.
+    defiNativeToken: ERC20Token
+
+    # fMintAccount provides DeFi/fMint information about an account on fMint protocol.
+    fMintAccount(owner: Address!):FMintAccount!
+
+    # positionHistory provides periodic snapshots of the fMint position of the given address
+    # taken in the given number of recent days, up to 366, the oldest first. Snapshots are taken
+    # only while the position has any debt, so gaps represent periods with the position closed.
+    positionHistory(address: Address!, days: Int = 30): [FMintPositionSnapshot!]!
+
+    # fMintTokenAllowance resolves the amount of ERC20 tokens unlocked
+    # by the token owner for DeFi/fMint operations.
+    fMintTokenAllowance(owner: Address!, token: Address!):BigInt!
+
+    # fMintUserTokens resolves a list of pairs of fMint users and their tokens
+    # used for a specified purpose.
+    fMintUserTokens(purpose:FMintUserTokenPurpose=FMINT_COLLATERAL):[FMintUserToken!]!
+
+    # maxMintable calculates the max amount of the given token the fMint account
+    # can mint without dropping below the minimal collateral to debt ratio
+    # of the fMint contract. The minting fee added to the debt is taken into account.
+    maxMintable(address: Address!, token: Address!): BigInt!
+
+    # repayRequiredForRatio calculates the debt the fMint account has to repay
+    # to reach the given collateral to debt ratio. The target ratio is represented
+    # in 4 digits, e.g. value 30000 = 3.0x. The amount is provided in fUSD value,
+    # or in the units of the debt token, if the token is specified.
+    # Zero is returned if the account already meets the ratio.
+    repayRequiredForRatio(address: Address!, targetRatio: Long!, token: Address): BigInt!
+
+    # collateralAlerts provides the list of fMint collateral ratio alerts
+    # registered by the calling API key. Requires an API key.
+    collateralAlerts: [CollateralAlert!]!
+
+    # balanceAlerts provides the list of account balance alerts
+    # registered by the calling API key. Requires an API key.
+    balanceAlerts: [BalanceAlert!]!
 
     # defiUniswapPairs represents a list of all pairs managed
     # by the Uniswap Core contract on AXIS blockchain.
-    defiUniswapPairs: [UniswapPair!]!
+    defiUniswapPairs: [UniswapPair!]! @cacheControl(maxAge: 60)
 
     # defiUniswapAmountsOut calculates the expected output amounts
     # required to finalize a swap operation specified by a list of
@@ -2128,6 +4461,13 @@ type Query {
     # for the calculation to succeed.
     defiUniswapAmountsOut(amountIn: BigInt!, tokens:[Address!]!): [BigInt!]!
 
+    # quoteSwap finds the best route of swapping the input amount of tokenIn
+    # to tokenOut through known Uniswap pairs, either directly, or through up to three hops.
+    # The router call data for signing are included if the recipient is provided; the minimal
+    # output amount of the call is derived from the slippage tolerance, i.e. 0.005 for 0.5%.
+    # Null is returned if the tokens are not connected by any route.
+    quoteSwap(tokenIn: Address!, tokenOut: Address!, amountIn: BigInt!, recipient: Address, slippage: Float = 0.005): SwapQuote
+
     # defiUniswapAmountsIn calculates the expected input amounts
     # required to finalize a swap operation specified by a list of
     # tokens involved in the swap steps and the output amount.
@@ -2201,6 +4541,10 @@ type Query {
     # identified by it's ERC20 contract address.
     ercTokenBalance(owner: Address!, token: Address!):BigInt!
 
+    # erc20BalancesBulk provides balances of the given ERC20 tokens of the owner
+    # in the given order; up to 50 tokens. The balances are loaded in a single batch.
+    erc20BalancesBulk(owner: Address!, tokens: [Address!]!): [ERC20Balance!]!
+
     # ercTokenAllowance provides the current amount of ERC20 tokens unlocked
     # by the token owner for the spender to be manipulated with.
     ercTokenAllowance(token: Address!, owner: Address!, spender: Address!):BigInt!
@@ -2232,7 +4576,36 @@ type Query {
     # trxVolume provides a list of daily aggregations of the network transaction flow.
     # If boundaries are not defined, last 90 days of aggregated trx flow is provided.
     # Boundaries are defined in format YYYY-MM-DD, i.e. 2021-01-23 for January 23rd, 2021.
-    trxVolume(from:String, to:String):[DailyTrxVolume!]!
+    trxVolume(from:String, to:String):[DailyTrxVolume!]! @cacheControl(maxAge: 300)
+
+    # gasPriceHistory provides a list of daily gas price distributions
+    # of transactions on the network for fee analytics.
+    # If boundaries are not defined, last 90 days are provided.
+    # Boundaries are defined in format YYYY-MM-DD, i.e. 2021-01-23 for January 23rd, 2021.
+    gasPriceHistory(from:String, to:String):[DailyGasPrice!]! @cacheControl(maxAge: 300)
+
+    # activityHeatmap provides the number of transactions of the account on each day
+    # of the last year, i.e. for a profile activity calendar. Only days with any activity
+    # are included, ordered from the oldest to the most recent.
+    activityHeatmap(address: Address!):[AccountActivityDay!]!
+
+    # taxReport provides staking rewards claimed, or re-staked by the delegator
+    # in the given calendar year valued in the fiat currency at the day of the claim.
+    # The report is not available to sandbox API keys.
+    taxReport(address: Address!, year: Int!, currency: String = "USD"): TaxReport!
+
+    # accountExport provides the state of an asynchronous export of account transactions
+    # started by the calling API key; NULL if not found, or expired. Requires an API key.
+    accountExport(id: String!): AccountExport
+
+    # addressGroups provides the list of address groups defined
+    # by the calling API key. Requires an API key.
+    addressGroups: [AddressGroup!]!
+
+    # portfolio provides aggregated balances, staking positions and activity
+    # of all the addresses of the given group owned by the calling API key.
+    # Requires an API key.
+    portfolio(groupId: String!): Portfolio!
 
     # trxSpeed provides the recent speed of the network
     # as number of transactions processed per second
@@ -2246,6 +4619,85 @@ type Query {
     # The range represents the number of seconds prior the end time stamp
     # we use to calculate the average gas consumption.
     trxGasSpeed(range: Int = 1200, to: String): Float!
+
+    # feesPaid provides a report of transaction fees paid by the given address
+    # broken down by periods and by contracts the address interacted with.
+    # Boundaries are defined in format YYYY-MM-DD, i.e. 2021-01-23 for January 23rd, 2021.
+    # If boundaries are not defined, last 90 days of fees are reported.
+    # The resolution can be either "month", or "day".
+    feesPaid(address: Address!, from: String, to: String, resolution: String = "month"): FeesPaidReport!
+
+    # counterparties provides the list of top counterparties of the given address,
+    # e.g. the addresses the account interacted with the most, sorted by the number
+    # of transactions. The period can be "day", "week", "month", "year", or "all".
+    # At most <count> counterparties are provided, the count is limited to 100.
+    counterparties(address: Address!, period: String = "month", count: Int = 25): [Counterparty!]!
+
+    # stakeFlows provides the list of the largest net stake movements between validators
+    # inferred from undelegations followed by delegations of the same delegators to another
+    # validator within 30 days. Opposite movements between a pair of validators are netted.
+    # The period can be "day", "week", "month", "year", or "all"; movements are tracked
+    # since the delegation events were first indexed by the API server.
+    # At most <count> flows are provided, the count is limited to 100.
+    stakeFlows(period: String = "month", count: Int = 25): [StakeFlow!]!
+
+    # logLevels provides the current log levels of the API server logging subsystems.
+    # Requires an admin API key.
+    logLevels: [LogLevel!]!
+
+    # maintenance provides the state of the read-only maintenance mode of the API server.
+    # Mutations and heavy list queries are rejected with MAINTENANCE error code
+    # while the mode is enabled.
+    maintenance: MaintenanceState!
+
+    # integrityReport provides the summary of data integrity checks performed
+    # by the API server, i.e. the comparison of distributed epoch rewards
+    # with the rewards derived from the epoch fee and base reward per second,
+    # or the sampling of indexed blocks against the connected node.
+    # Requires an admin API key.
+    integrityReport: IntegrityReport!
+
+    # webhookDeliveries provides the most recent webhook notifications delivery log,
+    # optionally filtered by the delivery status. The status is one of "pending", "retrying",
+    # "delivered" and "dead"; the count is limited to 200. Requires an admin API key.
+    webhookDeliveries(status: String, count: Int = 50): [WebhookDelivery!]!
+
+    # reindexes provides the state of the rebuilds of the indexed collections
+    # in shadow collections, the most recent first. Requires an admin API key.
+    reindexes: [Reindex!]!
+
+    # nodeInfo provides diagnostic information about the blockchain node
+    # backing the API server. Requires an API key.
+    nodeInfo: NodeInfo!
+
+    # subscriptionUsage provides the current websocket subscriptions usage and limits.
+    # Admin API keys see the usage of all the clients, other keys see their own usage only.
+    # Requires an API key.
+    subscriptionUsage: [SubscriptionUsage!]!
+
+    # subscriptionDiagnostics provides the delivery failures of websocket subscriptions,
+    # i.e. rejected subscriptions, dropped events, buffer overruns and disconnect reasons,
+    # so integrators can find out why their clients miss events. Admin API keys see
+    # the diagnostics of all the clients, other keys see their own only. Requires an API key.
+    subscriptionDiagnostics: [SubscriptionDiagnostics!]!
+
+    # status provides the state of the API server components, i.e. the sync status,
+    # node, subscriptions and storage health, and the recent incident markers,
+    # so operators can drive a public status page straight from the API.
+    status: Status! @cacheControl(maxAge: 10)
+
+    # enumLabels provides human-readable labels of values of the given enumeration,
+    # e.g. ValidatorExitReason, in the language picked by the Accept-Language header.
+    # Values without a localized label are labeled by the value itself.
+    enumLabels(enum: String!): [EnumLabel!]!
+
+    # network provides the identity of the blockchain network served by the API
+    # so multi-network clients can configure themselves from the API alone.
+    network: Network!
+
+    # networkMetrics provides the observed production of new blocks by the network;
+    # abnormal gaps between blocks are an early warning of a chain halt.
+    networkMetrics: NetworkMetrics!
 }
 
 # Mutation endpoints for modifying the data
@@ -2260,15 +4712,167 @@ type Mutation {
     # Returns updated contract information. If the contract can not be validated,
     # it raises a GraphQL error.
     validateContract(contract: ContractValidationInput!): Contract!
+
+    # setLogLevel adjusts the log level of the given logging subsystem at runtime.
+    # Known subsystems are "rpc", "observer", "indexer" and "graphql", the level is one of
+    # CRITICAL, ERROR, WARNING, NOTICE, INFO and DEBUG. Requires an admin API key.
+    setLogLevel(subsystem: String!, level: String!): LogLevel!
+
+    # setMaintenance switches the read-only maintenance mode on, or off.
+    # Mutations and heavy list queries are rejected while the mode is enabled,
+    # core queries stay online. Requires an admin API key.
+    setMaintenance(enabled: Boolean!, reason: String): MaintenanceState!
+
+    # openStatusIncident publishes an incident marker on the status page.
+    # The severity is one of "minor", "major" and "critical"; open major incidents
+    # report the service degraded, critical ones report an outage. Requires an admin API key.
+    openStatusIncident(severity: String!, title: String!, message: String): StatusIncident!
+
+    # resolveStatusIncident marks the incident marker resolved, optionally
+    # updating its message. Requires an admin API key.
+    resolveStatusIncident(id: String!, message: String): StatusIncident!
+
+    # startReindex starts the rebuild of the given indexed collection, e.g. "transaction",
+    # in a shadow collection built in the background while the API keeps serving from
+    # the live collection; the shadow collection replaces the live one once it catches up.
+    # Requires an admin API key.
+    startReindex(collection: String!): Reindex!
+
+    # recomputeDelegationRewards recomputes the recent reward claims of the delegation
+    # from the archive state right before each claim and compares them with the values
+    # derived from the SFC events, so the indexer correctness can be validated after upgrades.
+    # Mismatches are recorded in the integrity report. The node has to keep the archive state.
+    # The count is limited to 100. Requires an admin API key.
+    recomputeDelegationRewards(address: Address!, staker: BigInt!, count: Int = 25): RewardRecomputationReport!
+
+    # watchDelegationRewards adds the delegation to the watch list of the pending rewards
+    # reminder; a webhook reminder is sent when the pending rewards of the delegation are worth
+    # claiming. Delegations of the server configuration are always watched. Requires an admin API key.
+    watchDelegationRewards(address: Address!, validatorId: BigInt!): Boolean!
+
+    # unwatchDelegationRewards removes the delegation from the watch list of the pending rewards
+    # reminder. False is returned if the delegation has not been watched. Requires an admin API key.
+    unwatchDelegationRewards(address: Address!, validatorId: BigInt!): Boolean!
+
+    # registerAbi stores a custom ABI of the given contract used to decode
+    # transactions sent to the contract, their event logs and contract calls, so integrators
+    # can get decoded data for their own contracts without validated source code.
+    # The abiJson is the standard JSON ABI definition. Requires an API key; the ABI
+    # of a contract with validated source, or an ABI registered by another key,
+    # can be replaced by an admin API key only. ABIs registered by other than admin
+    # API keys are not used for decoding until approved by the approveAbi mutation.
+    registerAbi(address: Address!, abiJson: String!): Boolean!
+
+    # approveAbi approves the pending custom ABI of the given contract registered
+    # by the registerAbi mutation, so it is used for decoding. Requires an admin API key.
+    approveAbi(address: Address!): Boolean!
+
+    # buildMintSAXISTx prepares an unsigned SFC Tokenizer transaction minting sAXIS tokens
+    # for the locked stake of the delegator to the given validator not tokenized yet.
+    # The transaction has to be signed and submitted by the delegator.
+    buildMintSAXISTx(delegator: Address!, validatorId: BigInt!): PreparedTransaction!
+
+    # buildBurnSAXISTx prepares an unsigned SFC Tokenizer transaction burning the given amount
+    # of sAXIS tokens minted for the delegation to the given validator.
+    # The delegator has to hold enough sAXIS tokens to burn.
+    buildBurnSAXISTx(delegator: Address!, validatorId: BigInt!, amount: BigInt!): PreparedTransaction!
+
+    # buildErc20ApproveTx prepares an unsigned ERC20 transaction approving the spender
+    # to transfer the given amount of tokens of the owner. The amount is in token units
+    # with decimals, e.g. "12.5", validated against the decimals of the token.
+    # Zero amount revokes the approval. The transaction has to be signed by the owner.
+    buildErc20ApproveTx(owner: Address!, token: Address!, spender: Address!, amount: String!): PreparedTransaction!
+
+    # buildErc20TransferTx prepares an unsigned ERC20 transaction sending the given amount
+    # of tokens to the recipient. The amount is in token units with decimals, e.g. "12.5".
+    # If the owner is given and differs from the sender, the tokens are sent from the owner's
+    # balance using the allowance of the sender (transferFrom). The transaction has to be signed by the sender.
+    buildErc20TransferTx(sender: Address!, token: Address!, recipient: Address!, amount: String!, owner: Address): PreparedTransaction!
+
+    # validateStakeAction checks the intended staking operation against the SFC contract
+    # constraints (min stake, validator saturation, lock bounds, withdrawal period)
+    # before the user signs the transaction. Nothing is changed on the block chain.
+    # The action is one of DELEGATE, UNDELEGATE, LOCK, UNLOCK and WITHDRAW;
+    # the amount is ignored by WITHDRAW, the duration is the lock duration in seconds used by LOCK only.
+    validateStakeAction(action: String!, address: Address!, validatorID: BigInt!, amount: BigInt!, duration: Long): StakeActionValidation!
+
+    # registerCollateralAlert registers a collateral to debt ratio threshold
+    # of the given fMint account. The threshold is represented in 4 digits,
+    # e.g. value 30000 = 3.0x. An alert is sent to onCollateralAlert subscribers
+    # and to the optional webhook each time the ratio crosses the threshold.
+    # Requires an API key; the alert is owned by the key.
+    registerCollateralAlert(address: Address!, threshold4: Long!, webhook: String): CollateralAlert!
+
+    # removeCollateralAlert removes a collateral ratio alert owned by the calling API key.
+    removeCollateralAlert(id: String!): Boolean!
+
+    # registerBalanceAlert registers min and/or max thresholds of the native balance
+    # of the given account, or of its balance of the given ERC20 token. An alert is sent
+    # to the webhook each time the balance drops below the min, rises above the max,
+    # or returns in between. Requires an API key; the alert is owned by the key.
+    registerBalanceAlert(address: Address!, token: Address, min: BigInt, max: BigInt, webhook: String!): BalanceAlert!
+
+    # removeBalanceAlert removes a balance alert owned by the calling API key.
+    removeBalanceAlert(id: String!): Boolean!
+
+    # startAccountExport starts an asynchronous export of all the transactions
+    # of the given account. The export is built in the background, poll it by
+    # the accountExport query, or subscribe to onAccountExport to learn about
+    # its completion and the download link. Requires an API key; the export
+    # is not available to sandbox API keys.
+    startAccountExport(address: Address!): AccountExport!
+
+    # createAddressGroup defines a named group of addresses, e.g. linked wallets
+    # of a single user, to be queried as a single portfolio. Requires an API key;
+    # the group is owned by the key. Up to 50 addresses can be grouped.
+    createAddressGroup(name: String!, addresses: [Address!]!): AddressGroup!
+
+    # updateAddressGroup changes the name and/or the list of addresses
+    # of an address group owned by the calling API key.
+    updateAddressGroup(id: String!, name: String, addresses: [Address!]): AddressGroup!
+
+    # removeAddressGroup removes an address group owned by the calling API key.
+    removeAddressGroup(id: String!): Boolean!
+
+    # createSandboxKey mints a self-service sandbox API key for prototyping
+    # against the API. Sandbox keys are rate limited, expire after a while
+    # and grant the access of anonymous clients only, i.e. no bulk data exports
+    # and no calls requiring an API key. The number of valid keys per client
+    # address is limited. Send the key in the X-Api-Key header.
+    createSandboxKey: SandboxKey!
 }
 
-# Subscriptions to live events broadcasting
+# Subscriptions to live events broadcasting. Events of each subscription carry
+# their EventSequence and are delivered in strictly increasing order of it;
+# a subscriber too slow to receive an event is dropped, so a consumer resuming
+# after a closed subscription detects the gap and back-fills it from queries.
 type Subscription {
     # Subscribe to receive information about new blocks in the blockchain.
     onBlock: Block!
 
+    # Subscribe to receive blocks once they are final. Lachesis blocks are final
+    # on creation; if the server is configured with a finality depth, the block is
+    # delivered once the configured number of blocks is created on top of it.
+    onBlockFinalized: Block!
+
     # Subscribe to receive information about new transactions in the blockchain.
     onTransaction: Transaction!
+
+    # Subscribe to receive collateral ratio alerts registered by the calling API key.
+    onCollateralAlert: CollateralAlertEvent!
+
+    # Subscribe to receive base fee, gas usage and suggested tip of new blocks.
+    onFees: FeeTrend!
+
+    # Subscribe to receive price updates of the given ERC20 token. The price is checked
+    # on new blocks, so on-chain oracle updates are delivered as soon as they are mined.
+    # An update is sent only if the price changed. The minInterval is the minimal number
+    # of seconds between updates; the server enforces its own minimum.
+    onPrice(token: Address!, minInterval: Int): TokenPriceUpdate!
+
+    # Subscribe to receive the account export started by the calling API key
+    # once it finishes, or fails. The subscription ends with the event.
+    onAccountExport(id: String!): AccountExport!
 }
 
 `
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"axis-graphql/internal/repository"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// AccountExport constructs HTTP handler serving files of finished account exports.
+// The request is authorized by the signature of the download link, no API key is needed,
+// so the link can be passed to a browser, or a download manager. The connection is taken over
+// from the HTTP server, so large downloads are not cut by the write timeout of regular API requests.
+func AccountExport(cfg *config.AccountExport, log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, cfg.Path)
+		expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+		if err != nil || id == "" {
+			http.Error(w, "invalid download link", http.StatusBadRequest)
+			return
+		}
+
+		f, err := repository.R().AccountExportFile(id, expires, r.URL.Query().Get("sig"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Errorf("can not close account export %s; %s", id, err.Error())
+			}
+		}()
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"account-export-%s.csv\"", id))
+		w.Header().Set("Content-Length", strconv.FormatInt(f.Length, 10))
+		w.Header().Set("Last-Modified", f.Modified.UTC().Format(http.TimeFormat))
+		sw, _, cancel, err := newStreamWriter(w)
+		if err != nil {
+			log.Errorf("can not take over account export %s connection; %s", id, err.Error())
+			http.Error(w, "download not supported", http.StatusInternalServerError)
+			return
+		}
+		defer cancel()
+		defer func() {
+			if err := sw.Close(); err != nil {
+				log.Debugf("can not close account export %s connection; %s", id, err.Error())
+			}
+		}()
+
+		sw.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(sw, f); err != nil {
+			log.Debugf("account export %s download interrupted; %s", id, err.Error())
+		}
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"net/http"
 
 	"github.com/graph-gophers/graphql-go"
-	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/graph-gophers/graphql-transport-ws/graphqlws"
 	"github.com/rs/cors"
 )
@@ -20,17 +19,65 @@ func Api(cfg *config.Config, log logger.Logger, rs resolvers.ApiResolver) http.H
 	corsHandler := cors.New(corsOptions(cfg))
 	corsHandler.Log = log
 
-	// we don't want to write a method for each type field if it could be matched directly
-	opts := []graphql.SchemaOpt{graphql.UseFieldResolvers()}
+	// we don't want to write a method for each type field if it could be matched directly;
+	// the tracer collects cache hints of fields resolved by GET queries
+	tracer := new(cacheControlTracer)
+	opts := []graphql.SchemaOpt{graphql.UseFieldResolvers(), graphql.Tracer(tracer)}
 
 	// create new parsed GraphQL schema
 	schema := graphql.MustParseSchema(gqlSchema.Schema(), rs, opts...)
+	tracer.hints = newCacheHints(schema.ASTSchema())
+	registerEnums(schema.ASTSchema())
+
+	// the authentication is shared with subscriptions authenticated by the connection init payload
+	auth := newAuthHandler(cfg, log, nil)
+
+	// operations are executed by worker pools of their priority class, if enabled
+	var sched *opScheduler
+	if cfg.Scheduler.Enabled {
+		log.Notice("operations priority scheduling enabled")
+		sched = newOpScheduler(&cfg.Scheduler)
+	}
+
+	// legacy field and argument names are mapped to the current schema, if configured
+	compat := newCompatLayer(&cfg.Compat, schema.ASTSchema())
+
+	// websocket connections need the API key of the upgraded request to authenticate subscriptions
+	wsOpt := graphqlws.WithContextGenerator(graphqlws.ContextGeneratorFunc(wsApiKeyContext))
+	handler := http.Handler(graphqlws.NewHandlerFunc(
+		&SubscriptionGuard{logger: log, cfg: &cfg.Subscriptions, auth: auth, schema: schema, staleAfter: cfg.Server.StaleAfter},
+		&BatchHandler{logger: log, schema: schema, maxSize: cfg.Server.MaxResponseSize, sched: sched, compat: compat, staleAfter: cfg.Server.StaleAfter}, wsOpt))
+
+	// production mode serves unauthenticated clients by a schema without introspection
+	if cfg.Production.Enabled {
+		log.Notice("production hardening mode enabled")
+		public := graphql.MustParseSchema(gqlSchema.Schema(), rs, append(opts, graphql.DisableIntrospection())...)
+		publicHandler := graphqlws.NewHandlerFunc(
+			&SubscriptionGuard{logger: log, cfg: &cfg.Subscriptions, auth: auth, schema: public, allowList: allowList(&cfg.Production), staleAfter: cfg.Server.StaleAfter},
+			&BatchHandler{logger: log, schema: public, maxSize: cfg.Server.MaxResponseSize, sched: sched, compat: compat, staleAfter: cfg.Server.StaleAfter}, wsOpt)
+
+		handler = &ProductionHandler{
+			logger:  log,
+			cfg:     &cfg.Production,
+			public:  publicHandler,
+			private: handler,
+		}
+	}
 
 	// return the constructed API handler chain
+	auth.handler = handler
 	return &LoggingHandler{
 		logger:  log,
-		handler: corsHandler.Handler(graphqlws.NewHandlerFunc(schema, &relay.Handler{Schema: schema})),
+		handler: corsHandler.Handler(auth),
+	}
+}
+
+// allowList provides the persisted queries allow list of the production mode, nil if not configured.
+func allowList(cfg *config.Production) map[string]string {
+	if len(cfg.AllowList) == 0 {
+		return nil
 	}
+	return cfg.AllowList
 }
 
 // corsOptions constructs new set of options for the CORS handler based on provided configuration.
@@ -38,7 +85,7 @@ func corsOptions(cfg *config.Config) cors.Options {
 	return cors.Options{
 		AllowedOrigins: cfg.Server.CorsOrigin,
 		AllowedMethods: []string{"HEAD", "GET", "POST"},
-		AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With"},
+		AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", "Authorization", "X-Api-Key"},
 		MaxAge:         300,
 	}
 }
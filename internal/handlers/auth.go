@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/logger"
+	"axis-graphql/internal/repository"
+	"context"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// AuthHandler defines HTTP handler middleware for authenticating incoming requests by API keys.
+// Requests without an API key are passed through anonymously, requests with an unknown key are rejected.
+// Self-service sandbox keys are accepted if enabled; their requests are rate limited.
+// Anonymous requests are rate limited by the client address, never above the sandbox limit.
+type AuthHandler struct {
+	logger  logger.Logger
+	keys    []config.ApiKey
+	sandbox *config.Sandbox
+	limiter *rateLimiter
+	anon    *rateLimiter
+	proxies proxyList
+	handler http.Handler
+}
+
+// newAuthHandler creates a new API keys authentication middleware for the given handler.
+func newAuthHandler(cfg *config.Config, log logger.Logger, next http.Handler) *AuthHandler {
+	// anonymous clients can not get a better service than sandbox keys
+	anon := cfg.Sandbox.AnonymousRateLimit
+	if anon <= 0 || anon > cfg.Sandbox.RateLimit {
+		anon = cfg.Sandbox.RateLimit
+	}
+
+	return &AuthHandler{
+		logger:  log,
+		keys:    cfg.Auth.Keys,
+		sandbox: &cfg.Sandbox,
+		limiter: newRateLimiter(cfg.Sandbox.RateLimit),
+		anon:    newRateLimiter(anon),
+		proxies: newProxyList(&cfg.Server),
+		handler: next,
+	}
+}
+
+// ServeHTTP handles incoming request by resolving the API key used, if any,
+// and passing it to the next handler in the chain inside the request context.
+func (h *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// pass the client address to resolvers
+	addr := h.proxies.clientAddr(r)
+	r = r.WithContext(resolvers.ContextWithClientAddr(r.Context(), addr))
+
+	// any key used? anonymous clients are rate limited by their address
+	key := requestApiKey(r)
+	if key == "" {
+		if !h.anon.allow(addr) {
+			h.logger.Debugf("anonymous client %s over the rate limit", addr)
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	// find the key
+	ak := h.find(key)
+	if ak == nil {
+		h.logger.Warningf("invalid API key used by %s", r.RemoteAddr)
+		http.Error(w, "invalid API key", http.StatusUnauthorized)
+		return
+	}
+
+	// sandbox keys are rate limited
+	if ak.Sandbox && !h.limiter.allow(ak.Name) {
+		h.logger.Debugf("sandbox key %s of %s over the rate limit", ak.Name, r.RemoteAddr)
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return
+	}
+
+	h.logger.Debugf("request from %s authenticated as %s", r.RemoteAddr, ak.Name)
+	h.handler.ServeHTTP(w, r.WithContext(resolvers.ContextWithApiKey(r.Context(), ak)))
+}
+
+// find looks for the given API key in the list of configured keys.
+func (h *AuthHandler) find(key string) *config.ApiKey {
+	for i := range h.keys {
+		if subtle.ConstantTimeCompare([]byte(h.keys[i].Key), []byte(key)) == 1 {
+			return &h.keys[i]
+		}
+	}
+
+	// try the sandbox keys
+	if !h.sandbox.Enabled {
+		return nil
+	}
+	sk, err := repository.R().SandboxKey(key)
+	if err != nil || sk == nil {
+		return nil
+	}
+	return &config.ApiKey{Name: sk.Name, Sandbox: true}
+}
+
+// proxyList represents the list of trusted reverse proxies allowed to pass
+// the address of their clients in the X-Forwarded-For header.
+type proxyList []*net.IPNet
+
+// newProxyList creates the list of trusted proxies from the configured addresses and ranges.
+// The configuration is validated on load, invalid entries are skipped.
+func newProxyList(cfg *config.Server) proxyList {
+	list := make(proxyList, 0, len(cfg.TrustedProxies))
+	for _, addr := range cfg.TrustedProxies {
+		if ipn, err := config.ParseProxy(addr); err == nil {
+			list = append(list, ipn)
+		}
+	}
+	return list
+}
+
+// trusted checks if the given address belongs to a trusted proxy.
+func (pl proxyList) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipn := range pl {
+		if ipn.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientAddr extracts the address of the client of the request. The X-Forwarded-For header
+// is followed from the closest hop only through trusted proxies, so a client can not
+// pick its own address by sending the header.
+func (pl proxyList) clientAddr(r *http.Request) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	if !pl.trusted(addr) {
+		return addr
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		addr = hop
+		if !pl.trusted(hop) {
+			break
+		}
+	}
+	return addr
+}
+
+// requestApiKey extracts the API key from the request headers.
+// Both X-Api-Key and bearer Authorization headers are accepted.
+func requestApiKey(r *http.Request) string {
+	if key := r.Header.Get("X-Api-Key"); key != "" {
+		return key
+	}
+
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// wsApiKeyContext passes the API key of the upgraded request, if any,
+// to the context of the websocket connection so subscriptions can be authenticated.
+func wsApiKeyContext(ctx context.Context, r *http.Request) (context.Context, error) {
+	ctx = resolvers.ContextWithClientAddr(ctx, resolvers.ClientAddrFromContext(r.Context()))
+	if key := resolvers.ApiKeyFromContext(r.Context()); key != nil {
+		return resolvers.ContextWithApiKey(ctx, key), nil
+	}
+	return ctx, nil
+}
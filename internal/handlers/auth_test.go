@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+// newTestAuthHandler creates an authentication handler of the given sandbox config
+// passing authenticated requests to an empty handler.
+func newTestAuthHandler(sbx config.Sandbox) *AuthHandler {
+	cfg := new(config.Config)
+	cfg.Log.Format = "%{message}"
+	cfg.Auth.Keys = []config.ApiKey{{Key: "partner-key", Name: "partner"}}
+	cfg.Sandbox = sbx
+	return newAuthHandler(cfg, logger.New(cfg), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+}
+
+// serveTest sends a request from the given client address with the given API key, if any.
+func serveTest(h http.Handler, addr string, key string) int {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	r.RemoteAddr = addr + ":4000"
+	if key != "" {
+		r.Header.Set("X-Api-Key", key)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestAuthAnonymousRateLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	h := newTestAuthHandler(config.Sandbox{RateLimit: 5, AnonymousRateLimit: 2})
+
+	g.Expect(serveTest(h, "10.0.0.1", "")).To(gomega.Equal(http.StatusOK))
+	g.Expect(serveTest(h, "10.0.0.1", "")).To(gomega.Equal(http.StatusOK))
+	g.Expect(serveTest(h, "10.0.0.1", "")).To(gomega.Equal(http.StatusTooManyRequests))
+
+	// other clients and configured keys are not affected
+	g.Expect(serveTest(h, "10.0.0.2", "")).To(gomega.Equal(http.StatusOK))
+	for i := 0; i < 10; i++ {
+		g.Expect(serveTest(h, "10.0.0.1", "partner-key")).To(gomega.Equal(http.StatusOK))
+	}
+}
+
+func TestAuthAnonymousRateLimitCapped(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// anonymous limit above the sandbox tier, or missing, falls back to the sandbox limit
+	for _, anon := range []int{0, 100} {
+		h := newTestAuthHandler(config.Sandbox{RateLimit: 3, AnonymousRateLimit: anon})
+		g.Expect(h.anon.limit).To(gomega.Equal(3))
+	}
+}
+
+func TestAuthInvalidKey(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	h := newTestAuthHandler(config.Sandbox{RateLimit: 5})
+	g.Expect(serveTest(h, "10.0.0.1", "unknown-key")).To(gomega.Equal(http.StatusUnauthorized))
+}
+
+func TestClientAddr(t *testing.T) {
+	tests := []struct {
+		name   string
+		remote string
+		xff    []string
+		addr   string
+	}{
+		{name: "direct client", remote: "203.0.113.7", addr: "203.0.113.7"},
+		{name: "header of untrusted peer ignored", remote: "203.0.113.7", xff: []string{"198.51.100.1"}, addr: "203.0.113.7"},
+		{name: "trusted proxy", remote: "10.0.0.5", xff: []string{"198.51.100.1"}, addr: "198.51.100.1"},
+		{name: "trusted proxy without header", remote: "10.0.0.5", addr: "10.0.0.5"},
+		{name: "spoofed hop before the proxy", remote: "10.0.0.5", xff: []string{"1.2.3.4, 198.51.100.1"}, addr: "198.51.100.1"},
+		{name: "chain of trusted proxies", remote: "10.0.0.5", xff: []string{"198.51.100.1, 192.168.1.1", "10.0.0.9"}, addr: "198.51.100.1"},
+		{name: "invalid hop", remote: "10.0.0.5", xff: []string{"unknown, 10.0.0.9"}, addr: "10.0.0.9"},
+		{name: "ipv6 proxy", remote: "[fd00::1]", xff: []string{"2001:db8::7"}, addr: "2001:db8::7"},
+	}
+
+	pl := newProxyList(&config.Server{TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1", "fd00::/8", "invalid"}})
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tc.remote + ":4000"
+			for _, v := range tc.xff {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+			g.Expect(pl.clientAddr(r)).To(gomega.Equal(tc.addr))
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/locale"
+	"axis-graphql/internal/logger"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// batchMaxOperations represents the max number of operations accepted in a single batch request.
+const batchMaxOperations = 20
+
+// batchRequestMaxSize represents the max size of a GraphQL request body.
+const batchRequestMaxSize = 4 << 20
+
+// errCodeResponseTooLarge represents the error code of an operation with response over the size limit.
+const errCodeResponseTooLarge = "RESPONSE_TOO_LARGE"
+
+// responsePageSize represents the full page size of API lists the suggested count
+// of an oversized response is derived from.
+const responsePageSize = 100
+
+// graphqlRequest represents a single GraphQL operation received over HTTP.
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// BatchHandler defines HTTP handler executing GraphQL operations received over HTTP POST.
+// The body may contain a single operation, or an array of operations; operations of a batch
+// are executed in parallel within the context of the request and the response contains
+// the array of results in the same order. Results over the max response size are replaced
+// by an error advising the client to page through the requested lists. If the scheduler
+// is set, operations are executed by the worker pool of their priority class. Legacy field
+// and argument names are rewritten by the compatibility layer, if set. Results carry the age
+// of the chain head and are flagged stale if no block has been observed within the stale window.
+type BatchHandler struct {
+	logger     logger.Logger
+	schema     *graphql.Schema
+	maxSize    int
+	sched      *opScheduler
+	compat     *compatLayer
+	staleAfter time.Duration
+}
+
+// ServeHTTP handles incoming request by executing the operation, or the batch of operations.
+func (h *BatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// queries may be sent using GET so the responses can be cached
+	if r.Method == http.MethodGet {
+		h.serveGet(w, r)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, batchRequestMaxSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// single operation or a batch?
+	var res interface{}
+	if isBatchRequest(body) {
+		res, err = h.batch(r, body)
+	} else {
+		res, err = h.single(r, body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.write(w, res)
+}
+
+// serveGet executes a single query encoded in the URL of a GET request. The response carries
+// Cache-Control header derived from the cache hints of the resolved fields, so public deployments
+// can put a CDN in front of read-heavy queries. Mutations are not accepted using GET.
+func (h *BatchHandler) serveGet(w http.ResponseWriter, r *http.Request) {
+	req, err := graphqlRequestFromURL(r.URL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if operationKind(req.Query, req.OperationName) != graphqlOperationQuery {
+		writeGraphQLError(w, "only queries can be sent using GET", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cp := withCachePolicy(r.Context())
+	res := h.exec(ctx, r, req)
+
+	// failed queries and stale responses are not cached; responses to authenticated clients are not shared;
+	// the head status of a cached response must not be served past the staleness threshold
+	cc := cacheControlNoStore
+	if len(res.Errors) == 0 && !isStale(res) {
+		if h.staleAfter > 0 {
+			cp.limit(int(h.staleAfter / time.Second))
+		}
+		cc = cp.header(resolvers.ApiKeyFromContext(r.Context()) != nil)
+	}
+	w.Header().Set("Cache-Control", cc)
+	w.Header().Set("Vary", "Authorization, X-Api-Key, Accept-Language")
+	h.write(w, res)
+}
+
+// write sends the encoded result of the operation, or the batch of operations, to the client.
+func (h *BatchHandler) write(w http.ResponseWriter, res interface{}) {
+	data, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Errorf("can not write response; %s", err.Error())
+	}
+}
+
+// single executes a single GraphQL operation.
+// State reads of the operation are pinned to the same block.
+func (h *BatchHandler) single(r *http.Request, body []byte) (*graphql.Response, error) {
+	var req graphqlRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	return h.exec(r.Context(), r, &req), nil
+}
+
+// batch executes a batch of GraphQL operations in parallel.
+// Each operation pins its own block for state reads.
+func (h *BatchHandler) batch(r *http.Request, body []byte) ([]*graphql.Response, error) {
+	var list []graphqlRequest
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("empty batch")
+	}
+	if len(list) > batchMaxOperations {
+		return nil, fmt.Errorf("too many operations in batch, max %d allowed", batchMaxOperations)
+	}
+
+	h.logger.Debugf("executing batch of %d operations from %s", len(list), r.RemoteAddr)
+
+	// execute all the operations
+	res := make([]*graphql.Response, len(list))
+	var wg sync.WaitGroup
+	for i := range list {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = h.exec(r.Context(), r, &list[i])
+		}(i)
+	}
+	wg.Wait()
+	return res, nil
+}
+
+// exec executes a single GraphQL operation with state reads pinned to the same block.
+// Errors of the result are localized to the language accepted by the client.
+func (h *BatchHandler) exec(ctx context.Context, r *http.Request, req *graphqlRequest) *graphql.Response {
+	lang := locale.Match(r.Header.Get("Accept-Language"))
+	ctx = locale.ContextWithLanguage(resolvers.ContextWithBlockPin(ctx), lang)
+
+	// legacy names are replaced before the operation is classified and executed
+	query := req.Query
+	var warns []compatWarning
+	if h.compat != nil {
+		query, warns = h.compat.rewrite(query)
+	}
+
+	var res *graphql.Response
+	if h.sched == nil {
+		res = h.limitSize(h.schema.Exec(ctx, query, req.OperationName, req.Variables))
+	} else {
+		res = h.sched.exec(ctx, query, func() *graphql.Response {
+			return h.limitSize(h.schema.Exec(ctx, query, req.OperationName, req.Variables))
+		})
+	}
+
+	return withHeadStatus(withDeprecations(localizeErrors(lang, res), warns), h.staleAfter)
+}
+
+// limitSize replaces the result of an operation over the max response size
+// with a structured error containing the size limit and pagination hints.
+func (h *BatchHandler) limitSize(res *graphql.Response) *graphql.Response {
+	if h.maxSize <= 0 || len(res.Data) <= h.maxSize {
+		return res
+	}
+
+	h.logger.Warningf("response of %d bytes rejected, max %d bytes allowed", len(res.Data), h.maxSize)
+	return &graphql.Response{Errors: []*gqlErrors.QueryError{{
+		Message: fmt.Sprintf("response size of %d bytes exceeds the limit of %d bytes; request smaller pages of lists using count and cursor", len(res.Data), h.maxSize),
+		Extensions: map[string]interface{}{
+			"code":    errCodeResponseTooLarge,
+			"size":    len(res.Data),
+			"maxSize": h.maxSize,
+			"pagination": map[string]interface{}{
+				"count":  h.suggestedCount(len(res.Data)),
+				"cursor": "cursor of the last edge received",
+			},
+		},
+	}}}
+}
+
+// suggestedCount estimates the page size of lists which keeps the response
+// of the given size within the limit, assuming the size scales with the list length.
+func (h *BatchHandler) suggestedCount(size int) int {
+	count := int(int64(responsePageSize) * int64(h.maxSize) / int64(size))
+	if count < 1 {
+		return 1
+	}
+	return count
+}
+
+// isBatchRequest checks if the given request body contains an array of operations.
+func isBatchRequest(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go/trace"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// cacheControlDirective represents the name of the schema directive providing cache hints.
+const cacheControlDirective = "cacheControl"
+
+// cacheControlNoStore represents the Cache-Control header of responses which must not be cached.
+const cacheControlNoStore = "no-store"
+
+// cachePolicyCtxKey represents the context key of the cache policy of a GET query.
+type cachePolicyCtxKey struct{}
+
+// cacheHint represents the cache hint of a schema field.
+type cacheHint struct {
+	maxAge  int
+	private bool
+}
+
+// cacheHints represents the effective cache hints of the schema fields keyed by the type and field name.
+type cacheHints struct {
+	query  string
+	fields map[string]cacheHint
+}
+
+// newCacheHints collects the @cacheControl hints of the given schema. A field without
+// its own hint inherits the hint of the object type it resolves to, if any.
+func newCacheHints(s *types.Schema) *cacheHints {
+	ch := cacheHints{query: "Query", fields: make(map[string]cacheHint)}
+	if q, ok := s.EntryPoints["query"]; ok {
+		ch.query = q.TypeName()
+	}
+
+	for name, nt := range s.Types {
+		obj, ok := nt.(*types.ObjectTypeDefinition)
+		if !ok {
+			continue
+		}
+
+		for _, f := range obj.Fields {
+			if h, ok := cacheHintOf(f.Directives); ok {
+				ch.fields[name+"."+f.Name] = h
+				continue
+			}
+			if rt, ok := namedTypeOf(f.Type).(*types.ObjectTypeDefinition); ok {
+				if h, ok := cacheHintOf(rt.Directives); ok {
+					ch.fields[name+"."+f.Name] = h
+				}
+			}
+		}
+	}
+	return &ch
+}
+
+// cacheHintOf decodes the cache hint from the given list of directives, if present.
+func cacheHintOf(dl types.DirectiveList) (cacheHint, bool) {
+	d := dl.Get(cacheControlDirective)
+	if d == nil {
+		return cacheHint{}, false
+	}
+
+	var h cacheHint
+	if v, ok := d.Arguments.Get("maxAge"); ok && v != nil {
+		if age, err := strconv.Atoi(v.String()); err == nil && age > 0 {
+			h.maxAge = age
+		}
+	}
+	if v, ok := d.Arguments.Get("scope"); ok && v != nil {
+		h.private = v.String() == "PRIVATE"
+	}
+	return h, true
+}
+
+// namedTypeOf unwraps list and non-null modifiers of the given type.
+func namedTypeOf(t types.Type) types.Type {
+	for {
+		switch wt := t.(type) {
+		case *types.NonNull:
+			t = wt.OfType
+		case *types.List:
+			t = wt.OfType
+		default:
+			return t
+		}
+	}
+}
+
+// cachePolicy collects the cache hints of the fields resolved by a query.
+type cachePolicy struct {
+	mu      sync.Mutex
+	hinted  bool
+	maxAge  int
+	private bool
+}
+
+// withCachePolicy attaches a new cache policy collector to the given context.
+func withCachePolicy(ctx context.Context) (context.Context, *cachePolicy) {
+	cp := new(cachePolicy)
+	return context.WithValue(ctx, cachePolicyCtxKey{}, cp), cp
+}
+
+// resolved applies the hint of the resolved field to the policy. Root fields without a hint
+// make the response not cacheable, nested fields without a hint follow their parents.
+func (cp *cachePolicy) resolved(ch *cacheHints, typeName string, fieldName string) {
+	h, ok := ch.fields[typeName+"."+fieldName]
+	if !ok && typeName != ch.query {
+		return
+	}
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if !cp.hinted || h.maxAge < cp.maxAge {
+		cp.maxAge = h.maxAge
+	}
+	cp.private = cp.private || h.private
+	cp.hinted = true
+}
+
+// limit caps the max age of the response, i.e. so the head status embedded in a cached response
+// does not outlive the staleness threshold.
+func (cp *cachePolicy) limit(maxAge int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if cp.maxAge > maxAge {
+		cp.maxAge = maxAge
+	}
+}
+
+// header provides the Cache-Control header value of the response; the private flag
+// signals a response specific to the client, e.g. an authenticated one.
+func (cp *cachePolicy) header(private bool) string {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if !cp.hinted || cp.maxAge <= 0 {
+		return cacheControlNoStore
+	}
+	if private || cp.private {
+		return fmt.Sprintf("private, max-age=%d", cp.maxAge)
+	}
+	return fmt.Sprintf("public, max-age=%d", cp.maxAge)
+}
+
+// cacheControlTracer implements GraphQL tracer collecting cache hints of the fields
+// resolved by queries with a cache policy attached. The tracing itself is left
+// to the default OpenTracing tracer.
+type cacheControlTracer struct {
+	trace.OpenTracingTracer
+	hints *cacheHints
+}
+
+// TraceField records the resolved field in the cache policy of the query, if any.
+func (t *cacheControlTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	if cp, ok := ctx.Value(cachePolicyCtxKey{}).(*cachePolicy); ok && t.hints != nil {
+		cp.resolved(t.hints, typeName, fieldName)
+	}
+	return t.OpenTracingTracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"fmt"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// compatExtension represents the name of the response extension listing the legacy names used.
+const compatExtension = "deprecations"
+
+// compatWarning represents a deprecation warning of a legacy name used by an operation.
+type compatWarning struct {
+	Message string `json:"message"`
+	Legacy  string `json:"legacy"`
+	Current string `json:"current"`
+	Sunset  string `json:"sunset,omitempty"`
+}
+
+// compatLayer rewrites legacy field and argument names of incoming operations to the current
+// names of the schema. Renamed fields are aliased by their legacy names, so the shape
+// of the response expected by the client does not change. A rename may be limited to fields
+// of a single type; the type of each selection set is followed by the schema.
+type compatLayer struct {
+	fields map[string]*config.CompatName
+	args   map[string]*config.CompatName
+
+	// types maps fields of the schema types to the types they resolve to
+	types map[string]map[string]string
+	roots map[string]string
+}
+
+// newCompatLayer creates a new legacy names compatibility layer for the given schema;
+// nil is returned if no names are mapped.
+func newCompatLayer(cfg *config.Compat, s *types.Schema) *compatLayer {
+	if len(cfg.Fields) == 0 && len(cfg.Arguments) == 0 {
+		return nil
+	}
+
+	cl := compatLayer{
+		fields: make(map[string]*config.CompatName, len(cfg.Fields)),
+		args:   make(map[string]*config.CompatName, len(cfg.Arguments)),
+		types:  make(map[string]map[string]string),
+		roots:  map[string]string{"query": "Query", "mutation": "Mutation", "subscription": "Subscription"},
+	}
+	for i := range cfg.Fields {
+		cl.fields[compatKey(cfg.Fields[i].Type, cfg.Fields[i].Legacy)] = &cfg.Fields[i]
+	}
+	for i := range cfg.Arguments {
+		cl.args[compatKey(cfg.Arguments[i].Type, cfg.Arguments[i].Field+"."+cfg.Arguments[i].Legacy)] = &cfg.Arguments[i]
+	}
+
+	if s != nil {
+		for op, nt := range s.EntryPoints {
+			cl.roots[op] = nt.TypeName()
+		}
+		for name, nt := range s.Types {
+			var fields types.FieldsDefinition
+			switch t := nt.(type) {
+			case *types.ObjectTypeDefinition:
+				fields = t.Fields
+			case *types.InterfaceTypeDefinition:
+				fields = t.Fields
+			default:
+				continue
+			}
+
+			cl.types[name] = make(map[string]string, len(fields))
+			for _, f := range fields {
+				if ft, ok := namedTypeOf(f.Type).(types.NamedType); ok {
+					cl.types[name][f.Name] = ft.TypeName()
+				}
+			}
+		}
+	}
+	return &cl
+}
+
+// compatKey builds the key of a renamed name limited to the given type, if any.
+func compatKey(typ string, name string) string {
+	if typ == "" {
+		return name
+	}
+	return typ + "." + name
+}
+
+// field provides the rename of the legacy field of the given type, if any.
+// Renames limited to the type take precedence over renames of the name on any type.
+func (cl *compatLayer) field(typ string, name string) (*config.CompatName, bool) {
+	if cn, ok := cl.fields[compatKey(typ, name)]; ok && typ != "" {
+		return cn, true
+	}
+	cn, ok := cl.fields[name]
+	return cn, ok
+}
+
+// arg provides the rename of the legacy argument of the given field of the given type, if any.
+func (cl *compatLayer) arg(typ string, field string, name string) (*config.CompatName, bool) {
+	if cn, ok := cl.args[compatKey(typ, field+"."+name)]; ok && typ != "" {
+		return cn, true
+	}
+	cn, ok := cl.args[field+"."+name]
+	return cn, ok
+}
+
+// rewrite replaces legacy names in the given GraphQL document and provides the warnings
+// of the legacy names found. Strings, comments and variables are kept intact.
+func (cl *compatLayer) rewrite(doc string) (string, []compatWarning) {
+	var sb strings.Builder
+	var warns []compatWarning
+	seen := make(map[string]bool)
+
+	warn := func(kind string, cn *config.CompatName) {
+		key := kind + "/" + cn.Type + "/" + cn.Field + "/" + cn.Legacy
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		legacy := compatKey(cn.Type, cn.Legacy)
+		msg := fmt.Sprintf("%s %s is deprecated, use %s instead", kind, legacy, cn.Current)
+		if kind == "argument" {
+			msg = fmt.Sprintf("argument %s of %s is deprecated, use %s instead", cn.Legacy, compatKey(cn.Type, cn.Field), cn.Current)
+		}
+		warns = append(warns, compatWarning{Message: msg, Legacy: cn.Legacy, Current: cn.Current, Sunset: cn.Sunset})
+	}
+
+	// depth is the selection set nesting, args is the arguments nesting;
+	// argDepth is the selection depth the arguments were opened at
+	depth, args, argDepth := 0, 0, 0
+	field, prev := "", ""
+	last := 0
+
+	// scope keeps the types of the open selection sets, next is the type of the selection set opened next
+	scope := make([]string, 0, 8)
+	next := ""
+	current := func() string {
+		if len(scope) == 0 {
+			return ""
+		}
+		return scope[len(scope)-1]
+	}
+
+	toks := graphqlTokens(doc)
+	for i := range toks {
+		t := &toks[i]
+		switch t.kind {
+		case graphqlTokenDirective:
+			field = ""
+		case graphqlTokenPunct:
+			switch t.text {
+			case "...":
+				// the selection set of an inline fragment without a type condition keeps the type
+				next = current()
+			case "{":
+				// object values of arguments do not open a selection set
+				if args == 0 {
+					// the selection set of an anonymous query
+					if depth == 0 && next == "" {
+						next = cl.roots["query"]
+					}
+					scope = append(scope, next)
+					next = ""
+				}
+				depth++
+			case "}":
+				if args == 0 && len(scope) > 0 {
+					scope = scope[:len(scope)-1]
+				}
+				depth--
+			case "(":
+				if args == 0 {
+					argDepth = depth
+				}
+				args++
+			case ")":
+				args--
+			}
+		case graphqlTokenName:
+			name := t.text
+			switch {
+			case prev == "on":
+				// type condition of a fragment
+				next = name
+			case prev == "..." || args > 0 && depth == 0:
+				// fragment spreads and variable definitions are not renamed
+			case depth == 0:
+				// operation definitions
+				if root, ok := cl.roots[name]; ok && prev != "fragment" {
+					next = root
+				}
+			case args == 1 && depth == argDepth && followedBy(toks, i, ":"):
+				// argument of the current field
+				if cn, ok := cl.arg(current(), field, name); ok && field != "" {
+					warn("argument", cn)
+					sb.WriteString(doc[last:t.pos])
+					sb.WriteString(cn.Current)
+					last = t.end()
+				}
+			case args == 0 && followedBy(toks, i, ":"):
+				// alias, the field name follows
+			case args == 0:
+				field = name
+				if cn, ok := cl.field(current(), name); ok {
+					warn("field", cn)
+					sb.WriteString(doc[last:t.pos])
+
+					// keep the legacy name in the response, unless aliased already
+					if prev != ":" {
+						sb.WriteString(name)
+						sb.WriteString(": ")
+					}
+					sb.WriteString(cn.Current)
+					last = t.end()
+					field = cn.Current
+				}
+				next = cl.types[current()][field]
+			}
+
+			prev = name
+			if followedBy(toks, i, ":") && args == 0 {
+				prev = ":"
+			}
+			continue
+		}
+		prev = t.text
+	}
+
+	if last == 0 {
+		return doc, warns
+	}
+	sb.WriteString(doc[last:])
+	return sb.String(), warns
+}
+
+// withDeprecations adds the deprecation warnings of the legacy names to the extensions of the response.
+func withDeprecations(res *graphql.Response, warns []compatWarning) *graphql.Response {
+	if len(warns) == 0 {
+		return res
+	}
+	if res.Extensions == nil {
+		res.Extensions = make(map[string]interface{})
+	}
+	res.Extensions[compatExtension] = warns
+	return res
+}
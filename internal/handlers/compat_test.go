@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/onsi/gomega"
+)
+
+// compatTestSchema represents the schema the legacy names are mapped to.
+const compatTestSchema = `
+schema { query: Query }
+
+type Query {
+	account(address: String!): Account!
+	contract(address: String!): Contract
+	blocks(count: Int, filter: BlockFilter): [Block!]!
+	staker(id: Int!): Staker
+}
+
+input BlockFilter { from: Int }
+
+interface Owner { txCount: Int! }
+
+type Account implements Owner {
+	txCount: Int!
+	contract: Contract
+	blocks(count: Int): [Block!]!
+}
+
+type Contract {
+	txCount: Int!
+	owner: Account
+}
+
+type Staker {
+	txCount: Int!
+}
+
+type Block {
+	number: Int!
+}
+`
+
+// newTestCompatLayer creates the compatibility layer of the test schema.
+func newTestCompatLayer() *compatLayer {
+	cfg := config.Compat{
+		Fields: []config.CompatName{
+			{Type: "Account", Legacy: "sentTxCount", Current: "txCount", Sunset: "2027-01-01"},
+			{Legacy: "ownerAccount", Current: "owner"},
+		},
+		Arguments: []config.CompatName{
+			{Field: "blocks", Legacy: "first", Current: "count"},
+			{Type: "Account", Field: "blocks", Legacy: "last", Current: "count"},
+		},
+	}
+	return newCompatLayer(&cfg, graphql.MustParseSchema(compatTestSchema, nil).ASTSchema())
+}
+
+func TestCompatLayerDisabled(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(newCompatLayer(&config.Compat{}, nil)).To(gomega.BeNil())
+}
+
+func TestCompatRewrite(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		out  string
+	}{
+		// fields
+		{name: "no legacy names", doc: `{ account(address: "0x1") { txCount } }`, out: `{ account(address: "0x1") { txCount } }`},
+		{name: "typed field", doc: `{ account(address: "0x1") { sentTxCount } }`, out: `{ account(address: "0x1") { sentTxCount: txCount } }`},
+		{name: "typed field on other type", doc: `{ contract(address: "0x1") { sentTxCount } }`, out: `{ contract(address: "0x1") { sentTxCount } }`},
+		{name: "typed field on root", doc: `{ sentTxCount }`, out: `{ sentTxCount }`},
+		{name: "typed field nested", doc: `{ contract(address: "0x1") { owner { sentTxCount } } }`, out: `{ contract(address: "0x1") { owner { sentTxCount: txCount } } }`},
+		{name: "typed field after sibling selection", doc: `{ account(address: "0x1") { contract { txCount } sentTxCount } }`, out: `{ account(address: "0x1") { contract { txCount } sentTxCount: txCount } }`},
+		{name: "untyped field", doc: `{ contract(address: "0x1") { ownerAccount { txCount } } }`, out: `{ contract(address: "0x1") { ownerAccount: owner { txCount } } }`},
+		{name: "type followed through renamed field", doc: `{ contract(address: "0x1") { ownerAccount { sentTxCount } } }`, out: `{ contract(address: "0x1") { ownerAccount: owner { sentTxCount: txCount } } }`},
+		{name: "aliased field", doc: `{ account(address: "0x1") { sent: sentTxCount } }`, out: `{ account(address: "0x1") { sent: txCount } }`},
+		{name: "alias named as legacy field", doc: `{ account(address: "0x1") { sentTxCount: txCount } }`, out: `{ account(address: "0x1") { sentTxCount: txCount } }`},
+		{name: "named query", doc: `query Acc($a: String!) { account(address: $a) { sentTxCount } }`, out: `query Acc($a: String!) { account(address: $a) { sentTxCount: txCount } }`},
+
+		// arguments
+		{name: "untyped argument", doc: `{ blocks(first: 5) { number } }`, out: `{ blocks(count: 5) { number } }`},
+		{name: "untyped argument nested", doc: `{ account(address: "0x1") { blocks(first: 5) { number } } }`, out: `{ account(address: "0x1") { blocks(count: 5) { number } } }`},
+		{name: "typed argument", doc: `{ account(address: "0x1") { blocks(last: 5) { number } } }`, out: `{ account(address: "0x1") { blocks(count: 5) { number } } }`},
+		{name: "typed argument on other type", doc: `{ blocks(last: 5) { number } }`, out: `{ blocks(last: 5) { number } }`},
+		{name: "argument object value", doc: `{ blocks(filter: { from: 1 }, first: 2) { number } }`, out: `{ blocks(filter: { from: 1 }, count: 2) { number } }`},
+		{name: "argument named as legacy field", doc: `{ account(address: "0x1") { blocks(sentTxCount: 1) { number } } }`, out: `{ account(address: "0x1") { blocks(sentTxCount: 1) { number } } }`},
+
+		// fragments
+		{name: "fragment on type", doc: `{ account(address: "0x1") { ...A } } fragment A on Account { sentTxCount }`, out: `{ account(address: "0x1") { ...A } } fragment A on Account { sentTxCount: txCount }`},
+		{name: "fragment on other type", doc: `{ staker(id: 1) { ...S } } fragment S on Staker { sentTxCount }`, out: `{ staker(id: 1) { ...S } } fragment S on Staker { sentTxCount }`},
+		{name: "fragment spread named as legacy field", doc: `{ account(address: "0x1") { ...sentTxCount } }`, out: `{ account(address: "0x1") { ...sentTxCount } }`},
+		{name: "inline fragment", doc: `{ account(address: "0x1") { ... on Owner { txCount } ... on Account { sentTxCount } } }`, out: `{ account(address: "0x1") { ... on Owner { txCount } ... on Account { sentTxCount: txCount } } }`},
+		{name: "inline fragment without type", doc: `query($x: Boolean!) { account(address: "0x1") { ... @include(if: $x) { sentTxCount } } }`, out: `query($x: Boolean!) { account(address: "0x1") { ... @include(if: $x) { sentTxCount: txCount } } }`},
+
+		// comments and strings
+		{name: "legacy name in comment", doc: "{ account(address: \"0x1\") {\n # sentTxCount\n txCount } }", out: "{ account(address: \"0x1\") {\n # sentTxCount\n txCount } }"},
+		{name: "legacy name in string", doc: `{ account(address: "{ sentTxCount }") { txCount } }`, out: `{ account(address: "{ sentTxCount }") { txCount } }`},
+		{name: "legacy name in block string", doc: `{ account(address: """ } sentTxCount """) { sentTxCount } }`, out: `{ account(address: """ } sentTxCount """) { sentTxCount: txCount } }`},
+	}
+
+	cl := newTestCompatLayer()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			out, _ := cl.rewrite(tc.doc)
+			g.Expect(out).To(gomega.Equal(tc.out), "rewrite of %s", tc.doc)
+		})
+	}
+}
+
+func TestCompatRewriteWarnings(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	cl := newTestCompatLayer()
+
+	_, warns := cl.rewrite(`{ a: account(address: "0x1") { sentTxCount blocks(first: 1) { number } } b: account(address: "0x2") { sentTxCount blocks(first: 2) { number } } }`)
+	g.Expect(warns).To(gomega.Equal([]compatWarning{
+		{Message: "field Account.sentTxCount is deprecated, use txCount instead", Legacy: "sentTxCount", Current: "txCount", Sunset: "2027-01-01"},
+		{Message: "argument first of blocks is deprecated, use count instead", Legacy: "first", Current: "count"},
+	}))
+
+	_, warns = cl.rewrite(`{ account(address: "0x1") { txCount } }`)
+	g.Expect(warns).To(gomega.BeEmpty())
+}
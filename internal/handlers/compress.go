@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// compressMinSize represents the min size of a response to be compressed;
+// smaller responses are sent as they are since the compression would not pay off.
+const compressMinSize = 1400
+
+// brotliLevel represents the brotli compression level of responses;
+// the level keeps the speed close to gzip while still producing smaller responses.
+const brotliLevel = 4
+
+// compressEncoder represents a streaming encoder of the response body.
+type compressEncoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// encoders represents pools of encoders re-used across responses by the content encoding.
+var encoders = map[string]*sync.Pool{
+	"br": {
+		New: func() interface{} {
+			return brotli.NewWriterLevel(nil, brotliLevel)
+		},
+	},
+	"gzip": {
+		New: func() interface{} {
+			gz, _ := gzip.NewWriterLevel(nil, gzip.BestSpeed)
+			return gz
+		},
+	},
+}
+
+// CompressHandler defines HTTP handler middleware compressing responses
+// using brotli, or gzip, for clients accepting the encoding. Large result sets, like transaction
+// and transfer lists, shrink considerably since JSON encoded hex values compress well.
+type CompressHandler struct {
+	handler http.Handler
+}
+
+// NewCompressHandler creates a new compression middleware for the given handler.
+func NewCompressHandler(h http.Handler) http.Handler {
+	return &CompressHandler{handler: h}
+}
+
+// ServeHTTP handles incoming request by compressing the response of the next handler in the chain.
+func (h *CompressHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// websocket upgrade, or the client does not accept any of our encodings
+	enc := acceptedEncoding(r)
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") || enc == "" {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Encoding")
+	cw := compressResponseWriter{ResponseWriter: w, encoding: enc}
+	h.handler.ServeHTTP(&cw, r)
+	cw.close()
+}
+
+// acceptedEncoding provides the content encoding of the response preferred by the client;
+// brotli wins over gzip of the same quality. Empty string is returned if the client
+// accepts none of them.
+func acceptedEncoding(r *http.Request) string {
+	var enc string
+	var best float64
+	for _, item := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(item, ";")
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		if _, ok := encoders[name]; !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if len(p) > 2 && strings.EqualFold(p[:2], "q=") {
+				if v, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > best || (q == best && q > 0 && name == "br") {
+			enc, best = name, q
+		}
+	}
+	return enc
+}
+
+// compressResponseWriter implements response writer collecting the beginning
+// of the response to decide if the compression should be used.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+	enc      compressEncoder
+	buf      []byte
+	status   int
+	started  bool
+}
+
+// WriteHeader postpones the status code until we know if the response is compressed.
+func (cw *compressResponseWriter) WriteHeader(status int) {
+	cw.status = status
+}
+
+// Write collects the response data and starts the compression once the response is large enough.
+func (cw *compressResponseWriter) Write(data []byte) (int, error) {
+	if cw.started {
+		return cw.write(data)
+	}
+
+	// wait for enough data
+	cw.buf = append(cw.buf, data...)
+	if len(cw.buf) < compressMinSize {
+		return len(data), nil
+	}
+
+	if err := cw.start(); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Flush sends the data written so far to the client, so incremental responses,
+// i.e. parts of @defer and @stream results, reach the client one by one.
+// The response is compressed from the first flush on regardless of its size,
+// since more parts are expected to follow.
+func (cw *compressResponseWriter) Flush() {
+	if !cw.started {
+		if err := cw.start(); err != nil {
+			return
+		}
+	}
+	if cw.enc != nil {
+		if err := cw.enc.Flush(); err != nil {
+			return
+		}
+	}
+	if fl, ok := cw.ResponseWriter.(http.Flusher); ok {
+		fl.Flush()
+	}
+}
+
+// start begins the compressed response, unless already encoded, and sends the collected data.
+func (cw *compressResponseWriter) start() error {
+	if cw.Header().Get("Content-Encoding") == "" {
+		cw.enc = encoders[cw.encoding].Get().(compressEncoder)
+		cw.enc.Reset(cw.ResponseWriter)
+		cw.Header().Set("Content-Encoding", cw.encoding)
+		cw.Header().Del("Content-Length")
+	}
+	cw.started = true
+	cw.writeHeader()
+
+	if _, err := cw.write(cw.buf); err != nil {
+		return err
+	}
+	cw.buf = nil
+	return nil
+}
+
+// write sends the data to the client, compressed if the compression is on.
+func (cw *compressResponseWriter) write(data []byte) (int, error) {
+	if cw.enc != nil {
+		return cw.enc.Write(data)
+	}
+	return cw.ResponseWriter.Write(data)
+}
+
+// writeHeader sends the postponed status code, if any.
+func (cw *compressResponseWriter) writeHeader() {
+	if cw.status != 0 {
+		cw.ResponseWriter.WriteHeader(cw.status)
+	}
+}
+
+// close finishes the response sending any collected data.
+func (cw *compressResponseWriter) close() {
+	// small response is sent as is
+	if !cw.started {
+		cw.writeHeader()
+		if len(cw.buf) > 0 {
+			_, _ = cw.ResponseWriter.Write(cw.buf)
+		}
+		return
+	}
+
+	if cw.enc != nil {
+		_ = cw.enc.Close()
+		encoders[cw.encoding].Put(cw.enc)
+		cw.enc = nil
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/onsi/gomega"
+)
+
+func TestAcceptedEncoding(t *testing.T) {
+	tests := []struct {
+		accept string
+		enc    string
+	}{
+		{accept: "", enc: ""},
+		{accept: "identity", enc: ""},
+		{accept: "gzip", enc: "gzip"},
+		{accept: "br", enc: "br"},
+		{accept: "gzip, deflate, br", enc: "br"},
+		{accept: "GZIP;q=0.8, br;q=0.5", enc: "gzip"},
+		{accept: "br;q=0, gzip", enc: "gzip"},
+		{accept: "br;q=0", enc: ""},
+		{accept: "gzip;q=0.5, br;q=0.5", enc: "br"},
+	}
+
+	for _, tc := range tests {
+		g := gomega.NewGomegaWithT(t)
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", tc.accept)
+		g.Expect(acceptedEncoding(r)).To(gomega.Equal(tc.enc), "encoding of %q", tc.accept)
+	}
+}
+
+func TestCompressFlush(t *testing.T) {
+	for _, enc := range []string{"br", "gzip"} {
+		t.Run(enc, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			// the part flushed must reach the client before the response is finished
+			w := httptest.NewRecorder()
+			var flushed int
+			h := NewCompressHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				_, _ = rw.Write([]byte("part one;"))
+				rw.(http.Flusher).Flush()
+				flushed = w.Body.Len()
+				_, _ = rw.Write([]byte(strings.Repeat("part two;", 200)))
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", enc)
+			h.ServeHTTP(w, r)
+
+			g.Expect(flushed).To(gomega.BeNumerically(">", 0))
+			g.Expect(w.Header().Get("Content-Encoding")).To(gomega.Equal(enc))
+
+			var body []byte
+			var err error
+			if enc == "br" {
+				body, err = ioutil.ReadAll(brotli.NewReader(w.Body))
+			} else {
+				var gz *gzip.Reader
+				gz, err = gzip.NewReader(w.Body)
+				g.Expect(err).To(gomega.BeNil())
+				body, err = ioutil.ReadAll(gz)
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(string(body)).To(gomega.Equal("part one;" + strings.Repeat("part two;", 200)))
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"axis-graphql/internal/logger"
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ethRpcRequestMaxSize represents the max size of a JSON-RPC request body.
+const ethRpcRequestMaxSize = 1 << 20
+
+// JSON-RPC error codes used by the facade.
+const (
+	ethRpcErrParse          = -32700
+	ethRpcErrInvalidRequest = -32600
+	ethRpcErrMethodNotFound = -32601
+	ethRpcErrInvalidParams  = -32602
+	ethRpcErrInternal       = -32603
+)
+
+// ethRpcRequest represents a single JSON-RPC call.
+type ethRpcRequest struct {
+	Version string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// ethRpcError represents an error of a JSON-RPC call.
+type ethRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ethRpcResponse represents the response to a single JSON-RPC call.
+type ethRpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+	Error   *ethRpcError    `json:"error"`
+}
+
+// MarshalJSON encodes the response; either the result, or the error is included.
+func (res *ethRpcResponse) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{"jsonrpc": res.Version, "id": res.ID}
+	if res.Error != nil {
+		out["error"] = res.Error
+	} else {
+		out["result"] = res.Result
+	}
+	return json.Marshal(out)
+}
+
+// ethRpcTransaction represents a transaction in the format of eth_getTransactionByHash.
+type ethRpcTransaction struct {
+	BlockHash        *common.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Uint64 `json:"blockNumber"`
+	From             common.Address  `json:"from"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         hexutil.Big     `json:"gasPrice"`
+	Hash             common.Hash     `json:"hash"`
+	Input            hexutil.Bytes   `json:"input"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	To               *common.Address `json:"to"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	Value            hexutil.Big     `json:"value"`
+}
+
+// ethRpcFilter represents the filter object of eth_getLogs.
+type ethRpcFilter struct {
+	BlockHash *common.Hash      `json:"blockHash"`
+	FromBlock *string           `json:"fromBlock"`
+	ToBlock   *string           `json:"toBlock"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+// ethRpcMethods maps supported JSON-RPC methods to their implementation.
+var ethRpcMethods = map[string]func([]json.RawMessage) (interface{}, error){
+	"eth_blockNumber":          ethBlockNumber,
+	"eth_getBalance":           ethGetBalance,
+	"eth_getTransactionByHash": ethGetTransactionByHash,
+	"eth_getLogs":              ethGetLogs,
+}
+
+// ethRpcParamsError represents an error of invalid call parameters.
+type ethRpcParamsError struct {
+	msg string
+}
+
+// Error returns the message of the error.
+func (e *ethRpcParamsError) Error() string {
+	return e.msg
+}
+
+// EthRpc constructs HTTP handler of a limited Ethereum JSON-RPC facade.
+// The supported eth_* calls are served from the API index and cache, so legacy tools
+// can be pointed to the API server instead of the node. Batches of calls are supported.
+func EthRpc(log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, ethRpcRequestMaxSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// single call, or a batch
+		var res interface{}
+		if isBatchRequest(body) {
+			res = ethRpcBatch(body, log)
+		} else {
+			res = ethRpcSingle(body, log)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Errorf("can not write JSON-RPC response; %s", err.Error())
+		}
+	})
+}
+
+// ethRpcSingle executes a single JSON-RPC call.
+func ethRpcSingle(body []byte, log logger.Logger) *ethRpcResponse {
+	var req ethRpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return ethRpcFailure(nil, ethRpcErrParse, err.Error())
+	}
+	return ethRpcCall(&req, log)
+}
+
+// ethRpcBatch executes a batch of JSON-RPC calls.
+func ethRpcBatch(body []byte, log logger.Logger) interface{} {
+	var list []ethRpcRequest
+	if err := json.Unmarshal(body, &list); err != nil {
+		return ethRpcFailure(nil, ethRpcErrParse, err.Error())
+	}
+	if len(list) == 0 || len(list) > batchMaxOperations {
+		return ethRpcFailure(nil, ethRpcErrInvalidRequest, fmt.Sprintf("batch must contain 1 to %d calls", batchMaxOperations))
+	}
+
+	res := make([]*ethRpcResponse, len(list))
+	for i := range list {
+		res[i] = ethRpcCall(&list[i], log)
+	}
+	return res
+}
+
+// ethRpcCall executes the JSON-RPC call by its method implementation.
+func ethRpcCall(req *ethRpcRequest, log logger.Logger) *ethRpcResponse {
+	if req.Version != "2.0" || req.Method == "" {
+		return ethRpcFailure(req.ID, ethRpcErrInvalidRequest, "invalid request")
+	}
+
+	call, ok := ethRpcMethods[req.Method]
+	if !ok {
+		return ethRpcFailure(req.ID, ethRpcErrMethodNotFound, fmt.Sprintf("the method %s is not available", req.Method))
+	}
+
+	res, err := call(req.Params)
+	if err != nil {
+		if _, ok := err.(*ethRpcParamsError); ok {
+			return ethRpcFailure(req.ID, ethRpcErrInvalidParams, err.Error())
+		}
+		log.Debugf("JSON-RPC call %s failed; %s", req.Method, err.Error())
+		return ethRpcFailure(req.ID, ethRpcErrInternal, err.Error())
+	}
+	return &ethRpcResponse{Version: "2.0", ID: req.ID, Result: res}
+}
+
+// ethRpcFailure builds an error response of a JSON-RPC call.
+func ethRpcFailure(id json.RawMessage, code int, msg string) *ethRpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &ethRpcResponse{Version: "2.0", ID: id, Error: &ethRpcError{Code: code, Message: msg}}
+}
+
+// ethRpcParam decodes the call parameter at the given position.
+func ethRpcParam(params []json.RawMessage, i int, v interface{}) error {
+	if len(params) <= i {
+		return &ethRpcParamsError{msg: fmt.Sprintf("missing value for required argument %d", i)}
+	}
+	if err := json.Unmarshal(params[i], v); err != nil {
+		return &ethRpcParamsError{msg: fmt.Sprintf("invalid argument %d: %s", i, err.Error())}
+	}
+	return nil
+}
+
+// ethBlockNumber implements eth_blockNumber call; the last block known to the index is provided.
+func ethBlockNumber(_ []json.RawMessage) (interface{}, error) {
+	top, err := repository.R().LastKnownBlock()
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(top), nil
+}
+
+// ethGetBalance implements eth_getBalance call; only the latest state is available.
+func ethGetBalance(params []json.RawMessage) (interface{}, error) {
+	var adr common.Address
+	if err := ethRpcParam(params, 0, &adr); err != nil {
+		return nil, err
+	}
+
+	// historical state is not available
+	if len(params) > 1 {
+		var tag string
+		if err := ethRpcParam(params, 1, &tag); err != nil || (tag != "latest" && tag != "pending") {
+			return nil, &ethRpcParamsError{msg: "only the latest state is available"}
+		}
+	}
+	return repository.R().AccountBalance(&adr, nil)
+}
+
+// ethGetTransactionByHash implements eth_getTransactionByHash call;
+// transactions not indexed yet are reported as not found.
+func ethGetTransactionByHash(params []json.RawMessage) (interface{}, error) {
+	var hash common.Hash
+	if err := ethRpcParam(params, 0, &hash); err != nil {
+		return nil, err
+	}
+
+	trx, err := repository.R().IndexedTransaction(&hash)
+	if err != nil || trx == nil {
+		return nil, err
+	}
+	return newEthRpcTransaction(trx), nil
+}
+
+// newEthRpcTransaction converts the transaction into the JSON-RPC format.
+func newEthRpcTransaction(trx *types.Transaction) *ethRpcTransaction {
+	return &ethRpcTransaction{
+		BlockHash:        trx.BlockHash,
+		BlockNumber:      trx.BlockNumber,
+		From:             trx.From,
+		Gas:              trx.Gas,
+		GasPrice:         trx.GasPrice,
+		Hash:             trx.Hash,
+		Input:            trx.InputData,
+		Nonce:            trx.Nonce,
+		To:               trx.To,
+		TransactionIndex: trx.Index,
+		Value:            trx.Value,
+	}
+}
+
+// ethGetLogs implements eth_getLogs call.
+func ethGetLogs(params []json.RawMessage) (interface{}, error) {
+	var f ethRpcFilter
+	if err := ethRpcParam(params, 0, &f); err != nil {
+		return nil, err
+	}
+
+	q, err := f.query()
+	if err != nil {
+		return nil, &ethRpcParamsError{msg: err.Error()}
+	}
+	return repository.R().IndexedLogs(q)
+}
+
+// query converts the JSON-RPC filter into the logs filter query.
+func (f *ethRpcFilter) query() (*ethereum.FilterQuery, error) {
+	q := ethereum.FilterQuery{BlockHash: f.BlockHash}
+	if f.BlockHash != nil && (f.FromBlock != nil || f.ToBlock != nil) {
+		return nil, fmt.Errorf("blockHash can not be combined with fromBlock and toBlock")
+	}
+
+	// block range
+	var err error
+	if q.FromBlock, err = ethRpcBlockNumber(f.FromBlock); err != nil {
+		return nil, err
+	}
+	if q.ToBlock, err = ethRpcBlockNumber(f.ToBlock); err != nil {
+		return nil, err
+	}
+
+	// addresses may be a single address, or a list
+	if len(f.Address) > 0 && string(f.Address) != "null" {
+		if f.Address[0] == '[' {
+			err = json.Unmarshal(f.Address, &q.Addresses)
+		} else {
+			var adr common.Address
+			err = json.Unmarshal(f.Address, &adr)
+			q.Addresses = []common.Address{adr}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid address; %s", err.Error())
+		}
+	}
+
+	// topics on each position may be null, a single topic, or a list of alternatives
+	q.Topics = make([][]common.Hash, len(f.Topics))
+	for i, raw := range f.Topics {
+		switch {
+		case len(raw) == 0 || string(raw) == "null":
+		case raw[0] == '[':
+			err = json.Unmarshal(raw, &q.Topics[i])
+		default:
+			var topic common.Hash
+			err = json.Unmarshal(raw, &topic)
+			q.Topics[i] = []common.Hash{topic}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid topic %d; %s", i, err.Error())
+		}
+	}
+	return &q, nil
+}
+
+// ethRpcBlockNumber decodes the block number of a logs filter;
+// nil represents the latest block known to the index.
+func ethRpcBlockNumber(val *string) (*big.Int, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	switch *val {
+	case "latest", "pending":
+		return nil, nil
+	case "earliest":
+		return new(big.Int), nil
+	}
+
+	num, err := hexutil.DecodeUint64(*val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block number %s; %s", *val, err.Error())
+	}
+	return new(big.Int).SetUint64(num), nil
+}
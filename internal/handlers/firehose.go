@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/logger"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/cors"
+)
+
+// firehoseKeepAlive represents the interval of comments sent to idle streams
+// to keep proxies from closing them and to detect gone clients.
+const firehoseKeepAlive = 15 * time.Second
+
+// firehoseWriteTimeout represents the max time an event write to a client may take;
+// slow clients are disconnected so they can not hold the stream subscriptions.
+const firehoseWriteTimeout = 10 * time.Second
+
+// firehoseBlock represents the summary of a new block sent to the firehose clients.
+type firehoseBlock struct {
+	Number       hexutil.Uint64 `json:"number"`
+	Hash         common.Hash    `json:"hash"`
+	TimeStamp    hexutil.Uint64 `json:"timestamp"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Transactions int            `json:"transactions"`
+}
+
+// firehoseTrx represents the summary of a large transaction sent to the firehose clients.
+type firehoseTrx struct {
+	Hash        common.Hash     `json:"hash"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+	From        common.Address  `json:"from"`
+	To          *common.Address `json:"to"`
+	Value       hexutil.Big     `json:"value"`
+	TimeStamp   int64           `json:"timestamp"`
+}
+
+// firehose implements the HTTP handler of the public Server-Sent Events stream.
+type firehose struct {
+	cfg      *config.Firehose
+	log      logger.Logger
+	rs       resolvers.ApiResolver
+	limiter  *rateLimiter
+	proxies  proxyList
+	minValue *big.Int
+
+	lock    sync.Mutex
+	streams map[string]int
+	total   int
+}
+
+// Firehose constructs HTTP handler streaming lightweight JSON summaries of new blocks
+// and large transactions as Server-Sent Events, so public dashboards can follow the chain
+// without a GraphQL websocket subscription. No API key is needed; clients are limited
+// by the number of streams they open in a minute and keep open at once,
+// the number of streams open by all the clients is capped as well.
+func Firehose(cfg *config.Config, log logger.Logger, rs resolvers.ApiResolver) http.Handler {
+	corsHandler := cors.New(corsOptions(cfg))
+	corsHandler.Log = log
+
+	minValue, _ := new(big.Float).Mul(big.NewFloat(cfg.Firehose.MinTrxValue), big.NewFloat(1e18)).Int(nil)
+	return corsHandler.Handler(&firehose{
+		cfg:      &cfg.Firehose,
+		log:      log,
+		rs:       rs,
+		limiter:  newRateLimiter(cfg.Firehose.ConnectsPerMinute),
+		proxies:  newProxyList(&cfg.Server),
+		minValue: minValue,
+		streams:  make(map[string]int),
+	})
+}
+
+// ServeHTTP opens a new stream for the client, if the client fits into the limits.
+// The connection is taken over from the HTTP server, so the stream is not terminated
+// by the write timeout of regular API requests.
+func (fh *firehose) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	addr := fh.proxies.clientAddr(r)
+	if !fh.limiter.allow(addr) {
+		http.Error(w, "too many streams opened, please retry later", http.StatusTooManyRequests)
+		return
+	}
+	if err := fh.acquire(addr); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	defer fh.release(addr)
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// headers set so far, e.g. by the CORS handler, go with the response
+	hdr := w.Header().Clone()
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		fh.log.Errorf("can not take over firehose connection of %s; %s", addr, err.Error())
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			fh.log.Debugf("can not close firehose connection of %s; %s", addr, err.Error())
+		}
+	}()
+
+	// deadlines of the server apply to regular requests only;
+	// the stream ends when the client closes the connection
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, rw.Reader)
+		cancel()
+	}()
+
+	hdr.Set("Content-Type", "text/event-stream")
+	hdr.Set("Cache-Control", "no-cache")
+	hdr.Set("Connection", "close")
+	hdr.Del("Content-Length")
+	_, _ = rw.WriteString("HTTP/1.1 200 OK\r\n")
+	if err := hdr.Write(rw); err != nil {
+		return
+	}
+	_, _ = rw.WriteString("\r\n")
+
+	fh.log.Debugf("firehose stream of %s opened", addr)
+	fh.stream(ctx, conn, rw.Writer)
+	fh.log.Debugf("firehose stream of %s closed", addr)
+}
+
+// stream sends the events of new blocks and large transactions to the client until it leaves.
+func (fh *firehose) stream(ctx context.Context, conn net.Conn, w *bufio.Writer) {
+	blocks := fh.rs.OnBlock(ctx)
+	trxs := fh.rs.OnTransaction(ctx)
+
+	ka := time.NewTicker(firehoseKeepAlive)
+	defer ka.Stop()
+
+	// flush the response headers right away
+	if err := fh.write(conn, w, ": connected\n\n"); err != nil {
+		return
+	}
+
+	for {
+		var msg string
+		select {
+		case <-ctx.Done():
+			return
+		case <-ka.C:
+			msg = ": keep-alive\n\n"
+		case blk, ok := <-blocks:
+			if !ok {
+				return
+			}
+			msg = fh.event("block", &firehoseBlock{
+				Number:       blk.Number,
+				Hash:         blk.Hash,
+				TimeStamp:    blk.TimeStamp,
+				GasUsed:      blk.GasUsed,
+				Transactions: len(blk.Txs),
+			})
+		case trx, ok := <-trxs:
+			if !ok {
+				return
+			}
+			if trx.Value.ToInt().Cmp(fh.minValue) < 0 {
+				continue
+			}
+			msg = fh.event("transaction", &firehoseTrx{
+				Hash:        trx.Hash,
+				BlockNumber: trx.BlockNumber,
+				From:        trx.From,
+				To:          trx.To,
+				Value:       trx.Value,
+				TimeStamp:   trx.TimeStamp.Unix(),
+			})
+		}
+
+		if msg == "" {
+			continue
+		}
+		if err := fh.write(conn, w, msg); err != nil {
+			return
+		}
+	}
+}
+
+// event encodes an event of the given name and data in the Server-Sent Events format.
+func (fh *firehose) event(name string, data interface{}) string {
+	js, err := json.Marshal(data)
+	if err != nil {
+		fh.log.Errorf("can not encode firehose %s event; %s", name, err.Error())
+		return ""
+	}
+	return fmt.Sprintf("event: %s\ndata: %s\n\n", name, js)
+}
+
+// write sends the given message to the client within the write timeout.
+func (fh *firehose) write(conn net.Conn, w *bufio.Writer, msg string) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(firehoseWriteTimeout)); err != nil {
+		return err
+	}
+	if _, err := w.WriteString(msg); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// acquire registers a new stream of the given client address, if both the client
+// and the server fit into their limits of concurrent streams.
+func (fh *firehose) acquire(addr string) error {
+	fh.lock.Lock()
+	defer fh.lock.Unlock()
+
+	if fh.cfg.MaxStreams > 0 && fh.total >= fh.cfg.MaxStreams {
+		return fmt.Errorf("too many streams open, please retry later")
+	}
+	if fh.streams[addr] >= fh.cfg.MaxStreamsPerAddr {
+		return fmt.Errorf("at most %d concurrent streams allowed", fh.cfg.MaxStreamsPerAddr)
+	}
+	fh.streams[addr]++
+	fh.total++
+	return nil
+}
+
+// release unregisters a closed stream of the given client address.
+func (fh *firehose) release(addr string) {
+	fh.lock.Lock()
+	defer fh.lock.Unlock()
+
+	fh.total--
+	fh.streams[addr]--
+	if fh.streams[addr] <= 0 {
+		delete(fh.streams, addr)
+	}
+}
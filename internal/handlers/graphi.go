@@ -1,11 +1,24 @@
 package handlers
 
 import (
+	"axis-graphql/internal/config"
 	"axis-graphql/internal/logger"
+	"bufio"
+	"embed"
 	"html/template"
+	"io/fs"
 	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
 )
 
+// exampleQueries represents the library of curated example queries shipped with the playground.
+//
+//go:embed examples/*.graphql
+var exampleQueries embed.FS
+
 // graphiqlTemplate represents the template for the GraphiQL HTML output.
 const graphiqlTemplate = `
 <!DOCTYPE html>
@@ -18,10 +31,24 @@ const graphiqlTemplate = `
 		   <script src="https://cdnjs.cloudflare.com/ajax/libs/graphiql/0.11.10/graphiql.js"></script>
 		   <script src="//unpkg.com/subscriptions-transport-ws@0.8.3/browser/client.js"></script>
 		   <script src="//unpkg.com/graphiql-subscriptions-fetcher@0.0.2/browser/client.js"></script>
+		   <style>
+				   #examples { height: 40px; padding: 0 12px; display: flex; align-items: center; font-family: sans-serif; font-size: 13px; background: #f3f3f3; border-bottom: 1px solid #d0d0d0; }
+				   #examples select { margin: 0 8px; }
+				   #examples span { color: #777; }
+		   </style>
    </head>
    <body style="width: 100%; height: 100%; margin: 0; overflow: hidden;">
-		   <div id="graphiql" style="height: 100vh;">Loading...</div>
+		   <div id="examples">
+				   Examples:
+				   <select id="example" onchange="pick(this.value)">
+						   <option value="">-- pick an example query --</option>
+						   {{ range $i, $e := .Examples }}<option value="{{ $i }}">{{ $e.Title }}</option>{{ end }}
+				   </select>
+				   <span id="example-info"></span>
+		   </div>
+		   <div id="graphiql" style="height: calc(100vh - 41px);">Loading...</div>
 		   <script>
+				   var examples = {{ .Examples }};
 				   function graphQLFetcher(graphQLParams) {
 						   return fetch("/graphql", {
 								   method: "post",
@@ -37,36 +64,116 @@ const graphiqlTemplate = `
 								   }
 						   });
 				   }
-				   var subscriptionsClient = new window.SubscriptionsTransportWs.SubscriptionClient('wss://{{ . }}/graphql', { reconnect: true });
+				   var subscriptionsClient = new window.SubscriptionsTransportWs.SubscriptionClient('wss://{{ .Address }}/graphql', { reconnect: true });
 				   var subscriptionsFetcher = window.GraphiQLSubscriptionsFetcher.graphQLFetcher(subscriptionsClient, graphQLFetcher);
-				   ReactDOM.render(
-						   React.createElement(GraphiQL, {fetcher: subscriptionsFetcher}),
-						   document.getElementById("graphiql")
-				   );
+				   function render(query) {
+						   ReactDOM.render(
+								   React.createElement(GraphiQL, {fetcher: subscriptionsFetcher, query: query}),
+								   document.getElementById("graphiql")
+						   );
+				   }
+				   function pick(idx) {
+						   var ex = examples[idx];
+						   document.getElementById("example-info").textContent = ex ? ex.Description : "";
+						   if (ex) {
+								   render(ex.Query);
+						   }
+				   }
+				   render(undefined);
 		   </script>
    </body>
 </html>
 `
 
+// playgroundExample represents a single example query of the playground library.
+type playgroundExample struct {
+	Title       string
+	Description string
+	Query       string
+}
+
 // GraphiHandler builds a HTTP handler function for GraphiQL playground.
-func GraphiHandler(address string, log logger.Logger) http.Handler {
+// The playground offers the library of example queries including the queries
+// found in the configured examples directory, if any.
+func GraphiHandler(cfg *config.Config, log logger.Logger) http.Handler {
+	// parse the template, we don't expect it to fail
+	t, err := template.New("graphiql").Parse(graphiqlTemplate)
+	if err != nil {
+		log.Criticalf("can not parse GraphiQL template; %s", err.Error())
+	}
+
+	// load the examples library
+	examples := loadPlaygroundExamples(exampleQueries, "examples", log)
+	if cfg.Playground.ExamplesDir != "" {
+		examples = append(examples, loadPlaygroundExamples(os.DirFS(cfg.Playground.ExamplesDir), ".", log)...)
+	}
+	log.Debugf("playground loaded %d example queries", len(examples))
+
 	// build the handler function
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// parse the template, we don't expect it to fail
-		t, err := template.New("graphiql").Parse(graphiqlTemplate)
-		if err != nil {
-			// log and send 500 response to client
-			log.Critical("can not parse GraphiQL template; %s", err.Error())
+		if t == nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 
 		// execute the template
-		err = t.Execute(w, address)
+		err := t.Execute(w, struct {
+			Address  string
+			Examples []playgroundExample
+		}{
+			Address:  cfg.Server.DomainAddress,
+			Examples: examples,
+		})
 		if err != nil {
 			// log and send 500 response to client
-			log.Critical("can not server GraphiQL playground; %s", err.Error())
+			log.Criticalf("can not server GraphiQL playground; %s", err.Error())
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	})
 }
+
+// loadPlaygroundExamples loads example queries from the given directory of the file system.
+// Leading comment lines of each query file are used as the title and the description of the example.
+func loadPlaygroundExamples(fsys fs.FS, dir string, log logger.Logger) []playgroundExample {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.graphql"))
+	if err != nil {
+		log.Errorf("can not list example queries; %s", err.Error())
+		return nil
+	}
+	sort.Strings(files)
+
+	list := make([]playgroundExample, 0, len(files))
+	for _, fn := range files {
+		data, err := fs.ReadFile(fsys, fn)
+		if err != nil {
+			log.Errorf("can not read example query %s; %s", fn, err.Error())
+			continue
+		}
+		list = append(list, parsePlaygroundExample(path.Base(fn), string(data)))
+	}
+	return list
+}
+
+// parsePlaygroundExample decodes the example query from the given file content.
+func parsePlaygroundExample(name string, data string) playgroundExample {
+	ex := playgroundExample{Title: strings.TrimSuffix(name, ".graphql"), Query: data}
+
+	// the first comment line is the title, the rest of the leading comments is the description
+	sc := bufio.NewScanner(strings.NewReader(data))
+	desc := make([]string, 0)
+	for i := 0; sc.Scan(); i++ {
+		line := strings.TrimSpace(sc.Text())
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+
+		line = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+		if i == 0 {
+			ex.Title = line
+			continue
+		}
+		desc = append(desc, line)
+	}
+	ex.Description = strings.Join(desc, " ")
+	return ex
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	// graphqlOperationQuery represents the kind of GraphQL query operations.
+	graphqlOperationQuery = "query"
+
+	// graphqlOperationSubscription represents the kind of GraphQL subscription operations.
+	graphqlOperationSubscription = "subscription"
+)
+
+// graphqlRequestFromURL decodes a GraphQL operation encoded in the URL query of a GET request.
+func graphqlRequestFromURL(u *url.URL) (*graphqlRequest, error) {
+	q := u.Query()
+	req := graphqlRequest{
+		Query:         q.Get("query"),
+		OperationName: q.Get("operationName"),
+	}
+	if req.Query == "" {
+		return nil, fmt.Errorf("query not specified")
+	}
+
+	if v := q.Get("variables"); v != "" {
+		if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+			return nil, fmt.Errorf("invalid variables; %s", err.Error())
+		}
+	}
+	return &req, nil
+}
+
+// graphqlOperation represents an operation, or a fragment, defined in a GraphQL document.
+type graphqlOperation struct {
+	kind string
+	name string
+}
+
+// operationKind finds the kind of the operation of the given name in the GraphQL document;
+// the only operation of the document is used if the name is empty. An empty string is returned
+// if the operation is not found. The document is not validated, the schema executor does it.
+func operationKind(doc string, name string) string {
+	var list []graphqlOperation
+	var cur *graphqlOperation
+	depth := 0
+
+	for _, t := range graphqlTokens(doc) {
+		switch t.kind {
+		case graphqlTokenPunct:
+			switch t.text {
+			case "{", "(", "[":
+				// a selection set without a keyword is a query shorthand
+				if depth == 0 && cur == nil {
+					cur = &graphqlOperation{kind: graphqlOperationQuery}
+				}
+				depth++
+			case "}", ")", "]":
+				depth--
+				if depth == 0 && t.text == "}" && cur != nil {
+					list = append(list, *cur)
+					cur = nil
+				}
+			}
+		case graphqlTokenName:
+			if depth == 0 {
+				if cur == nil {
+					cur = &graphqlOperation{kind: t.text}
+				} else if cur.name == "" {
+					cur.name = t.text
+				}
+			}
+		}
+	}
+
+	// pick the operation
+	var found *graphqlOperation
+	for i := range list {
+		if list[i].kind == "fragment" || (name != "" && list[i].name != name) {
+			continue
+		}
+		if found != nil {
+			return ""
+		}
+		found = &list[i]
+	}
+	if found == nil {
+		return ""
+	}
+	return found.kind
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import "strings"
+
+// graphqlTokenKind represents the kind of a lexical token of a GraphQL document.
+type graphqlTokenKind int
+
+const (
+	// graphqlTokenPunct represents punctuators, i.e. { } ( ) [ ] : = ! | & and the spread "...".
+	graphqlTokenPunct graphqlTokenKind = iota
+
+	// graphqlTokenName represents names of keywords, operations, fields, aliases, arguments and types.
+	graphqlTokenName
+
+	// graphqlTokenVariable represents variables including the $ prefix.
+	graphqlTokenVariable
+
+	// graphqlTokenDirective represents directives including the @ prefix.
+	graphqlTokenDirective
+
+	// graphqlTokenValue represents strings, block strings and numbers.
+	graphqlTokenValue
+)
+
+// graphqlToken represents a lexical token of a GraphQL document at the given position.
+type graphqlToken struct {
+	kind graphqlTokenKind
+	text string
+	pos  int
+}
+
+// end provides the position in the document right after the token.
+func (t *graphqlToken) end() int {
+	return t.pos + len(t.text)
+}
+
+// graphqlTokens splits the GraphQL document into lexical tokens; white space, commas and comments
+// are skipped. The query parser of the GraphQL library is internal, so this is the single lexer
+// of documents inspected before execution by the scheduler, the compatibility layer and
+// the operation kind checks. The document is not validated, the schema executor does it;
+// an unterminated string makes the rest of the document a single value token.
+func graphqlTokens(doc string) []graphqlToken {
+	list := make([]graphqlToken, 0, len(doc)/4)
+	for i := 0; i < len(doc); {
+		c, start := doc[i], i
+		kind := graphqlTokenPunct
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ',':
+			i++
+			continue
+		case c == '#':
+			for i < len(doc) && doc[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '"':
+			kind = graphqlTokenValue
+			if strings.HasPrefix(doc[i:], `"""`) {
+				end := strings.Index(doc[i+3:], `"""`)
+				if end < 0 {
+					i = len(doc)
+				} else {
+					i += end + 6
+				}
+				break
+			}
+			for i++; i < len(doc) && doc[i] != '"'; i++ {
+				if doc[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		case c == '$' || c == '@':
+			kind = graphqlTokenVariable
+			if c == '@' {
+				kind = graphqlTokenDirective
+			}
+			for i++; i < len(doc) && isGraphQLNameChar(doc[i]); i++ {
+			}
+		case c == '.' && strings.HasPrefix(doc[i:], "..."):
+			i += 3
+		case c == '-' || (c >= '0' && c <= '9'):
+			kind = graphqlTokenValue
+			for i++; i < len(doc) && isGraphQLNumberChar(doc, i); i++ {
+			}
+		case isGraphQLNameChar(c):
+			kind = graphqlTokenName
+			for i++; i < len(doc) && isGraphQLNameChar(doc[i]); i++ {
+			}
+		default:
+			i++
+		}
+
+		if i > len(doc) {
+			i = len(doc)
+		}
+		list = append(list, graphqlToken{kind: kind, text: doc[start:i], pos: start})
+	}
+	return list
+}
+
+// isGraphQLNumberChar checks if the character at the given position of the document continues a number.
+func isGraphQLNumberChar(doc string, i int) bool {
+	c := doc[i]
+	if c == '+' || c == '-' {
+		return doc[i-1] == 'e' || doc[i-1] == 'E'
+	}
+	return c == '.' || isGraphQLNameChar(c)
+}
+
+// isGraphQLNameChar checks if the given character can be a part of a GraphQL name.
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// followedBy checks if the token next to the token at the given index is the given punctuator.
+func followedBy(list []graphqlToken, i int, punct string) bool {
+	return i+1 < len(list) && list[i+1].kind == graphqlTokenPunct && list[i+1].text == punct
+}
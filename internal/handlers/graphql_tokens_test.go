@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestGraphQLTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		toks []string
+	}{
+		{name: "selection", doc: `{ block(number: 1) { hash } }`, toks: []string{"{", "block", "(", "number", ":", "1", ")", "{", "hash", "}", "}"}},
+		{name: "commas and comments", doc: "{ a, b # c d\n e }", toks: []string{"{", "a", "b", "e", "}"}},
+		{name: "variables and directives", doc: `query($x: [Int!]) { a @include(if: $x) }`, toks: []string{"query", "(", "$x", ":", "[", "Int", "!", "]", ")", "{", "a", "@include", "(", "if", ":", "$x", ")", "}"}},
+		{name: "spreads", doc: `{ ...F ... on T { a } }`, toks: []string{"{", "...", "F", "...", "on", "T", "{", "a", "}", "}"}},
+		{name: "strings", doc: `{ a(s: "x \" } y", b: """ { """) }`, toks: []string{"{", "a", "(", "s", ":", `"x \" } y"`, "b", ":", `""" { """`, ")", "}"}},
+		{name: "numbers", doc: `{ a(f: -1.5e+3, i: 10) }`, toks: []string{"{", "a", "(", "f", ":", "-1.5e+3", "i", ":", "10", ")", "}"}},
+		{name: "unterminated string", doc: `{ a(s: "x`, toks: []string{"{", "a", "(", "s", ":", `"x`}},
+		{name: "unterminated block string", doc: `{ a(s: """ x } `, toks: []string{"{", "a", "(", "s", ":", `""" x } `}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			list := graphqlTokens(tc.doc)
+			texts := make([]string, len(list))
+			for i := range list {
+				texts[i] = list[i].text
+				g.Expect(tc.doc[list[i].pos:list[i].end()]).To(gomega.Equal(list[i].text))
+			}
+			g.Expect(texts).To(gomega.Equal(tc.toks))
+		})
+	}
+}
+
+func TestOperationKind(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		op   string
+		kind string
+	}{
+		{name: "shorthand", doc: `{ block { hash } }`, kind: graphqlOperationQuery},
+		{name: "subscription", doc: `subscription { onBlock { hash } }`, kind: graphqlOperationSubscription},
+		{name: "named", doc: `query A { a } mutation B { b }`, op: "B", kind: "mutation"},
+		{name: "ambiguous", doc: `query A { a } mutation B { b }`, kind: ""},
+		{name: "fragments skipped", doc: `fragment F on Query { a } query A { ...F }`, kind: graphqlOperationQuery},
+		{name: "keyword in string", doc: `query A { a(s: "} mutation B {") }`, op: "B", kind: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(operationKind(tc.doc, tc.op)).To(gomega.Equal(tc.kind))
+		})
+	}
+}
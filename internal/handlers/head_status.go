@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"axis-graphql/internal/repository"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// headExtension represents the name of the response extension describing the freshness of the chain head.
+const headExtension = "head"
+
+// headStatus represents the freshness of the data served, derived from the last block observed on the node.
+// Block and age are missing if no block has been observed since the server started.
+type headStatus struct {
+	Block *hexutil.Uint64 `json:"block,omitempty"`
+	Age   *int64          `json:"age,omitempty"`
+	Stale bool            `json:"stale"`
+}
+
+// withHeadStatus adds the age of the last observed block in seconds to the extensions of the response
+// and flags the response stale, if no block has been observed within the given window; front-ends
+// can display degraded data warnings during a chain halt, or a lost node connection.
+func withHeadStatus(res *graphql.Response, staleAfter time.Duration) *graphql.Response {
+	if staleAfter <= 0 {
+		return res
+	}
+
+	hs := headStatus{Stale: true}
+	bp := repository.R().BlockProduction()
+	if !bp.LastBlockTime.IsZero() {
+		age := time.Since(bp.LastBlockTime)
+		block := hexutil.Uint64(bp.LastBlock)
+		sec := int64(age / time.Second)
+
+		hs.Block = &block
+		hs.Age = &sec
+		hs.Stale = age > staleAfter
+	}
+
+	if res.Extensions == nil {
+		res.Extensions = make(map[string]interface{})
+	}
+	res.Extensions[headExtension] = hs
+	return res
+}
+
+// isStale checks if the response is flagged stale by its head status.
+func isStale(res *graphql.Response) bool {
+	hs, ok := res.Extensions[headExtension].(headStatus)
+	return ok && hs.Stale
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"axis-graphql/internal/locale"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// localizeErrors replaces messages of errors with a machine code by their localized version, if available.
+// The machine code of the error does not change, so clients can still rely on it.
+func localizeErrors(lang string, res *graphql.Response) *graphql.Response {
+	for _, qe := range res.Errors {
+		code, ok := qe.Extensions["code"].(string)
+		if !ok {
+			continue
+		}
+		if msg, ok := locale.Message(lang, code, qe.Extensions); ok {
+			qe.Message = msg
+		}
+	}
+	return res
+}
+
+// registerEnums registers values of the enumerations of the given schema so their labels can be localized.
+func registerEnums(s *types.Schema) {
+	for name, nt := range s.Types {
+		enum, ok := nt.(*types.EnumTypeDefinition)
+		if !ok {
+			continue
+		}
+
+		values := make([]string, len(enum.EnumValuesDefinition))
+		for i, v := range enum.EnumValuesDefinition {
+			values[i] = v.EnumValue
+		}
+		locale.RegisterEnum(name, values)
+	}
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/logger"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// productionRequestMaxSize represents the max size of a GraphQL request body accepted in production mode.
+const productionRequestMaxSize = 1 << 20
+
+// ProductionHandler defines HTTP handler middleware hardening the API for production use.
+// Unauthenticated clients are served by a schema without introspection and their queries
+// are limited to the persisted queries allow list, if configured. Responses of all the clients
+// are capped to the configured size.
+//
+// Websocket connections are not capped, the responses are streamed per operation. Unauthenticated
+// websocket clients may subscribe freely, their queries and mutations are limited to the allow list
+// by the subscription guard of the public schema.
+type ProductionHandler struct {
+	logger  logger.Logger
+	cfg     *config.Production
+	public  http.Handler
+	private http.Handler
+}
+
+// persistedQueryRequest represents a GraphQL request with optional persisted query reference.
+type persistedQueryRequest struct {
+	ID            string                 `json:"id,omitempty"`
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+	Extensions    *struct {
+		PersistedQuery *struct {
+			Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions,omitempty"`
+}
+
+// ServeHTTP handles incoming request by picking the schema handler
+// based on the authentication and enforcing the production mode restrictions.
+func (h *ProductionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// authenticated clients are trusted, sandbox keys are public
+	ak := resolvers.ApiKeyFromContext(r.Context())
+	trusted := ak != nil && !ak.Sandbox
+
+	// websocket upgrade needs the connection of the response writer, it can not be capped
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		if trusted {
+			h.private.ServeHTTP(w, r)
+			return
+		}
+		h.public.ServeHTTP(w, r)
+		return
+	}
+	if trusted {
+		h.serveCapped(h.private, w, r)
+		return
+	}
+
+	// apply the allow list
+	if len(h.cfg.AllowList) > 0 {
+		if !h.allowed(w, r) {
+			return
+		}
+	}
+	h.serveCapped(h.public, w, r)
+}
+
+// allowed checks the queries of the request against the persisted queries allow list.
+// Persisted queries referenced by the hash only are expanded into the request body.
+func (h *ProductionHandler) allowed(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet {
+		return h.allowedGet(w, r)
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, productionRequestMaxSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	// decode the operation, or the batch of operations
+	var list []*persistedQueryRequest
+	if isBatchRequest(body) {
+		err = json.Unmarshal(body, &list)
+	} else {
+		var req persistedQueryRequest
+		err = json.Unmarshal(body, &req)
+		list = []*persistedQueryRequest{&req}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	// all the operations must be on the list
+	for _, req := range list {
+		if !h.expand(req) {
+			h.logger.Warningf("query of %s not on the allow list", r.RemoteAddr)
+			writeGraphQLError(w, "query not allowed", http.StatusForbidden)
+			return false
+		}
+	}
+
+	// rebuild the request body with the persisted queries
+	if isBatchRequest(body) {
+		body, err = json.Marshal(list)
+	} else {
+		body, err = json.Marshal(list[0])
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	return true
+}
+
+// allowedGet checks the query of a GET request against the persisted queries allow list.
+// Persisted queries referenced by the hash only are expanded into the request URL.
+func (h *ProductionHandler) allowedGet(w http.ResponseWriter, r *http.Request) bool {
+	q := r.URL.Query()
+	req := persistedQueryRequest{ID: q.Get("id"), Query: q.Get("query")}
+	if ext := q.Get("extensions"); ext != "" {
+		if err := json.Unmarshal([]byte(ext), &req.Extensions); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return false
+		}
+	}
+
+	if !h.expand(&req) {
+		h.logger.Warningf("query of %s not on the allow list", r.RemoteAddr)
+		writeGraphQLError(w, "query not allowed", http.StatusForbidden)
+		return false
+	}
+
+	// rebuild the request URL with the persisted query
+	q.Set("query", req.Query)
+	q.Del("id")
+	q.Del("extensions")
+	r.URL.RawQuery = q.Encode()
+	return true
+}
+
+// expand checks the given operation against the allow list
+// and replaces the query reference with the persisted query.
+func (h *ProductionHandler) expand(req *persistedQueryRequest) bool {
+	// find the hash of the query
+	hash := req.ID
+	if req.Extensions != nil && req.Extensions.PersistedQuery != nil {
+		hash = req.Extensions.PersistedQuery.Hash
+	}
+	if req.Query != "" {
+		hash = queryHash(req.Query)
+	}
+
+	// is the query on the list?
+	query, ok := allowListed(h.cfg.AllowList, hash)
+	if !ok {
+		return false
+	}
+
+	req.Query = query
+	req.ID = ""
+	req.Extensions = nil
+	return true
+}
+
+// allowListed finds the persisted query of the given hash on the allow list.
+func allowListed(list map[string]string, hash string) (string, bool) {
+	query, ok := list[strings.ToLower(hash)]
+	return query, ok
+}
+
+// queryHash provides the hex encoded SHA256 hash of the given query the allow list is keyed by.
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// serveCapped passes the request to the given handler and makes sure
+// the response does not exceed the configured size.
+func (h *ProductionHandler) serveCapped(next http.Handler, w http.ResponseWriter, r *http.Request) {
+	if h.cfg.MaxResponseSize <= 0 {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	// collect the response
+	cw := cappedResponseWriter{header: make(http.Header), limit: h.cfg.MaxResponseSize}
+	next.ServeHTTP(&cw, r)
+
+	if cw.exceeded {
+		h.logger.Warningf("response to %s exceeded %d bytes", r.RemoteAddr, h.cfg.MaxResponseSize)
+		writeGraphQLError(w, "response size limit exceeded", http.StatusOK)
+		return
+	}
+
+	// copy the response to the client
+	for k, v := range cw.header {
+		w.Header()[k] = v
+	}
+	if cw.status != 0 {
+		w.WriteHeader(cw.status)
+	}
+	if _, err := w.Write(cw.buf.Bytes()); err != nil {
+		h.logger.Errorf("can not write response; %s", err.Error())
+	}
+}
+
+// cappedResponseWriter implements response writer collecting the response
+// up to the given size limit.
+type cappedResponseWriter struct {
+	header   http.Header
+	status   int
+	limit    int
+	exceeded bool
+	buf      bytes.Buffer
+}
+
+// Header returns the header map of the collected response.
+func (cw *cappedResponseWriter) Header() http.Header {
+	return cw.header
+}
+
+// WriteHeader records the status code of the collected response.
+func (cw *cappedResponseWriter) WriteHeader(status int) {
+	cw.status = status
+}
+
+// Write collects the response data; data beyond the size limit are dropped.
+func (cw *cappedResponseWriter) Write(data []byte) (int, error) {
+	if cw.exceeded || cw.buf.Len()+len(data) > cw.limit {
+		cw.exceeded = true
+		return len(data), nil
+	}
+	return cw.buf.Write(data)
+}
+
+// writeGraphQLError sends a GraphQL formatted error response to the client.
+func writeGraphQLError(w http.ResponseWriter, msg string, status int) {
+	data, _ := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{{"message": msg}},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// RequireApiKey constructs HTTP handler middleware denying access
+// to the given handler for unauthenticated requests; sandbox keys are not enough.
+func RequireApiKey(cfg *config.Config, log logger.Logger, next http.Handler) http.Handler {
+	return newAuthHandler(cfg, log, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := resolvers.ApiKeyFromContext(r.Context()); key == nil || key.Sandbox {
+			http.Error(w, "API key required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// RequireAdminKey constructs HTTP handler middleware denying access
+// to the given handler for requests not authenticated by an admin API key.
+func RequireAdminKey(cfg *config.Config, log logger.Logger, next http.Handler) http.Handler {
+	return newAuthHandler(cfg, log, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := resolvers.ApiKeyFromContext(r.Context())
+		if key == nil || !key.Admin {
+			http.Error(w, "admin API key required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter implements a fixed one minute window limit of requests per API key.
+type rateLimiter struct {
+	lock   sync.Mutex
+	limit  int
+	window int64
+	counts map[string]int
+}
+
+// newRateLimiter creates a new rate limiter allowing the given number of requests per minute.
+func newRateLimiter(limit int) *rateLimiter {
+	return &rateLimiter{limit: limit, counts: make(map[string]int)}
+}
+
+// allow registers a request of the given key and checks it fits into the limit.
+func (rl *rateLimiter) allow(key string) bool {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	// new window resets all the counters
+	now := time.Now().Unix() / 60
+	if now != rl.window {
+		rl.window = now
+		rl.counts = make(map[string]int)
+	}
+
+	rl.counts[key]++
+	return rl.counts[key] <= rl.limit
+}
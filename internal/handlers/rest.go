@@ -30,3 +30,26 @@ func GasPrice(log logger.Logger) http.Handler {
 		}
 	})
 }
+
+// DefiOverview constructs and return the REST API HTTP handler for DeFi aggregates provider.
+func DefiOverview(log logger.Logger) http.Handler {
+	// build the handler function
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// get the latest aggregates
+		val, err := repository.R().DefiOverview()
+		if err != nil {
+			log.Criticalf("can not get DeFi overview; %s", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// respond
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(val)
+		if err != nil {
+			log.Criticalf("can not encode DeFi overview structure; %s", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/metrics"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	// opClassCore represents cheap reads required by wallets to stay functional.
+	opClassCore = iota
+
+	// opClassHeavy represents scrollable lists and aggregations.
+	opClassHeavy
+
+	// opClassExport represents bulk data exports.
+	opClassExport
+)
+
+// opClassNames represents the names of the operation classes used by metrics.
+var opClassNames = []string{"core", "heavy", "export"}
+
+// errSchedulerBusy represents the error of an operation which did not get a worker in time.
+var errSchedulerBusy = fmt.Errorf("the server is under heavy load, please retry later")
+
+// opRootClasses represents the classes of the root fields; root fields not listed are cheap reads.
+var opRootClasses = map[string]int{
+	"accounts":            opClassHeavy,
+	"erc20BalancesBulk":   opClassHeavy,
+	"blocks":              opClassHeavy,
+	"transactions":        opClassHeavy,
+	"contracts":           opClassHeavy,
+	"epochs":              opClassHeavy,
+	"portfolio":           opClassHeavy,
+	"counterparties":      opClassHeavy,
+	"stakeFlows":          opClassHeavy,
+	"nextActions":         opClassHeavy,
+	"upcomingEvents":      opClassHeavy,
+	"delegationsOf":       opClassHeavy,
+	"erc20Transactions":   opClassHeavy,
+	"erc721Transactions":  opClassHeavy,
+	"erc1155Transactions": opClassHeavy,
+	"feesPaid":            opClassHeavy,
+	"gasPriceHistory":     opClassHeavy,
+	"govProposals":        opClassHeavy,
+	"quoteSwap":           opClassHeavy,
+	"trxVolume":           opClassHeavy,
+	"positionHistory":     opClassHeavy,
+	"defiUniswapActions":  opClassHeavy,
+	"validatorEarnings":   opClassHeavy,
+	"contractCall":        opClassHeavy,
+	"decodeTransaction":   opClassHeavy,
+	"taxReport":           opClassExport,
+	"startAccountExport":  opClassExport,
+	"accountExport":       opClassExport,
+}
+
+// opFieldClasses represents the classes of nested fields with names unique across the schema.
+var opFieldClasses = map[string]int{
+	"methodStats":     opClassHeavy,
+	"mintBurnHistory": opClassHeavy,
+	"stats":           opClassHeavy,
+}
+
+// opScheduler executes GraphQL operations by separate pools of workers of each operation class,
+// so bulk analytics can not starve simple wallet lookups under load. Operations waiting
+// for a worker too long, or over the queue capacity, are rejected with RETRY_LATER error.
+type opScheduler struct {
+	cfg    *config.Scheduler
+	slots  [3]chan struct{}
+	queued [3]int32
+}
+
+// newOpScheduler creates a new operations scheduler.
+func newOpScheduler(cfg *config.Scheduler) *opScheduler {
+	s := opScheduler{cfg: cfg}
+	for i, size := range []int{cfg.CoreWorkers, cfg.HeavyWorkers, cfg.ExportWorkers} {
+		if size < 1 {
+			size = 1
+		}
+		s.slots[i] = make(chan struct{}, size)
+	}
+	return &s
+}
+
+// exec executes the given operation by a worker of the operation class.
+func (s *opScheduler) exec(ctx context.Context, query string, fn func() *graphql.Response) *graphql.Response {
+	class := classifyOperation(query)
+	if err := s.acquire(ctx, class); err != nil {
+		metrics.Counter(fmt.Sprintf("scheduler/%s/rejected", opClassNames[class])).Inc(1)
+		return &graphql.Response{Errors: []*gqlErrors.QueryError{{
+			Message:    err.Error(),
+			Extensions: map[string]interface{}{"code": resolvers.ErrCodeRetryLater},
+		}}}
+	}
+	defer func() { <-s.slots[class] }()
+
+	metrics.Counter(fmt.Sprintf("scheduler/%s/executed", opClassNames[class])).Inc(1)
+	return fn()
+}
+
+// acquire waits for a free worker of the given class.
+func (s *opScheduler) acquire(ctx context.Context, class int) error {
+	// fast path, a worker is available
+	select {
+	case s.slots[class] <- struct{}{}:
+		return nil
+	default:
+	}
+
+	// is there a space in the queue?
+	if int(atomic.AddInt32(&s.queued[class], 1)) > s.cfg.QueueSize {
+		atomic.AddInt32(&s.queued[class], -1)
+		return errSchedulerBusy
+	}
+	defer atomic.AddInt32(&s.queued[class], -1)
+
+	tm := time.NewTimer(s.cfg.QueueTimeout)
+	defer tm.Stop()
+
+	select {
+	case s.slots[class] <- struct{}{}:
+		return nil
+	case <-tm.C:
+		return errSchedulerBusy
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// opRootTypes represents the names of the root operation types; fragments defined on them select root fields.
+var opRootTypes = map[string]bool{"Query": true, "Mutation": true, "Subscription": true}
+
+// classifyOperation picks the class of the given GraphQL document by the fields it selects;
+// the most expensive field found decides. Arguments, strings, comments and aliases are skipped.
+// Selections of inline fragments belong to the enclosing selection set, fragments defined
+// on the root types select root fields, fragments defined on other types select nested fields.
+func classifyOperation(doc string) int {
+	class := opClassCore
+	depth, args := 0, 0
+
+	// levels keeps the depth added by each open selection set,
+	// next is the depth added by the selection set opened next
+	levels := make([]int, 0, 8)
+	next := 1
+
+	toks := graphqlTokens(doc)
+	for i := 0; i < len(toks); i++ {
+		t := &toks[i]
+		switch t.kind {
+		case graphqlTokenPunct:
+			switch t.text {
+			case "...":
+				// inline fragment "... on Type {", or "... {"; named fragment spread "...Name"
+				switch {
+				case i+1 < len(toks) && toks[i+1].kind == graphqlTokenName && toks[i+1].text == "on":
+					next = 0
+					i += 2
+				case i+1 < len(toks) && toks[i+1].kind == graphqlTokenName:
+					i++
+				default:
+					next = 0
+				}
+			case "{":
+				levels = append(levels, next)
+				depth += next
+				next = 1
+			case "}":
+				if len(levels) > 0 {
+					depth -= levels[len(levels)-1]
+					levels = levels[:len(levels)-1]
+				}
+			case "(":
+				args++
+			case ")":
+				args--
+			}
+
+		case graphqlTokenName:
+			// fragment definition "fragment Name on Type {"
+			if depth == 0 && t.text == "fragment" {
+				if i+3 >= len(toks) || !opRootTypes[toks[i+3].text] {
+					next = 2
+				}
+				i += 3
+				continue
+			}
+
+			if depth > 0 && args == 0 && !followedBy(toks, i, ":") {
+				if cl, ok := opFieldClasses[t.text]; ok && cl > class {
+					class = cl
+				}
+				if cl, ok := opRootClasses[t.text]; ok && depth == 1 && cl > class {
+					class = cl
+				}
+			}
+		}
+	}
+	return class
+}
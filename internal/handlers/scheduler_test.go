@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestClassifyOperation(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		class int
+	}{
+		// plain selections
+		{name: "core root", doc: `{ block(number: 1) { hash } }`, class: opClassCore},
+		{name: "heavy root", doc: `query { blocks(count: 10) { totalCount } }`, class: opClassHeavy},
+		{name: "export root", doc: `mutation { startAccountExport(address: "0x1") { id } }`, class: opClassExport},
+		{name: "most expensive root decides", doc: `{ block { hash } taxReport(address: "0x1") { total } blocks { totalCount } }`, class: opClassExport},
+		{name: "accounts root", doc: `{ accounts(addresses: ["0x1", "0x2"]) { balance } }`, class: opClassHeavy},
+		{name: "contract call root", doc: `{ contractCall(contract: "0x1", input: "0x2") { output } }`, class: opClassHeavy},
+		{name: "decode transaction root", doc: `{ decodeTransaction(rawRlp: "0x1") { hash } }`, class: opClassHeavy},
+		{name: "account export root", doc: `{ accountExport(id: "1") { status } }`, class: opClassExport},
+		{name: "bulk balances root", doc: `{ erc20BalancesBulk(owner: "0x1", tokens: ["0x2"]) { balance } }`, class: opClassHeavy},
+		{name: "heavy root name nested", doc: `{ account(address: "0x1") { blocks transactions { totalCount } } }`, class: opClassCore},
+		{name: "heavy nested field", doc: `{ contract(address: "0x1") { methodStats { calls } } }`, class: opClassHeavy},
+		{name: "named operation with variables", doc: `query Blocks($count: Int = 10) { blocks(count: $count) { totalCount } }`, class: opClassHeavy},
+		{name: "variable named as heavy root", doc: `query Q($blocks: Int) { block(number: $blocks) { hash } }`, class: opClassCore},
+		{name: "directive named as heavy root", doc: `{ block @blocks { hash } }`, class: opClassCore},
+
+		// aliases
+		{name: "aliased heavy root", doc: `{ list: blocks(count: 10) { totalCount } }`, class: opClassHeavy},
+		{name: "aliased heavy root without spaces", doc: `{list:blocks{totalCount}}`, class: opClassHeavy},
+		{name: "alias named as heavy root", doc: `{ blocks: block(number: 1) { hash } }`, class: opClassCore},
+		{name: "alias named as export root", doc: `{ taxReport : block { hash } }`, class: opClassCore},
+		{name: "aliased nested field", doc: `{ contract(address: "0x1") { s: methodStats { calls } } }`, class: opClassHeavy},
+
+		// fragments
+		{name: "fragment on root", doc: `{ ...Lists } fragment Lists on Query { blocks { totalCount } }`, class: opClassHeavy},
+		{name: "fragment on root before use", doc: `fragment Lists on Query { epochs { totalCount } } query { ...Lists }`, class: opClassHeavy},
+		{name: "fragment on nested type", doc: `{ account(address: "0x1") { ...Acc } } fragment Acc on Account { transactions { totalCount } }`, class: opClassCore},
+		{name: "fragment on nested type with heavy field", doc: `{ contract(address: "0x1") { ...Sc } } fragment Sc on Contract { methodStats { calls } }`, class: opClassHeavy},
+		{name: "fragment spread named as heavy root", doc: `{ ...blocks } fragment blocks on Query { block { hash } }`, class: opClassCore},
+		{name: "inline fragment on root", doc: `{ ... on Query { blocks { totalCount } } }`, class: opClassHeavy},
+		{name: "inline fragment without type", doc: `query($all: Boolean!) { ... @include(if: $all) { transactions { totalCount } } }`, class: opClassHeavy},
+		{name: "inline fragment in nested selection", doc: `{ account(address: "0x1") { ... on Account { blocks } } }`, class: opClassCore},
+		{name: "nested fragments", doc: `{ ...A } fragment A on Query { ...B } fragment B on Query { counterparties { count } }`, class: opClassHeavy},
+
+		// comments and strings
+		{name: "commented heavy root", doc: "{\n  # blocks { totalCount }\n  block { hash }\n}", class: opClassCore},
+		{name: "comment at the end", doc: "{ block { hash } } # blocks", class: opClassCore},
+		{name: "heavy root after comment", doc: "# simple lookup\n{ blocks { totalCount } }", class: opClassHeavy},
+		{name: "heavy root in string", doc: `{ search(text: "blocks { totalCount }") { hash } }`, class: opClassCore},
+		{name: "escaped quote in string", doc: `{ search(text: "a\" blocks") { hash } blocks { totalCount } }`, class: opClassHeavy},
+		{name: "heavy root in block string", doc: `{ search(text: """ } blocks { """) { hash } }`, class: opClassCore},
+		{name: "unterminated block string", doc: `{ search(text: """ blocks`, class: opClassCore},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(classifyOperation(tc.doc)).To(gomega.Equal(tc.class), "class of %s", tc.doc)
+		})
+	}
+}
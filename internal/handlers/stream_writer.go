@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+)
+
+// streamWriteTimeout represents the max time a single chunk write of a long running
+// response may take; the deadline is extended with each chunk written.
+const streamWriteTimeout = 10 * time.Second
+
+// streamWriter implements http.ResponseWriter over a connection taken over from the HTTP server,
+// so long running responses are not terminated by the write timeout of regular API requests.
+// Each write must finish within the stream write timeout, so slow clients are still disconnected.
+type streamWriter struct {
+	conn        net.Conn
+	rw          *bufio.ReadWriter
+	hdr         http.Header
+	wroteHeader bool
+}
+
+// newStreamWriter takes over the connection of the given response writer. The returned context
+// is cancelled when the client closes the connection. The headers set so far go with the response.
+func newStreamWriter(w http.ResponseWriter) (*streamWriter, context.Context, context.CancelFunc, error) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("streaming not supported")
+	}
+
+	hdr := w.Header().Clone()
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// deadlines of the server apply to regular requests only
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return nil, nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, rw.Reader)
+		cancel()
+	}()
+	return &streamWriter{conn: conn, rw: rw, hdr: hdr}, ctx, cancel, nil
+}
+
+// Header provides the headers of the response.
+func (sw *streamWriter) Header() http.Header {
+	return sw.hdr
+}
+
+// WriteHeader sends the status line and the headers of the response.
+// The response body is delimited by closing the connection.
+func (sw *streamWriter) WriteHeader(code int) {
+	if sw.wroteHeader {
+		return
+	}
+	sw.wroteHeader = true
+
+	sw.hdr.Set("Connection", "close")
+	sw.hdr.Del("Transfer-Encoding")
+	_, _ = fmt.Fprintf(sw.rw, "HTTP/1.1 %d %s\r\n", code, http.StatusText(code))
+	_ = sw.hdr.Write(sw.rw)
+	_, _ = sw.rw.WriteString("\r\n")
+}
+
+// Write sends a chunk of the response body within the stream write timeout.
+func (sw *streamWriter) Write(b []byte) (int, error) {
+	if !sw.wroteHeader {
+		sw.WriteHeader(http.StatusOK)
+	}
+	if err := sw.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+		return 0, err
+	}
+	return sw.rw.Write(b)
+}
+
+// Flush sends the buffered data to the client within the stream write timeout.
+func (sw *streamWriter) Flush() {
+	if err := sw.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+		return
+	}
+	_ = sw.rw.Flush()
+}
+
+// Close flushes the buffered data and closes the connection.
+func (sw *streamWriter) Close() error {
+	if !sw.wroteHeader {
+		sw.WriteHeader(http.StatusOK)
+	}
+	sw.Flush()
+	return sw.conn.Close()
+}
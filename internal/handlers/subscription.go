@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/graphql/resolvers"
+	"axis-graphql/internal/logger"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// wsInitPayloadContextKey is the key the websocket transport stores the connection init payload under.
+const wsInitPayloadContextKey = "Header"
+
+// SubscriptionGuard wraps the GraphQL schema of the websocket transport to authenticate subscriptions
+// and enforce the per-key limits. The API key is taken from the upgraded HTTP request,
+// or from the payload of the connection init message, i.e. {"apiKey": "..."}.
+//
+// The transport passes all the operations of the connection to the guard, not only subscriptions.
+// If the allow list is set, queries and mutations of clients without a full API key must be on it.
+type SubscriptionGuard struct {
+	logger    logger.Logger
+	cfg       *config.Subscriptions
+	auth      *AuthHandler
+	schema    *graphql.Schema
+	allowList map[string]string
+
+	// staleAfter represents the age of the head the events are flagged stale after
+	staleAfter time.Duration
+}
+
+// wsInitPayload represents the API key related fields of the connection init payload.
+type wsInitPayload struct {
+	ApiKey        string `json:"apiKey"`
+	XApiKey       string `json:"X-Api-Key"`
+	Authorization string `json:"Authorization"`
+}
+
+// Subscribe authenticates the subscription, checks the client limits and subscribes to the schema.
+// Events over the throughput limit of the client are dropped.
+func (g *SubscriptionGuard) Subscribe(ctx context.Context, document string, operationName string, variableValues map[string]interface{}) (<-chan interface{}, error) {
+	ctx, err := g.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !g.allowed(ctx, document, operationName) {
+		g.logger.Warningf("query of %s not on the allow list", resolvers.ClientAddrFromContext(ctx))
+		return nil, fmt.Errorf("query not allowed")
+	}
+
+	release, err := resolvers.AcquireSubscription(ctx)
+	if err != nil {
+		g.logger.Debugf("subscription of %s rejected; %s", resolvers.ClientAddrFromContext(ctx), err.Error())
+		return nil, err
+	}
+
+	c, err := g.schema.Subscribe(ctx, document, operationName, variableValues)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	out := make(chan interface{})
+	go g.forward(ctx, c, out, release)
+	return out, nil
+}
+
+// allowed checks the operation against the allow list of the guard, if any.
+// Subscriptions and operations of clients with a full API key are always allowed.
+func (g *SubscriptionGuard) allowed(ctx context.Context, document string, operationName string) bool {
+	if g.allowList == nil {
+		return true
+	}
+	if ak := resolvers.ApiKeyFromContext(ctx); ak != nil && !ak.Sandbox {
+		return true
+	}
+	if operationKind(document, operationName) == graphqlOperationSubscription {
+		return true
+	}
+	_, ok := allowListed(g.allowList, queryHash(document))
+	return ok
+}
+
+// forward passes events of the subscription to the transport respecting the throughput limit.
+// Events carry the head status the same way responses of queries do.
+func (g *SubscriptionGuard) forward(ctx context.Context, in <-chan interface{}, out chan<- interface{}, release func()) {
+	defer func() {
+		release()
+		close(out)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			resolvers.SubscriptionDisconnected(ctx, resolvers.SubscriptionClientClosed)
+			return
+		case ev, ok := <-in:
+			if !ok {
+				resolvers.SubscriptionDisconnected(ctx, resolvers.SubscriptionStreamEnded)
+				return
+			}
+			if !resolvers.AllowSubscriptionEvent(ctx) {
+				continue
+			}
+			if res, ok := ev.(*graphql.Response); ok {
+				ev = withHeadStatus(res, g.staleAfter)
+			}
+
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				resolvers.SubscriptionDisconnected(ctx, resolvers.SubscriptionClientClosed)
+				return
+			}
+		}
+	}
+}
+
+// authenticate resolves the API key of the subscription and adds it to the context.
+func (g *SubscriptionGuard) authenticate(ctx context.Context) (context.Context, error) {
+	// authenticated by the upgraded request
+	if resolvers.ApiKeyFromContext(ctx) != nil {
+		return ctx, nil
+	}
+
+	// try the connection init payload
+	key := wsInitApiKey(ctx)
+	if key != "" {
+		ak := g.auth.find(key)
+		if ak == nil {
+			g.logger.Warningf("invalid API key used by %s for subscription", resolvers.ClientAddrFromContext(ctx))
+			return nil, fmt.Errorf("invalid API key")
+		}
+		return resolvers.ContextWithApiKey(ctx, ak), nil
+	}
+
+	if g.cfg.RequireKey {
+		return nil, fmt.Errorf("access denied, valid API key required for subscriptions")
+	}
+	return ctx, nil
+}
+
+// wsInitApiKey extracts the API key from the connection init payload, if any.
+func wsInitApiKey(ctx context.Context) string {
+	raw, ok := ctx.Value(wsInitPayloadContextKey).(json.RawMessage)
+	if !ok || len(raw) == 0 {
+		return ""
+	}
+
+	var pl wsInitPayload
+	if err := json.Unmarshal(raw, &pl); err != nil {
+		return ""
+	}
+
+	switch {
+	case pl.ApiKey != "":
+		return pl.ApiKey
+	case pl.XApiKey != "":
+		return pl.XApiKey
+	case len(pl.Authorization) > 7 && strings.EqualFold(pl.Authorization[:7], "bearer "):
+		return strings.TrimSpace(pl.Authorization[7:])
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"axis-graphql/internal/repository"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// TraceExport constructs HTTP handler streaming raw call traces of a block range
+// as newline delimited JSON, one trace per line. The range is given by the "from"
+// and "to" query parameters, both inclusive, as decimal or 0x prefixed hex numbers.
+// The connection is taken over from the HTTP server, so long exports are not cut
+// by the write timeout of regular API requests. If the export aborts in the middle,
+// the stream is terminated by a line with the error and the number of the block.
+func TraceExport(cfg *config.TraceExport, log logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		from, to, err := traceExportRange(r, cfg.MaxBlockRange)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// bulk exports are shed first if the node is under pressure
+		if repository.R().IsNodeUnderPressure() {
+			log.Warningf("traces export of blocks #%d to #%d rejected due to node pressure", from, to)
+			w.Header().Set("Retry-After", "60")
+			http.Error(w, "the server is under heavy load, please retry later", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		sw, ctx, cancel, err := newStreamWriter(w)
+		if err != nil {
+			log.Errorf("can not take over traces export connection; %s", err.Error())
+			http.Error(w, "streaming not supported", http.StatusInternalServerError)
+			return
+		}
+		defer cancel()
+		defer func() {
+			if err := sw.Close(); err != nil {
+				log.Debugf("can not close traces export connection; %s", err.Error())
+			}
+		}()
+
+		traceExportStream(ctx, sw, from, to, log)
+	})
+}
+
+// traceExportStream writes traces of the block range to the stream until the range is done,
+// the client leaves, or loading traces fails.
+func traceExportStream(ctx context.Context, sw *streamWriter, from uint64, to uint64, log logger.Logger) {
+	num := from
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Criticalf("traces export of blocks #%d to #%d failed at #%d; %v", from, to, num, rec)
+			traceExportAbort(sw, num, fmt.Errorf("export failed"))
+		}
+	}()
+
+	for ; num <= to; num++ {
+		// the client may give up
+		if ctx.Err() != nil {
+			log.Debugf("traces export of blocks #%d to #%d cancelled at #%d", from, to, num)
+			return
+		}
+
+		list, err := repository.R().BlockTraces(num)
+		if err != nil {
+			traceExportAbort(sw, num, err)
+			return
+		}
+
+		for _, trace := range list {
+			if _, err := sw.Write(append(trace, '\n')); err != nil {
+				log.Errorf("can not write traces of block #%d; %s", num, err.Error())
+				return
+			}
+		}
+		sw.Flush()
+	}
+}
+
+// traceExportAbort terminates the traces export stream by a line with the error and the number of the block.
+func traceExportAbort(sw *streamWriter, num uint64, err error) {
+	_, _ = fmt.Fprintf(sw, "{\"error\":%q,\"block\":%d}\n", err.Error(), num)
+}
+
+// traceExportRange decodes the block range of the traces export request.
+func traceExportRange(r *http.Request, maxRange uint64) (uint64, uint64, error) {
+	from, err := strconv.ParseUint(r.URL.Query().Get("from"), 0, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid from block; %s", err.Error())
+	}
+
+	to, err := strconv.ParseUint(r.URL.Query().Get("to"), 0, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid to block; %s", err.Error())
+	}
+
+	if to < from {
+		return 0, 0, fmt.Errorf("to block #%d is below from block #%d", to, from)
+	}
+	if maxRange > 0 && to-from >= maxRange {
+		return 0, 0, fmt.Errorf("at most %d blocks can be exported at once", maxRange)
+	}
+	return from, to, nil
+}
@@ -0,0 +1,217 @@
+/*
+Package locale provides localized human-readable error messages and enumeration labels.
+
+Each language is described by a JSON catalog file named by the language code, i.e. de.json
+or pt-br.json, containing messages of errors keyed by their machine codes and labels
+of enumeration values keyed by the enumeration name and the value:
+
+	{
+	  "errors": {"LIST_TOO_LARGE": "Höchstens {limit} Einträge erlaubt"},
+	  "enums": {"ValidatorExitReason": {"OFFLINE": "Offline"}}
+	}
+
+Placeholders in curly brackets are replaced by the extension values of the error.
+The machine codes and enumeration values never change; only the texts are localized.
+*/
+package locale
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// catalogExt represents the file extension of language catalogs.
+const catalogExt = ".json"
+
+// languageCtxKey represents the context key of the language of a request.
+type languageCtxKey struct{}
+
+// catalog represents texts of a single language.
+type catalog struct {
+	Errors map[string]string            `json:"errors"`
+	Enums  map[string]map[string]string `json:"enums"`
+}
+
+// EnumLabel represents a localized label of an enumeration value.
+type EnumLabel struct {
+	Value string
+	Label string
+}
+
+// catalogs represents the loaded languages; they are loaded on start and read only afterwards.
+var catalogs = make(map[string]*catalog)
+
+// enums represents the values of the known enumerations by the enumeration name.
+var enums = make(map[string][]string)
+
+// defLanguage represents the language used if none of the languages accepted by the client is available.
+var defLanguage = "en"
+
+// Load loads the language catalogs from the configured directory.
+func Load(cfg *config.Localization, log logger.Logger) error {
+	if cfg.Default != "" {
+		defLanguage = strings.ToLower(cfg.Default)
+	}
+	if cfg.Dir == "" {
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(cfg.Dir, "*"+catalogExt))
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range files {
+		data, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+
+		var cat catalog
+		if err := json.Unmarshal(data, &cat); err != nil {
+			return fmt.Errorf("invalid language catalog %s; %s", fn, err.Error())
+		}
+
+		lang := strings.ToLower(strings.TrimSuffix(filepath.Base(fn), catalogExt))
+		catalogs[lang] = &cat
+		log.Noticef("language %s loaded with %d messages and %d enumerations", lang, len(cat.Errors), len(cat.Enums))
+	}
+	return nil
+}
+
+// RegisterEnum registers the values of an enumeration so its labels can be listed.
+func RegisterEnum(name string, values []string) {
+	enums[name] = values
+}
+
+// Match picks the best available language for the given Accept-Language header value.
+// The default language is used if none of the accepted languages is available.
+func Match(accept string) string {
+	if accept == "" || len(catalogs) == 0 {
+		return defLanguage
+	}
+
+	type tag struct {
+		lang string
+		q    float64
+	}
+
+	// parse the list of accepted languages with their quality
+	list := make([]tag, 0)
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		t := tag{lang: strings.ToLower(strings.TrimSpace(fields[0])), q: 1}
+		for _, f := range fields[1:] {
+			f = strings.TrimSpace(f)
+			if strings.HasPrefix(f, "q=") {
+				if q, err := strconv.ParseFloat(f[2:], 64); err == nil {
+					t.q = q
+				}
+			}
+		}
+		if t.lang != "" && t.q > 0 {
+			list = append(list, t)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].q > list[j].q
+	})
+
+	// the exact language first, the base language of a regional variant second
+	for _, t := range list {
+		if available(t.lang) {
+			return t.lang
+		}
+		if i := strings.IndexByte(t.lang, '-'); i > 0 && available(t.lang[:i]) {
+			return t.lang[:i]
+		}
+	}
+	return defLanguage
+}
+
+// available checks if the given language can be served; the default language
+// is always available since the built-in messages are used without its catalog.
+func available(lang string) bool {
+	_, ok := catalogs[lang]
+	return ok || lang == defLanguage
+}
+
+// ContextWithLanguage provides a new context carrying the language of the request.
+func ContextWithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageCtxKey{}, lang)
+}
+
+// LanguageFromContext extracts the language of the request from the given context;
+// the default language is provided if none is set.
+func LanguageFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if lang, ok := ctx.Value(languageCtxKey{}).(string); ok {
+			return lang
+		}
+	}
+	return defLanguage
+}
+
+// Message provides the localized message of the error with the given code, if available.
+// Placeholders of the message are filled with the given error extensions.
+func Message(lang string, code string, ext map[string]interface{}) (string, bool) {
+	msg, ok := lookup(lang, func(cat *catalog) (string, bool) {
+		m, ok := cat.Errors[code]
+		return m, ok
+	})
+	if !ok {
+		return "", false
+	}
+
+	for k, v := range ext {
+		msg = strings.ReplaceAll(msg, "{"+k+"}", fmt.Sprint(v))
+	}
+	return msg, true
+}
+
+// Label provides the localized label of the enumeration value; the value itself is used if not available.
+func Label(lang string, enum string, value string) string {
+	label, ok := lookup(lang, func(cat *catalog) (string, bool) {
+		l, ok := cat.Enums[enum][value]
+		return l, ok
+	})
+	if !ok {
+		return value
+	}
+	return label
+}
+
+// Labels provides localized labels of all the values of the given enumeration.
+func Labels(lang string, enum string) ([]EnumLabel, error) {
+	values, ok := enums[enum]
+	if !ok {
+		return nil, fmt.Errorf("unknown enumeration %s", enum)
+	}
+
+	list := make([]EnumLabel, len(values))
+	for i, v := range values {
+		list[i] = EnumLabel{Value: v, Label: Label(lang, enum, v)}
+	}
+	return list, nil
+}
+
+// lookup finds a text in the catalog of the given language, or in the default language catalog.
+func lookup(lang string, get func(*catalog) (string, bool)) (string, bool) {
+	if cat, ok := catalogs[lang]; ok {
+		if s, ok := get(cat); ok {
+			return s, true
+		}
+	}
+	if cat, ok := catalogs[defLanguage]; ok && lang != defLanguage {
+		return get(cat)
+	}
+	return "", false
+}
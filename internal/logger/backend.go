@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"axis-graphql/internal/config"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/op/go-logging"
+)
+
+// BackendFactory represents a function creating a logging backend from the logger configuration.
+type BackendFactory func(cfg *config.Log) (logging.Backend, error)
+
+// backends represents the registry of known logging backends.
+var backends = map[string]BackendFactory{
+	"stderr": func(_ *config.Log) (logging.Backend, error) {
+		return logging.NewLogBackend(os.Stderr, "", 0), nil
+	},
+	"stdout": func(_ *config.Log) (logging.Backend, error) {
+		return logging.NewLogBackend(os.Stdout, "", 0), nil
+	},
+	"file": func(cfg *config.Log) (logging.Backend, error) {
+		if cfg.File == "" {
+			return nil, fmt.Errorf("log file path not specified")
+		}
+		f, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		return logging.NewLogBackend(f, "", 0), nil
+	},
+}
+
+// backendsLock synchronizes access to the backends registry.
+var backendsLock sync.Mutex
+
+// RegisterBackend adds a new logging backend to be available for configuration
+// under the given name. It must be called before the logger is created.
+func RegisterBackend(name string, factory BackendFactory) {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+	backends[strings.ToLower(name)] = factory
+}
+
+// makeBackend creates the logging backend configured.
+func makeBackend(cfg *config.Log) (logging.Backend, error) {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+
+	// stderr is the default
+	name := strings.ToLower(cfg.Backend)
+	if name == "" {
+		name = "stderr"
+	}
+
+	fa, ok := backends[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown log backend %s", cfg.Backend)
+	}
+	return fa(cfg)
+}
@@ -3,6 +3,7 @@ package logger
 import (
 	"axis-graphql/internal/config"
 	"os"
+	"strings"
 
 	"github.com/op/go-logging"
 )
@@ -18,28 +19,50 @@ func (a ApiLogger) Printf(format string, args ...interface{}) {
 	a.Debugf(format, args...)
 }
 
-// New provides pre-configured Logger with stderr output and leveled filtering.
-// Modules are not supported at the moment, but may be added in the future to make the logging setup more granular.
+// levels represents the leveled backend shared by all the subsystem loggers.
+var levels *moduleLevels
+
+// New provides pre-configured Logger with configured output and leveled filtering.
+// Subsystem loggers are available through the Module call once the root logger is created.
 func New(cfg *config.Config) Logger {
 	// Prep the backend for exporting the log records
-	// @todo Allow app to define different logging backend by configuration means.
-	backend := logging.NewLogBackend(os.Stderr, "", 0)
+	backend, err := makeBackend(&cfg.Log)
+	if err != nil {
+		backend = logging.NewLogBackend(os.Stderr, "", 0)
+	}
 
 	// Parse log format from configuration and apply it to the backend
 	format := logging.MustStringFormatter(cfg.Log.Format)
 	fmtBackend := logging.NewBackendFormatter(backend, format)
 
 	// Parse and apply the configured level on which the recording will be emitted
-	level, err := logging.LogLevel(cfg.Log.Level)
-	if err != nil {
+	level, lerr := logging.LogLevel(cfg.Log.Level)
+	if lerr != nil {
 		level = logging.INFO
 	}
-	lvlBackend := logging.AddModuleLevel(fmtBackend)
-	lvlBackend.SetLevel(level, "")
+	levels = newModuleLevels(fmtBackend, level)
+
+	// apply subsystems specific levels
+	for name, lvl := range cfg.Log.Subsystems {
+		if ml, err := logging.LogLevel(lvl); err == nil {
+			levels.SetLevel(ml, strings.ToLower(name))
+		}
+	}
 
 	// assign the backend and return the new logger
-	logging.SetBackend(lvlBackend)
+	logging.SetBackend(levels)
 	l := logging.MustGetLogger(cfg.AppName)
 
+	// report the backend failure through the fallback backend
+	if err != nil {
+		l.Errorf("log backend %s not available, using stderr; %s", cfg.Log.Backend, err.Error())
+	}
+	return &ApiLogger{*l}
+}
+
+// Module provides a logger of the given subsystem. The level of the subsystem
+// can be adjusted independently of other subsystems.
+func Module(name string) Logger {
+	l := logging.MustGetLogger(name)
 	return &ApiLogger{*l}
 }
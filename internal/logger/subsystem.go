@@ -0,0 +1,125 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/op/go-logging"
+)
+
+const (
+	// SubsystemRpc represents the blockchain node communication subsystem.
+	SubsystemRpc = "rpc"
+
+	// SubsystemObserver represents the blockchain observation subsystem.
+	SubsystemObserver = "observer"
+
+	// SubsystemIndexer represents the blockchain data processing subsystem.
+	SubsystemIndexer = "indexer"
+
+	// SubsystemGraphQL represents the GraphQL API subsystem.
+	SubsystemGraphQL = "graphql"
+)
+
+// Subsystems provides the list of known logging subsystems.
+func Subsystems() []string {
+	return []string{SubsystemRpc, SubsystemObserver, SubsystemIndexer, SubsystemGraphQL}
+}
+
+// moduleLevels implements a leveled backend with levels adjustable per module.
+// Unlike the go-logging module leveled backend it's safe to adjust levels
+// while the backend is in use.
+type moduleLevels struct {
+	mu      sync.RWMutex
+	backend logging.Backend
+	def     logging.Level
+	levels  map[string]logging.Level
+}
+
+// newModuleLevels creates a new leveled backend with the given default level.
+func newModuleLevels(backend logging.Backend, def logging.Level) *moduleLevels {
+	return &moduleLevels{
+		backend: backend,
+		def:     def,
+		levels:  make(map[string]logging.Level),
+	}
+}
+
+// GetLevel returns the log level for the given module.
+func (ml *moduleLevels) GetLevel(module string) logging.Level {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+
+	if lvl, ok := ml.levels[module]; ok {
+		return lvl
+	}
+	return ml.def
+}
+
+// SetLevel sets the log level for the given module; empty module sets the default level.
+func (ml *moduleLevels) SetLevel(level logging.Level, module string) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	if module == "" {
+		ml.def = level
+		return
+	}
+	ml.levels[module] = level
+}
+
+// IsEnabledFor checks if the given level is enabled for the given module.
+func (ml *moduleLevels) IsEnabledFor(level logging.Level, module string) bool {
+	return level <= ml.GetLevel(module)
+}
+
+// Log passes the record to the underlying backend if the level is enabled for its module.
+func (ml *moduleLevels) Log(level logging.Level, calldepth int, rec *logging.Record) error {
+	if ml.IsEnabledFor(level, rec.Module) {
+		return ml.backend.Log(level, calldepth+1, rec)
+	}
+	return nil
+}
+
+// SetLevel adjusts the log level of the given subsystem at runtime.
+func SetLevel(subsystem string, level string) error {
+	if levels == nil {
+		return fmt.Errorf("logger not initialized")
+	}
+
+	// is this a known subsystem?
+	subsystem = strings.ToLower(subsystem)
+	if !isSubsystem(subsystem) {
+		return fmt.Errorf("unknown subsystem %s", subsystem)
+	}
+
+	lvl, err := logging.LogLevel(level)
+	if err != nil {
+		return err
+	}
+	levels.SetLevel(lvl, subsystem)
+	return nil
+}
+
+// Levels provides the current log levels of all known subsystems.
+func Levels() map[string]string {
+	res := make(map[string]string)
+	if levels == nil {
+		return res
+	}
+	for _, name := range Subsystems() {
+		res[name] = levels.GetLevel(name).String()
+	}
+	return res
+}
+
+// isSubsystem checks if the given name represents a known subsystem.
+func isSubsystem(name string) bool {
+	for _, sub := range Subsystems() {
+		if sub == name {
+			return true
+		}
+	}
+	return false
+}
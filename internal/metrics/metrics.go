@@ -0,0 +1,43 @@
+/*
+Package metrics provides operational metrics of the API server.
+
+Metrics are collected in a private registry and exported in Prometheus text format
+so they can be scraped by a monitoring system. Names use slash separated paths,
+i.e. integrity/epoch/discrepancies, which are converted to underscores on export.
+*/
+package metrics
+
+import (
+	"net/http"
+
+	gm "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/metrics/prometheus"
+)
+
+// registry represents the metrics registry of the API server.
+var registry = gm.NewRegistry()
+
+func init() {
+	// go-ethereum metrics are disabled by default and produce no-op collectors
+	gm.Enabled = true
+}
+
+// Counter provides the counter of the given name, registering it on the first use.
+func Counter(name string) gm.Counter {
+	return gm.GetOrRegisterCounter(name, registry)
+}
+
+// Gauge provides the gauge of the given name, registering it on the first use.
+func Gauge(name string) gm.Gauge {
+	return gm.GetOrRegisterGauge(name, registry)
+}
+
+// GaugeFloat64 provides the float gauge of the given name, registering it on the first use.
+func GaugeFloat64(name string) gm.GaugeFloat64 {
+	return gm.GetOrRegisterGaugeFloat64(name, registry)
+}
+
+// Handler provides HTTP handler exporting the collected metrics in Prometheus format.
+func Handler() http.Handler {
+	return prometheus.Handler(registry)
+}
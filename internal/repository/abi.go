@@ -0,0 +1,302 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etc "github.com/ethereum/go-ethereum/core/types"
+)
+
+// abiMissTTL represents the time a contract without a known ABI is not looked up again.
+const abiMissTTL = 10 * time.Minute
+
+// abiMiss represents the time a contract has been found to have no known ABI.
+type abiMiss time.Time
+
+// RegisterAbi validates and stores a custom ABI of the given contract
+// to be used for decoding contract interactions. Admins can replace any ABI,
+// other registrars can register ABIs of contracts without validated source only
+// and replace only the ABIs they registered before. ABIs of other registrars
+// than admins are pending and not used for decoding until approved by an admin.
+func (p *proxy) RegisterAbi(addr *common.Address, abiJson string, by string, admin bool) error {
+	// make sure the ABI is valid
+	parsed, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		return fmt.Errorf("invalid ABI; %s", err.Error())
+	}
+
+	// the validated source is the authoritative ABI of the contract
+	if !admin {
+		sc, err := p.Contract(addr)
+		if err != nil {
+			return err
+		}
+		if sc != nil && sc.Validated != nil {
+			return fmt.Errorf("contract %s has validated source code, its ABI can not be replaced", addr.String())
+		}
+	}
+
+	// store the ABI
+	err = p.db.StoreAbi(&types.RegisteredAbi{
+		Address:    *addr,
+		Abi:        abiJson,
+		Registrar:  by,
+		Registered: time.Now().UTC(),
+		Approved:   admin,
+	}, admin)
+	if err != nil {
+		return err
+	}
+
+	// replace the parsed ABI we may have; a pending ABI replaces an approved one of the same registrar
+	if admin {
+		p.abis.Store(*addr, &parsed)
+	} else {
+		p.abis.Delete(*addr)
+	}
+	p.broadcastInvalidation(cacheBusContract, addr.String())
+	p.log.Noticef("ABI of %s registered by %s, approved %t", addr.String(), by, admin)
+	return nil
+}
+
+// ApproveAbi approves the pending custom ABI of the given contract,
+// so it is used for decoding contract interactions.
+func (p *proxy) ApproveAbi(addr *common.Address, by string) error {
+	if err := p.db.ApproveAbi(addr); err != nil {
+		return err
+	}
+
+	p.abis.Delete(*addr)
+	p.broadcastInvalidation(cacheBusContract, addr.String())
+	p.log.Noticef("ABI of %s approved by %s", addr.String(), by)
+	return nil
+}
+
+// ContractAbi provides the ABI used to decode interactions with the given contract.
+// Custom registered ABI takes precedence over the ABI of validated contract source.
+// Nil is returned if no ABI is known for the contract.
+func (p *proxy) ContractAbi(addr *common.Address) (*abi.ABI, error) {
+	// do we know the ABI, or that there is none?
+	if pa, ok := p.abis.Load(*addr); ok {
+		switch v := pa.(type) {
+		case *abi.ABI:
+			return v, nil
+		case abiMiss:
+			if time.Since(time.Time(v)) < abiMissTTL {
+				return nil, nil
+			}
+		}
+	}
+
+	// load the ABI source
+	src, err := p.contractAbiSource(addr)
+	if err != nil {
+		return nil, err
+	}
+	if src == "" {
+		p.abis.Store(*addr, abiMiss(time.Now()))
+		return nil, nil
+	}
+
+	// parse and keep for later
+	parsed, err := abi.JSON(strings.NewReader(src))
+	if err != nil {
+		p.log.Errorf("invalid ABI of %s; %s", addr.String(), err.Error())
+		p.abis.Store(*addr, abiMiss(time.Now()))
+		return nil, err
+	}
+	p.abis.Store(*addr, &parsed)
+	return &parsed, nil
+}
+
+// contractAbiSource loads the JSON ABI of the given contract, if any.
+func (p *proxy) contractAbiSource(addr *common.Address) (string, error) {
+	// approved custom ABI first
+	ra, err := p.db.Abi(addr)
+	if err != nil {
+		return "", err
+	}
+	if ra != nil && ra.Approved {
+		return ra.Abi, nil
+	}
+
+	// validated contract ABI
+	sc, err := p.Contract(addr)
+	if err != nil || sc == nil {
+		return "", err
+	}
+	return sc.Abi, nil
+}
+
+// DecodeCall decodes the given contract call input using the ABI of the contract.
+// Nil is returned if the contract ABI is not known, or the method is not found.
+func (p *proxy) DecodeCall(addr *common.Address, input []byte) (*types.DecodedCall, error) {
+	// we need at least the method selector
+	if len(input) < 4 {
+		return nil, nil
+	}
+
+	// get the ABI
+	ab, err := p.ContractAbi(addr)
+	if err != nil || ab == nil {
+		return nil, err
+	}
+	return decodeCallByAbi(ab, input)
+}
+
+// DecodeLog decodes the given event log record using the ABI of the emitting contract.
+// Nil is returned if the contract ABI is not known, or the event is not found.
+func (p *proxy) DecodeLog(lg *etc.Log) (*types.DecodedLog, error) {
+	// anonymous events can not be recognized
+	if len(lg.Topics) == 0 {
+		return nil, nil
+	}
+
+	// get the ABI
+	ab, err := p.ContractAbi(&lg.Address)
+	if err != nil || ab == nil {
+		return nil, err
+	}
+
+	// find the event
+	ev, err := ab.EventByID(lg.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	// unpack non-indexed arguments from the data and indexed ones from the topics
+	values := make(map[string]interface{})
+	if err := ab.UnpackIntoMap(values, ev.Name, lg.Data); err != nil {
+		return nil, fmt.Errorf("can not decode %s event; %s", ev.Name, err.Error())
+	}
+	var indexed abi.Arguments
+	for _, arg := range ev.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, lg.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("can not decode %s event topics; %s", ev.Name, err.Error())
+	}
+
+	// build the result in the order of the declaration
+	dl := types.DecodedLog{
+		Address:   lg.Address,
+		Index:     lg.Index,
+		Event:     ev.Name,
+		Signature: ev.Sig,
+		Args:      make([]types.DecodedArgument, len(ev.Inputs)),
+	}
+	for i, arg := range ev.Inputs {
+		dl.Args[i] = types.DecodedArgument{
+			Name:  arg.Name,
+			Type:  arg.Type.String(),
+			Value: decodedValueString(values[arg.Name]),
+		}
+	}
+	return &dl, nil
+}
+
+// ContractCall executes a read-only call of the given contract at the given block
+// and decodes the input and the output using the ABI of the contract, if known.
+func (p *proxy) ContractCall(addr *common.Address, input []byte, block *big.Int) (*types.ContractCallResult, error) {
+	out, err := p.rpc.ContractCall(addr, input, block)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.ContractCallResult{Output: out}
+	dc, err := p.DecodeCall(addr, input)
+	if err != nil || dc == nil {
+		return &res, nil
+	}
+	res.Call = dc
+
+	// decode the output by the called method
+	ab, err := p.ContractAbi(addr)
+	if err != nil || ab == nil {
+		return &res, nil
+	}
+	method, err := ab.MethodById(input[:4])
+	if err != nil {
+		return &res, nil
+	}
+	values, err := method.Outputs.Unpack(out)
+	if err != nil {
+		p.log.Debugf("can not decode output of %s call; %s", method.Name, err.Error())
+		return &res, nil
+	}
+
+	res.Outputs = make([]types.DecodedArgument, len(values))
+	for i, v := range values {
+		res.Outputs[i] = types.DecodedArgument{
+			Name:  method.Outputs[i].Name,
+			Type:  method.Outputs[i].Type.String(),
+			Value: decodedValueString(v),
+		}
+	}
+	return &res, nil
+}
+
+// decodeCallByAbi decodes the given contract call input using the given ABI.
+// Nil is returned if the method is not found in the ABI.
+func decodeCallByAbi(ab *abi.ABI, input []byte) (*types.DecodedCall, error) {
+	// find the method
+	method, err := ab.MethodById(input[:4])
+	if err != nil {
+		return nil, nil
+	}
+
+	// unpack the arguments
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("can not decode %s call; %s", method.Name, err.Error())
+	}
+
+	// build the result
+	dc := types.DecodedCall{
+		Method:    method.Name,
+		Signature: method.Sig,
+		Args:      make([]types.DecodedArgument, len(values)),
+	}
+	for i, v := range values {
+		dc.Args[i] = types.DecodedArgument{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: decodedValueString(v),
+		}
+	}
+	return &dc, nil
+}
+
+// decodedValueString formats the decoded ABI value into a human readable string.
+func decodedValueString(v interface{}) string {
+	switch val := v.(type) {
+	case *big.Int:
+		return val.String()
+	case common.Address:
+		return val.String()
+	case common.Hash:
+		return val.String()
+	case []byte:
+		return hexutil.Encode(val)
+	case [32]byte:
+		return hexutil.Encode(val[:])
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
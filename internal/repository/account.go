@@ -11,6 +11,7 @@ package repository
 import (
 	"axis-graphql/internal/types"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -67,14 +68,16 @@ func (p *proxy) getAccount(addr *common.Address) (*types.Account, error) {
 	return acc, nil
 }
 
-// AccountBalance returns the current balance of an account at AXIS blockchain.
-func (p *proxy) AccountBalance(addr *common.Address) (*hexutil.Big, error) {
-	return p.rpc.AccountBalance(addr)
+// AccountBalance returns the balance of an account at AXIS blockchain
+// at the given block; nil represents the latest block.
+func (p *proxy) AccountBalance(addr *common.Address, block *big.Int) (*hexutil.Big, error) {
+	return p.rpc.AccountBalance(addr, block)
 }
 
-// AccountNonce returns the current number of sent transactions of an account at AXIS blockchain.
-func (p *proxy) AccountNonce(addr *common.Address) (*hexutil.Uint64, error) {
-	val, err := p.rpc.AccountNonce(addr)
+// AccountNonce returns the number of sent transactions of an account at AXIS blockchain
+// at the given block; nil represents the latest block.
+func (p *proxy) AccountNonce(addr *common.Address, block *big.Int) (*hexutil.Uint64, error) {
+	val, err := p.rpc.AccountNonce(addr, block)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +87,12 @@ func (p *proxy) AccountNonce(addr *common.Address) (*hexutil.Uint64, error) {
 	return &nonce, nil
 }
 
+// AccountCode returns the deployed byte code of a contract account at AXIS blockchain;
+// the code is empty for wallet accounts.
+func (p *proxy) AccountCode(addr *common.Address) (hexutil.Bytes, error) {
+	return p.rpc.AccountCode(addr)
+}
+
 // AccountTransactions returns slice of AccountTransaction structure for a given account at AXIS blockchain.
 func (p *proxy) AccountTransactions(addr *common.Address, cursor *string, count int32) (*types.TransactionList, error) {
 	// do we have an account?
@@ -137,3 +146,24 @@ func (p *proxy) StoreAccount(acc *types.Account) error {
 func (p *proxy) AccountMarkActivity(addr *common.Address, ts uint64) error {
 	return p.db.AccountMarkActivity(addr, ts)
 }
+
+// AccountFirstSeen returns the time stamp of the first known activity of the given account.
+// Accounts indexed before the first activity has been tracked are resolved
+// from their oldest known transaction and updated on the way.
+func (p *proxy) AccountFirstSeen(acc *types.Account) (hexutil.Uint64, error) {
+	if acc.FirstSeen > 0 {
+		return acc.FirstSeen, nil
+	}
+
+	// find the oldest transaction
+	ts, err := p.db.AccountFirstTransactionTime(&acc.Address)
+	if err != nil || ts == 0 {
+		return 0, err
+	}
+
+	// keep the value for the next time
+	if err := p.db.AccountUpdateFirstSeen(&acc.Address, ts); err != nil {
+		p.log.Errorf("can not store first activity of %s; %s", acc.Address.String(), err.Error())
+	}
+	return hexutil.Uint64(ts), nil
+}
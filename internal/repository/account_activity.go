@@ -0,0 +1,32 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// accountActivityHeatmapDays represents the number of days covered by the account activity heatmap.
+const accountActivityHeatmapDays = 365
+
+// AccountActivityMark adds the transaction of the given ordinal index
+// made at the given time to the daily activity of the account.
+func (p *proxy) AccountActivityMark(addr *common.Address, ts uint64, ordinal uint64) error {
+	return p.db.AccountActivityMark(addr, ts, ordinal)
+}
+
+// AccountActivityHeatmap provides the daily transaction counts of the account
+// for the last year; only days with any activity are included.
+func (p *proxy) AccountActivityHeatmap(addr *common.Address) ([]*types.AccountActivityDay, error) {
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -accountActivityHeatmapDays)
+	return p.db.AccountActivity(addr, since)
+}
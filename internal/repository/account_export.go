@@ -0,0 +1,330 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// accountExportMaxPending represents the max number of unfinished exports of a single client.
+const accountExportMaxPending = 3
+
+// accountExportLease represents the duration a worker holds an export it builds;
+// the lease is renewed while the file is being written, exports of a crashed worker
+// are picked up by another one after the lease expires.
+const accountExportLease = 2 * time.Minute
+
+// accountExportFailedRetention represents the duration a failed export is kept for the client to find out.
+const accountExportFailedRetention = time.Hour
+
+// accountExportMaxAge represents the duration after which an export is removed even if it never finished.
+const accountExportMaxAge = 7 * 24 * time.Hour
+
+// accountExportStaleBatch represents the max number of stale exports picked up at once.
+const accountExportStaleBatch = 10
+
+// accountExportCsvHeader represents the header row of the account export file.
+var accountExportCsvHeader = []string{"hash", "block", "timestamp", "from", "to", "value", "gas_used", "gas_price", "fee", "status"}
+
+// accountExports represents the workers of asynchronous account exports of the server.
+// Export jobs and files are kept in the database, so any server sharing the database
+// can pick them up and serve the downloads; the signing secret is shared by the configuration.
+type accountExports struct {
+	mu     sync.Mutex
+	queued map[string]bool
+	slots  chan struct{}
+	secret []byte
+}
+
+// newAccountExports creates a new set of account export workers.
+func newAccountExports(cfg *config.AccountExport) *accountExports {
+	return &accountExports{
+		queued: make(map[string]bool),
+		slots:  make(chan struct{}, cfg.Workers),
+		secret: []byte(cfg.Secret),
+	}
+}
+
+// StartAccountExport registers a new export of the account transactions owned by the given client.
+// The export file is built in the background.
+func (p *proxy) StartAccountExport(owner string, addr *common.Address) (*types.AccountExport, error) {
+	if !p.cfg.AccountExport.Enabled {
+		return nil, fmt.Errorf("account exports are not enabled")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("can not generate export identifier; %s", err.Error())
+	}
+
+	// check the limit of unfinished exports
+	pending, err := p.db.AccountExportsUnfinished(owner)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= accountExportMaxPending {
+		return nil, fmt.Errorf("%d exports already in progress, wait for them to finish", pending)
+	}
+
+	// the lease of a new export lets any worker pick it up if this one does not start it in time
+	now := time.Now().UTC()
+	lease := now.Add(accountExportLease)
+	ex := types.AccountExport{
+		ID:      hex.EncodeToString(id),
+		Owner:   owner,
+		Address: *addr,
+		Status:  types.AccountExportPending,
+		Created: now,
+		Lease:   &lease,
+		Purge:   now.Add(accountExportMaxAge),
+	}
+	if err := p.db.AddAccountExport(&ex); err != nil {
+		return nil, err
+	}
+
+	p.queueAccountExport(ex.ID)
+	return &ex, nil
+}
+
+// AccountExport provides the export of the given identifier owned by the given client, nil if not found.
+func (p *proxy) AccountExport(owner string, id string) *types.AccountExport {
+	ex, err := p.db.AccountExport(id)
+	if err != nil || ex == nil || ex.Owner != owner {
+		return nil
+	}
+	return ex
+}
+
+// AccountExportUrl provides the signed download URL of the finished export; the URL is valid
+// until the export expires.
+func (p *proxy) AccountExportUrl(ex *types.AccountExport) string {
+	if ex.Status != types.AccountExportFinished || ex.Expires == nil {
+		return ""
+	}
+
+	exp := ex.Expires.Unix()
+	return fmt.Sprintf("%s%s%s?expires=%d&sig=%s",
+		strings.TrimSuffix(p.cfg.AccountExport.BaseUrl, "/"),
+		p.cfg.AccountExport.Path,
+		ex.ID,
+		exp,
+		p.exports.sign(ex.ID, exp))
+}
+
+// AccountExportFile verifies the signed download request of an export
+// and opens the export file for reading; the caller closes the file.
+func (p *proxy) AccountExportFile(id string, expires int64, sig string) (*types.AccountExportFile, error) {
+	if !hmac.Equal([]byte(sig), []byte(p.exports.sign(id, expires))) {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	if time.Now().Unix() > expires {
+		return nil, fmt.Errorf("download link expired")
+	}
+
+	ex, err := p.db.AccountExport(id)
+	if err != nil || ex == nil || ex.Status != types.AccountExportFinished {
+		return nil, fmt.Errorf("export not available")
+	}
+
+	f, err := p.db.OpenAccountExportFile(id)
+	if err != nil || f == nil {
+		return nil, fmt.Errorf("export not available")
+	}
+	return f, nil
+}
+
+// AccountExportsMaintain picks up exports abandoned by their workers and removes files
+// of exports which are expired, or no longer exist.
+func (p *proxy) AccountExportsMaintain() {
+	stale, err := p.db.AccountExportsStale(accountExportStaleBatch)
+	if err != nil {
+		return
+	}
+	for _, id := range stale {
+		p.queueAccountExport(id)
+	}
+
+	p.purgeAccountExportFiles()
+}
+
+// purgeAccountExportFiles removes export files which do not belong to a live export.
+func (p *proxy) purgeAccountExportFiles() {
+	ids, err := p.db.AccountExportFileIds()
+	if err != nil || len(ids) == 0 {
+		return
+	}
+
+	live, err := p.db.AccountExportsLive(ids)
+	if err != nil {
+		return
+	}
+
+	for _, id := range ids {
+		if live[id] {
+			continue
+		}
+		if err := p.db.DeleteAccountExportFile(id); err != nil {
+			continue
+		}
+		p.log.Debugf("account export file %s removed", id)
+	}
+}
+
+// queueAccountExport starts a background worker building the export of the given identifier,
+// unless the export is already queued on this server.
+func (p *proxy) queueAccountExport(id string) {
+	ae := p.exports
+	ae.mu.Lock()
+	defer ae.mu.Unlock()
+
+	if ae.queued[id] {
+		return
+	}
+	ae.queued[id] = true
+	go p.runAccountExport(id)
+}
+
+// runAccountExport builds the export file once a free worker slot is available
+// and the export can be claimed by this server.
+func (p *proxy) runAccountExport(id string) {
+	ae := p.exports
+	ae.slots <- struct{}{}
+	defer func() {
+		<-ae.slots
+
+		ae.mu.Lock()
+		delete(ae.queued, id)
+		ae.mu.Unlock()
+	}()
+
+	ok, err := p.db.ClaimAccountExport(id, time.Now().UTC().Add(accountExportLease))
+	if err != nil || !ok {
+		return
+	}
+
+	ex, err := p.db.AccountExport(id)
+	if err != nil || ex == nil {
+		return
+	}
+
+	rows, err := p.writeAccountExport(ex)
+
+	now := time.Now().UTC()
+	ex.Rows = rows
+	ex.Finished = &now
+	ex.Lease = nil
+
+	if err != nil {
+		p.log.Errorf("account export %s of %s failed; %s", ex.ID, ex.Address.String(), err.Error())
+		ex.Status = types.AccountExportFailed
+		ex.Error = err.Error()
+		ex.Purge = now.Add(accountExportFailedRetention)
+	} else {
+		exp := now.Add(p.cfg.AccountExport.Retention)
+		ex.Status = types.AccountExportFinished
+		ex.Expires = &exp
+		ex.Purge = exp
+		p.log.Noticef("account export %s of %s finished with %d transactions", ex.ID, ex.Address.String(), rows)
+	}
+
+	if err := p.db.FinishAccountExport(ex); err != nil {
+		_ = p.db.DeleteAccountExportFile(ex.ID)
+	}
+}
+
+// writeAccountExport writes the account transactions into the export file.
+func (p *proxy) writeAccountExport(ex *types.AccountExport) (uint64, error) {
+	var rows uint64
+	err := p.db.WriteAccountExportFile(ex.ID, func(out io.Writer) error {
+		renewed := time.Now()
+		w := csv.NewWriter(out)
+		if err := w.Write(accountExportCsvHeader); err != nil {
+			return err
+		}
+
+		err := p.db.AccountTransactionsEach(&ex.Address, func(trx *types.Transaction) error {
+			if p.cfg.AccountExport.MaxRows > 0 && rows >= p.cfg.AccountExport.MaxRows {
+				return fmt.Errorf("export limited to %d transactions", p.cfg.AccountExport.MaxRows)
+			}
+
+			// keep the export leased while we write it
+			if time.Since(renewed) > accountExportLease/4 {
+				if err := p.db.RenewAccountExport(ex.ID, time.Now().UTC().Add(accountExportLease)); err != nil {
+					return err
+				}
+				renewed = time.Now()
+			}
+
+			rows++
+			return w.Write(accountExportRow(trx))
+		})
+		if err != nil {
+			return err
+		}
+
+		w.Flush()
+		return w.Error()
+	})
+	return rows, err
+}
+
+// accountExportRow formats the transaction as a row of the export file.
+func accountExportRow(trx *types.Transaction) []string {
+	var blk, used, status uint64
+	if trx.BlockNumber != nil {
+		blk = uint64(*trx.BlockNumber)
+	}
+	if trx.GasUsed != nil {
+		used = uint64(*trx.GasUsed)
+	}
+	if trx.Status != nil {
+		status = uint64(*trx.Status)
+	}
+
+	to := ""
+	if trx.To != nil {
+		to = trx.To.String()
+	}
+
+	fee := new(big.Int).Mul(trx.GasPrice.ToInt(), new(big.Int).SetUint64(used))
+	return []string{
+		trx.Hash.String(),
+		strconv.FormatUint(blk, 10),
+		trx.TimeStamp.UTC().Format(time.RFC3339),
+		trx.From.String(),
+		to,
+		trx.Value.ToInt().String(),
+		strconv.FormatUint(used, 10),
+		trx.GasPrice.ToInt().String(),
+		fee.String(),
+		strconv.FormatUint(status, 10),
+	}
+}
+
+// sign provides the signature of the download link of the given export valid until the given time.
+func (ae *accountExports) sign(id string, expires int64) string {
+	mac := hmac.New(sha256.New, ae.secret)
+	mac.Write([]byte(fmt.Sprintf("%s:%d", id, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,98 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CreateAddressGroup registers a new named group of addresses owned by the given client.
+func (p *proxy) CreateAddressGroup(owner string, name string, addrs []common.Address) (*types.AddressGroup, error) {
+	// make the group identifier
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("can not generate group identifier; %s", err.Error())
+	}
+
+	now := time.Now().UTC()
+	ag := types.AddressGroup{
+		ID:        hex.EncodeToString(id),
+		Owner:     owner,
+		Name:      name,
+		Addresses: addressGroupList(addrs),
+		Created:   now,
+		Updated:   now,
+	}
+	if err := p.db.StoreAddressGroup(&ag); err != nil {
+		return nil, err
+	}
+	return &ag, nil
+}
+
+// UpdateAddressGroup changes the name and/or the addresses of the group owned by the given client.
+// Nil is returned if the group does not exist.
+func (p *proxy) UpdateAddressGroup(owner string, id string, name *string, addrs []common.Address) (*types.AddressGroup, error) {
+	ag, err := p.db.AddressGroup(id, owner)
+	if err != nil || ag == nil {
+		return nil, err
+	}
+
+	if name != nil {
+		ag.Name = *name
+	}
+	if addrs != nil {
+		ag.Addresses = addressGroupList(addrs)
+	}
+	ag.Updated = time.Now().UTC()
+
+	if err := p.db.StoreAddressGroup(ag); err != nil {
+		return nil, err
+	}
+	return ag, nil
+}
+
+// RemoveAddressGroup removes the address group owned by the given client.
+func (p *proxy) RemoveAddressGroup(owner string, id string) (bool, error) {
+	return p.db.RemoveAddressGroup(id, owner)
+}
+
+// AddressGroup loads the address group owned by the given client, nil if not found.
+func (p *proxy) AddressGroup(owner string, id string) (*types.AddressGroup, error) {
+	return p.db.AddressGroup(id, owner)
+}
+
+// AddressGroups loads all the address groups owned by the given client.
+func (p *proxy) AddressGroups(owner string) ([]*types.AddressGroup, error) {
+	return p.db.AddressGroups(owner)
+}
+
+// AddressGroupTransactions provides the list of transactions sent, or received, by any address of the group.
+func (p *proxy) AddressGroupTransactions(ag *types.AddressGroup, cursor *string, count int32) (*types.TransactionList, error) {
+	return p.db.AddressGroupTransactions(ag.Addresses, cursor, count)
+}
+
+// addressGroupList provides the list of unique addresses of a group in the stored format.
+func addressGroupList(addrs []common.Address) []string {
+	known := make(map[common.Address]bool, len(addrs))
+	list := make([]string, 0, len(addrs))
+	for _, adr := range addrs {
+		if known[adr] {
+			continue
+		}
+		known[adr] = true
+		list = append(list, adr.String())
+	}
+	return list
+}
@@ -0,0 +1,98 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RegisterBalanceAlert registers a new balance alert of the given owner for the native balance,
+// or the ERC20 token balance of the given address. The initial state of the alert is derived
+// from the current balance so only future crossings are reported.
+func (p *proxy) RegisterBalanceAlert(owner string, addr common.Address, token *common.Address, min *big.Int, max *big.Int, webhook string) (*types.BalanceAlert, error) {
+	// get the current state
+	bal, err := p.AccountTokenBalance(&addr, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the alert identifier
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("can not generate alert identifier; %s", err.Error())
+	}
+
+	ba := types.BalanceAlert{
+		ID:      hex.EncodeToString(id),
+		Owner:   owner,
+		Address: addr.String(),
+		Webhook: webhook,
+		State:   types.BalanceState(bal, min, max),
+		Balance: hexutil.EncodeBig(bal),
+		Created: time.Now().UTC(),
+	}
+	if token != nil {
+		tok := token.String()
+		ba.Token = &tok
+	}
+	if min != nil {
+		val := hexutil.EncodeBig(min)
+		ba.Min = &val
+	}
+	if max != nil {
+		val := hexutil.EncodeBig(max)
+		ba.Max = &val
+	}
+
+	if err := p.db.StoreBalanceAlert(&ba); err != nil {
+		return nil, err
+	}
+	return &ba, nil
+}
+
+// StoreBalanceAlert stores, or updates, the given balance alert.
+func (p *proxy) StoreBalanceAlert(ba *types.BalanceAlert) error {
+	return p.db.StoreBalanceAlert(ba)
+}
+
+// RemoveBalanceAlert removes the balance alert of the given owner.
+func (p *proxy) RemoveBalanceAlert(id string, owner string) (bool, error) {
+	return p.db.RemoveBalanceAlert(id, owner)
+}
+
+// BalanceAlerts loads balance alerts, optionally only those of the given owner.
+func (p *proxy) BalanceAlerts(owner *string) ([]*types.BalanceAlert, error) {
+	return p.db.BalanceAlerts(owner)
+}
+
+// AccountTokenBalance loads the current balance of the given address in the given ERC20 token,
+// or the native balance of the address, if the token is not specified.
+func (p *proxy) AccountTokenBalance(addr *common.Address, token *common.Address) (*big.Int, error) {
+	if token == nil {
+		bal, err := p.rpc.AccountBalance(addr, nil)
+		if err != nil {
+			return nil, err
+		}
+		return bal.ToInt(), nil
+	}
+
+	bal, err := p.rpc.Erc20BalanceOf(token, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bal.ToInt(), nil
+}
@@ -0,0 +1,30 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AccountBalances returns the balances of the given accounts at the given block,
+// nil represents the latest block. Balances are loaded from the node in a single batch;
+// balances not available are nil in the list.
+func (p *proxy) AccountBalances(addrs []common.Address, block *big.Int) ([]*hexutil.Big, error) {
+	return p.rpc.AccountBalances(addrs, block)
+}
+
+// Erc20BalancesOf returns the balances of the given ERC20 tokens of the owner at the given block,
+// nil represents the latest block. Balances are loaded from the node in a single batch;
+// balances of addresses not implementing the token interface are nil in the list.
+func (p *proxy) Erc20BalancesOf(owner *common.Address, tokens []common.Address, block *big.Int) ([]*hexutil.Big, error) {
+	return p.rpc.Erc20BalancesOf(owner, tokens, block)
+}
@@ -35,6 +35,11 @@ func (p *proxy) BlockHeight() (*hexutil.Big, error) {
 	return p.rpc.BlockHeight()
 }
 
+// CallBlockHeight returns the height of the block the default contract calls read the state of.
+func (p *proxy) CallBlockHeight() (*hexutil.Big, error) {
+	return p.rpc.CallBlockHeight()
+}
+
 // LastKnownBlock returns number of the last block known to the repository.
 func (p *proxy) LastKnownBlock() (uint64, error) {
 	return p.db.LastKnownBlock()
@@ -62,6 +67,21 @@ func (p *proxy) BlockByNumber(num *hexutil.Uint64) (*types.Block, error) {
 	return p.getBlock(num.String(), p.blockByTag)
 }
 
+// ReloadBlock pulls a block of the given number from the node bypassing the cache,
+// and replaces the cached copy of the block, if any.
+func (p *proxy) ReloadBlock(num *hexutil.Uint64) (*types.Block, error) {
+	tag := num.String()
+	blk, err := p.blockByTag(&tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.cache.PushBlock(tag, blk); err != nil {
+		p.log.Errorf("can not cache; %s", err.Error())
+	}
+	return blk, nil
+}
+
 // BlockByHash returns a block at AXIS blockchain represented by a hash. Top block is returned if the hash
 // is not provided.
 // If the block is not found, ErrBlockNotFound error is returned.
@@ -0,0 +1,21 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockHeader returns the raw header of the block by the number.
+// The header is always loaded from the node, it's never cached.
+func (p *proxy) BlockHeader(num hexutil.Uint64) (*types.BlockHeader, error) {
+	return p.rpc.BlockHeader(num)
+}
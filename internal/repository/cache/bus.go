@@ -0,0 +1,380 @@
+// Package cache implements bridge to fast in-memory object cache.
+package cache
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// busDialTimeout represents the timeout of connecting the Redis server.
+const busDialTimeout = 5 * time.Second
+
+// busReconnectDelay represents the delay before a lost Redis connection is re-opened.
+const busReconnectDelay = 5 * time.Second
+
+// busPingInterval represents the interval of pinging the Redis server over the subscriber connection;
+// the connection is considered lost if nothing is received for two intervals,
+// so a half-open connection doesn't silently stop the invalidation.
+const busPingInterval = 15 * time.Second
+
+// busQueueCapacity represents the max number of outgoing messages waiting to be published.
+const busQueueCapacity = 1024
+
+// busResyncMessage represents the control message asking all the replicas to drop their caches;
+// it's sent after published messages were lost, so no replica keeps stale data.
+const busResyncMessage = "\x00resync"
+
+// Bus represents the cache invalidation bus shared by API replicas.
+// Messages are exchanged through a Redis pub/sub channel; the bus speaks
+// the plain Redis protocol so no client library is needed.
+type Bus struct {
+	cfg     *config.CacheBus
+	log     logger.Logger
+	out     chan []byte
+	sigStop chan struct{}
+	wg      sync.WaitGroup
+
+	// ping is the interval of pinging the subscriber connection
+	ping time.Duration
+
+	// dropped signals outgoing messages were lost since the last resync
+	dropped int32
+
+	// the subscriber connection, closed to interrupt the reader on termination
+	subLock sync.Mutex
+	sub     net.Conn
+}
+
+// NewBus creates a new cache invalidation bus; the bus is not connected until it's opened.
+func NewBus(cfg *config.CacheBus, log logger.Logger) *Bus {
+	return &Bus{
+		cfg:     cfg,
+		log:     log,
+		out:     make(chan []byte, busQueueCapacity),
+		sigStop: make(chan struct{}),
+		ping:    busPingInterval,
+	}
+}
+
+// Open starts the publisher and the subscriber of the bus. The received messages
+// are passed to the given handler; messages published by this replica are received as well.
+// The resync function is called when messages may have been missed, i.e. after the subscription
+// is re-opened, or a replica lost some of its messages; it should drop all the shared caches.
+func (b *Bus) Open(handler func([]byte), resync func()) {
+	b.wg.Add(2)
+	go b.publish()
+	go b.subscribe(handler, resync)
+	b.log.Noticef("cache bus started on %s channel %s", b.cfg.Redis, b.cfg.Channel)
+}
+
+// Publish queues the given message to be sent to all the replicas. Messages are kept
+// in the queue while the bus is disconnected; if the queue is full, the message is dropped
+// and all the replicas are asked to resync once the bus is connected again.
+func (b *Bus) Publish(msg []byte) {
+	select {
+	case b.out <- msg:
+	default:
+		atomic.StoreInt32(&b.dropped, 1)
+		b.log.Errorf("cache bus queue full, message dropped")
+	}
+}
+
+// Close terminates the bus.
+func (b *Bus) Close() {
+	close(b.sigStop)
+
+	b.subLock.Lock()
+	if b.sub != nil {
+		_ = b.sub.Close()
+	}
+	b.subLock.Unlock()
+
+	b.wg.Wait()
+	b.log.Notice("cache bus closed")
+}
+
+// publish sends queued messages to the Redis channel. A message is kept until it's sent,
+// the connection is re-opened if lost.
+func (b *Bus) publish() {
+	defer b.wg.Done()
+
+	var con net.Conn
+	var rd *bufio.Reader
+	defer func() {
+		if con != nil {
+			_ = con.Close()
+		}
+	}()
+
+	for {
+		var msg []byte
+		select {
+		case <-b.sigStop:
+			return
+		case msg = <-b.out:
+		}
+
+		for {
+			var err error
+			if con == nil {
+				con, rd, err = b.connect()
+			}
+			if err == nil {
+				err = b.send(con, rd, msg)
+			}
+			if err == nil {
+				break
+			}
+
+			b.log.Errorf("cache bus publish failed, retrying; %s", err.Error())
+			if con != nil {
+				_ = con.Close()
+				con = nil
+			}
+
+			select {
+			case <-b.sigStop:
+				return
+			case <-time.After(busReconnectDelay):
+			}
+		}
+	}
+}
+
+// send publishes the message to the Redis channel. The replicas are asked
+// to resync first, if any outgoing messages were lost.
+func (b *Bus) send(con net.Conn, rd *bufio.Reader, msg []byte) error {
+	_ = con.SetDeadline(time.Now().Add(busDialTimeout))
+	if atomic.LoadInt32(&b.dropped) == 1 {
+		if err := busCommand(con, rd, "PUBLISH", b.cfg.Channel, busResyncMessage); err != nil {
+			return err
+		}
+		atomic.StoreInt32(&b.dropped, 0)
+	}
+	return busCommand(con, rd, "PUBLISH", b.cfg.Channel, string(msg))
+}
+
+// subscribe receives messages from the Redis channel and passes them to the handler.
+// The connection is re-opened if lost; messages sent in the meantime are missed,
+// so the caches are resynced once the subscription is back.
+func (b *Bus) subscribe(handler func([]byte), resync func()) {
+	defer b.wg.Done()
+
+	var subscribed func()
+	for {
+		err := b.listen(handler, resync, subscribed)
+
+		select {
+		case <-b.sigStop:
+			return
+		default:
+		}
+
+		// messages sent while we are away are missed
+		subscribed = resync
+
+		b.log.Errorf("cache bus subscription lost; %s", err.Error())
+		select {
+		case <-b.sigStop:
+			return
+		case <-time.After(busReconnectDelay):
+		}
+	}
+}
+
+// listen subscribes the Redis channel and reads messages until the connection fails.
+// The subscribed function, if any, is called once the subscription is confirmed.
+func (b *Bus) listen(handler func([]byte), resync func(), subscribed func()) error {
+	con, rd, err := b.connect()
+	if err != nil {
+		return err
+	}
+
+	b.subLock.Lock()
+	b.sub = con
+	b.subLock.Unlock()
+
+	defer func() {
+		b.subLock.Lock()
+		b.sub = nil
+		b.subLock.Unlock()
+		_ = con.Close()
+	}()
+
+	// the termination may have started before the connection was registered
+	select {
+	case <-b.sigStop:
+		return fmt.Errorf("terminated")
+	default:
+	}
+
+	if err := busWrite(con, "SUBSCRIBE", b.cfg.Channel); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go b.keepAlive(con, done)
+
+	for {
+		_ = con.SetReadDeadline(time.Now().Add(2 * b.ping))
+		reply, err := busRead(rd)
+		if err != nil {
+			return err
+		}
+
+		// we are interested in ["subscribe", channel, count] and ["message", channel, payload] only
+		list, ok := reply.([]interface{})
+		if !ok || len(list) != 3 {
+			continue
+		}
+		kind, _ := list[0].(string)
+		switch kind {
+		case "subscribe":
+			if subscribed != nil {
+				b.log.Noticef("cache bus subscription restored, resyncing caches")
+				subscribed()
+			}
+		case "message":
+			payload, ok := list[2].(string)
+			if !ok {
+				continue
+			}
+			if payload == busResyncMessage {
+				b.log.Noticef("cache bus resync requested")
+				resync()
+				continue
+			}
+			handler([]byte(payload))
+		}
+	}
+}
+
+// keepAlive pings the Redis server over the subscriber connection until done; the server replies
+// with a pong message, so the read deadline of the listener is hit only if the connection is broken.
+func (b *Bus) keepAlive(con net.Conn, done chan struct{}) {
+	ticker := time.NewTicker(b.ping)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			_ = con.SetWriteDeadline(time.Now().Add(b.ping))
+			if err := busWrite(con, "PING"); err != nil {
+				select {
+				case <-done:
+				default:
+					b.log.Errorf("cache bus ping failed; %s", err.Error())
+					_ = con.Close()
+				}
+				return
+			}
+		}
+	}
+}
+
+// connect opens a new connection to the Redis server and authenticates, if needed.
+func (b *Bus) connect() (net.Conn, *bufio.Reader, error) {
+	con, err := net.DialTimeout("tcp", b.cfg.Redis, busDialTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rd := bufio.NewReader(con)
+	if b.cfg.Password != "" {
+		_ = con.SetDeadline(time.Now().Add(busDialTimeout))
+		if err := busCommand(con, rd, "AUTH", b.cfg.Password); err != nil {
+			_ = con.Close()
+			return nil, nil, err
+		}
+		_ = con.SetDeadline(time.Time{})
+	}
+	return con, rd, nil
+}
+
+// busCommand sends the given command to the Redis server and waits for the reply.
+func busCommand(w io.Writer, rd *bufio.Reader, args ...string) error {
+	if err := busWrite(w, args...); err != nil {
+		return err
+	}
+	_, err := busRead(rd)
+	return err
+}
+
+// busWrite encodes the given command into the Redis protocol array of bulk strings.
+func busWrite(w io.Writer, args ...string) error {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+	for _, a := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(a)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, a...)
+		buf = append(buf, '\r', '\n')
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// busRead decodes a single Redis protocol reply. Strings are provided as string,
+// integers as int64, arrays as []interface{}; an error reply is returned as an error.
+func busRead(rd *bufio.Reader) (interface{}, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("invalid reply %q", line)
+	}
+	line = line[:len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, fmt.Errorf("redis error; %s", line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(rd, data); err != nil {
+			return nil, err
+		}
+		return string(data[:size]), nil
+	case '*':
+		count, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return nil, nil
+		}
+		list := make([]interface{}, count)
+		for i := range list {
+			if list[i], err = busRead(rd); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("unknown reply %q", line)
+}
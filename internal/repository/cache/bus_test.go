@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/logger"
+	"bufio"
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+// busReadTest represents a single test of the Redis protocol reply decoder.
+type busReadTest struct {
+	name  string
+	input string
+	value interface{}
+	err   string
+}
+
+// the list of Redis protocol replies and their decoded values.
+var busReadTests = []busReadTest{
+	{name: "simple string", input: "+OK\r\n", value: "OK"},
+	{name: "error", input: "-ERR unknown command\r\n", err: "redis error; ERR unknown command"},
+	{name: "integer", input: ":1042\r\n", value: int64(1042)},
+	{name: "negative integer", input: ":-3\r\n", value: int64(-3)},
+	{name: "invalid integer", input: ":x\r\n", err: "invalid syntax"},
+	{name: "bulk string", input: "$5\r\nhello\r\n", value: "hello"},
+	{name: "empty bulk string", input: "$0\r\n\r\n", value: ""},
+	{name: "bulk string with line breaks", input: "$7\r\na\r\nb\r\nc\r\n", value: "a\r\nb\r\nc"},
+	{name: "null bulk string", input: "$-1\r\n", value: nil},
+	{name: "truncated bulk string", input: "$10\r\nhello\r\n", err: "unexpected EOF"},
+	{name: "array", input: "*3\r\n$7\r\nmessage\r\n$5\r\ncache\r\n$2\r\n{}\r\n", value: []interface{}{"message", "cache", "{}"}},
+	{name: "mixed array", input: "*3\r\n$9\r\nsubscribe\r\n$5\r\ncache\r\n:1\r\n", value: []interface{}{"subscribe", "cache", int64(1)}},
+	{name: "nested array", input: "*2\r\n*1\r\n+a\r\n:2\r\n", value: []interface{}{[]interface{}{"a"}, int64(2)}},
+	{name: "empty array", input: "*0\r\n", value: []interface{}{}},
+	{name: "null array", input: "*-1\r\n", value: nil},
+	{name: "truncated array", input: "*2\r\n+a\r\n", err: "EOF"},
+	{name: "error inside array", input: "*2\r\n+a\r\n-ERR oops\r\n", err: "redis error; ERR oops"},
+	{name: "missing carriage return", input: "+OK\n", err: "invalid reply"},
+	{name: "unknown type", input: "!5\r\n", err: "unknown reply"},
+	{name: "incomplete line", input: "+OK", err: "EOF"},
+}
+
+func TestBusRead(t *testing.T) {
+	for _, tc := range busReadTests {
+		t.Run(tc.name, func(t *testing.T) {
+			checkBusRead(t, bufio.NewReader(strings.NewReader(tc.input)), tc)
+		})
+	}
+}
+
+func TestBusReadPartial(t *testing.T) {
+	// replies arriving one byte at a time through the smallest buffer
+	for _, tc := range busReadTests {
+		t.Run(tc.name, func(t *testing.T) {
+			checkBusRead(t, bufio.NewReaderSize(iotest.OneByteReader(strings.NewReader(tc.input)), 16), tc)
+		})
+	}
+}
+
+func TestBusReadSequence(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	rd := bufio.NewReader(iotest.HalfReader(strings.NewReader("+OK\r\n*3\r\n$7\r\nmessage\r\n$5\r\ncache\r\n$3\r\nabc\r\n:7\r\n")))
+
+	val, err := busRead(rd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(val).To(gomega.Equal("OK"))
+
+	val, err = busRead(rd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(val).To(gomega.Equal([]interface{}{"message", "cache", "abc"}))
+
+	val, err = busRead(rd)
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(val).To(gomega.Equal(int64(7)))
+}
+
+// checkBusRead decodes a reply from the reader and checks it against the test.
+func checkBusRead(t *testing.T, rd *bufio.Reader, tc busReadTest) {
+	g := gomega.NewGomegaWithT(t)
+
+	val, err := busRead(rd)
+	if tc.err != "" {
+		g.Expect(err).NotTo(gomega.BeNil())
+		g.Expect(err.Error()).To(gomega.ContainSubstring(tc.err))
+		return
+	}
+
+	g.Expect(err).To(gomega.BeNil())
+	if tc.value == nil {
+		g.Expect(val).To(gomega.BeNil())
+		return
+	}
+	g.Expect(val).To(gomega.Equal(tc.value))
+}
+
+func TestBusWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		output string
+	}{
+		{name: "no arguments", args: []string{}, output: "*0\r\n"},
+		{name: "single argument", args: []string{"PING"}, output: "*1\r\n$4\r\nPING\r\n"},
+		{name: "subscribe", args: []string{"SUBSCRIBE", "cache"}, output: "*2\r\n$9\r\nSUBSCRIBE\r\n$5\r\ncache\r\n"},
+		{name: "empty argument", args: []string{"PUBLISH", "cache", ""}, output: "*3\r\n$7\r\nPUBLISH\r\n$5\r\ncache\r\n$0\r\n\r\n"},
+		{name: "binary argument", args: []string{"PUBLISH", "cache", busResyncMessage}, output: "*3\r\n$7\r\nPUBLISH\r\n$5\r\ncache\r\n$7\r\n\x00resync\r\n"},
+		{name: "argument with line breaks", args: []string{"PUBLISH", "c", "a\r\nb"}, output: "*3\r\n$7\r\nPUBLISH\r\n$1\r\nc\r\n$4\r\na\r\nb\r\n"},
+		{name: "multi-byte argument", args: []string{"AUTH", "pässwörd"}, output: "*2\r\n$4\r\nAUTH\r\n$10\r\npässwörd\r\n"},
+		{name: "long argument", args: []string{"PUBLISH", strings.Repeat("x", 100)}, output: "*2\r\n$7\r\nPUBLISH\r\n$100\r\n" + strings.Repeat("x", 100) + "\r\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			var buf bytes.Buffer
+			g.Expect(busWrite(&buf, tc.args...)).To(gomega.Succeed())
+			g.Expect(buf.String()).To(gomega.Equal(tc.output))
+
+			// the encoded command must be readable as an array of bulk strings
+			val, err := busRead(bufio.NewReader(&buf))
+			g.Expect(err).To(gomega.BeNil())
+			list := make([]interface{}, len(tc.args))
+			for i, a := range tc.args {
+				list[i] = a
+			}
+			g.Expect(val).To(gomega.Equal(list))
+		})
+	}
+}
+
+func TestBusWriteError(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(busWrite(failingWriter{}, "PING")).NotTo(gomega.Succeed())
+}
+
+// failingWriter represents a writer failing all writes, e.g. a closed connection.
+type failingWriter struct{}
+
+// Write fails the write.
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, iotest.ErrTimeout
+}
+
+// TestBusListenHalfOpen checks the subscription is dropped if the Redis server stops responding
+// while the connection stays open, so the bus reconnects and resyncs the caches.
+func TestBusListenHalfOpen(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).To(gomega.BeNil())
+	defer ln.Close()
+
+	// confirm the subscription, answer the first ping and go silent
+	pings := make(chan string, 4)
+	go func() {
+		con, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer con.Close()
+
+		rd := bufio.NewReader(con)
+		for i := 0; ; i++ {
+			cmd, err := busRead(rd)
+			if err != nil {
+				return
+			}
+			list := cmd.([]interface{})
+			switch {
+			case list[0] == "SUBSCRIBE":
+				_ = busWrite(con, "subscribe", "cache", "1")
+			case i == 1:
+				pings <- list[0].(string)
+				_ = busWrite(con, "pong", "")
+			default:
+				pings <- list[0].(string)
+			}
+		}
+	}()
+
+	cfg := new(config.Config)
+	cfg.Log.Format = "%{message}"
+	b := NewBus(&config.CacheBus{Redis: ln.Addr().String(), Channel: "cache"}, logger.New(cfg))
+	b.ping = 50 * time.Millisecond
+
+	subscribed := 0
+	start := time.Now()
+	err = b.listen(func([]byte) {}, func() {}, func() { subscribed++ })
+
+	g.Expect(err).ToNot(gomega.BeNil())
+	g.Expect(subscribed).To(gomega.Equal(1))
+	g.Expect(<-pings).To(gomega.Equal("PING"))
+	g.Expect(time.Since(start)).To(gomega.BeNumerically("<", 2*time.Second))
+}
@@ -76,3 +76,24 @@ func (b *MemBridge) EvictContract(addr *common.Address) {
 		b.log.Criticalf("cache error %s", err.Error())
 	}
 }
+
+// EvictContracts makes sure no contract is kept in the cache.
+func (b *MemBridge) EvictContracts() {
+	keys := make([]string, 0)
+	it := b.cache.Iterator()
+	for it.SetNext() {
+		entry, err := it.Value()
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(entry.Key(), contractCacheIdPrefix) {
+			keys = append(keys, entry.Key())
+		}
+	}
+
+	for _, key := range keys {
+		if err := b.cache.Delete(key); err != nil && err != bigcache.ErrEntryNotFound {
+			b.log.Criticalf("cache error %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Package cache implements bridge to fast in-memory object cache.
+package cache
+
+import (
+	"axis-graphql/internal/types"
+	"encoding/json"
+
+	"github.com/allegro/bigcache"
+)
+
+const (
+	// sandboxKeyMissCacheIdPrefix is the prefix of cache ids of unknown sandbox keys.
+	sandboxKeyMissCacheIdPrefix = "sbx_miss_"
+
+	// sandboxKeyCacheIdPrefix is the prefix of cache ids of valid sandbox keys.
+	sandboxKeyCacheIdPrefix = "sbx_key_"
+)
+
+// CheckSandboxKeyMiss checks if the sandbox key of the given hash is known not to exist.
+func (b *MemBridge) CheckSandboxKeyMiss(id string) bool {
+	_, err := b.cache.Get(sandboxKeyMissCacheIdPrefix + id)
+	return err == nil
+}
+
+// PushSandboxKeyMiss caches the sandbox key of the given hash is not valid,
+// so repeated requests with an unknown key don't hit the database.
+func (b *MemBridge) PushSandboxKeyMiss(id string) {
+	if err := b.cache.Set(sandboxKeyMissCacheIdPrefix+id, []byte{1}); err != nil {
+		b.log.Errorf("can not cache unknown sandbox key; %s", err.Error())
+	}
+}
+
+// PullSandboxKey extracts the sandbox key of the given hash from the in-memory cache, if available.
+func (b *MemBridge) PullSandboxKey(id string) *types.SandboxKey {
+	data, err := b.cache.Get(sandboxKeyCacheIdPrefix + id)
+	if err != nil {
+		return nil
+	}
+
+	var sk types.SandboxKey
+	if err := json.Unmarshal(data, &sk); err != nil {
+		b.log.Criticalf("can not decode sandbox key from in-memory cache; %s", err.Error())
+		return nil
+	}
+	return &sk
+}
+
+// PushSandboxKey stores the given sandbox key in the in-memory cache; the cache size
+// is limited and entries are evicted after the configured time, so the number
+// of sandbox keys kept in memory is bounded.
+func (b *MemBridge) PushSandboxKey(sk *types.SandboxKey) {
+	data, err := json.Marshal(sk)
+	if err != nil {
+		b.log.Criticalf("can not marshal sandbox key to JSON; %s", err.Error())
+		return
+	}
+	if err := b.cache.Set(sandboxKeyCacheIdPrefix+sk.ID, data); err != nil {
+		b.log.Errorf("can not cache sandbox key; %s", err.Error())
+	}
+}
+
+// EvictSandboxKey removes the sandbox key of the given hash from the in-memory cache.
+func (b *MemBridge) EvictSandboxKey(id string) {
+	if err := b.cache.Delete(sandboxKeyCacheIdPrefix + id); err != nil && err != bigcache.ErrEntryNotFound {
+		b.log.Errorf("can not evict sandbox key; %s", err.Error())
+	}
+}
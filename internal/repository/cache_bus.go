@@ -0,0 +1,137 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/repository/cache"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Kinds of cache invalidations shared by API replicas over the cache bus.
+const (
+	cacheBusDefiConfig    = "defi_config"
+	cacheBusDefiAddresses = "defi_addresses"
+	cacheBusValidator     = "validator"
+	cacheBusContract      = "contract"
+)
+
+// cacheBusMessage represents a cache invalidation sent over the cache bus.
+type cacheBusMessage struct {
+	Origin  string `json:"origin"`
+	Kind    string `json:"kind"`
+	Subject string `json:"subject,omitempty"`
+}
+
+// cacheBus represents the connection of the repository to the cache invalidation bus.
+type cacheBus struct {
+	bus    *cache.Bus
+	origin string
+}
+
+// openCacheBus connects the repository to the cache invalidation bus, if configured,
+// so invalidations made by this replica propagate to the other replicas and vice versa.
+func (p *proxy) openCacheBus() {
+	if p.cfg.Cache.Bus.Redis == "" {
+		return
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		p.log.Errorf("cache bus not available; %s", err.Error())
+		return
+	}
+
+	p.cacheBus = &cacheBus{
+		bus:    cache.NewBus(&p.cfg.Cache.Bus, p.log),
+		origin: hex.EncodeToString(id),
+	}
+	p.cacheBus.bus.Open(p.cacheBusReceived, p.cacheBusResync)
+}
+
+// broadcastInvalidation sends the cache invalidation to the other replicas, if the cache bus is connected.
+func (p *proxy) broadcastInvalidation(kind string, subject string) {
+	if p.cacheBus == nil {
+		return
+	}
+
+	msg, err := json.Marshal(cacheBusMessage{Origin: p.cacheBus.origin, Kind: kind, Subject: subject})
+	if err != nil {
+		p.log.Errorf("can not encode cache invalidation; %s", err.Error())
+		return
+	}
+	p.cacheBus.bus.Publish(msg)
+}
+
+// cacheBusReceived applies a cache invalidation received from another replica.
+func (p *proxy) cacheBusReceived(data []byte) {
+	var msg cacheBusMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		p.log.Errorf("invalid cache invalidation received; %s", err.Error())
+		return
+	}
+
+	// our own invalidations were already applied
+	if msg.Origin == p.cacheBus.origin {
+		return
+	}
+
+	p.log.Debugf("cache invalidation %s %s received from %s", msg.Kind, msg.Subject, msg.Origin)
+	switch msg.Kind {
+	case cacheBusDefiConfig:
+		p.invalidateDefiConfiguration(false)
+	case cacheBusDefiAddresses:
+		p.invalidateDefiConfiguration(true)
+	case cacheBusValidator:
+		id, ok := new(big.Int).SetString(msg.Subject, 10)
+		if !ok {
+			p.log.Errorf("invalid validator %s invalidated", msg.Subject)
+			return
+		}
+		p.invalidateValidator((*hexutil.Big)(id))
+	case cacheBusContract:
+		if !common.IsHexAddress(msg.Subject) {
+			p.log.Errorf("invalid contract %s invalidated", msg.Subject)
+			return
+		}
+		p.evictContract(common.HexToAddress(msg.Subject))
+	default:
+		p.log.Warningf("unknown cache invalidation %s received", msg.Kind)
+	}
+}
+
+// cacheBusResync drops all the caches shared over the cache bus; invalidations
+// may have been missed while the bus was not available.
+func (p *proxy) cacheBusResync() {
+	p.invalidateDefiConfiguration(true)
+
+	p.validators.lock.Lock()
+	p.validators.list = nil
+	p.validators.dirty = nil
+	p.validators.lock.Unlock()
+
+	p.cache.EvictContracts()
+	p.abis.Range(func(key, _ interface{}) bool {
+		p.abis.Delete(key)
+		return true
+	})
+	p.log.Noticef("caches shared over the cache bus dropped")
+}
+
+// closeCacheBus disconnects the repository from the cache invalidation bus.
+func (p *proxy) closeCacheBus() {
+	if p.cacheBus != nil {
+		p.cacheBus.bus.Close()
+	}
+}
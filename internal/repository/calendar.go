@@ -0,0 +1,153 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// UpcomingEvents collects the known future time-based events of the given addresses,
+// i.e. delegation lock expirations and withdrawal availability of pending un-delegations,
+// and the voting deadlines of open proposals of all the known Governance contracts.
+// The events are sorted by the time they happen at.
+func (p *proxy) UpcomingEvents(addrs []common.Address) ([]*types.CalendarEvent, error) {
+	now := uint64(time.Now().UTC().Unix())
+	list := make([]*types.CalendarEvent, 0)
+
+	for i := range addrs {
+		evs, err := p.delegationEvents(&addrs[i], now)
+		if err != nil {
+			p.log.Errorf("upcoming events of %s not available; %s", addrs[i].String(), err.Error())
+			return nil, err
+		}
+		list = append(list, evs...)
+	}
+
+	evs, err := p.governanceEvents(now)
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, evs...)
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Time < list[j].Time
+	})
+	return list, nil
+}
+
+// delegationEvents collects the upcoming lock expirations and withdrawals of the given delegator.
+func (p *proxy) delegationEvents(addr *common.Address, now uint64) ([]*types.CalendarEvent, error) {
+	cfg, err := p.SfcConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	dl, err := p.DelegationsByAddressAll(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*types.CalendarEvent, 0)
+	for _, dlg := range dl {
+		valID := dlg.ToStakerId.ToInt().Uint64()
+
+		lock, err := p.DelegationLock(&dlg.Address, dlg.ToStakerId, nil)
+		if err != nil {
+			return nil, err
+		}
+		if lock.LockedAmount.ToInt().Sign() > 0 && uint64(lock.LockedUntil) > now {
+			amount := lock.LockedAmount
+			list = append(list, &types.CalendarEvent{
+				Type:        types.CalendarEventLockExpiration,
+				Time:        lock.LockedUntil,
+				Address:     addr,
+				ValidatorID: dlg.ToStakerId,
+				Amount:      &amount,
+				Title:       fmt.Sprintf("lock of the delegation to #%d expires", valID),
+			})
+		}
+
+		wl, err := p.pendingWithdrawRequests(&dlg.Address, dlg.ToStakerId)
+		if err != nil {
+			return nil, err
+		}
+		for _, wr := range wl {
+			if withdrawPeriodPassed(wr, cfg, now) {
+				continue
+			}
+			list = append(list, &types.CalendarEvent{
+				Type:              types.CalendarEventWithdrawal,
+				Time:              wr.CreatedTime + hexutil.Uint64(cfg.WithdrawalPeriodTime.ToInt().Uint64()),
+				Address:           addr,
+				ValidatorID:       dlg.ToStakerId,
+				Amount:            wr.Amount,
+				WithdrawRequestID: wr.WithdrawRequestID,
+				Title:             fmt.Sprintf("withdrawal period of request #%d to #%d passes", wr.WithdrawRequestID.ToInt().Uint64(), valID),
+			})
+		}
+	}
+	return list, nil
+}
+
+// governanceEvents collects the upcoming voting deadlines of unresolved proposals
+// of the known Governance contracts.
+func (p *proxy) governanceEvents(now uint64) ([]*types.CalendarEvent, error) {
+	list := make([]*types.CalendarEvent, 0)
+	for _, gc := range p.govContracts {
+		gov := gc.Address
+		gpl, err := p.rpc.GovernanceProposalsBy(&gov)
+		if err != nil {
+			p.log.Errorf("list of proposals not available for %s; %s", gov.String(), err.Error())
+			return nil, err
+		}
+
+		for _, gp := range gpl {
+			if uint64(gp.VotingMustEnd) <= now {
+				continue
+			}
+
+			state, err := p.GovernanceProposalState(&gov, &gp.Id)
+			if err != nil {
+				return nil, err
+			}
+			if state.IsResolved {
+				continue
+			}
+
+			for _, ev := range []struct {
+				typ  string
+				at   hexutil.Uint64
+				what string
+			}{
+				{types.CalendarEventVotingOpens, gp.VotingStarts, "voting on %s opens"},
+				{types.CalendarEventVotingMayEnd, gp.VotingMayEnd, "voting on %s may end"},
+				{types.CalendarEventVotingMustEnd, gp.VotingMustEnd, "voting on %s ends"},
+			} {
+				if uint64(ev.at) <= now {
+					continue
+				}
+				id := gp.Id
+				list = append(list, &types.CalendarEvent{
+					Type:       ev.typ,
+					Time:       ev.at,
+					Governance: &gc.Address,
+					ProposalID: &id,
+					Title:      fmt.Sprintf(ev.what, gp.Name),
+				})
+			}
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,98 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// collateralRatioDecimals represents the correction applied to collateral ratio
+// to get the ratio in 4 digits.
+var collateralRatioDecimals = big.NewInt(10000)
+
+// RegisterCollateralAlert registers a new collateral ratio alert of the given owner
+// for the given fMint account. The initial state of the alert is derived
+// from the current collateral ratio so only future crossings are reported.
+func (p *proxy) RegisterCollateralAlert(owner string, addr common.Address, threshold4 int64, webhook *string) (*types.CollateralAlert, error) {
+	// get the current state
+	ratio, err := p.FMintCollateralRatio(&addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the alert identifier
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("can not generate alert identifier; %s", err.Error())
+	}
+
+	ca := types.CollateralAlert{
+		ID:         hex.EncodeToString(id),
+		Owner:      owner,
+		Address:    addr.String(),
+		Threshold4: threshold4,
+		Webhook:    webhook,
+		Below:      ratio != nil && *ratio < threshold4,
+		Ratio4:     ratio,
+		Created:    time.Now().UTC(),
+	}
+	if err := p.db.StoreCollateralAlert(&ca); err != nil {
+		return nil, err
+	}
+	return &ca, nil
+}
+
+// StoreCollateralAlert stores, or updates, the given collateral ratio alert.
+func (p *proxy) StoreCollateralAlert(ca *types.CollateralAlert) error {
+	return p.db.StoreCollateralAlert(ca)
+}
+
+// RemoveCollateralAlert removes the collateral ratio alert of the given owner.
+func (p *proxy) RemoveCollateralAlert(id string, owner string) (bool, error) {
+	return p.db.RemoveCollateralAlert(id, owner)
+}
+
+// CollateralAlerts loads collateral ratio alerts, optionally only those of the given owner.
+func (p *proxy) CollateralAlerts(owner *string) ([]*types.CollateralAlert, error) {
+	return p.db.CollateralAlerts(owner)
+}
+
+// FMintCollateralRatio calculates the current collateral to debt ratio of the given fMint account
+// in 4 digits using the oracle prices of the tokens. The ratio is nil if the account has no debt.
+func (p *proxy) FMintCollateralRatio(addr *common.Address) (*int64, error) {
+	fa, err := p.rpc.FMintAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	return collateralRatio4(fa), nil
+}
+
+// collateralRatio4 calculates the collateral to debt ratio of the given fMint account in 4 digits.
+func collateralRatio4(fa *types.FMintAccount) *int64 {
+	// no debt, no ratio
+	if fa.DebtValue.ToInt().Sign() == 0 {
+		return nil
+	}
+
+	val := new(big.Int).Mul(fa.CollateralValue.ToInt(), collateralRatioDecimals)
+	val.Div(val, fa.DebtValue.ToInt())
+	if !val.IsInt64() {
+		return nil
+	}
+
+	ratio := val.Int64()
+	return &ratio
+}
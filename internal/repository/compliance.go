@@ -0,0 +1,224 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ComplianceHook represents a screening provider checking addresses against
+// a list of sanctioned, or otherwise flagged addresses. Integrators with specific
+// regulatory requirements may plug their own implementation using SetComplianceHook.
+type ComplianceHook interface {
+	// Name returns the name of the screening source.
+	Name() string
+
+	// Screen checks the given address and provides the screening result.
+	Screen(*common.Address) (*types.RiskFlag, error)
+}
+
+// complianceHook represents a custom compliance hook to be used by the repository.
+var complianceHook ComplianceHook
+
+// SetComplianceHook sets a custom compliance hook to be used by the repository
+// instead of the configured one. It has to be set before the repository is instantiated.
+func SetComplianceHook(h ComplianceHook) {
+	complianceHook = h
+}
+
+// riskFlagCacheItem represents a cached screening result.
+type riskFlagCacheItem struct {
+	flag    *types.RiskFlag
+	expires time.Time
+}
+
+// newComplianceHook creates the compliance hook based on the given configuration.
+// It returns nil if the compliance screening is not configured.
+func newComplianceHook(cfg *config.Compliance) ComplianceHook {
+	// custom hook has priority
+	if complianceHook != nil {
+		log.Noticef("using custom compliance hook %s", complianceHook.Name())
+		return complianceHook
+	}
+
+	// external screening service
+	if cfg.Url != "" {
+		log.Noticef("using compliance screening service at %s", cfg.Url)
+		return &remoteScreening{
+			url:    cfg.Url,
+			apiKey: cfg.ApiKey,
+			client: &http.Client{Timeout: cfg.Timeout},
+		}
+	}
+
+	// local list of flagged addresses
+	if cfg.ListFile != "" {
+		ls, err := loadListScreening(cfg.ListFile)
+		if err != nil {
+			log.Errorf("can not load compliance list %s; %s", cfg.ListFile, err.Error())
+			return nil
+		}
+		log.Noticef("loaded %d flagged addresses from %s", len(ls.flagged), cfg.ListFile)
+		return ls
+	}
+	return nil
+}
+
+// IsComplianceEnabled signals if the compliance screening hook is available.
+func (p *proxy) IsComplianceEnabled() bool {
+	return p.compliance != nil
+}
+
+// AccountRiskFlag provides the compliance screening result of the given address.
+// It returns nil if the compliance screening is not enabled.
+func (p *proxy) AccountRiskFlag(addr *common.Address) (*types.RiskFlag, error) {
+	if p.compliance == nil {
+		return nil, nil
+	}
+
+	// do we have a recent result?
+	if it, ok := p.riskFlags.Load(*addr); ok && it.(*riskFlagCacheItem).expires.After(time.Now()) {
+		return it.(*riskFlagCacheItem).flag, nil
+	}
+
+	// screen the address only once if called in parallel
+	fl, err, _ := p.apiRequestGroup.Do("risk_flag_"+addr.String(), func() (interface{}, error) {
+		return p.compliance.Screen(addr)
+	})
+	if err != nil {
+		p.log.Errorf("compliance screening of %s failed; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	p.riskFlags.Store(*addr, &riskFlagCacheItem{
+		flag:    fl.(*types.RiskFlag),
+		expires: time.Now().Add(p.cfg.Compliance.CacheTTL),
+	})
+	return fl.(*types.RiskFlag), nil
+}
+
+// listScreening implements compliance hook screening addresses
+// against a local list of flagged addresses.
+type listScreening struct {
+	flagged map[common.Address]string
+}
+
+// loadListScreening loads the list of flagged addresses from the given JSON file.
+// The file contains a map of flagged addresses to their risk category.
+func loadListScreening(path string) (*listScreening, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// make sure to close the file
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("can not close compliance list file; %s", err.Error())
+		}
+	}()
+
+	// read and decode the list
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var list map[common.Address]string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return &listScreening{flagged: list}, nil
+}
+
+// Name returns the name of the screening source.
+func (ls *listScreening) Name() string {
+	return "list"
+}
+
+// Screen checks the given address against the local list.
+func (ls *listScreening) Screen(addr *common.Address) (*types.RiskFlag, error) {
+	cat, ok := ls.flagged[*addr]
+	return &types.RiskFlag{
+		Address:  *addr,
+		Flagged:  ok,
+		Category: cat,
+		Source:   ls.Name(),
+		Checked:  time.Now().UTC(),
+	}, nil
+}
+
+// remoteScreening implements compliance hook calling an external screening service.
+// The service is expected to respond to GET requests with the address in the query
+// by a JSON object with flagged, category and reason fields.
+type remoteScreening struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// Name returns the name of the screening source.
+func (rs *remoteScreening) Name() string {
+	return "remote"
+}
+
+// Screen checks the given address using the external screening service.
+func (rs *remoteScreening) Screen(addr *common.Address) (*types.RiskFlag, error) {
+	// prep the request
+	sep := "?"
+	if strings.Contains(rs.url, "?") {
+		sep = "&"
+	}
+	req, err := http.NewRequest(http.MethodGet, rs.url+sep+"address="+url.QueryEscape(addr.String()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if rs.apiKey != "" {
+		req.Header.Set("X-Api-Key", rs.apiKey)
+	}
+
+	// do the request
+	res, err := rs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// don't forget to close the body
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Errorf("can not close screening service response; %s", err.Error())
+		}
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("screening service responded with status %d", res.StatusCode)
+	}
+
+	// decode the response
+	var fl types.RiskFlag
+	if err := json.NewDecoder(res.Body).Decode(&fl); err != nil {
+		return nil, fmt.Errorf("can not decode screening service response; %s", err.Error())
+	}
+
+	fl.Address = *addr
+	fl.Source = rs.Name()
+	fl.Checked = time.Now().UTC()
+	return &fl, nil
+}
@@ -161,7 +161,8 @@ func (p *proxy) ValidateContract(sc *types.Contract) error {
 
 			// inform about success
 			p.log.Debugf("contract %s [%s] validated", sc.Address.String(), name)
-			p.cache.EvictContract(&sc.Address)
+			p.evictContract(sc.Address)
+			p.broadcastInvalidation(cacheBusContract, sc.Address.String())
 
 			// inform the upper instance we have a winner
 			return nil
@@ -187,7 +188,14 @@ func (p *proxy) StoreContract(con *types.Contract) error {
 	if isUpdate {
 		// log what we have done here
 		p.log.Debugf("updated known contract at %s", con.Address.String())
-		p.cache.EvictContract(&con.Address)
+		p.evictContract(con.Address)
+		p.broadcastInvalidation(cacheBusContract, con.Address.String())
 	}
 	return nil
 }
+
+// evictContract drops the cached contract and its parsed ABI so they are re-loaded on the next request.
+func (p *proxy) evictContract(addr common.Address) {
+	p.cache.EvictContract(&addr)
+	p.abis.Delete(addr)
+}
@@ -0,0 +1,84 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// names of the core contracts in the on-chain address registry
+const (
+	registryNameSfc           = "sfc"
+	registryNameSti           = "sti"
+	registryNameTokenizer     = "sfc_tokenizer"
+	registryNameStakeToken    = "sfc_token"
+	registryNameFMintProvider = "fmint_address_provider"
+)
+
+// registrySlot represents a configured address of a core contract resolved from the registry.
+type registrySlot struct {
+	name string
+	addr *common.Address
+}
+
+// registrySlots provides the configured addresses of the core contracts resolved from the registry.
+func (p *proxy) registrySlots() []registrySlot {
+	return []registrySlot{
+		{name: registryNameSfc, addr: &p.cfg.Staking.SFCContract},
+		{name: registryNameSti, addr: &p.cfg.Staking.StiContract},
+		{name: registryNameTokenizer, addr: &p.cfg.Staking.TokenizerContract},
+		{name: registryNameStakeToken, addr: &p.cfg.Staking.TokenizedStakeToken},
+		{name: registryNameFMintProvider, addr: &p.cfg.DeFi.FMint.AddressProvider},
+	}
+}
+
+// ResolveContractRegistry resolves addresses of the core contracts from the on-chain
+// address registry and applies the changed ones. Contracts not registered keep
+// the configured address. The list of applied changes is returned.
+func (p *proxy) ResolveContractRegistry() ([]types.ContractRegistryChange, error) {
+	if p.cfg.Registry.Address == (common.Address{}) {
+		return nil, nil
+	}
+
+	p.registryLock.Lock()
+	defer p.registryLock.Unlock()
+
+	// resolve all the addresses first so a failure does not leave the configuration half updated
+	slots := p.registrySlots()
+	resolved := make([]common.Address, len(slots))
+	for i, rs := range slots {
+		addr, err := p.rpc.RegistryAddress(p.cfg.Registry.Address, rs.name)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = addr
+	}
+
+	changes := make([]types.ContractRegistryChange, 0)
+	for i, rs := range slots {
+		if resolved[i] == (common.Address{}) || resolved[i] == *rs.addr {
+			continue
+		}
+
+		changes = append(changes, types.ContractRegistryChange{Name: rs.name, Previous: *rs.addr, Current: resolved[i]})
+		p.log.Noticef("contract %s resolved to %s by registry, was %s", rs.name, resolved[i].String(), rs.addr.String())
+		*rs.addr = resolved[i]
+
+		switch rs.name {
+		case registryNameSfc:
+			p.rpc.ResetSfcContract()
+		case registryNameFMintProvider:
+			p.rpc.SetFMintAddressProvider(resolved[i])
+			p.invalidateDefiConfiguration(true)
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,31 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProxyImplementation resolves the current implementation address of an EIP-1967 proxy contract.
+// Nil is returned if the contract is not a proxy.
+func (p *proxy) ProxyImplementation(addr *common.Address) (*common.Address, error) {
+	return p.rpc.ProxyImplementation(addr)
+}
+
+// StoreContractUpgrade stores an upgrade of a proxy contract in the persistent storage.
+func (p *proxy) StoreContractUpgrade(cu *types.ContractUpgrade) error {
+	return p.db.AddContractUpgrade(cu)
+}
+
+// ContractUpgrades provides the most recent upgrades of the proxy contract.
+func (p *proxy) ContractUpgrades(addr *common.Address, count int32) ([]*types.ContractUpgrade, error) {
+	return p.db.ContractUpgrades(addr, int64(count))
+}
@@ -0,0 +1,59 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Counterparties provides the list of top counterparties of the given address,
+// e.g. the addresses the account interacted with the most in the given period.
+func (p *proxy) Counterparties(adr *common.Address, period string, count int32) ([]*types.Counterparty, error) {
+	// get the period start
+	length, ok := types.CounterpartyPeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	var since *time.Time
+	if length > 0 {
+		t := time.Now().UTC().Add(-length)
+		since = &t
+	}
+
+	// load the aggregated rows
+	rows, err := p.db.Counterparties(adr, since, count)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*types.Counterparty, 0, len(rows))
+	for _, row := range rows {
+		// contract deployments don't have a counterparty
+		if row.Address == nil {
+			continue
+		}
+
+		// restore the amount precision
+		list = append(list, &types.Counterparty{
+			Address:        common.HexToAddress(*row.Address),
+			TrxCount:       uint64(row.Count),
+			SentCount:      uint64(row.SentCount),
+			ReceivedCount:  uint64(row.ReceivedCount),
+			SentVolume:     new(big.Int).Mul(big.NewInt(row.SentAmount), types.TransactionDecimalsCorrection),
+			ReceivedVolume: new(big.Int).Mul(big.NewInt(row.ReceivedAmount), types.TransactionDecimalsCorrection),
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,31 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DagEvent returns the Lachesis consensus event by its id.
+func (p *proxy) DagEvent(id *common.Hash) (*types.DagEvent, error) {
+	return p.rpc.DagEvent(id)
+}
+
+// DagEventPayload returns the Lachesis consensus event by its id including the list of transactions.
+func (p *proxy) DagEventPayload(id *common.Hash) (*types.DagEventPayload, error) {
+	return p.rpc.DagEventPayload(id)
+}
+
+// DagHeads returns the list of ids of the head events of the given epoch.
+func (p *proxy) DagHeads(epoch *hexutil.Uint64) ([]common.Hash, error) {
+	return p.rpc.DagHeads(epoch)
+}
@@ -0,0 +1,103 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coAbi is the name of the off-chain database collection storing custom registered ABIs.
+	coAbi = "abi"
+
+	// fiAbiPk is the name of the primary key field of the ABI collection.
+	fiAbiPk = "_id"
+
+	// fiAbiRegistrar is the name of the field of the ABI registrar.
+	fiAbiRegistrar = "by"
+
+	// fiAbiApproved is the name of the field of the ABI approval flag.
+	fiAbiApproved = "ok"
+)
+
+// ErrAbiOwned signals the custom ABI of the contract has been registered by another registrar.
+var ErrAbiOwned = fmt.Errorf("ABI of the contract has been registered by another API key")
+
+// ErrAbiNotFound signals there is no custom ABI registered for the contract.
+var ErrAbiNotFound = fmt.Errorf("no ABI has been registered for the contract")
+
+// StoreAbi stores the given custom ABI of a contract. A previous ABI is replaced only
+// if it has been registered by the same registrar, unless the override is allowed.
+// The ownership is checked by the upsert itself, so concurrent registrations can not race.
+func (db *MongoDbBridge) StoreAbi(ra *types.RegisteredAbi, override bool) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coAbi)
+
+	// an ABI of another registrar does not match, the upsert then collides on the primary key
+	filter := bson.D{{Key: fiAbiPk, Value: ra.Address.String()}}
+	if !override {
+		filter = append(filter, bson.E{Key: fiAbiRegistrar, Value: ra.Registrar})
+	}
+
+	// insert/update
+	_, err := col.UpdateOne(context.Background(), filter, bson.D{{Key: "$set", Value: ra}}, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrAbiOwned
+		}
+		db.log.Errorf("can not store ABI of %s; %s", ra.Address.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// Abi loads the custom ABI registered for the given contract, if any.
+func (db *MongoDbBridge) Abi(addr *common.Address) (*types.RegisteredAbi, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coAbi)
+
+	// try to find the ABI
+	res := col.FindOne(context.Background(), bson.D{{Key: fiAbiPk, Value: addr.String()}})
+	if res.Err() != nil {
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load ABI of %s; %s", addr.String(), res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row types.RegisteredAbi
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode ABI of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+	row.Address = *addr
+	return &row, nil
+}
+
+// ApproveAbi marks the custom ABI registered for the given contract as approved,
+// so it is used for decoding interactions with the contract.
+func (db *MongoDbBridge) ApproveAbi(addr *common.Address) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coAbi)
+
+	// update the flag
+	res, err := col.UpdateOne(context.Background(),
+		bson.D{{Key: fiAbiPk, Value: addr.String()}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: fiAbiApproved, Value: true}}}})
+	if err != nil {
+		db.log.Errorf("can not approve ABI of %s; %s", addr.String(), err.Error())
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrAbiNotFound
+	}
+	return nil
+}
@@ -25,6 +25,9 @@ const (
 	// fiAccountType is the name of the field of the account contract type.
 	fiAccountType = "type"
 
+	// fiAccountFirstSeen is the name of the field of the account first activity time stamp.
+	fiAccountFirstSeen = "fts"
+
 	// fiAccountLastActivity is the name of the field of the account last activity time stamp.
 	fiAccountLastActivity = "ats"
 
@@ -44,6 +47,7 @@ type AccountRow struct {
 	Address  string       `bson:"_id"`
 	Type     string       `bson:"type"`
 	Sc       *string      `bson:"sc"`
+	First    uint64       `bson:"fts"`
 	Activity uint64       `bson:"ats"`
 	Counter  uint64       `bson:"atc"`
 	ScHash   *common.Hash `bson:"-"`
@@ -93,6 +97,7 @@ func (db *MongoDbBridge) Account(addr *common.Address) (*types.Account, error) {
 		Address:      *addr,
 		ContractTx:   row.ScHash,
 		Type:         row.Type,
+		FirstSeen:    hexutil.Uint64(row.First),
 		LastActivity: hexutil.Uint64(row.Activity),
 		TrxCounter:   hexutil.Uint64(row.Counter),
 	}, nil
@@ -120,6 +125,7 @@ func (db *MongoDbBridge) AddAccount(acc *types.Account) error {
 		{Key: fiAccountPk, Value: acc.Address.String()},
 		{Key: fiScCreationTx, Value: conTx},
 		{Key: fiAccountType, Value: acc.Type},
+		{Key: fiAccountFirstSeen, Value: uint64(acc.FirstSeen)},
 		{Key: fiAccountLastActivity, Value: uint64(acc.LastActivity)},
 		{Key: fiAccountTransactionCounter, Value: uint64(acc.TrxCounter)},
 	})
@@ -204,7 +210,7 @@ func (db *MongoDbBridge) AccountMarkActivity(addr *common.Address, ts uint64) er
 	if _, err := col.UpdateOne(context.Background(),
 		bson.D{{Key: fiAccountPk, Value: addr.String()}},
 		bson.D{
-			{Key: "$set", Value: bson.D{{Key: fiAccountLastActivity, Value: ts}}},
+			{Key: "$max", Value: bson.D{{Key: fiAccountLastActivity, Value: ts}}},
 			{Key: "$inc", Value: bson.D{{Key: fiAccountTransactionCounter, Value: 1}}},
 		}); err != nil {
 		// log the issue
@@ -215,6 +221,51 @@ func (db *MongoDbBridge) AccountMarkActivity(addr *common.Address, ts uint64) er
 	return nil
 }
 
+// AccountUpdateFirstSeen sets the time stamp of the first activity of the given account.
+func (db *MongoDbBridge) AccountUpdateFirstSeen(addr *common.Address, ts uint64) error {
+	col := db.client.Database(db.dbName).Collection(coAccounts)
+
+	// we never move the first activity forward
+	if _, err := col.UpdateOne(context.Background(),
+		bson.D{{Key: fiAccountPk, Value: addr.String()}},
+		bson.D{{Key: "$min", Value: bson.D{{Key: fiAccountFirstSeen, Value: ts}}}},
+	); err != nil {
+		db.log.Errorf("can not update account %s first activity; %s", addr.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// AccountFirstTransactionTime finds the time stamp of the oldest known transaction of the given account.
+// It returns zero if no transaction of the account is known.
+func (db *MongoDbBridge) AccountFirstTransactionTime(addr *common.Address) (uint64, error) {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	// find the oldest transaction sent, or received by the account
+	sr := col.FindOne(context.Background(), bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: fiTransactionSender, Value: addr.String()}},
+		bson.D{{Key: fiTransactionRecipient, Value: addr.String()}},
+	}}}, options.FindOne().
+		SetSort(bson.D{{Key: fiTransactionOrdinalIndex, Value: 1}}).
+		SetProjection(bson.D{{Key: fiTransactionTimeStamp, Value: true}}))
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		db.log.Errorf("can not find first transaction of %s; %s", addr.String(), sr.Err().Error())
+		return 0, sr.Err()
+	}
+
+	var row struct {
+		Stamp time.Time `bson:"stamp"`
+	}
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode first transaction of %s; %s", addr.String(), err.Error())
+		return 0, err
+	}
+	return uint64(row.Stamp.Unix()), nil
+}
+
 // Erc20TokensList returns a list of known ERC20 tokens ordered by their activity.
 func (db *MongoDbBridge) Erc20TokensList(count int32) ([]common.Address, error) {
 	// make sure the count is positive; use default size if not
@@ -0,0 +1,91 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// colAccountActivity represents the name of the daily account activity collection.
+	colAccountActivity = "account_activity"
+
+	// fiAccountActivityLastTrx is the name of the field of the ordinal index
+	// of the last transaction counted in the daily account activity.
+	fiAccountActivityLastTrx = "lo"
+
+	// accountActivityRetention represents the time the daily account activity is kept.
+	accountActivityRetention = 366 * 24 * time.Hour
+)
+
+// AccountActivityMark adds the transaction of the given ordinal index made at the given time
+// to the daily activity of the account. Transactions are counted in the order of their ordinal
+// index, so a transaction processed again, e.g. on a block re-scan, is not counted twice.
+func (db *MongoDbBridge) AccountActivityMark(addr *common.Address, ts uint64, ordinal uint64) error {
+	col := db.client.Database(db.dbName).Collection(colAccountActivity)
+
+	stamp := time.Unix(int64(ts), 0).UTC().Truncate(24 * time.Hour)
+	day := stamp.Format("2006-01-02")
+
+	// the upsert collides with the existing day if the transaction has been already counted
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{
+			{Key: "_id", Value: addr.String() + "/" + day},
+			{Key: fiAccountActivityLastTrx, Value: bson.D{{Key: "$lt", Value: ordinal}}},
+		},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: types.FiAccountActivityAddress, Value: addr.String()},
+				{Key: "day", Value: day},
+				{Key: types.FiAccountActivityStamp, Value: stamp},
+				{Key: fiAccountActivityLastTrx, Value: ordinal},
+			}},
+			{Key: "$inc", Value: bson.D{{Key: "cnt", Value: 1}}},
+		},
+		options.Update().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		db.log.Errorf("can not mark activity of %s on %s; %s", addr.String(), day, err.Error())
+		return err
+	}
+	return nil
+}
+
+// AccountActivity loads the daily activity of the account since the given time.
+// Only days with any activity are included.
+func (db *MongoDbBridge) AccountActivity(addr *common.Address, since time.Time) ([]*types.AccountActivityDay, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colAccountActivity)
+
+	ld, err := col.Find(ctx, bson.D{
+		{Key: types.FiAccountActivityAddress, Value: addr.String()},
+		{Key: types.FiAccountActivityStamp, Value: bson.D{{Key: "$gte", Value: since}}},
+	}, options.Find().SetSort(bson.D{{Key: types.FiAccountActivityStamp, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load activity of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account activity cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.AccountActivityDay, 0)
+	for ld.Next(ctx) {
+		var row types.AccountActivityDay
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account activity day; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,206 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coAccountExports is the name of the off-chain database collection storing account export jobs.
+const coAccountExports = "account_exports"
+
+// AddAccountExport stores a new account export job.
+func (db *MongoDbBridge) AddAccountExport(ex *types.AccountExport) error {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+	if _, err := col.InsertOne(context.Background(), ex); err != nil {
+		db.log.Errorf("can not store account export %s; %s", ex.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// AccountExport loads the account export job of the given identifier; nil is returned if not found.
+func (db *MongoDbBridge) AccountExport(id string) (*types.AccountExport, error) {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	sr := col.FindOne(context.Background(), bson.D{{Key: "_id", Value: id}})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load account export %s; %s", id, sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var ex types.AccountExport
+	if err := sr.Decode(&ex); err != nil {
+		db.log.Errorf("can not decode account export %s; %s", id, err.Error())
+		return nil, err
+	}
+	return &ex, nil
+}
+
+// AccountExportsUnfinished provides the number of unfinished account exports of the given owner.
+func (db *MongoDbBridge) AccountExportsUnfinished(owner string) (int64, error) {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	cnt, err := col.CountDocuments(context.Background(), bson.D{
+		{Key: types.FiAccountExportOwner, Value: owner},
+		{Key: types.FiAccountExportStatus, Value: bson.D{{Key: "$in", Value: bson.A{types.AccountExportPending, types.AccountExportRunning}}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not count account exports of %s; %s", owner, err.Error())
+		return 0, err
+	}
+	return cnt, nil
+}
+
+// ClaimAccountExport atomically leases the unfinished account export of the given identifier
+// to the calling worker until the given time. The export can be claimed if it's pending,
+// or the lease of the previous worker expired. False is returned if the export can not be claimed.
+func (db *MongoDbBridge) ClaimAccountExport(id string, lease time.Time) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	res, err := col.UpdateOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: types.FiAccountExportStatus, Value: types.AccountExportPending}},
+			bson.D{
+				{Key: types.FiAccountExportStatus, Value: types.AccountExportRunning},
+				{Key: types.FiAccountExportLease, Value: bson.D{{Key: "$lte", Value: time.Now().UTC()}}},
+			},
+		}},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: types.FiAccountExportStatus, Value: types.AccountExportRunning},
+		{Key: types.FiAccountExportLease, Value: lease},
+	}}})
+	if err != nil {
+		db.log.Errorf("can not claim account export %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+// RenewAccountExport extends the lease of the running account export of the given identifier.
+func (db *MongoDbBridge) RenewAccountExport(id string, lease time.Time) error {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{Key: "_id", Value: id}, {Key: types.FiAccountExportStatus, Value: types.AccountExportRunning}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: types.FiAccountExportLease, Value: lease}}}})
+	if err != nil {
+		db.log.Errorf("can not renew account export %s; %s", id, err.Error())
+	}
+	return err
+}
+
+// FinishAccountExport stores the final state of the account export.
+func (db *MongoDbBridge) FinishAccountExport(ex *types.AccountExport) error {
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	if _, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: ex.ID}}, ex); err != nil {
+		db.log.Errorf("can not store account export %s; %s", ex.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// AccountExportsStale provides identifiers of unfinished account exports no worker holds a valid lease of.
+func (db *MongoDbBridge) AccountExportsStale(limit int64) ([]string, error) {
+	return db.accountExportIds(bson.D{
+		{Key: types.FiAccountExportStatus, Value: bson.D{{Key: "$in", Value: bson.A{types.AccountExportPending, types.AccountExportRunning}}}},
+		{Key: types.FiAccountExportLease, Value: bson.D{{Key: "$lte", Value: time.Now().UTC()}}},
+	}, limit)
+}
+
+// AccountExportsLive provides the set of the given identifiers of account exports still kept.
+func (db *MongoDbBridge) AccountExportsLive(ids []string) (map[string]bool, error) {
+	list, err := db.accountExportIds(bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+		{Key: types.FiAccountExportPurge, Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}, int64(len(ids)))
+	if err != nil {
+		return nil, err
+	}
+
+	live := make(map[string]bool, len(list))
+	for _, id := range list {
+		live[id] = true
+	}
+	return live, nil
+}
+
+// accountExportIds loads identifiers of account exports matching the given filter.
+func (db *MongoDbBridge) accountExportIds(filter bson.D, limit int64) ([]string, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coAccountExports)
+
+	ld, err := col.Find(ctx, filter, options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit))
+	if err != nil {
+		db.log.Errorf("can not load account exports; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account exports cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]string, 0)
+	for ld.Next(ctx) {
+		var row struct {
+			ID string `bson:"_id"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account export; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.ID)
+	}
+	return list, ld.Err()
+}
+
+// AccountTransactionsEach calls the given function for each transaction of the account
+// from the oldest to the newest one. The iteration stops on the first error of the function.
+func (db *MongoDbBridge) AccountTransactionsEach(addr *common.Address, fn func(*types.Transaction) error) error {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	filter := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: fiTransactionSender, Value: addr.String()}},
+		bson.D{{Key: fiTransactionRecipient, Value: addr.String()}},
+	}}}
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: fiTransactionOrdinalIndex, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load transactions of %s; %s", addr.String(), err.Error())
+		return err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account transactions cursor; %s", err.Error())
+		}
+	}()
+
+	for ld.Next(ctx) {
+		var trx types.Transaction
+		if err := ld.Decode(&trx); err != nil {
+			db.log.Errorf("can not decode transaction of %s; %s", addr.String(), err.Error())
+			return err
+		}
+		if err := fn(&trx); err != nil {
+			return err
+		}
+	}
+	return ld.Err()
+}
@@ -0,0 +1,122 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// bucketAccountExports is the name of the GridFS bucket storing files of account exports;
+// all the servers sharing the database can serve the files regardless of the server building them.
+const bucketAccountExports = "account_export_files"
+
+// accountExportBucket provides the GridFS bucket of account export files.
+func (db *MongoDbBridge) accountExportBucket() (*gridfs.Bucket, error) {
+	b, err := gridfs.NewBucket(db.client.Database(db.dbName), options.GridFSBucket().SetName(bucketAccountExports))
+	if err != nil {
+		db.log.Errorf("can not open account exports bucket; %s", err.Error())
+		return nil, err
+	}
+	return b, nil
+}
+
+// WriteAccountExportFile stores the file of the account export of the given identifier
+// written by the given function. The file becomes available only if the function succeeds;
+// a previous file of the export, e.g. left behind by a crashed worker, is replaced.
+func (db *MongoDbBridge) WriteAccountExportFile(id string, write func(io.Writer) error) error {
+	b, err := db.accountExportBucket()
+	if err != nil {
+		return err
+	}
+
+	// remove leftovers of a previous attempt
+	if err := b.Delete(id); err != nil && err != gridfs.ErrFileNotFound {
+		db.log.Errorf("can not remove previous file of account export %s; %s", id, err.Error())
+		return err
+	}
+
+	us, err := b.OpenUploadStreamWithID(id, fmt.Sprintf("account-export-%s.csv", id))
+	if err != nil {
+		db.log.Errorf("can not open file of account export %s; %s", id, err.Error())
+		return err
+	}
+
+	if err := write(us); err != nil {
+		if aErr := us.Abort(); aErr != nil {
+			db.log.Errorf("can not abort file of account export %s; %s", id, aErr.Error())
+		}
+		return err
+	}
+	return us.Close()
+}
+
+// OpenAccountExportFile opens the stored file of the account export of the given identifier
+// for reading; nil is returned if there is no such file.
+func (db *MongoDbBridge) OpenAccountExportFile(id string) (*types.AccountExportFile, error) {
+	b, err := db.accountExportBucket()
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := b.OpenDownloadStream(id)
+	if err != nil {
+		if err == gridfs.ErrFileNotFound {
+			return nil, nil
+		}
+		db.log.Errorf("can not open file of account export %s; %s", id, err.Error())
+		return nil, err
+	}
+	return &types.AccountExportFile{ReadCloser: ds, Length: ds.GetFile().Length, Modified: ds.GetFile().UploadDate}, nil
+}
+
+// DeleteAccountExportFile removes the stored file of the account export of the given identifier, if any.
+func (db *MongoDbBridge) DeleteAccountExportFile(id string) error {
+	b, err := db.accountExportBucket()
+	if err != nil {
+		return err
+	}
+
+	if err := b.Delete(id); err != nil && err != gridfs.ErrFileNotFound {
+		db.log.Errorf("can not remove file of account export %s; %s", id, err.Error())
+		return err
+	}
+	return nil
+}
+
+// AccountExportFileIds provides identifiers of account exports with a stored file.
+func (db *MongoDbBridge) AccountExportFileIds() ([]string, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(bucketAccountExports + ".files")
+
+	ld, err := col.Find(ctx, bson.D{}, options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load account export files; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account export files cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]string, 0)
+	for ld.Next(ctx) {
+		var row struct {
+			ID string `bson:"_id"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account export file; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, row.ID)
+	}
+	return list, ld.Err()
+}
@@ -0,0 +1,113 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coAddressGroups is the name of the off-chain database collection storing address groups.
+const coAddressGroups = "address_groups"
+
+// StoreAddressGroup stores, or updates, the given address group.
+func (db *MongoDbBridge) StoreAddressGroup(ag *types.AddressGroup) error {
+	col := db.client.Database(db.dbName).Collection(coAddressGroups)
+
+	// replace the previous state of the group, if any
+	_, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: ag.ID}}, ag, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store address group %s; %s", ag.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// RemoveAddressGroup removes the address group of the given owner.
+// It returns false if no such group was found.
+func (db *MongoDbBridge) RemoveAddressGroup(id string, owner string) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coAddressGroups)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: types.FiAddressGroupOwner, Value: owner},
+	})
+	if err != nil {
+		db.log.Errorf("can not remove address group %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// AddressGroup loads the address group of the given owner; nil is returned if not found.
+func (db *MongoDbBridge) AddressGroup(id string, owner string) (*types.AddressGroup, error) {
+	col := db.client.Database(db.dbName).Collection(coAddressGroups)
+
+	sr := col.FindOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: types.FiAddressGroupOwner, Value: owner},
+	})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load address group %s; %s", id, sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var row types.AddressGroup
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode address group %s; %s", id, err.Error())
+		return nil, err
+	}
+	return &row, nil
+}
+
+// AddressGroups loads address groups of the given owner.
+func (db *MongoDbBridge) AddressGroups(owner string) ([]*types.AddressGroup, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coAddressGroups)
+
+	ld, err := col.Find(ctx, bson.D{{Key: types.FiAddressGroupOwner, Value: owner}}, options.Find().SetSort(bson.D{{Key: types.FiAddressGroupName, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load address groups; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing address groups cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.AddressGroup, 0)
+	for ld.Next(ctx) {
+		var row types.AddressGroup
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode address group; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// AddressGroupTransactions loads list of transactions sent, or received, by any address of the group.
+func (db *MongoDbBridge) AddressGroupTransactions(addrs []string, cursor *string, count int32) (*types.TransactionList, error) {
+	list := make(bson.A, len(addrs))
+	for i, adr := range addrs {
+		list[i] = adr
+	}
+
+	// make the filter for [(from in Group) OR (to in Group)]
+	filter := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "from", Value: bson.D{{Key: "$in", Value: list}}}},
+		bson.D{{Key: "to", Value: bson.D{{Key: "$in", Value: list}}}},
+	}}}
+	return db.Transactions(cursor, count, &filter)
+}
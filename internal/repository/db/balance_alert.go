@@ -0,0 +1,79 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coBalanceAlerts is the name of the off-chain database collection storing balance alerts.
+const coBalanceAlerts = "balance_alerts"
+
+// StoreBalanceAlert stores, or updates, the given balance alert.
+func (db *MongoDbBridge) StoreBalanceAlert(ba *types.BalanceAlert) error {
+	col := db.client.Database(db.dbName).Collection(coBalanceAlerts)
+
+	// replace the previous state of the alert, if any
+	_, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: ba.ID}}, ba, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store balance alert %s; %s", ba.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// RemoveBalanceAlert removes the balance alert of the given owner.
+// It returns false if no such alert was found.
+func (db *MongoDbBridge) RemoveBalanceAlert(id string, owner string) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coBalanceAlerts)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: types.FiBalanceAlertOwner, Value: owner},
+	})
+	if err != nil {
+		db.log.Errorf("can not remove balance alert %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// BalanceAlerts loads balance alerts, optionally only those of the given owner.
+func (db *MongoDbBridge) BalanceAlerts(owner *string) ([]*types.BalanceAlert, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coBalanceAlerts)
+
+	// filter by owner if requested
+	filter := bson.D{}
+	if owner != nil {
+		filter = append(filter, bson.E{Key: types.FiBalanceAlertOwner, Value: *owner})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: types.FiBalanceAlertAddress, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load balance alerts; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing balance alerts cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.BalanceAlert, 0)
+	for ld.Next(ctx) {
+		var row types.BalanceAlert
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode balance alert; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -33,6 +33,7 @@ type MongoDbBridge struct {
 	initFMintTrx     *sync.Once
 	initEpochs       *sync.Once
 	initGasPrice     *sync.Once
+	initEpochVals    *sync.Once
 }
 
 // docListCountAggregationTimeout represents a max duration of DB query executed to calculate
@@ -67,6 +68,12 @@ func New(cfg *config.Config, log logger.Logger) (*MongoDbBridge, error) {
 
 	// check the state
 	db.CheckDatabaseInitState()
+
+	// apply pending migrations
+	if err := db.Migrate(); err != nil {
+		log.Criticalf("can not migrate the database; %s", err.Error())
+		return nil, err
+	}
 	return db, nil
 }
 
@@ -90,6 +97,18 @@ func connectDb(cfg *config.Database) (*mongo.Client, error) {
 	return client, nil
 }
 
+// Ping checks the database server is available and provides the round trip time of the check.
+func (db *MongoDbBridge) Ping() (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	if err := db.client.Ping(ctx, nil); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
 // Close will terminate or finish all operations and close the connection to Mongo database.
 func (db *MongoDbBridge) Close() {
 	// do we have a client?
@@ -176,6 +195,7 @@ func (db *MongoDbBridge) CheckDatabaseInitState() {
 	db.collectionNeedInit("fmint transactions", db.FMintTransactionCount, &db.initFMintTrx)
 	db.collectionNeedInit("epochs", db.EpochsCount, &db.initEpochs)
 	db.collectionNeedInit("gas price periods", db.GasPricePeriodCount, &db.initGasPrice)
+	db.collectionNeedInit("epoch validators", db.EpochValidatorsCount, &db.initEpochVals)
 }
 
 // checkAccountCollectionState checks the Accounts collection state.
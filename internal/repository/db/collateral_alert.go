@@ -0,0 +1,79 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coCollateralAlerts is the name of the off-chain database collection storing collateral ratio alerts.
+const coCollateralAlerts = "collateral_alerts"
+
+// StoreCollateralAlert stores, or updates, the given collateral ratio alert.
+func (db *MongoDbBridge) StoreCollateralAlert(ca *types.CollateralAlert) error {
+	col := db.client.Database(db.dbName).Collection(coCollateralAlerts)
+
+	// replace the previous state of the alert, if any
+	_, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: ca.ID}}, ca, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store collateral alert %s; %s", ca.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// RemoveCollateralAlert removes the collateral ratio alert of the given owner.
+// It returns false if no such alert was found.
+func (db *MongoDbBridge) RemoveCollateralAlert(id string, owner string) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coCollateralAlerts)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: types.FiCollateralAlertOwner, Value: owner},
+	})
+	if err != nil {
+		db.log.Errorf("can not remove collateral alert %s; %s", id, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// CollateralAlerts loads collateral ratio alerts, optionally only those of the given owner.
+func (db *MongoDbBridge) CollateralAlerts(owner *string) ([]*types.CollateralAlert, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coCollateralAlerts)
+
+	// filter by owner if requested
+	filter := bson.D{}
+	if owner != nil {
+		filter = append(filter, bson.E{Key: types.FiCollateralAlertOwner, Value: *owner})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: types.FiCollateralAlertAddress, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load collateral alerts; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing collateral alerts cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.CollateralAlert, 0)
+	for ld.Next(ctx) {
+		var row types.CollateralAlert
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode collateral alert; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
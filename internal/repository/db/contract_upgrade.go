@@ -0,0 +1,64 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// colContractUpgrades represents the name of the proxy contract upgrades collection in database.
+const colContractUpgrades = "contract_upgrades"
+
+// AddContractUpgrade stores a proxy contract upgrade record in the persistent storage.
+// Re-processed events replace the existing record, so the upgrade is never duplicated.
+func (db *MongoDbBridge) AddContractUpgrade(cu *types.ContractUpgrade) error {
+	col := db.client.Database(db.dbName).Collection(colContractUpgrades)
+	if _, err := col.ReplaceOne(
+		context.Background(),
+		bson.D{{Key: "_id", Value: cu.Pk()}},
+		cu,
+		options.Replace().SetUpsert(true),
+	); err != nil {
+		db.log.Errorf("can not store upgrade of %s; %s", cu.Proxy.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// ContractUpgrades loads the most recent upgrades of the given proxy contract, the newest first.
+func (db *MongoDbBridge) ContractUpgrades(proxy *common.Address, count int64) ([]*types.ContractUpgrade, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colContractUpgrades)
+
+	ld, err := col.Find(ctx,
+		bson.D{{Key: types.FiContractUpgradeProxy, Value: proxy.String()}},
+		options.Find().SetSort(bson.D{{Key: types.FiContractUpgradeOrdinal, Value: -1}}).SetLimit(count),
+	)
+	if err != nil {
+		db.log.Errorf("can not load upgrades of %s; %s", proxy.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing contract upgrades cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.ContractUpgrade, 0)
+	for ld.Next(ctx) {
+		var row types.ContractUpgrade
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode contract upgrade; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
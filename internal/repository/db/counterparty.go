@@ -0,0 +1,73 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Counterparties aggregates transactions of the given address since the given time
+// by the other side of the transaction and provides the top counterparties
+// sorted by the number of transactions.
+func (db *MongoDbBridge) Counterparties(adr *common.Address, since *time.Time, count int32) ([]*types.CounterpartyAggregate, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	// match all the transactions of the address
+	addr := adr.String()
+	filter := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: fiTransactionSender, Value: addr}},
+		bson.D{{Key: fiTransactionRecipient, Value: addr}},
+	}}}
+	if since != nil {
+		filter = append(filter, bson.E{Key: fiTransactionTimeStamp, Value: bson.D{{Key: "$gte", Value: *since}}})
+	}
+
+	// is the address the sender of the transaction?
+	isSender := bson.D{{Key: "$eq", Value: bson.A{"$from", addr}}}
+
+	// aggregate the counterparties; the amount is stored with reduced precision
+	// so the volume needs to be corrected by types.TransactionDecimalsCorrection
+	cr, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$cond", Value: bson.A{isSender, "$to", "$from"}}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "sent", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{isSender, 1, 0}}}}}},
+			{Key: "received", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{isSender, 0, 1}}}}}},
+			{Key: "sent_amo", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{isSender, "$amo", 0}}}}}},
+			{Key: "received_amo", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{isSender, 0, "$amo"}}}}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: int64(count)}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate counterparties of %s; %s", addr, err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing counterparties cursor; %s", err.Error())
+		}
+	}()
+
+	// load the rows
+	list := make([]*types.CounterpartyAggregate, 0)
+	for cr.Next(ctx) {
+		var row types.CounterpartyAggregate
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode counterparty row; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
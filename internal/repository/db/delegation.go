@@ -85,13 +85,17 @@ func (db *MongoDbBridge) AddDelegation(dl *types.Delegation) error {
 	// get the collection for delegations
 	col := db.client.Database(db.dbName).Collection(colDelegations)
 
-	// if the delegation already exists, update it with the new data
-	if db.isDelegationKnown(col, dl) {
+	// if the delegation already exists, update it with the new data;
+	// creation details of a delegation with known origin are kept
+	if known, orig := db.isDelegationKnown(col, dl); known {
+		if orig {
+			return db.UpdateDelegationBalance(&dl.Address, dl.ToStakerId, dl.AmountDelegated)
+		}
 		return db.UpdateDelegation(dl)
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), dl); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), dl); err != nil {
 		db.log.Criticalf("can not add delegation %s to %d; %s", dl.Address.String(), dl.ToStakerId.ToInt().Uint64(), err.Error())
 		return err
 	}
@@ -117,7 +121,7 @@ func (db *MongoDbBridge) UpdateDelegation(dl *types.Delegation) error {
 
 	// try to update a delegation by replacing it in the database
 	// we use address and validator ID to identify unique delegation
-	er, err := col.UpdateOne(context.Background(), bson.D{
+	er, err := db.live(col).UpdateOne(context.Background(), bson.D{
 		{Key: types.FiDelegationAddress, Value: dl.Address.String()},
 		{Key: types.FiDelegationToValidator, Value: dl.ToStakerId.String()},
 	}, bson.D{{Key: "$set", Value: bson.D{
@@ -155,7 +159,7 @@ func (db *MongoDbBridge) UpdateDelegationBalance(addr *common.Address, valID *he
 	db.log.Debugf("%s delegation to #%d value changed to %d", addr.String(), valID.ToInt().Uint64(), val)
 
 	// update the transaction details
-	ur, err := col.UpdateOne(context.Background(),
+	ur, err := db.live(col).UpdateOne(context.Background(),
 		bson.D{
 			{Key: types.FiDelegationAddress, Value: addr.String()},
 			{Key: types.FiDelegationToValidator, Value: valID.String()},
@@ -178,28 +182,118 @@ func (db *MongoDbBridge) UpdateDelegationBalance(addr *common.Address, valID *he
 	return nil
 }
 
-// isDelegationKnown checks if the given delegation exists in the database.
-func (db *MongoDbBridge) isDelegationKnown(col *mongo.Collection, dl *types.Delegation) bool {
+// UpdateDelegationLock updates the lock end time of the given delegation in database.
+func (db *MongoDbBridge) UpdateDelegationLock(addr *common.Address, valID *hexutil.Big, until time.Time) error {
+	col := db.client.Database(db.dbName).Collection(colDelegations)
+
+	ur, err := db.live(col).UpdateOne(context.Background(),
+		bson.D{
+			{Key: types.FiDelegationAddress, Value: addr.String()},
+			{Key: types.FiDelegationToValidator, Value: valID.String()},
+		},
+		bson.D{{Key: "$set", Value: bson.D{{Key: types.FiDelegationLockedUntil, Value: until}}}})
+	if err != nil {
+		db.log.Criticalf("delegation lock can not be updated; %s", err.Error())
+		return err
+	}
+
+	if ur.MatchedCount == 0 {
+		db.log.Errorf("delegation %s to %d not found", addr.String(), valID.ToInt().Uint64())
+		return ErrUnknownDelegation
+	}
+	return nil
+}
+
+// UpdateDelegationOrigin updates the creation time and transaction of the given delegation
+// to the original delegation event and marks the origin as known.
+func (db *MongoDbBridge) UpdateDelegationOrigin(dl *types.Delegation) error {
+	col := db.client.Database(db.dbName).Collection(colDelegations)
+
+	ur, err := db.live(col).UpdateOne(context.Background(),
+		bson.D{
+			{Key: types.FiDelegationAddress, Value: dl.Address.String()},
+			{Key: types.FiDelegationToValidator, Value: dl.ToStakerId.String()},
+		},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: types.FiDelegationOrdinal, Value: dl.OrdinalIndex()},
+			{Key: types.FiDelegationTransaction, Value: dl.Transaction.String()},
+			{Key: types.FiDelegationCreated, Value: uint64(dl.CreatedTime)},
+			{Key: types.FiDelegationStamp, Value: time.Unix(int64(dl.CreatedTime), 0)},
+			{Key: types.FiDelegationOrigin, Value: true},
+		}}})
+	if err != nil {
+		db.log.Criticalf("delegation origin can not be updated; %s", err.Error())
+		return err
+	}
+
+	if ur.MatchedCount == 0 {
+		db.log.Errorf("delegation %s to %d not found", dl.Address.String(), dl.ToStakerId.ToInt().Uint64())
+		return ErrUnknownDelegation
+	}
+	return nil
+}
+
+// DelegationsWithoutOrigin pulls a batch of delegations with the original delegation event not verified yet.
+func (db *MongoDbBridge) DelegationsWithoutOrigin(count int64) ([]*types.Delegation, error) {
+	col := db.client.Database(db.dbName).Collection(colDelegations)
+	list := make([]*types.Delegation, 0, count)
+	ctx := context.Background()
+
+	ld, err := col.Find(ctx, bson.D{{Key: types.FiDelegationOrigin, Value: bson.D{{Key: "$ne", Value: true}}}},
+		options.Find().SetSort(bson.D{{Key: types.FiDelegationOrdinal, Value: 1}}).SetLimit(count))
+	if err != nil {
+		db.log.Errorf("error loading delegations without origin; %s", err.Error())
+		return nil, err
+	}
+
+	defer func() {
+		if err = ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing delegations without origin cursor; %s", err.Error())
+		}
+	}()
+
+	for ld.Next(ctx) {
+		var row types.Delegation
+		if err = ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode delegation without origin; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// isDelegationKnown checks if the given delegation exists in the database
+// and if the original delegation event of the existing delegation is known.
+func (db *MongoDbBridge) isDelegationKnown(col *mongo.Collection, dl *types.Delegation) (bool, bool) {
 	// try to find the delegation in the database
 	sr := col.FindOne(context.Background(), bson.D{
 		{Key: types.FiDelegationAddress, Value: dl.Address.String()},
 		{Key: types.FiDelegationToValidator, Value: dl.ToStakerId.String()},
 	}, options.FindOne().SetProjection(bson.D{
 		{Key: types.FiDelegationPk, Value: true},
+		{Key: types.FiDelegationOrigin, Value: true},
 	}))
 
 	// error on lookup?
 	if sr.Err() != nil {
 		// may be ErrNoDocuments, which we seek
 		if sr.Err() == mongo.ErrNoDocuments {
-			return false
+			return false, false
 		}
 
 		// inform that we can not get the PK; should not happen
 		db.log.Errorf("can not get existing delegation pk; %s", sr.Err().Error())
-		return false
+		return false, false
 	}
-	return true
+
+	var row struct {
+		Origin bool `bson:"orig"`
+	}
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode existing delegation; %s", err.Error())
+	}
+	return true, row.Origin
 }
 
 // DelegationsCountFiltered calculates total number of delegations in the database for the given filter.
@@ -354,13 +448,13 @@ func (db *MongoDbBridge) dlgListOptions(count int32) *options.FindOptions {
 	return opt
 }
 
-// dlgListLoad load the initialized list of delegations from database.
-func (db *MongoDbBridge) dlgListLoad(col *mongo.Collection, cursor *string, count int32, list *types.DelegationList) (err error) {
+// dlgListLoad load the initialized list of delegations from database using the given filter and options.
+func (db *MongoDbBridge) dlgListLoad(col *mongo.Collection, cursor *string, count int32, list *types.DelegationList, filter *bson.D, opt *options.FindOptions) (err error) {
 	// get the context for loader
 	ctx := context.Background()
 
 	// load the data
-	ld, err := col.Find(ctx, db.dlgListFilter(cursor, count, list), db.dlgListOptions(count))
+	ld, err := col.Find(ctx, filter, opt)
 	if err != nil {
 		db.log.Errorf("error loading delegations list; %s", err.Error())
 		return err
@@ -423,25 +517,124 @@ func (db *MongoDbBridge) Delegations(cursor *string, count int32, filter *bson.D
 
 	// load data if there are any
 	if list.Total > 0 {
-		err = db.dlgListLoad(col, cursor, count, list)
+		err = db.dlgListLoad(col, cursor, count, list, db.dlgListFilter(cursor, count, list), db.dlgListOptions(count))
 		if err != nil {
 			db.log.Errorf("can not load delegation list from database; %s", err.Error())
 			return nil, err
 		}
+		db.dlgListFinish(count, list)
+	}
 
-		// reverse on negative so new-er delegations will be on top
-		if count < 0 {
-			list.Reverse()
-			count = -count
-		}
+	return list, nil
+}
 
-		// cut the end?
-		if len(list.Collection) > int(count) {
-			list.Collection = list.Collection[:len(list.Collection)-1]
-		}
+// dlgListFinish puts the loaded delegations into the requested order and cuts the extra one.
+func (db *MongoDbBridge) dlgListFinish(count int32, list *types.DelegationList) {
+	// reverse on negative so new-er delegations will be on top
+	if count < 0 {
+		list.Reverse()
+		count = -count
 	}
 
-	return list, nil
+	// cut the end?
+	if len(list.Collection) > int(count) {
+		list.Collection = list.Collection[:len(list.Collection)-1]
+	}
+}
+
+// DelegationsByValue pulls list of delegations ordered by the active amount, from high to low,
+// starting at the specified cursor. Delegations with the same amount are ordered by creation.
+func (db *MongoDbBridge) DelegationsByValue(cursor *string, count int32, filter *bson.D) (*types.DelegationList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero delegations requested")
+	}
+
+	// make sure some filter is used
+	if nil == filter {
+		filter = &bson.D{}
+	}
+
+	// get the collection and count the delegations
+	col := db.client.Database(db.dbName).Collection(colDelegations)
+	total, err := col.CountDocuments(context.Background(), *filter)
+	if err != nil {
+		db.log.Errorf("can not count delegations; %s", err.Error())
+		return nil, err
+	}
+
+	list := types.DelegationList{
+		Collection: make([]*types.Delegation, 0),
+		Total:      uint64(total),
+		IsStart:    total == 0,
+		IsEnd:      total == 0,
+		Filter:     *filter,
+	}
+	if total == 0 {
+		return &list, nil
+	}
+
+	// get the filter of the slice behind the cursor
+	kf, err := db.dlgValueListFilter(col, cursor, count, &list)
+	if err != nil {
+		db.log.Errorf("can not find the initial delegation; %s", err.Error())
+		return nil, err
+	}
+
+	// sort from high to low by default; reversed if loading from bottom
+	sd, limit := -1, int64(count)
+	if count < 0 {
+		sd, limit = 1, int64(-count)
+	}
+	opt := options.Find().
+		SetSort(bson.D{{Key: types.FiDelegationValue, Value: sd}, {Key: types.FiDelegationOrdinal, Value: sd}}).
+		SetLimit(limit + 1)
+
+	if err := db.dlgListLoad(col, cursor, count, &list, kf, opt); err != nil {
+		db.log.Errorf("can not load delegation list by value; %s", err.Error())
+		return nil, err
+	}
+	db.dlgListFinish(count, &list)
+	return &list, nil
+}
+
+// dlgValueListFilter creates a filter for the delegations list ordered by value;
+// the value and the ordinal index of the cursor delegation set the key of the slice.
+func (db *MongoDbBridge) dlgValueListFilter(col *mongo.Collection, cursor *string, count int32, list *types.DelegationList) (*bson.D, error) {
+	filter := append(bson.D{}, list.Filter...)
+	if cursor == nil {
+		return &filter, nil
+	}
+
+	// decode the cursor
+	id, err := primitive.ObjectIDFromHex(*cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// find the key of the cursor delegation
+	var row struct {
+		Value uint64 `bson:"val"`
+		Orx   uint64 `bson:"orx"`
+	}
+	sr := col.FindOne(context.Background(),
+		append(append(bson.D{}, list.Filter...), bson.E{Key: types.FiDelegationPk, Value: id}),
+		options.FindOne().SetProjection(bson.D{{Key: types.FiDelegationValue, Value: true}, {Key: types.FiDelegationOrdinal, Value: true}}))
+	if err := sr.Decode(&row); err != nil {
+		return nil, err
+	}
+	list.First = row.Orx
+
+	// below the cursor on positive count, above it on negative
+	op := "$lt"
+	if count < 0 {
+		op = "$gt"
+	}
+	filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: types.FiDelegationValue, Value: bson.D{{Key: op, Value: row.Value}}}},
+		bson.D{{Key: types.FiDelegationValue, Value: row.Value}, {Key: types.FiDelegationOrdinal, Value: bson.D{{Key: op, Value: row.Orx}}}},
+	}})
+	return &filter, nil
 }
 
 // DelegationsAll pulls list of delegations for the given filter un-paged.
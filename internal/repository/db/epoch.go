@@ -59,7 +59,7 @@ func (db *MongoDbBridge) AddEpoch(e *types.Epoch) error {
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), e); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), e); err != nil {
 		db.log.Critical(err)
 		return err
 	}
@@ -0,0 +1,99 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// colEpochValidators represents the name of the epoch validator sets collection in database.
+const colEpochValidators = "epoch_validators"
+
+// initEpochValidatorsCollection initializes the epoch validators collection with
+// indexes and additional parameters needed by the app.
+func (db *MongoDbBridge) initEpochValidatorsCollection(col *mongo.Collection) {
+	// prepare index models
+	ix := make([]mongo.IndexModel, 0)
+
+	// index the epoch and validator so we can load the set of an epoch fast
+	ix = append(ix, mongo.IndexModel{Keys: bson.D{
+		{Key: types.FiEpochValidatorEpoch, Value: 1},
+		{Key: types.FiEpochValidatorID, Value: 1},
+	}})
+	ix = append(ix, mongo.IndexModel{Keys: bson.D{{Key: types.FiEpochValidatorID, Value: 1}}})
+
+	// create indexes
+	if _, err := col.Indexes().CreateMany(context.Background(), ix); err != nil {
+		db.log.Panicf("can not create indexes for epoch validators collection; %s", err.Error())
+	}
+	db.log.Debugf("epoch validators collection initialized")
+}
+
+// StoreEpochValidators stores the validator set of an epoch in the persistent storage.
+func (db *MongoDbBridge) StoreEpochValidators(list []*types.EpochValidator) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(colEpochValidators)
+
+	// upsert the records so repeated snapshots don't collide
+	for _, ev := range list {
+		if _, err := col.ReplaceOne(
+			context.Background(),
+			bson.D{{Key: "_id", Value: ev.Pk()}},
+			ev,
+			options.Replace().SetUpsert(true),
+		); err != nil {
+			db.log.Errorf("can not store validator #%d of epoch #%d; %s", ev.ValidatorId.ToInt().Uint64(), uint64(ev.Epoch), err.Error())
+			return err
+		}
+	}
+
+	// make sure the collection is initialized
+	if db.initEpochVals != nil {
+		db.initEpochVals.Do(func() { db.initEpochValidatorsCollection(col); db.initEpochVals = nil })
+	}
+	return nil
+}
+
+// EpochValidators loads the stored validator set of the given epoch.
+func (db *MongoDbBridge) EpochValidators(epoch hexutil.Uint64) ([]*types.EpochValidator, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colEpochValidators)
+
+	// load the set
+	ld, err := col.Find(ctx,
+		bson.D{{Key: types.FiEpochValidatorEpoch, Value: int64(epoch)}},
+		options.Find().SetSort(bson.D{{Key: types.FiEpochValidatorID, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load validators of epoch #%d; %s", uint64(epoch), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing epoch validators cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.EpochValidator, 0)
+	for ld.Next(ctx) {
+		var row types.EpochValidator
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode epoch validator; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// EpochValidatorsCount calculates total number of epoch validator records in the database.
+func (db *MongoDbBridge) EpochValidatorsCount() (uint64, error) {
+	return db.EstimateCount(db.client.Database(db.dbName).Collection(colEpochValidators))
+}
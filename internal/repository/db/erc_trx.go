@@ -48,7 +48,7 @@ func (db *MongoDbBridge) AddERC20Transaction(trx *types.TokenTransaction) error
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), trx); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), trx); err != nil {
 		db.log.Critical(err)
 		return err
 	}
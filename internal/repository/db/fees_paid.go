@@ -0,0 +1,125 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// feesPaidPeriodFormat maps the report resolution to the date format used to group the fees.
+var feesPaidPeriodFormat = map[string]string{
+	types.FeesPaidResolutionDay:   "2006-01-02",
+	types.FeesPaidResolutionMonth: "2006-01",
+}
+
+// feesPaidRow represents the fee related fields of a transaction loaded for the fees paid aggregation.
+type feesPaidRow struct {
+	To       *string   `bson:"to"`
+	GasUsed  *uint64   `bson:"gas_use"`
+	GasPrice string    `bson:"gas_pri"`
+	GasGWei  int64     `bson:"gwx100"`
+	Stamp    time.Time `bson:"stamp"`
+}
+
+// fee calculates the fee paid by the transaction in full precision; the reduced precision
+// gas price is used only if the full one is not available.
+func (row *feesPaidRow) fee() *big.Int {
+	if row.GasUsed == nil {
+		return new(big.Int)
+	}
+
+	price, err := hexutil.DecodeBig(row.GasPrice)
+	if err != nil {
+		price = new(big.Int).Mul(big.NewInt(row.GasGWei), types.TransactionGasCorrection)
+	}
+	return price.Mul(price, new(big.Int).SetUint64(*row.GasUsed))
+}
+
+// FeesPaid aggregates gas fees paid by the given sender in the given time range
+// grouped by the period of the given resolution and by the transaction recipient.
+// The fees are summed in full precision, the database can not do it on the stored gas price.
+func (db *MongoDbBridge) FeesPaid(adr *common.Address, from *time.Time, to *time.Time, resolution string) ([]*types.FeesPaidAggregate, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	// what is the period format
+	format, ok := feesPaidPeriodFormat[resolution]
+	if !ok {
+		format = feesPaidPeriodFormat[types.FeesPaidResolutionMonth]
+	}
+
+	// load the fee fields of the transactions;
+	// the range end is a day, so the whole last day is included
+	cr, err := col.Find(ctx, bson.D{
+		{Key: fiTransactionSender, Value: adr.String()},
+		{Key: fiTransactionTimeStamp, Value: bson.D{{Key: "$gte", Value: *from}, {Key: "$lt", Value: to.AddDate(0, 0, 1)}}},
+	}, options.Find().SetProjection(bson.D{
+		{Key: fiTransactionRecipient, Value: 1},
+		{Key: fiTransactionGasUsed, Value: 1},
+		{Key: fiTransactionGasPriceExact, Value: 1},
+		{Key: fiTransactionGasPrice, Value: 1},
+		{Key: fiTransactionTimeStamp, Value: 1},
+	}))
+	if err != nil {
+		db.log.Errorf("can not aggregate fees paid by %s; %s", adr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing fees paid cursor; %s", err.Error())
+		}
+	}()
+
+	// sum the fees by the period and the recipient
+	type feesPaidKey struct {
+		period string
+		to     string
+	}
+	groups := make(map[feesPaidKey]*types.FeesPaidAggregate)
+	list := make([]*types.FeesPaidAggregate, 0)
+	for cr.Next(ctx) {
+		var row feesPaidRow
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode fees paid row; %s", err.Error())
+			return nil, err
+		}
+
+		key := feesPaidKey{period: row.Stamp.UTC().Format(format)}
+		if row.To != nil {
+			key.to = *row.To
+		}
+
+		agg, ok := groups[key]
+		if !ok {
+			agg = &types.FeesPaidAggregate{Period: key.period, Recipient: row.To, GasFee: new(big.Int)}
+			groups[key] = agg
+			list = append(list, agg)
+		}
+		agg.GasFee.Add(agg.GasFee, row.fee())
+		agg.Count++
+	}
+	if err := cr.Err(); err != nil {
+		db.log.Errorf("can not load fees paid by %s; %s", adr.String(), err.Error())
+		return nil, err
+	}
+
+	// periods in the time order, the most expensive recipients first
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Period != list[j].Period {
+			return list[i].Period < list[j].Period
+		}
+		return list[i].GasFee.Cmp(list[j].GasFee) > 0
+	})
+	return list, nil
+}
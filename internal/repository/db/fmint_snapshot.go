@@ -0,0 +1,117 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coFMintSnapshots is the name of the off-chain database collection storing fMint position snapshots.
+	coFMintSnapshots = "fmint_snapshots"
+
+	// fMintSnapshotRetention represents the time the fMint position snapshots are kept.
+	fMintSnapshotRetention = 366 * 24 * time.Hour
+)
+
+// fMintSnapshotRow represents a stored fMint position snapshot; the values are hex encoded.
+type fMintSnapshotRow struct {
+	ID         string    `bson:"_id"`
+	Address    string    `bson:"adr"`
+	Epoch      int64     `bson:"epoch"`
+	Stamp      time.Time `bson:"stamp"`
+	Collateral string    `bson:"col"`
+	Debt       string    `bson:"debt"`
+	Ratio4     *int64    `bson:"ratio"`
+	MinRatio4  int64     `bson:"min"`
+}
+
+// AddFMintPositionSnapshots stores the given fMint position snapshots taken in the given slot.
+// Snapshots of the same position and slot replace each other, so API server instances sharing
+// the database do not duplicate them.
+func (db *MongoDbBridge) AddFMintPositionSnapshots(list []*types.FMintPositionSnapshot, slot string) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(list))
+	for i, ps := range list {
+		row := fMintSnapshotRow{
+			ID:         fmt.Sprintf("%s/%s", ps.Address.String(), slot),
+			Address:    ps.Address.String(),
+			Epoch:      int64(ps.Epoch),
+			Stamp:      ps.Stamp,
+			Collateral: ps.CollateralValue.String(),
+			Debt:       ps.DebtValue.String(),
+			Ratio4:     ps.Ratio4,
+			MinRatio4:  ps.MinRatio4,
+		}
+		models[i] = mongo.NewReplaceOneModel().SetFilter(bson.D{{Key: "_id", Value: row.ID}}).SetReplacement(&row).SetUpsert(true)
+	}
+
+	col := db.client.Database(db.dbName).Collection(coFMintSnapshots)
+	if _, err := col.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(false)); err != nil {
+		db.log.Errorf("can not store %d fMint position snapshots; %s", len(list), err.Error())
+		return err
+	}
+	return nil
+}
+
+// FMintPositionHistory loads snapshots of the fMint position of the given address taken since the given time,
+// the oldest first.
+func (db *MongoDbBridge) FMintPositionHistory(addr *common.Address, since time.Time) ([]*types.FMintPositionSnapshot, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coFMintSnapshots)
+
+	ld, err := col.Find(ctx, bson.D{
+		{Key: types.FiFMintSnapshotAddress, Value: addr.String()},
+		{Key: types.FiFMintSnapshotStamp, Value: bson.D{{Key: "$gte", Value: since}}},
+	}, options.Find().SetSort(bson.D{{Key: types.FiFMintSnapshotStamp, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load fMint position history of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing fMint position history cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.FMintPositionSnapshot, 0)
+	for ld.Next(ctx) {
+		var row fMintSnapshotRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode fMint position snapshot; %s", err.Error())
+			return nil, err
+		}
+
+		ps := types.FMintPositionSnapshot{
+			Address:   common.HexToAddress(row.Address),
+			Epoch:     hexutil.Uint64(row.Epoch),
+			Stamp:     row.Stamp,
+			Ratio4:    row.Ratio4,
+			MinRatio4: row.MinRatio4,
+		}
+		if err := ps.CollateralValue.UnmarshalText([]byte(row.Collateral)); err != nil {
+			db.log.Errorf("invalid collateral value of snapshot %s; %s", row.ID, err.Error())
+			continue
+		}
+		if err := ps.DebtValue.UnmarshalText([]byte(row.Debt)); err != nil {
+			db.log.Errorf("invalid debt value of snapshot %s; %s", row.ID, err.Error())
+			continue
+		}
+		list = append(list, &ps)
+	}
+	return list, nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -46,7 +47,7 @@ func (db *MongoDbBridge) AddFMintTransaction(trx *types.FMintTransaction) error
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), trx); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), trx); err != nil {
 		db.log.Critical(err)
 		return err
 	}
@@ -309,3 +310,40 @@ func (db *MongoDbBridge) fMintTrxListLoad(col *mongo.Collection, cursor *string,
 	}
 	return nil
 }
+
+// FMintUserTransactions loads the whole history of fMint transactions of the given user
+// sorted from the oldest to the newest one.
+func (db *MongoDbBridge) FMintUserTransactions(user *common.Address) ([]*types.FMintTransaction, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(colFMintTransactions)
+	ctx := context.Background()
+
+	// load the data
+	ld, err := col.Find(ctx, bson.D{{Key: types.FiFMintTransactionUser, Value: user.String()}}, options.Find().SetSort(bson.D{
+		{Key: types.FiFMintTransactionTimestamp, Value: 1},
+		{Key: types.FiFMintTransactionOrdinal, Value: 1},
+	}))
+	if err != nil {
+		db.log.Errorf("can not load fMint history of %s; %s", user.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing fMint history cursor; %s", err.Error())
+		}
+	}()
+
+	// loop and load the list
+	list := make([]*types.FMintTransaction, 0)
+	for ld.Next(ctx) {
+		var row types.FMintTransaction
+		if err = ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode the fMint history row; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,125 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coGasPriceDays represents the name of the daily gas price distribution collection.
+	coGasPriceDays = "gas_price_days"
+
+	// fiTransactionGasPrice is the name of the field of the transaction gas price in GWei x100.
+	fiTransactionGasPrice = "gwx100"
+)
+
+// GasPriceDailyList loads a range of daily gas price distributions from the database.
+func (db *MongoDbBridge) GasPriceDailyList(from *time.Time, to *time.Time) ([]*types.DailyGasPrice, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coGasPriceDays)
+
+	// pull the data; make sure there is a limit to the range
+	ld, err := col.Find(ctx, trxDailyFlowListFilter(from, to), options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(365))
+	if err != nil {
+		db.log.Errorf("can not load daily gas prices; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing daily gas prices cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.DailyGasPrice, 0)
+	for ld.Next(ctx) {
+		var row types.DailyGasPrice
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode daily gas price; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// GasPriceDailyUpdate calculates the gas price distribution of transactions
+// of the day starting at the given midnight and stores it in the database.
+// The closed flag marks the day final so it's not calculated again.
+func (db *MongoDbBridge) GasPriceDailyUpdate(day time.Time, closed bool) error {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	filter := bson.D{{Key: fiTransactionTimeStamp, Value: bson.D{
+		{Key: "$gte", Value: day},
+		{Key: "$lt", Value: day.Add(24 * time.Hour)},
+	}}}
+
+	count, err := col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		db.log.Errorf("can not count transactions of %s; %s", day.Format("2006-01-02"), err.Error())
+		return err
+	}
+
+	row := types.DailyGasPrice{Day: day.Format("2006-01-02"), Stamp: day, Count: count, Closed: closed}
+	if count > 0 {
+		for _, p := range []struct {
+			val *int64
+			pct int64
+		}{{&row.P25, 25}, {&row.P50, 50}, {&row.P90, 90}} {
+			if *p.val, err = db.gasPricePercentile(col, filter, (count-1)*p.pct/100); err != nil {
+				return err
+			}
+		}
+	}
+
+	// replace the previous state of the day, if any
+	_, err = db.client.Database(db.dbName).Collection(coGasPriceDays).ReplaceOne(context.Background(),
+		bson.D{{Key: "_id", Value: row.Day}}, &row, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store daily gas price of %s; %s", row.Day, err.Error())
+		return err
+	}
+	return nil
+}
+
+// gasPricePercentile finds the gas price of the transaction on the given position
+// of the list of transactions matching the filter ordered by the gas price.
+func (db *MongoDbBridge) gasPricePercentile(col *mongo.Collection, filter bson.D, pos int64) (int64, error) {
+	ctx := context.Background()
+	cr, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$project", Value: bson.D{{Key: fiTransactionGasPrice, Value: 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: fiTransactionGasPrice, Value: 1}}}},
+		{{Key: "$skip", Value: pos}},
+		{{Key: "$limit", Value: 1}},
+	}, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		db.log.Errorf("can not aggregate gas price percentile; %s", err.Error())
+		return 0, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing gas price percentile cursor; %s", err.Error())
+		}
+	}()
+
+	var row struct {
+		Price int64 `bson:"gwx100"`
+	}
+	if cr.Next(ctx) {
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode gas price percentile; %s", err.Error())
+			return 0, err
+		}
+	}
+	return row.Price, nil
+}
@@ -0,0 +1,63 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ContractMethodStats aggregates calls of the contract indexed since the given time
+// by the method selector, the most used method goes first. Plain transfers
+// without a method selector are not included.
+func (db *MongoDbBridge) ContractMethodStats(addr *common.Address, since time.Time) ([]types.ContractMethodStat, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	ld, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: fiTransactionRecipient, Value: addr.String()},
+			{Key: fiTransactionTimeStamp, Value: bson.D{{Key: "$gte", Value: since}}},
+			{Key: fiTransactionSelector, Value: bson.D{{Key: "$exists", Value: true}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + fiTransactionSelector},
+			{Key: "calls", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "failed", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{"$" + fiTransactionStatus, 0}}}, 1, 0,
+			}}}}}},
+			{Key: "gas", Value: bson.D{{Key: "$sum", Value: "$" + fiTransactionGasUsed}}},
+			{Key: "size", Value: bson.D{{Key: "$avg", Value: "$" + fiTransactionInputSize}}},
+			{Key: "max_size", Value: bson.D{{Key: "$max", Value: "$" + fiTransactionInputSize}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "calls", Value: -1}, {Key: "_id", Value: 1}}}},
+	}, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		db.log.Errorf("can not aggregate method stats of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing method stats cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]types.ContractMethodStat, 0)
+	for ld.Next(ctx) {
+		var row types.ContractMethodStat
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode method stats of %s; %s", addr.String(), err.Error())
+			return nil, err
+		}
+		list = append(list, row)
+	}
+	return list, nil
+}
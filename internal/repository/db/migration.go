@@ -0,0 +1,347 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coMigrations is the name of the off-chain database collection storing applied migrations.
+	coMigrations = "migrations"
+
+	// keyConfigMigrationLock is the primary key of the migration lock in the config collection.
+	keyConfigMigrationLock = "migration_lock"
+
+	// migrationLockDuration represents the max duration the migration lock is held without renewal;
+	// a lock of a crashed server expires after this time.
+	migrationLockDuration = 10 * time.Minute
+
+	// migrationLockRenewal represents the period of the migration lock renewal while migrations run.
+	migrationLockRenewal = time.Minute
+
+	// migrationLockRetryDelay represents the delay between attempts to acquire the migration lock.
+	migrationLockRetryDelay = 5 * time.Second
+)
+
+// migration represents a single versioned change of the database structure.
+// Migrations are applied in the order of their versions, each of them exactly once.
+type migration struct {
+	version int
+	name    string
+	apply   func(*MongoDbBridge) error
+}
+
+// migrationRow represents a record of an applied migration.
+type migrationRow struct {
+	Version int       `bson:"_id"`
+	Name    string    `bson:"name"`
+	Applied time.Time `bson:"applied"`
+}
+
+// migrations is the ordered list of known database migrations.
+// New migrations are added to the end of the list with the next version number.
+var migrations = []migration{
+	{version: 1, name: "webhook deliveries indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coWebhookDeliveries, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiWebhookDeliveryUpdated, Value: -1}}},
+			{Keys: bson.D{{Key: types.FiWebhookDeliveryStatus, Value: 1}, {Key: types.FiWebhookDeliveryUpdated, Value: -1}}},
+		})
+	}},
+	{version: 2, name: "collateral alerts indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coCollateralAlerts, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiCollateralAlertOwner, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiCollateralAlertAddress, Value: 1}}},
+		})
+	}},
+	{version: 3, name: "fMint user history index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(colFMintTransactions, []mongo.IndexModel{
+			{Keys: bson.D{
+				{Key: types.FiFMintTransactionUser, Value: 1},
+				{Key: types.FiFMintTransactionTimestamp, Value: 1},
+				{Key: types.FiFMintTransactionOrdinal, Value: 1},
+			}},
+		})
+	}},
+	{version: 4, name: "validator changes indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(colValidatorChanges, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiValidatorChangeID, Value: 1}, {Key: types.FiValidatorChangeOrdinal, Value: -1}}},
+			{Keys: bson.D{
+				{Key: types.FiValidatorChangeID, Value: 1},
+				{Key: types.FiValidatorChangeType, Value: 1},
+				{Key: types.FiValidatorChangeOrdinal, Value: -1},
+			}},
+		})
+	}},
+	{version: 5, name: "sandbox keys indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coSandboxKeys, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiSandboxKeyClient, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiSandboxKeyExpires, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		})
+	}},
+	{version: 6, name: "contract upgrades index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(colContractUpgrades, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiContractUpgradeProxy, Value: 1}, {Key: types.FiContractUpgradeOrdinal, Value: -1}}},
+		})
+	}},
+	{version: 7, name: "stake flows indexes", apply: func(db *MongoDbBridge) error {
+		if err := db.createIndexes(coDelegationEvents, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiDelegationEventStamp, Value: 1}}},
+		}); err != nil {
+			return err
+		}
+		return db.createIndexes(colWithdrawals, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiWithdrawalType, Value: 1}, {Key: types.FiWithdrawalStamp, Value: 1}}},
+		})
+	}},
+	{version: 8, name: "delegations value indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(colDelegations, []mongo.IndexModel{
+			{Keys: bson.D{
+				{Key: types.FiDelegationToValidator, Value: 1},
+				{Key: types.FiDelegationValue, Value: -1},
+				{Key: types.FiDelegationOrdinal, Value: -1},
+			}},
+			{Keys: bson.D{
+				{Key: types.FiDelegationAddress, Value: 1},
+				{Key: types.FiDelegationValue, Value: -1},
+				{Key: types.FiDelegationOrdinal, Value: -1},
+			}},
+		})
+	}},
+	{version: 9, name: "account activity indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(colAccountActivity, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiAccountActivityAddress, Value: 1}, {Key: types.FiAccountActivityStamp, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiAccountActivityStamp, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(accountActivityRetention.Seconds()))},
+		})
+	}},
+	{version: 10, name: "balance alerts indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coBalanceAlerts, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiBalanceAlertOwner, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiBalanceAlertAddress, Value: 1}}},
+		})
+	}},
+	{version: 11, name: "contract calls index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coTransactions, []mongo.IndexModel{
+			{Keys: bson.D{{Key: fiTransactionRecipient, Value: 1}, {Key: fiTransactionTimeStamp, Value: -1}}},
+		})
+	}},
+	{version: 12, name: "address groups index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coAddressGroups, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiAddressGroupOwner, Value: 1}, {Key: types.FiAddressGroupName, Value: 1}}},
+		})
+	}},
+	{version: 13, name: "token mints and burns", apply: func(db *MongoDbBridge) error {
+		return db.classifyTokenSupplyTransactions()
+	}},
+	{version: 14, name: "sfc tokenizer events index", apply: func(db *MongoDbBridge) error {
+		return db.tokenizerEventsIndexes()
+	}},
+	{version: 15, name: "fMint position snapshots indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coFMintSnapshots, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiFMintSnapshotAddress, Value: 1}, {Key: types.FiFMintSnapshotStamp, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiFMintSnapshotStamp, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(fMintSnapshotRetention.Seconds()))},
+		})
+	}},
+	{version: 16, name: "webhook deliveries queue index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coWebhookDeliveries, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiWebhookDeliveryStatus, Value: 1}, {Key: types.FiWebhookDeliveryNextAttempt, Value: 1}}},
+		})
+	}},
+	{version: 17, name: "sandbox key slots index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coSandboxSlots, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "key", Value: 1}}},
+			{Keys: bson.D{{Key: types.FiSandboxKeyExpires, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		})
+	}},
+	{version: 18, name: "transactions gas price index", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coTransactions, []mongo.IndexModel{
+			{Keys: bson.D{{Key: fiTransactionTimeStamp, Value: 1}, {Key: fiTransactionGasPrice, Value: 1}}},
+		})
+	}},
+	{version: 19, name: "account exports indexes", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coAccountExports, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiAccountExportOwner, Value: 1}, {Key: types.FiAccountExportStatus, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiAccountExportStatus, Value: 1}, {Key: types.FiAccountExportLease, Value: 1}}},
+			{Keys: bson.D{{Key: types.FiAccountExportPurge, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		})
+	}},
+	{version: 20, name: "webhook deliveries expiration", apply: func(db *MongoDbBridge) error {
+		return db.createIndexes(coWebhookDeliveries, []mongo.IndexModel{
+			{Keys: bson.D{{Key: types.FiWebhookDeliveryCreated, Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(webhookDeliveryRetention.Seconds()))},
+		})
+	}},
+}
+
+// Migrate applies pending database migrations. The migration lock makes sure
+// only one API server instance sharing the database performs the migrations.
+func (db *MongoDbBridge) Migrate() error {
+	// get the lock
+	owner, err := db.lockMigrations()
+	if err != nil {
+		return err
+	}
+	defer db.unlockMigrations(owner)
+
+	// keep the lock alive while the migrations run
+	stop := make(chan bool)
+	lost := db.renewMigrationLock(owner, stop)
+	defer close(stop)
+
+	// find applied migrations
+	applied, err := db.appliedMigrations()
+	if err != nil {
+		return err
+	}
+
+	// apply the missing ones
+	col := db.client.Database(db.dbName).Collection(coMigrations)
+	for _, mg := range migrations {
+		if applied[mg.version] {
+			continue
+		}
+
+		// do not continue without the lock, another instance may be migrating now
+		select {
+		case <-lost:
+			return fmt.Errorf("database migration lock lost before migration #%d", mg.version)
+		default:
+		}
+
+		db.log.Noticef("applying database migration #%d %s", mg.version, mg.name)
+		if err := mg.apply(db); err != nil {
+			db.log.Criticalf("database migration #%d failed; %s", mg.version, err.Error())
+			return err
+		}
+
+		// record the migration
+		if _, err := col.InsertOne(context.Background(), &migrationRow{
+			Version: mg.version,
+			Name:    mg.name,
+			Applied: time.Now().UTC(),
+		}); err != nil {
+			db.log.Criticalf("can not record database migration #%d; %s", mg.version, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// appliedMigrations loads the set of versions of already applied migrations.
+func (db *MongoDbBridge) appliedMigrations() (map[int]bool, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coMigrations)
+
+	ld, err := col.Find(ctx, bson.D{})
+	if err != nil {
+		db.log.Errorf("can not load applied migrations; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing migrations cursor; %s", err.Error())
+		}
+	}()
+
+	list := make(map[int]bool)
+	for ld.Next(ctx) {
+		var row migrationRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode migration record; %s", err.Error())
+			return nil, err
+		}
+		list[row.Version] = true
+	}
+	return list, nil
+}
+
+// lockMigrations acquires the migration lock waiting for other instances to finish, if needed.
+func (db *MongoDbBridge) lockMigrations() (string, error) {
+	host, _ := os.Hostname()
+	owner := fmt.Sprintf("%s/%d/%d", host, os.Getpid(), time.Now().UnixNano())
+	col := db.client.Database(db.dbName).Collection(coConfiguration)
+
+	// the lock of a running instance is renewed, the lock of a crashed one expires,
+	// so we wait as long as it takes
+	for {
+		now := time.Now().UTC()
+
+		// take the lock if it's not held, or expired
+		_, err := col.UpdateOne(context.Background(),
+			bson.D{{Key: fiConfigPk, Value: keyConfigMigrationLock}, {Key: "until", Value: bson.D{{Key: "$lt", Value: now}}}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "owner", Value: owner}, {Key: "until", Value: now.Add(migrationLockDuration)}}}},
+			options.Update().SetUpsert(true))
+		if err == nil {
+			return owner, nil
+		}
+
+		// the lock is held by someone else if the upsert collides with the existing lock
+		if !mongo.IsDuplicateKeyError(err) {
+			db.log.Errorf("can not acquire migration lock; %s", err.Error())
+			return "", err
+		}
+
+		db.log.Noticef("database migration lock is held by another instance, waiting")
+		time.Sleep(migrationLockRetryDelay)
+	}
+}
+
+// renewMigrationLock extends the migration lock of the given owner periodically until
+// the stop channel is closed. The returned channel is closed if the lock is lost.
+func (db *MongoDbBridge) renewMigrationLock(owner string, stop chan bool) chan bool {
+	lost := make(chan bool)
+	go func() {
+		col := db.client.Database(db.dbName).Collection(coConfiguration)
+		ticker := time.NewTicker(migrationLockRenewal)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			rs, err := col.UpdateOne(context.Background(),
+				bson.D{{Key: fiConfigPk, Value: keyConfigMigrationLock}, {Key: "owner", Value: owner}},
+				bson.D{{Key: "$set", Value: bson.D{{Key: "until", Value: time.Now().UTC().Add(migrationLockDuration)}}}})
+			if err != nil {
+				// the lock is still valid for a while, try again next time
+				db.log.Errorf("can not renew migration lock; %s", err.Error())
+				continue
+			}
+			if rs.MatchedCount == 0 {
+				db.log.Criticalf("database migration lock lost")
+				close(lost)
+				return
+			}
+		}
+	}()
+	return lost
+}
+
+// unlockMigrations releases the migration lock held by the given owner.
+func (db *MongoDbBridge) unlockMigrations(owner string) {
+	col := db.client.Database(db.dbName).Collection(coConfiguration)
+	if _, err := col.DeleteOne(context.Background(), bson.D{{Key: fiConfigPk, Value: keyConfigMigrationLock}, {Key: "owner", Value: owner}}); err != nil {
+		db.log.Errorf("can not release migration lock; %s", err.Error())
+	}
+}
+
+// createIndexes creates the given indexes on the collection; existing indexes are left intact.
+func (db *MongoDbBridge) createIndexes(name string, ix []mongo.IndexModel) error {
+	col := db.client.Database(db.dbName).Collection(name)
+	if _, err := col.Indexes().CreateMany(context.Background(), ix); err != nil {
+		db.log.Errorf("can not create indexes for %s collection; %s", name, err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,664 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coReindexes is the name of the off-chain database collection storing the state of collection rebuilds.
+	coReindexes = "reindexes"
+
+	// reindexShadowSuffix is the suffix of the name of the shadow collection being built.
+	reindexShadowSuffix = "_shadow"
+
+	// reindexBatchSize represents the number of documents processed by a single reindex step.
+	reindexBatchSize = 500
+
+	// reindexLeaseDuration represents the time an instance owns the reindex without renewing it;
+	// the reindex of a crashed server is taken over by another instance after this time.
+	reindexLeaseDuration = 2 * time.Minute
+
+	// reindexChangesWait represents the max time a catch-up step waits for new changes of the live collection.
+	reindexChangesWait = time.Second
+
+	// reindexSwitchSettle represents the min time writes to the live collection are paused before the switch,
+	// so writes accepted right before the pause reach the change stream and are replayed.
+	reindexSwitchSettle = 5 * time.Second
+
+	// reindexPauseTimeout represents the max time a write waits for the switch of the paused live collection;
+	// it outlives the lease, so the switch of a crashed server is finished by another instance in time.
+	reindexPauseTimeout = 2 * reindexLeaseDuration
+
+	// reindexPauseRetry represents the delay between attempts to write to the paused live collection.
+	reindexPauseRetry = 250 * time.Millisecond
+
+	// reindexSwitchRetries represents the max number of failed replays of the final changes in a row
+	// before the switch gives up; the replay is retried with a doubling delay, starting at reindexPauseRetry.
+	reindexSwitchRetries = 5
+
+	// errCodeDuplicateKey represents the Mongo error code of a duplicate key.
+	errCodeDuplicateKey = 11000
+
+	// errCodeDocumentValidation represents the Mongo error code of a document rejected by the collection validator.
+	errCodeDocumentValidation = 121
+
+	// errCodeChangeStreamFatal represents the Mongo error code of a change stream which can not continue.
+	errCodeChangeStreamFatal = 280
+
+	// errCodeChangeStreamHistoryLost represents the Mongo error code of a change stream resume token
+	// no longer available in the oplog.
+	errCodeChangeStreamHistoryLost = 286
+)
+
+// reindexable represents the indexed collections which can be rebuilt in a shadow collection;
+// the documents are copied as they are and the indexes of the live collection are built anew.
+var reindexable = map[string]bool{
+	coTransactions:       true,
+	colErcTransactions:   true,
+	colDelegations:       true,
+	colWithdrawals:       true,
+	colRewards:           true,
+	colEpochs:            true,
+	colFMintTransactions: true,
+	colTokenizerEvents:   true,
+}
+
+// StartReindex starts the rebuild of the given collection in an empty shadow collection
+// with the indexes of the live collection. The API keeps serving from the live collection
+// until the shadow collection catches up and atomically replaces it.
+func (db *MongoDbBridge) StartReindex(name string) (*types.Reindex, error) {
+	if !reindexable[name] {
+		return nil, fmt.Errorf("collection %s can not be reindexed", name)
+	}
+
+	ri, err := db.Reindex(name)
+	if err != nil {
+		return nil, err
+	}
+	if ri != nil && ri.IsActive() {
+		return nil, fmt.Errorf("reindex of %s is already in progress", name)
+	}
+
+	if err := db.prepareShadow(name); err != nil {
+		return nil, err
+	}
+
+	// changes made to the live collection from now on are replayed after the copy
+	resume, err := db.reindexResumeToken(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	ri = &types.Reindex{
+		Collection: name,
+		State:      types.ReindexStateBuilding,
+		Started:    now,
+		Updated:    now,
+		Resume:     resume,
+	}
+
+	col := db.client.Database(db.dbName).Collection(coReindexes)
+	if _, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: name}}, ri, options.Replace().SetUpsert(true)); err != nil {
+		db.log.Errorf("can not store reindex of %s; %s", name, err.Error())
+		return nil, err
+	}
+	return ri, nil
+}
+
+// Reindex loads the state of the most recent rebuild of the given collection; nil if never rebuilt.
+func (db *MongoDbBridge) Reindex(name string) (*types.Reindex, error) {
+	col := db.client.Database(db.dbName).Collection(coReindexes)
+
+	var ri types.Reindex
+	if err := col.FindOne(context.Background(), bson.D{{Key: "_id", Value: name}}).Decode(&ri); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load reindex of %s; %s", name, err.Error())
+		return nil, err
+	}
+	return &ri, nil
+}
+
+// Reindexes loads the state of the rebuilds of all the collections, the most recent first.
+func (db *MongoDbBridge) Reindexes() ([]*types.Reindex, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coReindexes)
+
+	ld, err := col.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "started", Value: -1}}))
+	if err != nil {
+		db.log.Errorf("can not load reindexes; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing reindexes cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.Reindex, 0)
+	for ld.Next(ctx) {
+		var row types.Reindex
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reindex; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// ReindexStep claims an active reindex for the given owner and processes the next batch of its documents.
+// The live documents are copied in the order of their primary keys first; the changes made to the live collection
+// since the start of the reindex are replayed from its change stream after that. Once the replay catches up,
+// writes to the live collection are paused, the final changes are replayed and the shadow collection replaces
+// the live one. Nil is returned if no active reindex is available to the owner.
+func (db *MongoDbBridge) ReindexStep(owner string) (*types.Reindex, error) {
+	ri, err := db.claimReindex(owner)
+	if err != nil || ri == nil {
+		return nil, err
+	}
+
+	switch ri.State {
+	case types.ReindexStateBuilding:
+		err = db.reindexCopy(ri)
+	case types.ReindexStateCatchUp:
+		err = db.reindexCatchUp(ri)
+	case types.ReindexStateSwitching:
+		err = db.reindexSwitch(ri)
+	}
+
+	now := time.Now().UTC()
+	if err != nil {
+		db.log.Criticalf("reindex of %s failed; %s", ri.Collection, err.Error())
+		if ri.Paused != nil && db.resumeWrites(ri.Collection) == nil {
+			ri.Paused = nil
+		}
+		msg := err.Error()
+		ri.State = types.ReindexStateFailed
+		ri.Error = &msg
+		ri.Finished = &now
+	}
+	ri.Updated = now
+
+	col := db.client.Database(db.dbName).Collection(coReindexes)
+	if _, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: ri.Collection}, {Key: "owner", Value: owner}}, ri); err != nil {
+		db.log.Errorf("can not update reindex of %s; %s", ri.Collection, err.Error())
+		return nil, err
+	}
+	return ri, nil
+}
+
+// claimReindex takes, or renews, the lease of an active reindex for the given owner.
+func (db *MongoDbBridge) claimReindex(owner string) (*types.Reindex, error) {
+	col := db.client.Database(db.dbName).Collection(coReindexes)
+	now := time.Now().UTC()
+
+	sr := col.FindOneAndUpdate(context.Background(), bson.D{
+		{Key: "state", Value: bson.D{{Key: "$in", Value: bson.A{types.ReindexStateBuilding, types.ReindexStateCatchUp, types.ReindexStateSwitching}}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "owner", Value: owner}},
+			bson.D{{Key: "until", Value: bson.D{{Key: "$lt", Value: now}}}},
+		}},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "owner", Value: owner},
+		{Key: "until", Value: now.Add(reindexLeaseDuration)},
+	}}}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	var ri types.Reindex
+	if err := sr.Decode(&ri); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not claim reindex; %s", err.Error())
+		return nil, err
+	}
+	return &ri, nil
+}
+
+// prepareShadow creates an empty shadow collection of the given collection with the same indexes.
+func (db *MongoDbBridge) prepareShadow(name string) error {
+	ctx := context.Background()
+	live := db.client.Database(db.dbName).Collection(name)
+	shadow := db.client.Database(db.dbName).Collection(name + reindexShadowSuffix)
+
+	if err := shadow.Drop(ctx); err != nil {
+		db.log.Errorf("can not drop shadow collection of %s; %s", name, err.Error())
+		return err
+	}
+
+	ld, err := live.Indexes().List(ctx)
+	if err != nil {
+		db.log.Errorf("can not list indexes of %s; %s", name, err.Error())
+		return err
+	}
+
+	var specs []bson.Raw
+	if err := ld.All(ctx, &specs); err != nil {
+		db.log.Errorf("can not decode indexes of %s; %s", name, err.Error())
+		return err
+	}
+
+	ix := make([]mongo.IndexModel, 0, len(specs))
+	for _, spec := range specs {
+		if n, _ := spec.Lookup("name").StringValueOK(); n == "_id_" {
+			continue
+		}
+		ix = append(ix, shadowIndexModel(spec))
+	}
+	if len(ix) == 0 {
+		return nil
+	}
+	return db.createIndexes(name+reindexShadowSuffix, ix)
+}
+
+// shadowIndexModel builds the model of an index of the shadow collection from the live index specification.
+func shadowIndexModel(spec bson.Raw) mongo.IndexModel {
+	opt := options.Index()
+	if n, ok := spec.Lookup("name").StringValueOK(); ok {
+		opt.SetName(n)
+	}
+	if u, ok := spec.Lookup("unique").BooleanOK(); ok && u {
+		opt.SetUnique(true)
+	}
+	if s, ok := spec.Lookup("sparse").BooleanOK(); ok && s {
+		opt.SetSparse(true)
+	}
+	if ttl, ok := spec.Lookup("expireAfterSeconds").AsInt32OK(); ok {
+		opt.SetExpireAfterSeconds(ttl)
+	}
+	if pf, ok := spec.Lookup("partialFilterExpression").DocumentOK(); ok {
+		opt.SetPartialFilterExpression(pf)
+	}
+	return mongo.IndexModel{Keys: spec.Lookup("key").Document(), Options: opt}
+}
+
+// reindexCopy copies the next batch of live documents into the shadow collection.
+func (db *MongoDbBridge) reindexCopy(ri *types.Reindex) error {
+	docs, err := db.reindexLoad(ri)
+	if err != nil {
+		return err
+	}
+
+	// all the documents copied; replay the changes made since
+	if len(docs) == 0 {
+		db.log.Noticef("reindex of %s copied %d documents, catching up", ri.Collection, ri.Copied)
+		ri.State = types.ReindexStateCatchUp
+		ri.Cursor = nil
+		ri.Missed = 0
+		return nil
+	}
+
+	n, err := db.reindexInsert(ri.Collection, docs)
+	if err != nil {
+		return err
+	}
+	ri.Copied += n
+	ri.Cursor = docs[len(docs)-1].Lookup("_id")
+	return nil
+}
+
+// reindexCatchUp replays the next batch of the live collection changes into the shadow collection.
+// Writes to the live collection are paused once the replay catches up with the changes.
+func (db *MongoDbBridge) reindexCatchUp(ri *types.Reindex) error {
+	n, err := db.reindexReplay(ri)
+	if err != nil || n == reindexBatchSize {
+		return err
+	}
+
+	// the final changes are replayed by the switch while the live collection is paused
+	if err := db.pauseWrites(ri.Collection); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	ri.State = types.ReindexStateSwitching
+	ri.Paused = &now
+	db.log.Noticef("reindex of %s caught up with %d changes, switching", ri.Collection, ri.Missed)
+	return nil
+}
+
+// reindexSwitch replays the final changes of the paused live collection and replaces it with the shadow collection.
+// A failed replay, e.g. of a change stream closed by a replica set election, is retried with a growing delay
+// unless the changes can not be replayed at all; the switch fails after too many failures in a row.
+func (db *MongoDbBridge) reindexSwitch(ri *types.Reindex) error {
+	var failures int
+	for {
+		n, err := db.reindexReplay(ri)
+		if err != nil {
+			failures++
+			if failures >= reindexSwitchRetries || isChangeStreamLost(err) {
+				return err
+			}
+
+			db.log.Errorf("replay %d of %d of %s final changes failed; %s", failures, reindexSwitchRetries, ri.Collection, err.Error())
+			time.Sleep(reindexPauseRetry << uint(failures))
+			continue
+		}
+
+		failures = 0
+		if n == 0 && time.Since(*ri.Paused) > reindexSwitchSettle {
+			return db.switchReindex(ri)
+		}
+	}
+}
+
+// reindexResumeToken provides the change stream resume token of the current state of the live collection.
+// Change streams are available only if the database runs as a replica set.
+func (db *MongoDbBridge) reindexResumeToken(name string) (bson.Raw, error) {
+	ctx := context.Background()
+	cs, err := db.client.Database(db.dbName).Collection(name).Watch(ctx, mongo.Pipeline{})
+	if err != nil {
+		db.log.Errorf("can not watch changes of %s, is the database a replica set?; %s", name, err.Error())
+		return nil, err
+	}
+	defer db.closeChangeStream(cs)
+
+	// older servers provide the token with the first poll for changes
+	if cs.ResumeToken() == nil && !cs.TryNext(ctx) && cs.Err() != nil {
+		db.log.Errorf("can not poll changes of %s; %s", name, cs.Err().Error())
+		return nil, cs.Err()
+	}
+
+	tok := cs.ResumeToken()
+	if tok == nil {
+		return nil, fmt.Errorf("change stream of %s provides no resume token", name)
+	}
+	return tok, nil
+}
+
+// reindexReplay replays the next batch of the live collection changes past the resume token
+// of the reindex into the shadow collection. It provides the number of changes replayed.
+func (db *MongoDbBridge) reindexReplay(ri *types.Reindex) (int, error) {
+	ctx := context.Background()
+	opt := options.ChangeStream().
+		SetResumeAfter(ri.Resume).
+		SetFullDocument(options.UpdateLookup).
+		SetBatchSize(reindexBatchSize).
+		SetMaxAwaitTime(reindexChangesWait)
+
+	cs, err := db.client.Database(db.dbName).Collection(ri.Collection).Watch(ctx, mongo.Pipeline{}, opt)
+	if err != nil {
+		db.log.Errorf("can not watch changes of %s; %s", ri.Collection, err.Error())
+		return 0, err
+	}
+	defer db.closeChangeStream(cs)
+
+	n := 0
+	list := make([]mongo.WriteModel, 0)
+	for n < reindexBatchSize && cs.TryNext(ctx) {
+		n++
+
+		// the current change is only valid until the next one is loaded
+		ev := make(bson.Raw, len(cs.Current))
+		copy(ev, cs.Current)
+
+		wm, err := reindexChangeModel(ri.Collection, ev)
+		if err != nil {
+			return 0, err
+		}
+		if wm != nil {
+			list = append(list, wm)
+		}
+	}
+	if err := cs.Err(); err != nil {
+		db.log.Errorf("can not load changes of %s; %s", ri.Collection, err.Error())
+		return 0, err
+	}
+
+	if len(list) > 0 {
+		col := db.client.Database(db.dbName).Collection(ri.Collection + reindexShadowSuffix)
+		if _, err := col.BulkWrite(ctx, list, options.BulkWrite().SetOrdered(true)); err != nil {
+			db.log.Errorf("can not replay changes of %s; %s", ri.Collection, err.Error())
+			return 0, err
+		}
+	}
+
+	if tok := cs.ResumeToken(); tok != nil {
+		ri.Resume = tok
+	}
+	ri.Missed += int64(n)
+	return n, nil
+}
+
+// reindexChangeModel builds the shadow collection write replaying the given change of the live collection.
+// Nil is returned for changes with nothing to replay, e.g. updates of documents deleted since.
+func reindexChangeModel(name string, ev bson.Raw) (mongo.WriteModel, error) {
+	id := ev.Lookup("documentKey", "_id")
+
+	switch op, _ := ev.Lookup("operationType").StringValueOK(); op {
+	case "insert", "update", "replace":
+		doc, ok := ev.Lookup("fullDocument").DocumentOK()
+		if !ok {
+			return nil, nil
+		}
+
+		return mongo.NewReplaceOneModel().SetFilter(bson.D{{Key: "_id", Value: id}}).SetReplacement(doc).SetUpsert(true), nil
+	case "delete":
+		return mongo.NewDeleteOneModel().SetFilter(bson.D{{Key: "_id", Value: id}}), nil
+	default:
+		return nil, fmt.Errorf("live collection %s changed by %s", name, op)
+	}
+}
+
+// closeChangeStream closes the given change stream.
+func (db *MongoDbBridge) closeChangeStream(cs *mongo.ChangeStream) {
+	if err := cs.Close(context.Background()); err != nil {
+		db.log.Errorf("error closing change stream; %s", err.Error())
+	}
+}
+
+// reindexLoad loads the next batch of live documents past the cursor of the reindex
+// in the order of their primary keys.
+func (db *MongoDbBridge) reindexLoad(ri *types.Reindex) ([]bson.Raw, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(ri.Collection)
+
+	filter := bson.D{}
+	if ri.Cursor != nil {
+		filter = bson.D{{Key: "_id", Value: bson.D{{Key: "$gt", Value: ri.Cursor}}}}
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(reindexBatchSize))
+	if err != nil {
+		db.log.Errorf("can not load documents of %s; %s", ri.Collection, err.Error())
+		return nil, err
+	}
+
+	var docs []bson.Raw
+	if err := ld.All(ctx, &docs); err != nil {
+		db.log.Errorf("can not decode documents of %s; %s", ri.Collection, err.Error())
+		return nil, err
+	}
+	return docs, nil
+}
+
+// reindexInsert inserts the given live documents into the shadow collection.
+// Documents already present in the shadow collection, e.g. copied by an interrupted step, are skipped.
+func (db *MongoDbBridge) reindexInsert(name string, docs []bson.Raw) (int64, error) {
+	list := make([]interface{}, len(docs))
+	for i, doc := range docs {
+		list[i] = doc
+	}
+
+	col := db.client.Database(db.dbName).Collection(name + reindexShadowSuffix)
+	res, err := col.InsertMany(context.Background(), list, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return int64(len(res.InsertedIDs)), nil
+	}
+
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil {
+		return 0, err
+	}
+	for _, we := range bwe.WriteErrors {
+		if we.Code != errCodeDuplicateKey {
+			return 0, err
+		}
+	}
+	return int64(len(docs) - len(bwe.WriteErrors)), nil
+}
+
+// switchReindex atomically replaces the paused live collection with the shadow collection of the reindex.
+// The shadow collection does not pause writes, so the writes waiting for the switch go to the rebuilt collection.
+func (db *MongoDbBridge) switchReindex(ri *types.Reindex) error {
+	err := db.client.Database("admin").RunCommand(context.Background(), bson.D{
+		{Key: "renameCollection", Value: db.dbName + "." + ri.Collection + reindexShadowSuffix},
+		{Key: "to", Value: db.dbName + "." + ri.Collection},
+		{Key: "dropTarget", Value: true},
+	}).Err()
+	if err != nil {
+		db.log.Errorf("can not switch %s to the shadow collection; %s", ri.Collection, err.Error())
+		return err
+	}
+
+	now := time.Now().UTC()
+	ri.State = types.ReindexStateSwitched
+	ri.Finished = &now
+	ri.Cursor = nil
+	ri.Resume = nil
+	ri.Paused = nil
+	db.log.Noticef("collection %s switched to the rebuilt shadow collection of %d documents", ri.Collection, ri.Copied)
+	return nil
+}
+
+// pauseWrites pauses writes to the live collection by a validator rejecting all documents;
+// writers retry the rejected writes until the shadow collection replaces the live one.
+// Validators do not apply to deletes, the state of the reindex is checked before those.
+func (db *MongoDbBridge) pauseWrites(name string) error {
+	return db.setValidator(name, bson.D{{Key: "_id", Value: bson.D{{Key: "$exists", Value: false}}}})
+}
+
+// resumeWrites resumes writes to the live collection paused for the switch.
+func (db *MongoDbBridge) resumeWrites(name string) error {
+	return db.setValidator(name, bson.D{})
+}
+
+// setValidator sets the validator of documents written to the given collection.
+func (db *MongoDbBridge) setValidator(name string, validator bson.D) error {
+	err := db.client.Database(db.dbName).RunCommand(context.Background(), bson.D{
+		{Key: "collMod", Value: name},
+		{Key: "validator", Value: validator},
+		{Key: "validationLevel", Value: "strict"},
+		{Key: "validationAction", Value: "error"},
+	}).Err()
+	if err != nil {
+		db.log.Errorf("can not set validator of %s; %s", name, err.Error())
+	}
+	return err
+}
+
+// awaitSwitch waits while writes to the given live collection are paused for the switch.
+func (db *MongoDbBridge) awaitSwitch(name string) error {
+	if !reindexable[name] {
+		return nil
+	}
+
+	deadline := time.Now().Add(reindexPauseTimeout)
+	for {
+		ri, err := db.Reindex(name)
+		if err != nil {
+			return err
+		}
+		if ri == nil || ri.State != types.ReindexStateSwitching {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("writes to %s paused for too long", name)
+		}
+		time.Sleep(reindexPauseRetry)
+	}
+}
+
+// liveCollection represents a reindexable collection written by the API server. Writes rejected
+// while the collection is paused for the switch to its rebuilt shadow collection are retried
+// until the shadow collection replaces it.
+type liveCollection struct {
+	*mongo.Collection
+	db *MongoDbBridge
+}
+
+// live wraps the given reindexable collection for writes.
+func (db *MongoDbBridge) live(col *mongo.Collection) liveCollection {
+	return liveCollection{Collection: col, db: db}
+}
+
+// InsertOne inserts the document into the live collection.
+func (lc liveCollection) InsertOne(ctx context.Context, doc interface{}, opts ...*options.InsertOneOptions) (res *mongo.InsertOneResult, err error) {
+	err = lc.retry(func() error {
+		res, err = lc.Collection.InsertOne(ctx, doc, opts...)
+		return err
+	})
+	return res, err
+}
+
+// UpdateOne updates a single document of the live collection.
+func (lc liveCollection) UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (res *mongo.UpdateResult, err error) {
+	err = lc.retry(func() error {
+		res, err = lc.Collection.UpdateOne(ctx, filter, update, opts...)
+		return err
+	})
+	return res, err
+}
+
+// UpdateMany updates the matching documents of the live collection.
+func (lc liveCollection) UpdateMany(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (res *mongo.UpdateResult, err error) {
+	err = lc.retry(func() error {
+		res, err = lc.Collection.UpdateMany(ctx, filter, update, opts...)
+		return err
+	})
+	return res, err
+}
+
+// ReplaceOne replaces a single document of the live collection.
+func (lc liveCollection) ReplaceOne(ctx context.Context, filter interface{}, doc interface{}, opts ...*options.ReplaceOptions) (res *mongo.UpdateResult, err error) {
+	err = lc.retry(func() error {
+		res, err = lc.Collection.ReplaceOne(ctx, filter, doc, opts...)
+		return err
+	})
+	return res, err
+}
+
+// DeleteMany removes the matching documents of the live collection once it's not paused.
+func (lc liveCollection) DeleteMany(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	if err := lc.db.awaitSwitch(lc.Name()); err != nil {
+		return nil, err
+	}
+	return lc.Collection.DeleteMany(ctx, filter, opts...)
+}
+
+// retry runs the given write until it's not rejected by the paused live collection, or the pause times out.
+func (lc liveCollection) retry(write func() error) error {
+	deadline := time.Now().Add(reindexPauseTimeout)
+	for {
+		err := write()
+		if !isWritePaused(err) || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(reindexPauseRetry)
+	}
+}
+
+// isChangeStreamLost checks if the change stream failed for good, so the changes can not be replayed by a retry.
+func isChangeStreamLost(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && (se.HasErrorCode(errCodeChangeStreamHistoryLost) || se.HasErrorCode(errCodeChangeStreamFatal))
+}
+
+// isWritePaused checks if the write was rejected by the validator pausing writes to the live collection.
+func isWritePaused(err error) bool {
+	var se mongo.ServerError
+	return err != nil && errors.As(err, &se) && se.HasErrorCode(errCodeDocumentValidation)
+}
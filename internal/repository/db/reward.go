@@ -6,7 +6,9 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -47,7 +49,7 @@ func (db *MongoDbBridge) AddRewardClaim(rc *types.RewardClaim) error {
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), rc); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), rc); err != nil {
 		db.log.Critical(err)
 		return err
 	}
@@ -327,3 +329,37 @@ func (db *MongoDbBridge) RewardsSumValue(filter *bson.D) (*big.Int, error) {
 		filter,
 		types.RewardDecimalsCorrection)
 }
+
+// RewardClaimsInRange loads at most limit reward claims of the delegator made in the given time range, the oldest first.
+func (db *MongoDbBridge) RewardClaimsInRange(addr *common.Address, from time.Time, to time.Time, limit int64) ([]*types.RewardClaim, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colRewards)
+
+	ld, err := col.Find(ctx, bson.D{
+		{Key: types.FiRewardClaimAddress, Value: addr.String()},
+		{Key: types.FiRewardClaimedTimeStamp, Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}},
+	}, options.Find().SetSort(bson.D{{Key: types.FiRewardClaimOrdinal, Value: 1}}).SetLimit(limit))
+	if err != nil {
+		db.log.Errorf("can not load reward claims of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing reward claims cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.RewardClaim, 0)
+	for ld.Next(ctx) {
+		var row types.RewardClaim
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reward claim; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,130 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coRewardWatches is the name of the off-chain database collection storing delegations
+	// watched by the pending rewards reminder and the state of their last reminders.
+	coRewardWatches = "reward_watches"
+
+	// fiRewardWatchPk is the name of the primary key field of the reward watches collection.
+	fiRewardWatchPk = "_id"
+
+	// fiRewardWatchStatic is the name of the field of the static configuration flag.
+	fiRewardWatchStatic = "static"
+
+	// fiRewardWatchEpoch is the name of the field of the epoch of the last reminder.
+	fiRewardWatchEpoch = "ep"
+
+	// fiRewardWatchAmount is the name of the field of the pending amount of the last reminder.
+	fiRewardWatchAmount = "amo"
+
+	// fiRewardWatchSent is the name of the field of the time of the last reminder.
+	fiRewardWatchSent = "sent"
+)
+
+// AddRewardWatch adds the delegation to the watch list of the pending rewards reminder.
+// The state of an existing watch is kept, so a watch re-added on the server start
+// does not remind the same rewards again.
+func (db *MongoDbBridge) AddRewardWatch(addr *common.Address, valID uint64, static bool) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coRewardWatches)
+
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{Key: fiRewardWatchPk, Value: types.RewardWatchID(addr, valID)}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: fiRewardWatchStatic, Value: static}}},
+			{Key: "$setOnInsert", Value: bson.D{
+				{Key: "addr", Value: addr.String()},
+				{Key: "vid", Value: int64(valID)},
+			}},
+		}, options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not watch rewards of %s to #%d; %s", addr.String(), valID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// RemoveRewardWatch removes the delegation from the watch list of the pending rewards reminder.
+// False is returned if the delegation has not been watched.
+func (db *MongoDbBridge) RemoveRewardWatch(addr *common.Address, valID uint64) (bool, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coRewardWatches)
+
+	res, err := col.DeleteOne(context.Background(), bson.D{{Key: fiRewardWatchPk, Value: types.RewardWatchID(addr, valID)}})
+	if err != nil {
+		db.log.Errorf("can not remove rewards watch of %s to #%d; %s", addr.String(), valID, err.Error())
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// RewardWatches loads the watch list of the pending rewards reminder.
+func (db *MongoDbBridge) RewardWatches() ([]*types.RewardWatch, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coRewardWatches)
+
+	cr, err := col.Find(ctx, bson.D{})
+	if err != nil {
+		db.log.Errorf("can not load rewards watches; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing rewards watches cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.RewardWatch, 0)
+	for cr.Next(ctx) {
+		var row types.RewardWatch
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode rewards watch; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, cr.Err()
+}
+
+// StoreRewardReminder records the reminder sent for the watched delegation at the given epoch.
+// Nil amount clears the record, i.e. after the rewards were claimed.
+func (db *MongoDbBridge) StoreRewardReminder(addr *common.Address, valID uint64, epoch uint64, amount *big.Int) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coRewardWatches)
+
+	update := bson.D{{Key: "$unset", Value: bson.D{
+		{Key: fiRewardWatchEpoch, Value: ""},
+		{Key: fiRewardWatchAmount, Value: ""},
+		{Key: fiRewardWatchSent, Value: ""},
+	}}}
+	if amount != nil {
+		update = bson.D{{Key: "$set", Value: bson.D{
+			{Key: fiRewardWatchEpoch, Value: int64(epoch)},
+			{Key: fiRewardWatchAmount, Value: (*hexutil.Big)(amount).String()},
+			{Key: fiRewardWatchSent, Value: time.Now().UTC()},
+		}}}
+	}
+
+	_, err := col.UpdateOne(context.Background(), bson.D{{Key: fiRewardWatchPk, Value: types.RewardWatchID(addr, valID)}}, update)
+	if err != nil {
+		db.log.Errorf("can not store rewards reminder of %s to #%d; %s", addr.String(), valID, err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coSandboxKeys is the name of the off-chain database collection storing sandbox API keys.
+const coSandboxKeys = "sandbox_keys"
+
+// coSandboxSlots is the name of the off-chain database collection storing sandbox key slots of clients.
+const coSandboxSlots = "sandbox_slots"
+
+// AddSandboxKey stores a new sandbox API key.
+func (db *MongoDbBridge) AddSandboxKey(sk *types.SandboxKey) error {
+	col := db.client.Database(db.dbName).Collection(coSandboxKeys)
+	if _, err := col.InsertOne(context.Background(), sk); err != nil {
+		db.log.Errorf("can not store sandbox key %s; %s", sk.Name, err.Error())
+		return err
+	}
+	return nil
+}
+
+// SandboxKey loads a valid sandbox API key by the hash of the key; nil is returned if no such key is valid.
+func (db *MongoDbBridge) SandboxKey(id string) (*types.SandboxKey, error) {
+	col := db.client.Database(db.dbName).Collection(coSandboxKeys)
+
+	sr := col.FindOne(context.Background(), bson.D{
+		{Key: "_id", Value: id},
+		{Key: types.FiSandboxKeyExpires, Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load sandbox key; %s", sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var sk types.SandboxKey
+	if err := sr.Decode(&sk); err != nil {
+		db.log.Errorf("can not decode sandbox key; %s", err.Error())
+		return nil, err
+	}
+	return &sk, nil
+}
+
+// ClaimSandboxSlot atomically claims one of the given number of sandbox key slots of the client address
+// for the key of the given hash until the given expiration. A slot is free if it has never been used,
+// or its key has expired. False is returned if all the slots of the client are taken.
+func (db *MongoDbBridge) ClaimSandboxSlot(client string, slots int, id string, expires time.Time) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coSandboxSlots)
+
+	for i := 0; i < slots; i++ {
+		// take the slot if it's not used, or expired; a used slot collides with the upsert
+		_, err := col.UpdateOne(context.Background(),
+			bson.D{{Key: "_id", Value: fmt.Sprintf("%s/%d", client, i)}, {Key: types.FiSandboxKeyExpires, Value: bson.D{{Key: "$lte", Value: time.Now().UTC()}}}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "key", Value: id}, {Key: types.FiSandboxKeyExpires, Value: expires}}}},
+			options.Update().SetUpsert(true))
+		if err == nil {
+			return true, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			db.log.Errorf("can not claim sandbox key slot of %s; %s", client, err.Error())
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// ReleaseSandboxSlot frees the sandbox key slot claimed for the key of the given hash.
+func (db *MongoDbBridge) ReleaseSandboxSlot(id string) {
+	col := db.client.Database(db.dbName).Collection(coSandboxSlots)
+	if _, err := col.DeleteOne(context.Background(), bson.D{{Key: "key", Value: id}}); err != nil {
+		db.log.Errorf("can not release sandbox key slot; %s", err.Error())
+	}
+}
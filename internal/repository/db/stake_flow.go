@@ -0,0 +1,97 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coDelegationEvents is the name of the off-chain database collection storing delegation events.
+const coDelegationEvents = "delegation_events"
+
+// AddDelegationEvent stores a delegation event; already known events are ignored.
+func (db *MongoDbBridge) AddDelegationEvent(ev *types.DelegationEvent) error {
+	col := db.client.Database(db.dbName).Collection(coDelegationEvents)
+	if _, err := col.InsertOne(context.Background(), ev); err != nil && !mongo.IsDuplicateKeyError(err) {
+		db.log.Errorf("can not store delegation event %s; %s", ev.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// DelegationEvents loads delegation events since the given time ordered by the time.
+func (db *MongoDbBridge) DelegationEvents(since *time.Time) ([]*types.DelegationEvent, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coDelegationEvents)
+
+	filter := bson.D{}
+	if since != nil {
+		filter = append(filter, bson.E{Key: types.FiDelegationEventStamp, Value: bson.D{{Key: "$gte", Value: *since}}})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: types.FiDelegationEventStamp, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load delegation events; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing delegation events cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.DelegationEvent, 0)
+	for ld.Next(ctx) {
+		var row types.DelegationEvent
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode delegation event; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// Undelegations loads SFC undelegation requests created since the given time ordered by the time.
+func (db *MongoDbBridge) Undelegations(since *time.Time) ([]*types.WithdrawRequest, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colWithdrawals)
+
+	filter := bson.D{{Key: types.FiWithdrawalType, Value: types.WithdrawTypeUndelegated}}
+	if since != nil {
+		filter = append(filter, bson.E{Key: types.FiWithdrawalStamp, Value: bson.D{{Key: "$gte", Value: *since}}})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: types.FiWithdrawalStamp, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load undelegations; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing undelegations cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.WithdrawRequest, 0)
+	for ld.Next(ctx) {
+		var row types.WithdrawRequest
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode undelegation; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,80 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coStatusIncidents is the name of the off-chain database collection storing status page incidents.
+const coStatusIncidents = "status_incidents"
+
+// StoreStatusIncident stores, or updates, the given status incident.
+func (db *MongoDbBridge) StoreStatusIncident(si *types.StatusIncident) error {
+	col := db.client.Database(db.dbName).Collection(coStatusIncidents)
+
+	_, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: si.ID}}, si, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store status incident %s; %s", si.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// StatusIncident loads the status incident by its id; nil is returned if not found.
+func (db *MongoDbBridge) StatusIncident(id string) (*types.StatusIncident, error) {
+	col := db.client.Database(db.dbName).Collection(coStatusIncidents)
+
+	sr := col.FindOne(context.Background(), bson.D{{Key: "_id", Value: id}})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load status incident %s; %s", id, sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var si types.StatusIncident
+	if err := sr.Decode(&si); err != nil {
+		db.log.Errorf("can not decode status incident; %s", err.Error())
+		return nil, err
+	}
+	return &si, nil
+}
+
+// StatusIncidents loads the given number of the most recent status incidents.
+func (db *MongoDbBridge) StatusIncidents(count int64) ([]*types.StatusIncident, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coStatusIncidents)
+
+	ld, err := col.Find(ctx, bson.D{}, options.Find().
+		SetSort(bson.D{{Key: types.FiStatusIncidentStarted, Value: -1}}).
+		SetLimit(count))
+	if err != nil {
+		db.log.Errorf("can not load status incidents; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing status incidents cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.StatusIncident, 0)
+	for ld.Next(ctx) {
+		var row types.StatusIncident
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode status incident; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,216 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// tokenSupplyFilter provides the filter of mints and burns of the given ERC20 token.
+func tokenSupplyFilter(token *common.Address) bson.D {
+	return bson.D{
+		{Key: types.FiTokenTransactionTokenType, Value: types.AccountTypeERC20Token},
+		{Key: types.FiTokenTransactionToken, Value: token.String()},
+		{Key: types.FiTokenTransactionType, Value: bson.D{{Key: "$in", Value: bson.A{types.TokenTrxTypeMint, types.TokenTrxTypeBurn}}}},
+	}
+}
+
+// TokenSupplyTransactions loads the list of mints and burns of the given token.
+func (db *MongoDbBridge) TokenSupplyTransactions(token *common.Address, cursor *string, count int32) (*types.TokenTransactionList, error) {
+	filter := tokenSupplyFilter(token)
+	return db.Erc20Transactions(cursor, count, &filter)
+}
+
+// TokenSupplyChanges aggregates mints and burns of the given token made since the given time by days.
+// The amounts are summed here since they are stored as hex encoded strings.
+func (db *MongoDbBridge) TokenSupplyChanges(token *common.Address, since time.Time) ([]*types.TokenSupplyChange, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colErcTransactions)
+
+	// the ordinal index starts with the time stamp, use it for the range
+	filter := tokenSupplyFilter(token)
+	filter = append(filter, bson.E{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$gte", Value: uint64(since.Unix()) << 24}}})
+
+	ld, err := col.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: 1}}).
+		SetProjection(bson.D{{Key: types.FiTokenTransactionType, Value: 1}, {Key: "amo", Value: 1}, {Key: "ts", Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load supply changes of %s; %s", token.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing supply changes cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.TokenSupplyChange, 0)
+	var day *types.TokenSupplyChange
+	for ld.Next(ctx) {
+		var row struct {
+			Type      int32  `bson:"type"`
+			Amount    string `bson:"amo"`
+			TimeStamp int64  `bson:"ts"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode supply change of %s; %s", token.String(), err.Error())
+			return nil, err
+		}
+
+		amount, err := hexutil.DecodeBig(row.Amount)
+		if err != nil {
+			db.log.Errorf("invalid amount of supply change of %s; %s", token.String(), err.Error())
+			continue
+		}
+
+		// start a new day if needed
+		ts := time.Unix(row.TimeStamp, 0).UTC().Truncate(24 * time.Hour)
+		if day == nil || !day.Day.Equal(ts) {
+			day = &types.TokenSupplyChange{Day: ts}
+			list = append(list, day)
+		}
+
+		if row.Type == types.TokenTrxTypeMint {
+			day.Minted = hexutil.Big(*new(big.Int).Add(day.Minted.ToInt(), amount))
+			day.Mints++
+			continue
+		}
+		day.Burned = hexutil.Big(*new(big.Int).Add(day.Burned.ToInt(), amount))
+		day.Burns++
+	}
+	return list, nil
+}
+
+// classifyTokenSupplyTransactions re-classifies token transfers indexed before mints and burns
+// were recognized in all the transfer events and indexes mints and burns of tokens.
+func (db *MongoDbBridge) classifyTokenSupplyTransactions() error {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colErcTransactions)
+
+	// transfers from the zero address are mints
+	res, err := db.live(col).UpdateMany(ctx, bson.D{
+		{Key: types.FiTokenTransactionType, Value: types.TokenTrxTypeTransfer},
+		{Key: types.FiTokenTransactionSender, Value: common.Address{}.String()},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: types.FiTokenTransactionType, Value: types.TokenTrxTypeMint}}}})
+	if err != nil {
+		return err
+	}
+	db.log.Noticef("%d token transfers classified as mints", res.ModifiedCount)
+
+	// transfers into the zero address, or the burn address, are burns
+	res, err = db.live(col).UpdateMany(ctx, bson.D{
+		{Key: types.FiTokenTransactionType, Value: types.TokenTrxTypeTransfer},
+		{Key: types.FiTokenTransactionRecipient, Value: bson.D{{Key: "$in", Value: bson.A{common.Address{}.String(), types.TokenBurnAddress}}}},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: types.FiTokenTransactionType, Value: types.TokenTrxTypeBurn}}}})
+	if err != nil {
+		return err
+	}
+	db.log.Noticef("%d token transfers classified as burns", res.ModifiedCount)
+
+	return db.createIndexes(colErcTransactions, []mongo.IndexModel{
+		{Keys: bson.D{
+			{Key: types.FiTokenTransactionToken, Value: 1},
+			{Key: types.FiTokenTransactionType, Value: 1},
+			{Key: types.FiTokenTransactionOrdinal, Value: -1},
+		}},
+	})
+}
+
+// TokenTransferStats aggregates transfers of the given token made since the given time by days.
+// The amounts are summed and unique addresses counted here since the amounts are stored
+// as hex encoded strings; mints and burns are not transfers between accounts and are skipped.
+func (db *MongoDbBridge) TokenTransferStats(token *common.Address, since time.Time) (*types.TokenStats, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colErcTransactions)
+
+	filter := bson.D{
+		{Key: types.FiTokenTransactionTokenType, Value: types.AccountTypeERC20Token},
+		{Key: types.FiTokenTransactionToken, Value: token.String()},
+		{Key: types.FiTokenTransactionType, Value: types.TokenTrxTypeTransfer},
+		{Key: types.FiTokenTransactionOrdinal, Value: bson.D{{Key: "$gte", Value: uint64(since.Unix()) << 24}}},
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: types.FiTokenTransactionOrdinal, Value: 1}}).
+		SetProjection(bson.D{
+			{Key: types.FiTokenTransactionSender, Value: 1},
+			{Key: types.FiTokenTransactionRecipient, Value: 1},
+			{Key: "amo", Value: 1},
+			{Key: "ts", Value: 1},
+		}))
+	if err != nil {
+		db.log.Errorf("can not load transfers of %s; %s", token.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing token transfers cursor; %s", err.Error())
+		}
+	}()
+
+	stats := types.TokenStats{Since: since, Daily: make([]*types.TokenDailyStats, 0)}
+	senders, receivers := make(map[string]bool), make(map[string]bool)
+
+	var day *types.TokenDailyStats
+	var daySenders, dayReceivers map[string]bool
+	for ld.Next(ctx) {
+		var row struct {
+			Sender    string `bson:"from"`
+			Recipient string `bson:"to"`
+			Amount    string `bson:"amo"`
+			TimeStamp int64  `bson:"ts"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode transfer of %s; %s", token.String(), err.Error())
+			return nil, err
+		}
+
+		amount, err := hexutil.DecodeBig(row.Amount)
+		if err != nil {
+			db.log.Errorf("invalid amount of transfer of %s; %s", token.String(), err.Error())
+			continue
+		}
+
+		// start a new day if needed
+		ts := time.Unix(row.TimeStamp, 0).UTC().Truncate(24 * time.Hour)
+		if day == nil || !day.Day.Equal(ts) {
+			day = &types.TokenDailyStats{Day: ts}
+			daySenders, dayReceivers = make(map[string]bool), make(map[string]bool)
+			stats.Daily = append(stats.Daily, day)
+		}
+
+		day.Transfers++
+		day.Volume = hexutil.Big(*new(big.Int).Add(day.Volume.ToInt(), amount))
+		if !daySenders[row.Sender] {
+			daySenders[row.Sender] = true
+			day.Senders++
+		}
+		if !dayReceivers[row.Recipient] {
+			dayReceivers[row.Recipient] = true
+			day.Receivers++
+		}
+
+		stats.Transfers++
+		stats.Volume = hexutil.Big(*new(big.Int).Add(stats.Volume.ToInt(), amount))
+		senders[row.Sender] = true
+		receivers[row.Recipient] = true
+	}
+
+	stats.Senders = int32(len(senders))
+	stats.Receivers = int32(len(receivers))
+	return &stats, nil
+}
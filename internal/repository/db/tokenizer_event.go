@@ -0,0 +1,188 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// colTokenizerEvents represents the name of the SFC Tokenizer events collection in database.
+const colTokenizerEvents = "tokenizer_events"
+
+// AddTokenizerEvent stores an SFC Tokenizer mint, or burn event in the persistent storage.
+// Re-processed events replace the existing record, so the event is never duplicated.
+func (db *MongoDbBridge) AddTokenizerEvent(te *types.TokenizerEvent) error {
+	col := db.client.Database(db.dbName).Collection(colTokenizerEvents)
+	if _, err := db.live(col).ReplaceOne(
+		context.Background(),
+		bson.D{{Key: "_id", Value: te.Pk()}},
+		te,
+		options.Replace().SetUpsert(true),
+	); err != nil {
+		db.log.Errorf("can not store tokenizer event of %s; %s", te.TrxHash.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// TokenizerEvents loads the most recent SFC Tokenizer events of the given account, the newest first.
+func (db *MongoDbBridge) TokenizerEvents(addr *common.Address, count int64) ([]*types.TokenizerEvent, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colTokenizerEvents)
+
+	ld, err := col.Find(ctx,
+		bson.D{{Key: types.FiTokenizerEventAccount, Value: addr.String()}},
+		options.Find().SetSort(bson.D{{Key: types.FiTokenizerEventOrdinal, Value: -1}}).SetLimit(count))
+	if err != nil {
+		db.log.Errorf("can not load tokenizer events of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing tokenizer events cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.TokenizerEvent, 0)
+	for ld.Next(ctx) {
+		var row types.TokenizerEvent
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode tokenizer event; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// SAXISSupply calculates the daily amounts of sAXIS minted and burned, and the amount
+// outstanding at the end of each day starting with the given day.
+func (db *MongoDbBridge) SAXISSupply(since time.Time) ([]*types.SAXISSupply, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colTokenizerEvents)
+
+	// all the days are aggregated since the outstanding amount is cumulative
+	cr, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "$dateToString", Value: bson.D{
+					{Key: "format", Value: "%Y-%m-%d"},
+					{Key: "date", Value: "$stamp"},
+				}},
+			}},
+			{Key: "minted", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$max", Value: bson.A{"$val", 0}}}}}},
+			{Key: "burned", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$min", Value: bson.A{"$val", 0}}}}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate sAXIS supply; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing sAXIS supply cursor; %s", err.Error())
+		}
+	}()
+
+	var outstanding int64
+	list := make([]*types.SAXISSupply, 0)
+	for cr.Next(ctx) {
+		var row struct {
+			Day    string `bson:"_id"`
+			Minted int64  `bson:"minted"`
+			Burned int64  `bson:"burned"`
+		}
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode sAXIS supply; %s", err.Error())
+			return nil, err
+		}
+
+		outstanding += row.Minted + row.Burned
+		day, err := time.Parse("2006-01-02", row.Day)
+		if err != nil || day.Before(since) {
+			continue
+		}
+
+		list = append(list, &types.SAXISSupply{
+			Day:         day,
+			Minted:      correctedValue(row.Minted),
+			Burned:      correctedValue(-row.Burned),
+			Outstanding: correctedValue(outstanding),
+		})
+	}
+	return list, nil
+}
+
+// SAXISCollateral calculates the amount of sAXIS outstanding by validator, the largest first.
+func (db *MongoDbBridge) SAXISCollateral() ([]*types.SAXISCollateral, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colTokenizerEvents)
+
+	cr, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: types.FiTokenizerEventValidator, Value: bson.D{{Key: "$ne", Value: nil}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + types.FiTokenizerEventValidator},
+			{Key: "val", Value: bson.D{{Key: "$sum", Value: "$" + types.FiTokenizerEventValue}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "val", Value: bson.D{{Key: "$gt", Value: 0}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "val", Value: -1}}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate sAXIS collateral; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cr.Close(ctx); err != nil {
+			db.log.Errorf("error closing sAXIS collateral cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.SAXISCollateral, 0)
+	for cr.Next(ctx) {
+		var row struct {
+			ValidatorId int64 `bson:"_id"`
+			Value       int64 `bson:"val"`
+		}
+		if err := cr.Decode(&row); err != nil {
+			db.log.Errorf("can not decode sAXIS collateral; %s", err.Error())
+			return nil, err
+		}
+
+		list = append(list, &types.SAXISCollateral{
+			ValidatorId: (hexutil.Big)(*new(big.Int).SetInt64(row.ValidatorId)),
+			Outstanding: correctedValue(row.Value),
+		})
+	}
+	return list, nil
+}
+
+// tokenizerEventsIndexes creates indexes of the SFC Tokenizer events collection.
+func (db *MongoDbBridge) tokenizerEventsIndexes() error {
+	return db.createIndexes(colTokenizerEvents, []mongo.IndexModel{
+		{Keys: bson.D{{Key: types.FiTokenizerEventAccount, Value: 1}, {Key: types.FiTokenizerEventOrdinal, Value: -1}}},
+		{Keys: bson.D{{Key: types.FiTokenizerEventValidator, Value: 1}}},
+	})
+}
+
+// correctedValue restores the amount of tokens from the value reduced by the decimals correction.
+func correctedValue(val int64) hexutil.Big {
+	return (hexutil.Big)(*new(big.Int).Mul(big.NewInt(val), types.WithdrawDecimalsCorrection))
+}
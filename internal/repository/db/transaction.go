@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -40,6 +41,21 @@ const (
 
 	// fiTransactionTimeStamp is the name of the field of the transaction time stamp.
 	fiTransactionTimeStamp = "stamp"
+
+	// fiTransactionStatus is the name of the field of the transaction status.
+	fiTransactionStatus = "stat"
+
+	// fiTransactionGasUsed is the name of the field of the gas used by the transaction.
+	fiTransactionGasUsed = "gas_use"
+
+	// fiTransactionGasPriceExact is the name of the field of the transaction gas price in full precision.
+	fiTransactionGasPriceExact = "gas_pri"
+
+	// fiTransactionInputSize is the name of the field of the transaction input size.
+	fiTransactionInputSize = "isz"
+
+	// fiTransactionSelector is the name of the field of the method selector of a contract call.
+	fiTransactionSelector = "sel"
 )
 
 // initTransactionsCollection initializes the transaction collection with
@@ -101,7 +117,7 @@ func (db *MongoDbBridge) AddTransaction(block *types.Block, trx *types.Transacti
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), trx); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), trx); err != nil {
 		db.log.Critical(err)
 		return err
 	}
@@ -124,14 +140,22 @@ func (db *MongoDbBridge) UpdateTransaction(col *mongo.Collection, trx *types.Tra
 
 	// try to update a delegation by replacing it in the database
 	// we use address and validator ID to identify unique delegation
-	er, err := col.UpdateOne(context.Background(), bson.D{
-		{Key: fiTransactionPk, Value: trx.Hash.String()},
-	}, bson.D{{Key: "$set", Value: bson.D{
+	set := bson.D{
 		{Key: fiTransactionOrdinalIndex, Value: trx.Uid()},
 		{Key: fiTransactionSender, Value: trx.From.String()},
 		{Key: fiTransactionValue, Value: trx.Value.String()},
 		{Key: fiTransactionTimeStamp, Value: trx.TimeStamp},
-	}}}, new(options.UpdateOptions).SetUpsert(false))
+		{Key: fiTransactionInputSize, Value: len(trx.InputData)},
+	}
+
+	// method selector is back-filled on transactions stored before it was collected
+	if sel := trx.Selector(); sel != nil {
+		set = append(set, bson.E{Key: fiTransactionSelector, Value: *sel})
+	}
+
+	er, err := db.live(col).UpdateOne(context.Background(), bson.D{
+		{Key: fiTransactionPk, Value: trx.Hash.String()},
+	}, bson.D{{Key: "$set", Value: set}}, new(options.UpdateOptions).SetUpsert(false))
 	if err != nil {
 		db.log.Critical(err)
 		return err
@@ -233,10 +257,18 @@ func (db *MongoDbBridge) trxListWithRangeMarks(
 		list.IsEnd = true
 
 	} else if cursor != nil {
-		// get the highest available ordinal index (top transaction)
-		list.First, err = db.findBorderOrdinalIndex(col,
-			bson.D{{Key: fiTransactionPk, Value: *cursor}},
-			options.FindOne())
+		// block number and index based cursor gives us the ordinal index directly
+		// we fall back to legacy transaction hash cursor lookup if not available
+		var ok bool
+		if list.First, ok = types.TransactionCursorOrdinal(*cursor); !ok {
+			if !types.IsTransactionHashCursor(*cursor) {
+				db.log.Errorf("invalid transaction cursor %s", *cursor)
+				return nil, fmt.Errorf("invalid transaction cursor %s", *cursor)
+			}
+			list.First, err = db.findBorderOrdinalIndex(col,
+				bson.D{{Key: fiTransactionPk, Value: *cursor}},
+				options.FindOne())
+		}
 	}
 
 	// check the error
@@ -371,6 +403,24 @@ func (db *MongoDbBridge) txListLoad(col *mongo.Collection, cursor *string, count
 	return nil
 }
 
+// TransactionBlockRangeFilter creates a transaction list filter limiting the list
+// to transactions of the given block range. Both boundaries are inclusive and optional.
+func TransactionBlockRangeFilter(from *hexutil.Uint64, to *hexutil.Uint64) *bson.D {
+	rng := bson.D{}
+	if from != nil {
+		rng = append(rng, bson.E{Key: "$gte", Value: uint64(*from)})
+	}
+	if to != nil {
+		rng = append(rng, bson.E{Key: "$lte", Value: uint64(*to)})
+	}
+
+	// no range at all?
+	if len(rng) == 0 {
+		return &bson.D{}
+	}
+	return &bson.D{{Key: fiTransactionBlock, Value: rng}}
+}
+
 // TransactionsCount returns the number of transactions stored in the database.
 func (db *MongoDbBridge) TransactionsCount() (uint64, error) {
 	return db.EstimateCount(db.client.Database(db.dbName).Collection(coTransactions))
@@ -0,0 +1,63 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// blockTransactionsFilter provides the filter of transactions of the given block;
+// the ordinal index range covers the block and uses the index.
+func blockTransactionsFilter(num uint64) bson.D {
+	return bson.D{
+		{Key: fiTransactionOrdinalIndex, Value: bson.D{{Key: "$gte", Value: num << 14}, {Key: "$lt", Value: (num + 1) << 14}}},
+		{Key: fiTransactionBlock, Value: num},
+	}
+}
+
+// BlockTransactions loads transactions of the given block indexed in the database.
+func (db *MongoDbBridge) BlockTransactions(num uint64) ([]*types.Transaction, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	ld, err := col.Find(ctx, blockTransactionsFilter(num), options.Find().SetSort(bson.D{{Key: fiTransactionOrdinalIndex, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load transactions of block #%d; %s", num, err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing block transactions cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.Transaction, 0)
+	for ld.Next(ctx) {
+		var trx types.Transaction
+		if err := ld.Decode(&trx); err != nil {
+			db.log.Errorf("can not decode transaction of block #%d; %s", num, err.Error())
+			return nil, err
+		}
+		list = append(list, &trx)
+	}
+	return list, nil
+}
+
+// RemoveBlockTransactions removes transactions of the given block from the database
+// so the block can be indexed again from scratch.
+func (db *MongoDbBridge) RemoveBlockTransactions(num uint64) (int64, error) {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	dr, err := db.live(col).DeleteMany(context.Background(), blockTransactionsFilter(num))
+	if err != nil {
+		db.log.Errorf("can not remove transactions of block #%d; %s", num, err.Error())
+		return 0, err
+	}
+	return dr.DeletedCount, nil
+}
@@ -0,0 +1,95 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	retypes "github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// fiTransactionLogAddress is the name of the emitting contract address field of the transaction logs.
+const fiTransactionLogAddress = "logs.addr"
+
+// IndexedTransaction loads the transaction from the database; nil is returned if the transaction is not indexed.
+func (db *MongoDbBridge) IndexedTransaction(hash *common.Hash) (*types.Transaction, error) {
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	sr := col.FindOne(context.Background(), bson.D{{Key: fiTransactionPk, Value: hash.String()}})
+	if sr.Err() != nil {
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		db.log.Errorf("can not load transaction %s; %s", hash.String(), sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	var trx types.Transaction
+	if err := sr.Decode(&trx); err != nil {
+		db.log.Errorf("can not decode transaction %s; %s", hash.String(), err.Error())
+		return nil, err
+	}
+	return &trx, nil
+}
+
+// TransactionLogs loads log records of transactions in the given block range emitted by any of the given
+// contracts; logs of all contracts are considered if none given. The match function decides
+// which log records are collected; an error is returned if more than limit records match.
+func (db *MongoDbBridge) TransactionLogs(from uint64, to uint64, adr []common.Address, match func(*retypes.Log) bool, limit int) ([]retypes.Log, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	// the ordinal index range covers the block range and uses the index
+	filter := bson.D{
+		{Key: fiTransactionOrdinalIndex, Value: bson.D{{Key: "$gte", Value: from << 14}, {Key: "$lt", Value: (to + 1) << 14}}},
+		{Key: fiTransactionBlock, Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lte", Value: to}}},
+		{Key: "logs.0", Value: bson.D{{Key: "$exists", Value: true}}},
+	}
+	if len(adr) > 0 {
+		list := make(bson.A, len(adr))
+		for i, a := range adr {
+			list[i] = a.String()
+		}
+		filter = append(filter, bson.E{Key: fiTransactionLogAddress, Value: bson.D{{Key: "$in", Value: list}}})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: fiTransactionOrdinalIndex, Value: 1}}))
+	if err != nil {
+		db.log.Errorf("can not load logs of blocks #%d to #%d; %s", from, to, err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing transaction logs cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]retypes.Log, 0)
+	for ld.Next(ctx) {
+		var trx types.Transaction
+		if err := ld.Decode(&trx); err != nil {
+			db.log.Errorf("can not decode transaction; %s", err.Error())
+			return nil, err
+		}
+
+		// collect matching logs
+		for i := range trx.Logs {
+			if !match(&trx.Logs[i]) {
+				continue
+			}
+			if len(list) >= limit {
+				return nil, fmt.Errorf("query returned more than %d results", limit)
+			}
+			list = append(list, trx.Logs[i])
+		}
+	}
+	return list, nil
+}
@@ -1019,3 +1019,70 @@ func (db *MongoDbBridge) findUniswapActionBorderOrdinalIndex(col *mongo.Collecti
 
 	return row.Value, nil
 }
+
+// UniswapReserveSamples provides reserves of the given pair observed since the given time
+// sorted by the time of the observation. The last observation before the given time,
+// if any, is included as the first sample so the reserves at the start are known.
+func (db *MongoDbBridge) UniswapReserveSamples(pairAddress *common.Address, fromTime int64) ([]types.DefiReserveSample, error) {
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coUniswap)
+	from := primitive.NewDateTimeFromTime(time.Unix(fromTime, 0).UTC())
+	prj := bson.D{{Key: fiSwapDate, Value: true}, {Key: fiSwapReserve0, Value: true}, {Key: fiSwapReserve1, Value: true}}
+
+	type reserveRow struct {
+		Date     primitive.DateTime `bson:"date"`
+		Reserve0 int64              `bson:"reserve0"`
+		Reserve1 int64              `bson:"reserve1"`
+	}
+	sample := func(row *reserveRow) types.DefiReserveSample {
+		return types.DefiReserveSample{
+			Time:     row.Date.Time().Unix(),
+			Reserve0: returnDecimals(new(big.Int).SetInt64(row.Reserve0), swapReserveDecimalsCorrection),
+			Reserve1: returnDecimals(new(big.Int).SetInt64(row.Reserve1), swapReserveDecimalsCorrection),
+		}
+	}
+
+	// the last observation before the window
+	list := make([]types.DefiReserveSample, 0)
+	res := col.FindOne(ctx, bson.D{
+		{Key: fiSwapPair, Value: pairAddress.String()},
+		{Key: fiSwapDate, Value: bson.D{{Key: "$lt", Value: from}}},
+	}, options.FindOne().SetSort(bson.D{{Key: fiSwapDate, Value: -1}}).SetProjection(prj))
+	if res.Err() == nil {
+		var row reserveRow
+		if err := res.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reserve sample; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, sample(&row))
+	} else if res.Err() != mongo.ErrNoDocuments {
+		db.log.Errorf("can not load reserve sample; %s", res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// observations inside the window
+	cursor, err := col.Find(ctx, bson.D{
+		{Key: fiSwapPair, Value: pairAddress.String()},
+		{Key: fiSwapDate, Value: bson.D{{Key: "$gte", Value: from}}},
+	}, options.Find().SetSort(bson.D{{Key: fiSwapDate, Value: 1}}).SetProjection(prj))
+	if err != nil {
+		db.log.Errorf("can not load reserve samples; %s", err.Error())
+		return nil, err
+	}
+
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("can not close cursor; %s", err.Error())
+		}
+	}()
+
+	for cursor.Next(ctx) {
+		var row reserveRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reserve sample; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, sample(&row))
+	}
+	return list, nil
+}
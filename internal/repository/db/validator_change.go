@@ -0,0 +1,84 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// colValidatorChanges represents the name of the validator changes collection in database.
+const colValidatorChanges = "validator_changes"
+
+// AddValidatorChange stores a validator change record in the persistent storage.
+// Re-processed events replace the existing record, so the change is never duplicated.
+func (db *MongoDbBridge) AddValidatorChange(vc *types.ValidatorChange) error {
+	col := db.client.Database(db.dbName).Collection(colValidatorChanges)
+	if _, err := col.ReplaceOne(
+		context.Background(),
+		bson.D{{Key: "_id", Value: vc.Pk()}},
+		vc,
+		options.Replace().SetUpsert(true),
+	); err != nil {
+		db.log.Errorf("can not store change of validator #%d; %s", vc.ValidatorId.ToInt().Uint64(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// ValidatorChanges loads the most recent changes of the given validator, the newest first.
+func (db *MongoDbBridge) ValidatorChanges(valID *hexutil.Big, count int64) ([]*types.ValidatorChange, error) {
+	return db.validatorChanges(
+		bson.D{{Key: types.FiValidatorChangeID, Value: valID.ToInt().Int64()}},
+		options.Find().SetSort(bson.D{{Key: types.FiValidatorChangeOrdinal, Value: -1}}).SetLimit(count),
+	)
+}
+
+// LastValidatorChange loads the most recent change of the given type of the given validator, if any.
+func (db *MongoDbBridge) LastValidatorChange(valID *hexutil.Big, typ string) (*types.ValidatorChange, error) {
+	list, err := db.validatorChanges(
+		bson.D{
+			{Key: types.FiValidatorChangeID, Value: valID.ToInt().Int64()},
+			{Key: types.FiValidatorChangeType, Value: typ},
+		},
+		options.Find().SetSort(bson.D{{Key: types.FiValidatorChangeOrdinal, Value: -1}}).SetLimit(1),
+	)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// validatorChanges loads validator changes matching the given filter.
+func (db *MongoDbBridge) validatorChanges(filter bson.D, opt *options.FindOptions) ([]*types.ValidatorChange, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(colValidatorChanges)
+
+	ld, err := col.Find(ctx, filter, opt)
+	if err != nil {
+		db.log.Errorf("can not load validator changes; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing validator changes cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.ValidatorChange, 0)
+	for ld.Next(ctx) {
+		var row types.ValidatorChange
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode validator change; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
@@ -0,0 +1,129 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// coWebhookDeliveries is the name of the off-chain database collection storing webhook delivery log.
+const coWebhookDeliveries = "webhook_deliveries"
+
+// webhookDeliveryRetention represents the time a webhook delivery log record is kept after it's created;
+// deliveries are done, or dead-lettered, within minutes, so only the history of the delivery log is purged.
+const webhookDeliveryRetention = 30 * 24 * time.Hour
+
+// StoreWebhookDelivery stores, or updates, the given webhook delivery log record.
+func (db *MongoDbBridge) StoreWebhookDelivery(wd *types.WebhookDelivery) error {
+	col := db.client.Database(db.dbName).Collection(coWebhookDeliveries)
+
+	// replace the previous state of the delivery, if any
+	_, err := col.ReplaceOne(context.Background(), bson.D{{Key: "_id", Value: wd.ID}}, wd, options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store webhook delivery %s; %s", wd.ID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// WebhookDeliveries loads the most recently updated webhook delivery log records
+// optionally filtered by the delivery status.
+func (db *MongoDbBridge) WebhookDeliveries(status *string, count int32) ([]*types.WebhookDelivery, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coWebhookDeliveries)
+
+	// filter by status if requested
+	filter := bson.D{}
+	if status != nil {
+		filter = append(filter, bson.E{Key: types.FiWebhookDeliveryStatus, Value: *status})
+	}
+
+	ld, err := col.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: types.FiWebhookDeliveryUpdated, Value: -1}}).
+		SetLimit(int64(count)))
+	if err != nil {
+		db.log.Errorf("can not load webhook deliveries; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing webhook deliveries cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.WebhookDelivery, 0)
+	for ld.Next(ctx) {
+		var row types.WebhookDelivery
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode webhook delivery; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// WebhookDeliveriesDue loads the oldest webhook deliveries waiting for the first attempt,
+// or scheduled for a retry at, or before the given time.
+func (db *MongoDbBridge) WebhookDeliveriesDue(until time.Time, count int32) ([]*types.WebhookDelivery, error) {
+	// get the collection and context
+	ctx := context.Background()
+	col := db.client.Database(db.dbName).Collection(coWebhookDeliveries)
+
+	ld, err := col.Find(ctx, bson.D{
+		{Key: types.FiWebhookDeliveryStatus, Value: bson.D{{Key: "$in", Value: bson.A{types.WebhookStatusPending, types.WebhookStatusRetrying}}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: types.FiWebhookDeliveryNextAttempt, Value: nil}},
+			bson.D{{Key: types.FiWebhookDeliveryNextAttempt, Value: bson.D{{Key: "$lte", Value: until}}}},
+		}},
+	}, options.Find().
+		SetSort(bson.D{{Key: types.FiWebhookDeliveryCreated, Value: 1}}).
+		SetLimit(int64(count)))
+	if err != nil {
+		db.log.Errorf("can not load due webhook deliveries; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing webhook deliveries cursor; %s", err.Error())
+		}
+	}()
+
+	list := make([]*types.WebhookDelivery, 0)
+	for ld.Next(ctx) {
+		var row types.WebhookDelivery
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode webhook delivery; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, &row)
+	}
+	return list, nil
+}
+
+// ClaimWebhookDelivery atomically postpones the next attempt of the given due webhook delivery
+// to the given lease end so other API server instances sharing the database skip it.
+// It returns false if the delivery has been changed, or claimed by someone else meanwhile.
+func (db *MongoDbBridge) ClaimWebhookDelivery(wd *types.WebhookDelivery, lease time.Time) (bool, error) {
+	col := db.client.Database(db.dbName).Collection(coWebhookDeliveries)
+
+	rs, err := col.UpdateOne(context.Background(), bson.D{
+		{Key: "_id", Value: wd.ID},
+		{Key: types.FiWebhookDeliveryStatus, Value: wd.Status},
+		{Key: types.FiWebhookDeliveryNextAttempt, Value: wd.NextAttempt},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: types.FiWebhookDeliveryNextAttempt, Value: lease}}}})
+	if err != nil {
+		db.log.Errorf("can not claim webhook delivery %s; %s", wd.ID, err.Error())
+		return false, err
+	}
+	return rs.ModifiedCount == 1, nil
+}
@@ -95,7 +95,7 @@ func (db *MongoDbBridge) AddWithdrawal(wr *types.WithdrawRequest) error {
 	}
 
 	// try to do the insert
-	if _, err := col.InsertOne(context.Background(), wr); err != nil {
+	if _, err := db.live(col).InsertOne(context.Background(), wr); err != nil {
 		db.log.Criticalf("failed to store %s to %d, %s, %s; %s",
 			wr.Address.String(),
 			wr.StakerID.ToInt().Uint64(),
@@ -143,7 +143,7 @@ func (db *MongoDbBridge) shiftClosedWithdrawRequest(col *mongo.Collection, wr *t
 	reqID := (*hexutil.Big)(new(big.Int).SetBytes(wr.RequestTrx.Bytes()[:16])).String()
 
 	// try to shift a closed withdrawal request to a different reqID by updating it in the database
-	er, err := col.UpdateOne(context.Background(), bson.D{
+	er, err := db.live(col).UpdateOne(context.Background(), bson.D{
 		{Key: types.FiWithdrawalAddress, Value: wr.Address.String()},
 		{Key: types.FiWithdrawalToValidator, Value: wr.StakerID.String()},
 		{Key: types.FiWithdrawalRequestID, Value: wr.WithdrawRequestID.String()},
@@ -195,7 +195,7 @@ func (db *MongoDbBridge) UpdateWithdrawal(wr *types.WithdrawRequest) error {
 
 	// try to update a withdraw request by replacing it in the database
 	// we use request ID identify unique withdrawal
-	er, err := col.UpdateOne(context.Background(), bson.D{
+	er, err := db.live(col).UpdateOne(context.Background(), bson.D{
 		{Key: types.FiWithdrawalAddress, Value: wr.Address.String()},
 		{Key: types.FiWithdrawalToValidator, Value: wr.StakerID.String()},
 		{Key: types.FiWithdrawalRequestID, Value: wr.WithdrawRequestID.String()},
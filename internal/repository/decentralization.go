@@ -0,0 +1,67 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Decentralization calculates stake distribution metrics of the validator set
+// of the given sealed epoch; the latest sealed epoch is used if not specified.
+func (p *proxy) Decentralization(epoch *hexutil.Uint64) (*types.Decentralization, error) {
+	// use the latest sealed epoch, if needed
+	if epoch == nil {
+		id, err := p.rpc.CurrentSealedEpoch()
+		if err != nil {
+			return nil, err
+		}
+		epoch = &id
+	}
+
+	list, err := p.EpochValidators(*epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the stakes sorted from the largest
+	total := new(big.Int)
+	stakes := make([]*big.Int, len(list))
+	for i, ev := range list {
+		stakes[i] = ev.ReceivedStake.ToInt()
+		total.Add(total, stakes[i])
+	}
+	sort.Slice(stakes, func(i, j int) bool {
+		return stakes[i].Cmp(stakes[j]) > 0
+	})
+
+	return &types.Decentralization{
+		Epoch:               *epoch,
+		TotalStake:          hexutil.Big(*total),
+		Stakes:              stakes,
+		NakamotoCoefficient: nakamotoCoefficient(stakes, total),
+	}, nil
+}
+
+// nakamotoCoefficient calculates the minimal number of the largest stakes
+// summing up to more than one third of the total stake.
+func nakamotoCoefficient(stakes []*big.Int, total *big.Int) int32 {
+	// we need more than 1/3 of the total; compare 3 * sum > total
+	sum := new(big.Int)
+	for i, st := range stakes {
+		sum.Add(sum, st)
+		if new(big.Int).Mul(sum, big.NewInt(3)).Cmp(total) > 0 {
+			return int32(i + 1)
+		}
+	}
+	return 0
+}
@@ -9,8 +9,60 @@ import (
 )
 
 // DefiConfiguration resolves the current DeFi contract settings.
+// The configuration is loaded from the node only once and kept
+// until an fMint configuration change is observed.
 func (p *proxy) DefiConfiguration() (*types.DefiSettings, error) {
-	return p.rpc.DefiConfiguration()
+	// do we have the config already?
+	p.defiConfigLock.RLock()
+	ds := p.defiConfig
+	p.defiConfigLock.RUnlock()
+	if ds != nil {
+		return ds, nil
+	}
+
+	// load the config only once for parallel requests
+	val, err, _ := p.apiRequestGroup.Do("defi_config", func() (interface{}, error) {
+		ds, err := p.rpc.DefiConfiguration()
+		if err != nil {
+			return nil, err
+		}
+
+		p.defiConfigLock.Lock()
+		p.defiConfig = ds
+		p.defiConfigLock.Unlock()
+		return ds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*types.DefiSettings), nil
+}
+
+// InvalidateDefiConfiguration drops the cached DeFi configuration so it's
+// re-loaded on the next request. If the fMint contract addresses changed,
+// the addresses resolved from the fMint AddressProvider are dropped as well.
+// The invalidation is shared with other API replicas over the cache bus.
+func (p *proxy) InvalidateDefiConfiguration(addressChanged bool) {
+	p.invalidateDefiConfiguration(addressChanged)
+
+	if addressChanged {
+		p.broadcastInvalidation(cacheBusDefiAddresses, "")
+		return
+	}
+	p.broadcastInvalidation(cacheBusDefiConfig, "")
+}
+
+// invalidateDefiConfiguration drops the cached DeFi configuration of this replica.
+func (p *proxy) invalidateDefiConfiguration(addressChanged bool) {
+	if addressChanged {
+		p.rpc.FMintResetAddresses()
+	}
+
+	p.defiConfigLock.Lock()
+	p.defiConfig = nil
+	p.defiConfigLock.Unlock()
+
+	p.log.Noticef("DeFi configuration cache invalidated")
 }
 
 // DefiToken loads details of a single DeFi token by it's address.
@@ -23,12 +75,6 @@ func (p *proxy) DefiTokens() ([]types.DefiToken, error) {
 	return p.rpc.DefiTokens()
 }
 
-// DefiTokenPrice loads the current price of the given token
-// from on-chain price oracle.
-func (p *proxy) DefiTokenPrice(token *common.Address) (hexutil.Big, error) {
-	return p.rpc.FMintTokenPrice(token)
-}
-
 // FMintAccount loads details of a DeFi/fMint account identified by the owner address.
 func (p *proxy) FMintAccount(owner common.Address) (*types.FMintAccount, error) {
 	return p.rpc.FMintAccount(&owner)
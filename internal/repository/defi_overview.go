@@ -0,0 +1,189 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DefiOverview provides the latest network-wide aggregates of the DeFi modules.
+// The aggregates are refreshed regularly by the DeFi overview monitor; if they were not
+// calculated yet, they are calculated on demand.
+func (p *proxy) DefiOverview() (*types.DefiOverview, error) {
+	p.defiOverviewLock.RLock()
+	do := p.defiOverview
+	p.defiOverviewLock.RUnlock()
+	if do != nil {
+		return do, nil
+	}
+
+	// calculate the overview only once for parallel requests
+	val, err, _ := p.apiRequestGroup.Do("defi_overview", func() (interface{}, error) {
+		return p.UpdateDefiOverview()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*types.DefiOverview), nil
+}
+
+// UpdateDefiOverview re-calculates network-wide aggregates of the DeFi modules.
+func (p *proxy) UpdateDefiOverview() (*types.DefiOverview, error) {
+	// get DeFi tokens so we can value the assets
+	tokens, err := p.defiPricedTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	do := types.DefiOverview{Updated: hexutil.Uint64(time.Now().UTC().Unix())}
+	do.StakingTvl = hexutil.Big(*p.defiStakingTvl(tokens))
+	do.FMintTvl = hexutil.Big(*p.defiFMintTvl(tokens))
+
+	// AMM reserves and volumes
+	amm, d, w := p.defiAmmAggregates(tokens)
+	do.AmmTvl = hexutil.Big(*amm)
+	do.Volume24h = hexutil.Big(*d)
+	do.Volume7d = hexutil.Big(*w)
+
+	tvl := new(big.Int).Add(do.StakingTvl.ToInt(), do.FMintTvl.ToInt())
+	do.Tvl = hexutil.Big(*tvl.Add(tvl, amm))
+
+	p.defiOverviewLock.Lock()
+	p.defiOverview = &do
+	p.defiOverviewLock.Unlock()
+
+	p.log.Debugf("DeFi overview updated, TVL %s", do.Tvl.String())
+	return &do, nil
+}
+
+// defiPricedToken represents a DeFi token with its current oracle price.
+type defiPricedToken struct {
+	token *types.DefiToken
+	price *big.Int
+}
+
+// defiPricedTokens provides a map of DeFi tokens with known oracle price.
+func (p *proxy) defiPricedTokens() (map[common.Address]*defiPricedToken, error) {
+	list, err := p.DefiTokens()
+	if err != nil {
+		p.log.Errorf("DeFi tokens not available; %s", err.Error())
+		return nil, err
+	}
+
+	res := make(map[common.Address]*defiPricedToken, len(list))
+	for i := range list {
+		price, err := p.DefiTokenPrice(&list[i].Address)
+		if err != nil || price.ToInt().Sign() == 0 {
+			continue
+		}
+		res[list[i].Address] = &defiPricedToken{token: &list[i], price: price.ToInt()}
+	}
+	return res, nil
+}
+
+// value calculates fUSD value of the given amount of the priced token.
+func (pt *defiPricedToken) value(amount *big.Int) *big.Int {
+	return defiValue(pt.token, amount, pt.price)
+}
+
+// defiStakingTvl calculates the value of the native tokens staked in SFC.
+func (p *proxy) defiStakingTvl(tokens map[common.Address]*defiPricedToken) *big.Int {
+	native, err := p.NativeTokenAddress()
+	if err != nil {
+		return new(big.Int)
+	}
+
+	// the native token wrapper must be priced
+	pt, ok := tokens[*native]
+	if !ok {
+		p.log.Debugf("native token price not available for staking TVL")
+		return new(big.Int)
+	}
+
+	total, err := p.TotalStaked()
+	if err != nil {
+		return new(big.Int)
+	}
+	return pt.value(total.ToInt())
+}
+
+// defiFMintTvl calculates the value of the collateral locked in fMint.
+func (p *proxy) defiFMintTvl(tokens map[common.Address]*defiPricedToken) *big.Int {
+	tvl := new(big.Int)
+	for adr, pt := range tokens {
+		if !pt.token.CanDeposit {
+			continue
+		}
+
+		a := adr
+		bal, err := p.FMintTokenTotalBalance(&a, types.DefiTokenTypeCollateral)
+		if err != nil {
+			continue
+		}
+		tvl.Add(tvl, pt.value(bal.ToInt()))
+	}
+	return tvl
+}
+
+// defiAmmAggregates calculates the value of reserves of the known AMM pairs
+// and their swap volumes over the last 24 hours and 7 days. Pairs with only one token
+// priced are valued by doubling the priced side; pairs without any priced token are skipped.
+func (p *proxy) defiAmmAggregates(tokens map[common.Address]*defiPricedToken) (*big.Int, *big.Int, *big.Int) {
+	tvl, daily, weekly := new(big.Int), new(big.Int), new(big.Int)
+
+	pairs, err := p.UniswapKnownPairs()
+	if err != nil {
+		return tvl, daily, weekly
+	}
+
+	now := time.Now().UTC()
+	for i := range pairs {
+		tl, err := p.UniswapTokens(&pairs[i])
+		if err != nil || len(tl) != 2 {
+			continue
+		}
+
+		// reserves
+		res, err := p.UniswapReserves(&pairs[i])
+		if err == nil && len(res) == 2 {
+			tvl.Add(tvl, pairValue(tokens[tl[0]], tokens[tl[1]], res[0].ToInt(), res[1].ToInt()))
+		}
+
+		// volumes are collected in the first token of the pair
+		pt, ok := tokens[tl[0]]
+		if !ok {
+			continue
+		}
+		if vol, err := p.UniswapVolume(&pairs[i], now.AddDate(0, 0, -1).Unix(), now.Unix()); err == nil {
+			daily.Add(daily, pt.value(vol.Volume))
+		}
+		if vol, err := p.UniswapVolume(&pairs[i], now.AddDate(0, 0, -7).Unix(), now.Unix()); err == nil {
+			weekly.Add(weekly, pt.value(vol.Volume))
+		}
+	}
+	return tvl, daily, weekly
+}
+
+// pairValue calculates the value of AMM pair reserves.
+func pairValue(a *defiPricedToken, b *defiPricedToken, resA *big.Int, resB *big.Int) *big.Int {
+	switch {
+	case a != nil && b != nil:
+		return new(big.Int).Add(a.value(resA), b.value(resB))
+	case a != nil:
+		return new(big.Int).Lsh(a.value(resA), 1)
+	case b != nil:
+		return new(big.Int).Lsh(b.value(resB), 1)
+	}
+	return new(big.Int)
+}
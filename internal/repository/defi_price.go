@@ -0,0 +1,227 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// defiTwapCacheDuration represents the duration a calculated AMM TWAP price is kept.
+const defiTwapCacheDuration = time.Minute
+
+// twapCacheItem represents a cached AMM TWAP price of a token.
+type twapCacheItem struct {
+	price   *types.DefiTokenPrice
+	expires time.Time
+}
+
+// DefiTokenPrice loads the current price of the given token
+// from on-chain price oracle. Use DefiTokenPriceSourced if the AMM TWAP fallback is acceptable.
+func (p *proxy) DefiTokenPrice(token *common.Address) (hexutil.Big, error) {
+	return p.rpc.FMintTokenPrice(token)
+}
+
+// DefiTokenPriceSourced loads the current price of the given token along with its source.
+// The on-chain price oracle is used if it knows the price, the time weighted average price
+// calculated from indexed AMM pair reserves is used as the fallback.
+func (p *proxy) DefiTokenPriceSourced(token *common.Address) (*types.DefiTokenPrice, error) {
+	// try the oracle first
+	val, err := p.rpc.FMintTokenPrice(token)
+	if err != nil {
+		return nil, err
+	}
+	if val.ToInt().Sign() > 0 {
+		return &types.DefiTokenPrice{Price: val, Source: types.DefiPriceSourceOracle}, nil
+	}
+
+	// fallback disabled?
+	none := types.DefiTokenPrice{Source: types.DefiPriceSourceNone}
+	if p.cfg.DeFi.TwapWindow <= 0 {
+		return &none, nil
+	}
+
+	// do we have a recent TWAP?
+	if it, ok := p.twapPrices.Load(*token); ok && it.(*twapCacheItem).expires.After(time.Now()) {
+		return it.(*twapCacheItem).price, nil
+	}
+
+	pri, err := p.ammTwapPrice(token)
+	if err != nil {
+		p.log.Debugf("AMM TWAP price of %s not available; %s", token.String(), err.Error())
+		pri = &none
+	}
+	p.twapPrices.Store(*token, &twapCacheItem{price: pri, expires: time.Now().Add(defiTwapCacheDuration)})
+	return pri, nil
+}
+
+// twapPairCandidate represents an AMM pair usable for the TWAP price of a token.
+type twapPairCandidate struct {
+	pair         common.Address
+	sibling      common.Address
+	ix           int
+	siblingPrice *big.Int
+	liquidity    *big.Int
+}
+
+// ammTwapPrice calculates the time weighted average price of the given token
+// using indexed reserves of the deepest known AMM pair with a sibling token priced by the oracle.
+// Pairs with the priced side of the reserves worth less than the configured minimum are not used.
+func (p *proxy) ammTwapPrice(token *common.Address) (*types.DefiTokenPrice, error) {
+	list, err := p.twapPairCandidates(token)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no liquid AMM pair with a priced sibling")
+	}
+
+	// try the deepest pair first
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].liquidity.Cmp(list[j].liquidity) > 0
+	})
+	for _, c := range list {
+		pri, err := p.pairTwapPrice(&c.pair, token, &c.sibling, c.ix, c.siblingPrice)
+		if err != nil {
+			p.log.Debugf("can not calculate TWAP on pair %s; %s", c.pair.String(), err.Error())
+			continue
+		}
+		return &types.DefiTokenPrice{Price: hexutil.Big(*pri), Source: types.DefiPriceSourceAmmTwap}, nil
+	}
+	return nil, fmt.Errorf("no AMM pair with usable reserves")
+}
+
+// twapPairCandidates collects the known AMM pairs of the given token with a sibling token
+// priced by the oracle and the sibling reserve worth at least the configured minimum.
+func (p *proxy) twapPairCandidates(token *common.Address) ([]*twapPairCandidate, error) {
+	pairs, err := p.UniswapKnownPairs()
+	if err != nil {
+		return nil, err
+	}
+
+	// the minimal liquidity with the value decimals
+	minLiq, _ := new(big.Float).Mul(
+		big.NewFloat(p.cfg.DeFi.TwapMinLiquidity),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(fMintValueDecimals), nil)),
+	).Int(nil)
+
+	list := make([]*twapPairCandidate, 0)
+	for i := range pairs {
+		tokens, err := p.UniswapTokens(&pairs[i])
+		if err != nil || len(tokens) != 2 {
+			continue
+		}
+
+		// is the token in the pair?
+		var ix int
+		switch *token {
+		case tokens[0]:
+			ix = 0
+		case tokens[1]:
+			ix = 1
+		default:
+			continue
+		}
+
+		// the sibling must have an oracle price
+		sibling := tokens[1-ix]
+		sp, err := p.rpc.FMintTokenPrice(&sibling)
+		if err != nil || sp.ToInt().Sign() == 0 {
+			continue
+		}
+
+		// value the sibling side of the current reserves
+		res, err := p.UniswapReserves(&pairs[i])
+		if err != nil || len(res) != 2 {
+			continue
+		}
+		st, err := p.DefiToken(&sibling)
+		if err != nil {
+			continue
+		}
+
+		liq := defiValue(st, res[1-ix].ToInt(), sp.ToInt())
+		if liq.Cmp(minLiq) < 0 {
+			p.log.Debugf("AMM pair %s too shallow for TWAP of %s", pairs[i].String(), token.String())
+			continue
+		}
+		list = append(list, &twapPairCandidate{pair: pairs[i], sibling: sibling, ix: ix, siblingPrice: sp.ToInt(), liquidity: liq})
+	}
+	return list, nil
+}
+
+// pairTwapPrice calculates the price of the token at the given index of the pair
+// as the time weighted average of the reserve ratio over the configured window
+// multiplied by the oracle price of the sibling token.
+func (p *proxy) pairTwapPrice(pair *common.Address, token *common.Address, sibling *common.Address, ix int, siblingPrice *big.Int) (*big.Int, error) {
+	now := time.Now().Unix()
+	from := now - int64(p.cfg.DeFi.TwapWindow.Seconds())
+
+	samples, err := p.db.UniswapReserveSamples(pair, from)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no reserves observed")
+	}
+
+	// integrate the ratio of sibling reserve to token reserve over time
+	sum := new(big.Float)
+	var span int64
+	for i, sm := range samples {
+		start := sm.Time
+		if start < from {
+			start = from
+		}
+		end := now
+		if i+1 < len(samples) {
+			end = samples[i+1].Time
+		}
+		if end <= start {
+			continue
+		}
+
+		own, sib := sm.Reserve0, sm.Reserve1
+		if ix == 1 {
+			own, sib = sib, own
+		}
+		if own.Sign() == 0 {
+			continue
+		}
+
+		ratio := new(big.Float).Quo(new(big.Float).SetInt(sib), new(big.Float).SetInt(own))
+		sum.Add(sum, ratio.Mul(ratio, new(big.Float).SetInt64(end-start)))
+		span += end - start
+	}
+	if span == 0 {
+		return nil, fmt.Errorf("no usable reserves observed")
+	}
+
+	// adjust the ratio for the difference in token decimals
+	dt, err := p.Erc20Decimals(token)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := p.Erc20Decimals(sibling)
+	if err != nil {
+		return nil, err
+	}
+	twap := sum.Quo(sum, new(big.Float).SetInt64(span))
+	twap.Mul(twap, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(dt)), nil)))
+	twap.Quo(twap, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(ds)), nil)))
+
+	val, _ := twap.Mul(twap, new(big.Float).SetInt(siblingPrice)).Int(nil)
+	return val, nil
+}
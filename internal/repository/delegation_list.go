@@ -0,0 +1,123 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// delegationsByRewardsMaxSet represents the max number of delegations
+// ordered by pending rewards; the rewards are not indexed and have to be pulled from the node.
+const delegationsByRewardsMaxSet = 500
+
+// delegationList loads the list of delegations matching the base filter
+// in the order and scope given by the list options; nil options keep the creation order.
+func (p *proxy) delegationList(filter bson.D, cursor *string, count int32, opt *types.DelegationListOptions) (*types.DelegationList, error) {
+	if opt == nil {
+		return p.db.Delegations(cursor, count, &filter)
+	}
+
+	// narrow the list
+	if opt.ActiveOnly {
+		filter = append(filter, bson.E{Key: types.FiDelegationValue, Value: bson.D{{Key: "$gt", Value: 0}}})
+	}
+	if opt.LockedOnly {
+		filter = append(filter, bson.E{Key: types.FiDelegationLockedUntil, Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}})
+	}
+
+	switch opt.OrderBy {
+	case types.DelegationOrderAmount:
+		return p.db.DelegationsByValue(cursor, count, &filter)
+	case types.DelegationOrderPendingRewards:
+		return p.delegationsByPendingRewards(filter, cursor, count)
+	}
+	return p.db.Delegations(cursor, count, &filter)
+}
+
+// delegationsByPendingRewards loads the list of delegations matching the filter ordered
+// by the pending rewards from high to low. Delegations with the same rewards are ordered by creation.
+func (p *proxy) delegationsByPendingRewards(filter bson.D, cursor *string, count int32) (*types.DelegationList, error) {
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero delegations requested")
+	}
+
+	all, err := p.db.DelegationsAll(&filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(all) > delegationsByRewardsMaxSet {
+		return nil, fmt.Errorf("too many delegations to order by pending rewards, narrow the list by a filter")
+	}
+
+	// pull the rewards of all the delegations
+	rewards := make(map[string]*big.Int, len(all))
+	for _, dlg := range all {
+		pr, err := p.PendingRewards(&dlg.Address, dlg.ToStakerId, nil)
+		if err != nil {
+			p.log.Errorf("can not order delegations by pending rewards; %s", err.Error())
+			return nil, err
+		}
+		rewards[dlg.ID] = pr.Amount.ToInt()
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if c := rewards[all[i].ID].Cmp(rewards[all[j].ID]); c != 0 {
+			return c > 0
+		}
+		return all[i].Index > all[j].Index
+	})
+	return delegationsPage(all, filter, cursor, count)
+}
+
+// delegationsPage cuts the page of the given ordered delegations next to the cursor;
+// positive count goes down the list, negative count goes up.
+func delegationsPage(all []*types.Delegation, filter bson.D, cursor *string, count int32) (*types.DelegationList, error) {
+	// the page border is the cursor position, or the list end
+	at := -1
+	if count < 0 {
+		at = len(all)
+	}
+	if cursor != nil {
+		at = -2
+		for i, dlg := range all {
+			if dlg.ID == *cursor {
+				at = i
+				break
+			}
+		}
+		if at == -2 {
+			return nil, fmt.Errorf("unknown delegation cursor %s", *cursor)
+		}
+	}
+
+	from, to := at+1, at+1+int(count)
+	if count < 0 {
+		from, to = at+int(count), at
+	}
+	if from < 0 {
+		from = 0
+	}
+	if to > len(all) {
+		to = len(all)
+	}
+
+	return &types.DelegationList{
+		Collection: all[from:to],
+		Total:      uint64(len(all)),
+		IsStart:    from == 0,
+		IsEnd:      to == len(all),
+		Filter:     filter,
+	}, nil
+}
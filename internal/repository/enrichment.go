@@ -0,0 +1,119 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Enricher represents an external source of off-chain data decorating accounts
+// and contracts, e.g. KYC tags, dApp names, or audit statuses. Operators may plug
+// any number of enrichers using RegisterEnricher without changes to the resolvers.
+type Enricher interface {
+	// Name returns the name of the enricher; it's used as the source of the tags.
+	Name() string
+
+	// Enrich provides the tags of the given address; an empty list if none are known.
+	Enrich(context.Context, *common.Address) ([]types.EnrichmentTag, error)
+}
+
+// enrichers represents the list of enrichers registered by the operator.
+var enrichers []Enricher
+
+// RegisterEnricher adds an off-chain data enricher to be used by the repository.
+// Enrichers have to be registered before the repository is instantiated.
+func RegisterEnricher(e Enricher) {
+	enrichers = append(enrichers, e)
+}
+
+// registeredEnrichers provides the list of enrichers registered so far.
+func registeredEnrichers() []Enricher {
+	for _, e := range enrichers {
+		log.Noticef("using off-chain data enricher %s", e.Name())
+	}
+	return enrichers
+}
+
+// enrichmentCacheItem represents cached enrichment tags of an address.
+type enrichmentCacheItem struct {
+	tags    []types.EnrichmentTag
+	expires time.Time
+}
+
+// Enrichments provides the off-chain data tags of the given address.
+// Enrichers are never called on the resolver path; known tags are provided immediately
+// and a refresh is scheduled in background if they are missing, or expired.
+func (p *proxy) Enrichments(addr *common.Address) []types.EnrichmentTag {
+	if len(p.enrichers) == 0 {
+		return []types.EnrichmentTag{}
+	}
+
+	it, ok := p.enrichments.Load(*addr)
+	if ok && it.(*enrichmentCacheItem).expires.After(time.Now()) {
+		return it.(*enrichmentCacheItem).tags
+	}
+
+	// schedule the refresh, if there is a free slot; otherwise try next time
+	select {
+	case p.enrichSlots <- struct{}{}:
+		go func() {
+			defer func() { <-p.enrichSlots }()
+			p.apiRequestGroup.Do("enrich_"+addr.String(), func() (interface{}, error) {
+				p.enrich(*addr)
+				return nil, nil
+			})
+		}()
+	default:
+	}
+
+	if ok {
+		return it.(*enrichmentCacheItem).tags
+	}
+	return []types.EnrichmentTag{}
+}
+
+// enrich collects tags of the address from all the enrichers and updates the cache.
+// Tags of a failing enricher are kept from the previous run, if any.
+func (p *proxy) enrich(addr common.Address) {
+	var prev []types.EnrichmentTag
+	if it, ok := p.enrichments.Load(addr); ok {
+		prev = it.(*enrichmentCacheItem).tags
+	}
+
+	tags := make([]types.EnrichmentTag, 0)
+	for _, e := range p.enrichers {
+		ctx, cancel := context.WithTimeout(context.Background(), p.cfg.Enrichment.Timeout)
+		list, err := e.Enrich(ctx, &addr)
+		cancel()
+
+		if err != nil {
+			p.log.Errorf("enricher %s failed on %s; %s", e.Name(), addr.String(), err.Error())
+			for _, t := range prev {
+				if t.Source == e.Name() {
+					tags = append(tags, t)
+				}
+			}
+			continue
+		}
+
+		for _, t := range list {
+			t.Source = e.Name()
+			tags = append(tags, t)
+		}
+	}
+
+	p.enrichments.Store(addr, &enrichmentCacheItem{
+		tags:    tags,
+		expires: time.Now().Add(p.cfg.Enrichment.CacheTTL),
+	})
+}
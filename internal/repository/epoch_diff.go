@@ -0,0 +1,93 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EpochDiff compares the validator sets and the supply of the given sealed epochs.
+func (p *proxy) EpochDiff(from hexutil.Uint64, to hexutil.Uint64) (*types.EpochDiff, error) {
+	fromEp, err := p.Epoch(&from)
+	if err != nil {
+		return nil, err
+	}
+	toEp, err := p.Epoch(&to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromSet, err := p.EpochValidators(from)
+	if err != nil {
+		return nil, err
+	}
+	toSet, err := p.EpochValidators(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := types.EpochDiff{
+		From:            from,
+		To:              to,
+		TotalSupplyFrom: fromEp.TotalSupply,
+		TotalSupplyTo:   toEp.TotalSupply,
+		Validators:      make([]*types.EpochValidatorDiff, 0, len(toSet)),
+	}
+
+	// the base set is indexed by validator id so the compared set can be matched
+	base := make(map[uint64]*types.EpochValidator, len(fromSet))
+	stake := new(big.Int)
+	for _, ev := range fromSet {
+		base[ev.ValidatorId.ToInt().Uint64()] = ev
+		stake.Add(stake, ev.ReceivedStake.ToInt())
+	}
+	diff.TotalStakeFrom = hexutil.Big(*stake)
+
+	stake = new(big.Int)
+	for _, ev := range toSet {
+		stake.Add(stake, ev.ReceivedStake.ToInt())
+
+		vd := types.EpochValidatorDiff{ValidatorId: ev.ValidatorId, StakeTo: ev.ReceivedStake}
+		if prev, ok := base[ev.ValidatorId.ToInt().Uint64()]; ok {
+			vd.StakeFrom = prev.ReceivedStake
+			delete(base, ev.ValidatorId.ToInt().Uint64())
+		} else {
+			vd.IsNew = true
+		}
+		diff.Validators = append(diff.Validators, &vd)
+	}
+	diff.TotalStakeTo = hexutil.Big(*stake)
+
+	// what remains in the base set left the validator set
+	for _, ev := range base {
+		diff.Validators = append(diff.Validators, &types.EpochValidatorDiff{
+			ValidatorId: ev.ValidatorId,
+			StakeFrom:   ev.ReceivedStake,
+			IsRemoved:   true,
+		})
+	}
+
+	sort.Slice(diff.Validators, func(i, j int) bool {
+		di, dj := stakeDelta(diff.Validators[i]), stakeDelta(diff.Validators[j])
+		if c := di.CmpAbs(dj); c != 0 {
+			return c > 0
+		}
+		return diff.Validators[i].ValidatorId.ToInt().Cmp(diff.Validators[j].ValidatorId.ToInt()) < 0
+	})
+	return &diff, nil
+}
+
+// stakeDelta calculates the change of the stake received by the validator.
+func stakeDelta(vd *types.EpochValidatorDiff) *big.Int {
+	return new(big.Int).Sub(vd.StakeTo.ToInt(), vd.StakeFrom.ToInt())
+}
@@ -4,6 +4,7 @@ import (
 	"axis-graphql/internal/config"
 	"axis-graphql/internal/repository/cache"
 	"axis-graphql/internal/types"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -102,8 +103,8 @@ func (p *proxy) Erc20Decimals(token *common.Address) (int32, error) {
 
 // Erc20BalanceOf load the current available balance of and ERC20 token identified by the token
 // contract address for an identified owner address.
-func (p *proxy) Erc20BalanceOf(token *common.Address, owner *common.Address) (hexutil.Big, error) {
-	return p.rpc.Erc20BalanceOf(token, owner)
+func (p *proxy) Erc20BalanceOf(token *common.Address, owner *common.Address, block *big.Int) (hexutil.Big, error) {
+	return p.rpc.Erc20BalanceOf(token, owner, block)
 }
 
 // Erc20Allowance loads the current amount of ERC20 tokens unlocked for DeFi
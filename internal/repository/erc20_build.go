@@ -0,0 +1,125 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BuildErc20ApproveTx prepares an unsigned ERC20 transaction approving the spender
+// to transfer the given amount of tokens of the owner. The amount is in token units
+// with decimals, e.g. "12.5"; zero amount revokes the approval.
+func (p *proxy) BuildErc20ApproveTx(owner *common.Address, token *common.Address, spender *common.Address, amount string) (*types.PreparedTransaction, error) {
+	value, err := p.erc20Amount(token, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.rpc.Erc20CallData("approve", *spender, value)
+	if err != nil {
+		return nil, err
+	}
+	return p.prepareTransaction(owner, *token, data, new(big.Int))
+}
+
+// BuildErc20TransferTx prepares an unsigned ERC20 transaction sending the given amount of tokens
+// to the recipient. If the owner is given and differs from the sender, the tokens are transferred
+// from the owner's balance using the allowance of the sender. The amount is in token units with decimals.
+func (p *proxy) BuildErc20TransferTx(sender *common.Address, token *common.Address, owner *common.Address, recipient *common.Address, amount string) (*types.PreparedTransaction, error) {
+	value, err := p.erc20Amount(token, amount)
+	if err != nil {
+		return nil, err
+	}
+	if value.Sign() == 0 {
+		return nil, fmt.Errorf("invalid amount of tokens to transfer")
+	}
+
+	// own tokens are sent directly
+	if owner == nil || *owner == *sender {
+		owner = sender
+	}
+
+	// the owner must hold enough tokens
+	balance, err := p.rpc.Erc20BalanceOf(token, owner, nil)
+	if err != nil {
+		return nil, err
+	}
+	if balance.ToInt().Cmp(value) < 0 {
+		return nil, fmt.Errorf("insufficient token balance of %s", owner.String())
+	}
+
+	if owner == sender {
+		data, err := p.rpc.Erc20CallData("transfer", *recipient, value)
+		if err != nil {
+			return nil, err
+		}
+		return p.prepareTransaction(sender, *token, data, new(big.Int))
+	}
+
+	// the sender must be allowed to spend enough tokens of the owner
+	allowed, err := p.rpc.Erc20Allowance(token, owner, sender)
+	if err != nil {
+		return nil, err
+	}
+	if allowed.ToInt().Cmp(value) < 0 {
+		return nil, fmt.Errorf("insufficient allowance of %s to spend tokens of %s", sender.String(), owner.String())
+	}
+
+	data, err := p.rpc.Erc20CallData("transferFrom", *owner, *recipient, value)
+	if err != nil {
+		return nil, err
+	}
+	return p.prepareTransaction(sender, *token, data, new(big.Int))
+}
+
+// erc20Amount converts the amount of tokens in token units with decimals
+// into the raw amount using the decimals of the given token.
+func (p *proxy) erc20Amount(token *common.Address, amount string) (*big.Int, error) {
+	decimals, err := p.Erc20Decimals(token)
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenAmount(amount, decimals)
+}
+
+// parseTokenAmount parses a non-negative decimal amount, e.g. "12.5",
+// into the raw token amount with the given number of decimals.
+func parseTokenAmount(amount string, decimals int32) (*big.Int, error) {
+	whole, frac := strings.TrimSpace(amount), ""
+	if i := strings.IndexByte(whole, '.'); i >= 0 {
+		whole, frac = whole[:i], whole[i+1:]
+	}
+
+	// validate the digits
+	if whole == "" && frac == "" {
+		return nil, fmt.Errorf("invalid token amount %q", amount)
+	}
+	for _, c := range whole + frac {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid token amount %q", amount)
+		}
+	}
+
+	// the token can not represent more decimal places
+	frac = strings.TrimRight(frac, "0")
+	if int32(len(frac)) > decimals {
+		return nil, fmt.Errorf("token amount %q exceeds %d decimals of the token", amount, decimals)
+	}
+
+	val, _ := new(big.Int).SetString(whole+frac+strings.Repeat("0", int(decimals)-len(frac)), 10)
+	if val.BitLen() > 256 {
+		return nil, fmt.Errorf("token amount %q out of range", amount)
+	}
+	return val, nil
+}
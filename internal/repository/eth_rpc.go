@@ -0,0 +1,123 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	etc "github.com/ethereum/go-ethereum/core/types"
+)
+
+// IndexedTransaction returns a transaction from the in-memory cache, or the off-chain database,
+// without reaching the node. Nil is returned if the transaction is not indexed yet.
+func (p *proxy) IndexedTransaction(hash *common.Hash) (*types.Transaction, error) {
+	if trx := p.cache.PullTransaction(hash); trx != nil {
+		return trx, nil
+	}
+
+	trx, err := p.db.IndexedTransaction(hash)
+	if err != nil || trx == nil {
+		return nil, err
+	}
+
+	// large input is not kept in the database
+	if trx.LargeInput {
+		return p.Transaction(hash)
+	}
+	return trx, nil
+}
+
+// IndexedLogs returns log records matching the given filter loaded from the off-chain database.
+// Open block range boundaries are the last block known to the index.
+func (p *proxy) IndexedLogs(q *ethereum.FilterQuery) ([]etc.Log, error) {
+	from, to, err := p.indexedLogsRange(q)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the range
+	if from > to {
+		return []etc.Log{}, nil
+	}
+	if to-from >= p.cfg.EthRpc.MaxBlockRange {
+		return nil, fmt.Errorf("block range too large, max %d blocks allowed", p.cfg.EthRpc.MaxBlockRange)
+	}
+
+	return p.db.TransactionLogs(from, to, q.Addresses, func(lg *etc.Log) bool {
+		return matchLogFilter(lg, q)
+	}, p.cfg.EthRpc.MaxLogs)
+}
+
+// indexedLogsRange resolves the block range of the logs filter.
+func (p *proxy) indexedLogsRange(q *ethereum.FilterQuery) (uint64, uint64, error) {
+	// single block referenced by hash
+	if q.BlockHash != nil {
+		blk, err := p.BlockByHash(q.BlockHash)
+		if err != nil {
+			return 0, 0, err
+		}
+		return uint64(blk.Number), uint64(blk.Number), nil
+	}
+
+	top, err := p.db.LastKnownBlock()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	from, to := top, top
+	if q.FromBlock != nil {
+		from = q.FromBlock.Uint64()
+	}
+	if q.ToBlock != nil && q.ToBlock.Uint64() < top {
+		to = q.ToBlock.Uint64()
+	}
+	return from, to, nil
+}
+
+// matchLogFilter checks if the log record matches the addresses and topics of the filter.
+// Empty topic position matches any topic, multiple topics on a position match any of them.
+func matchLogFilter(lg *etc.Log, q *ethereum.FilterQuery) bool {
+	if len(q.Addresses) > 0 && !containsAddress(q.Addresses, lg.Address) {
+		return false
+	}
+	if len(q.Topics) > len(lg.Topics) {
+		return false
+	}
+
+	for i, set := range q.Topics {
+		if len(set) == 0 {
+			continue
+		}
+
+		found := false
+		for _, topic := range set {
+			if topic == lg.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAddress checks if the address is in the list.
+func containsAddress(list []common.Address, adr common.Address) bool {
+	for _, a := range list {
+		if a == adr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeesPaid provides a report of gas fees paid by the given address in the given time range
+// broken down by periods of the given resolution and by contracts interacted with.
+func (p *proxy) FeesPaid(adr *common.Address, from *time.Time, to *time.Time, resolution string) (*types.FeesPaidReport, error) {
+	// load the aggregated rows
+	rows, err := p.db.FeesPaid(adr, from, to, resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the report
+	rep := types.FeesPaidReport{
+		Address: *adr,
+		From:    *from,
+		To:      *to,
+		Fee:     new(big.Int),
+		Periods: make([]*types.FeesPaidPeriod, 0),
+	}
+
+	// rows are sorted by period so we just need to detect the period change
+	var pe *types.FeesPaidPeriod
+	for _, row := range rows {
+		if pe == nil || pe.Period != row.Period {
+			pe = &types.FeesPaidPeriod{Period: row.Period, Fee: new(big.Int), Contracts: make([]*types.FeesPaidContract, 0)}
+			rep.Periods = append(rep.Periods, pe)
+		}
+
+		// add the contract
+		fee := row.GasFee
+		fc := types.FeesPaidContract{Fee: fee, TrxCount: uint64(row.Count)}
+		if row.Recipient != nil {
+			addr := common.HexToAddress(*row.Recipient)
+			fc.Address = &addr
+		}
+		pe.Contracts = append(pe.Contracts, &fc)
+
+		// update totals
+		pe.Fee.Add(pe.Fee, fee)
+		pe.TrxCount += fc.TrxCount
+		rep.Fee.Add(rep.Fee, fee)
+		rep.TrxCount += fc.TrxCount
+	}
+	return &rep, nil
+}
@@ -0,0 +1,34 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+// IsBlockFinalized checks if the block of the given number is final. Lachesis blocks are final
+// on creation, the configured finality depth adds the number of blocks required on top of the block.
+func (p *proxy) IsBlockFinalized(num uint64) bool {
+	depth := p.cfg.Lachesis.FinalityDepth
+	if depth == 0 {
+		return true
+	}
+
+	// the observed head is preferred; the last indexed block is used until a head is observed
+	head := p.rpc.BlockProduction().LastBlock
+	if head == 0 {
+		var err error
+		if head, err = p.db.LastKnownBlock(); err != nil {
+			p.log.Errorf("can not check finality of block #%d; %s", num, err.Error())
+			return false
+		}
+	}
+	return num+depth <= head
+}
+
+// FinalityDepth provides the number of blocks required on top of a block to consider it final.
+func (p *proxy) FinalityDepth() uint64 {
+	return p.cfg.Lachesis.FinalityDepth
+}
@@ -8,7 +8,12 @@ results. BigCache for in-memory object storage to speed up loading of frequently
 */
 package repository
 
-import "axis-graphql/internal/types"
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+)
 
 // AddFMintTransaction adds the specified fMint transaction to persistent storage.
 func (p *proxy) AddFMintTransaction(trx *types.FMintTransaction) error {
@@ -20,3 +25,18 @@ func (p *proxy) AddFMintTransaction(trx *types.FMintTransaction) error {
 func (p *proxy) FMintUsers(tt int32) ([]*types.FMintUserTokens, error) {
 	return p.db.FMintUsers(tt)
 }
+
+// FMintTransactions provides a list of fMint transactions of the given user, if any.
+func (p *proxy) FMintTransactions(user *common.Address, cursor *string, count int32) (*types.FMintTransactionList, error) {
+	// prep the filter
+	fi := bson.D{}
+
+	// add user address to the filter
+	if user != nil {
+		fi = append(fi, bson.E{
+			Key:   types.FiFMintTransactionUser,
+			Value: user.String(),
+		})
+	}
+	return p.db.FMintTransactions(cursor, count, &fi)
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// FMintMaxMintable calculates the max amount of the given token the fMint account can mint
+// without dropping below the minimal collateral to debt ratio. The minting fee,
+// which is added to the debt of the account, is taken into account.
+func (p *proxy) FMintMaxMintable(addr *common.Address, token *common.Address) (hexutil.Big, error) {
+	dt, err := p.DefiToken(token)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if !dt.IsActive || !dt.CanMint {
+		return hexutil.Big{}, nil
+	}
+
+	ds, err := p.DefiConfiguration()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if ds.MinCollateralRatio4.ToInt().Sign() <= 0 {
+		return hexutil.Big{}, fmt.Errorf("minimal collateral ratio not available")
+	}
+
+	fa, err := p.FMintAccount(*addr)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+
+	// the max debt value allowed by the collateral
+	room := new(big.Int).Mul(fa.CollateralValue.ToInt(), collateralRatioDecimals)
+	room.Div(room, ds.MinCollateralRatio4.ToInt())
+	room.Sub(room, fa.DebtValue.ToInt())
+	if room.Sign() <= 0 {
+		return hexutil.Big{}, nil
+	}
+
+	// the minting fee is added to the debt, so the debt grows by amount x (1 + fee)
+	room.Mul(room, collateralRatioDecimals)
+	room.Div(room, new(big.Int).Add(collateralRatioDecimals, ds.MintFee4.ToInt()))
+
+	// convert the value to the token amount
+	price, digits, err := p.rpc.FMintExtendedPrice(token)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if price.Sign() <= 0 {
+		return hexutil.Big{}, fmt.Errorf("price of token %s not available", token.String())
+	}
+	return hexutil.Big(*room.Mul(room, digits).Div(room, price)), nil
+}
+
+// FMintRepayRequired calculates the debt the fMint account has to repay to reach the given
+// collateral to debt ratio represented in 4 digits. The amount is provided in ref. denomination (fUSD)
+// value, or in the given token units if the token is specified. Zero is returned if the account
+// already meets the ratio.
+func (p *proxy) FMintRepayRequired(addr *common.Address, ratio4 int64, token *common.Address) (hexutil.Big, error) {
+	if ratio4 <= 0 {
+		return hexutil.Big{}, fmt.Errorf("target ratio must be positive")
+	}
+
+	fa, err := p.FMintAccount(*addr)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+
+	// the max debt value at the target ratio
+	debt := new(big.Int).Mul(fa.CollateralValue.ToInt(), collateralRatioDecimals)
+	debt.Div(debt, big.NewInt(ratio4))
+
+	repay := new(big.Int).Sub(fa.DebtValue.ToInt(), debt)
+	if repay.Sign() <= 0 {
+		return hexutil.Big{}, nil
+	}
+	if token == nil {
+		return hexutil.Big(*repay), nil
+	}
+
+	// convert the value to the token amount; round up so the ratio is actually reached
+	dt, err := p.DefiToken(token)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if !dt.CanMint {
+		return hexutil.Big{}, fmt.Errorf("token %s is not an fMint debt token", token.String())
+	}
+
+	price, digits, err := p.rpc.FMintExtendedPrice(token)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if price.Sign() <= 0 {
+		return hexutil.Big{}, fmt.Errorf("price of token %s not available", token.String())
+	}
+
+	repay.Mul(repay, digits)
+	repay.Add(repay, new(big.Int).Sub(price, big.NewInt(1)))
+	return hexutil.Big(*repay.Div(repay, price)), nil
+}
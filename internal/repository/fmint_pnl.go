@@ -0,0 +1,226 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// fMintValueDecimals represents the number of decimals of fUSD values calculated for PnL.
+const fMintValueDecimals = 18
+
+// fMintPosition represents the state of a single fMint position being replayed from history.
+type fMintPosition struct {
+	token    *types.DefiToken
+	side     types.DefiTokenType
+	amount   *big.Int
+	cost     *big.Int
+	realized *big.Int
+	fees     *big.Int
+}
+
+// FMintAccountPnL calculates realized and unrealized profit and loss of the given fMint account
+// by replaying its position history. Each position change is valued at the oracle price recorded
+// when the change was indexed; changes without the price are valued at the current price.
+// Collateral positions profit on price increase, debt positions profit on price decrease.
+func (p *proxy) FMintAccountPnL(addr *common.Address) (*types.FMintAccountPnL, error) {
+	// load the history
+	hist, err := p.db.FMintUserTransactions(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// replay the history on positions; keep the order of positions as they were opened
+	prices := make(map[common.Address]*big.Int)
+	positions := make(map[string]*fMintPosition)
+	order := make([]string, 0)
+
+	for _, trx := range hist {
+		pos, err := p.fMintPosition(positions, &order, trx)
+		if err != nil {
+			return nil, err
+		}
+
+		// get the price of the change
+		price := trx.Price.ToInt()
+		if price.Sign() == 0 {
+			price, err = p.fMintCurrentPrice(prices, &trx.TokenAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pos.apply(trx, price)
+	}
+
+	// collect the result
+	pnl := types.FMintAccountPnL{
+		Address:   *addr,
+		Positions: make([]*types.FMintTokenPnL, 0, len(order)),
+	}
+	realized, unrealized, fees := new(big.Int), new(big.Int), new(big.Int)
+
+	for _, key := range order {
+		pos := positions[key]
+		price, err := p.fMintCurrentPrice(prices, &pos.token.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		tp := pos.pnl(price)
+		realized.Add(realized, tp.Realized.ToInt())
+		unrealized.Add(unrealized, tp.Unrealized.ToInt())
+		fees.Add(fees, tp.Fees.ToInt())
+		pnl.Positions = append(pnl.Positions, tp)
+	}
+
+	pnl.Realized = hexutil.Big(*realized)
+	pnl.Unrealized = hexutil.Big(*unrealized)
+	pnl.Fees = hexutil.Big(*fees)
+
+	// get the native token price so the PnL can be expressed in native terms
+	native, err := p.NativeTokenAddress()
+	if err != nil {
+		log.Errorf("native token not available for fMint PnL; %s", err.Error())
+		return &pnl, nil
+	}
+	np, err := p.fMintCurrentPrice(prices, native)
+	if err != nil {
+		return &pnl, nil
+	}
+
+	// normalize the price to the fUSD value decimals using value of a single native token
+	tk, err := p.DefiToken(native)
+	if err != nil {
+		log.Errorf("native token not available for fMint PnL; %s", err.Error())
+		return &pnl, nil
+	}
+	one := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tk.Decimals)), nil)
+	pnl.NativePrice = hexutil.Big(*defiValue(tk, one, np))
+	return &pnl, nil
+}
+
+// fMintPosition provides the position the given fMint transaction belongs to.
+func (p *proxy) fMintPosition(positions map[string]*fMintPosition, order *[]string, trx *types.FMintTransaction) (*fMintPosition, error) {
+	side := types.DefiTokenTypeCollateral
+	if trx.Type == types.FMintTrxTypeMint || trx.Type == types.FMintTrxTypeRepay {
+		side = types.DefiTokenTypeDebt
+	}
+
+	// do we know the position already?
+	key := string(side) + trx.TokenAddress.String()
+	if pos, ok := positions[key]; ok {
+		return pos, nil
+	}
+
+	// get the token details so we can normalize values
+	tk, err := p.DefiToken(&trx.TokenAddress)
+	if err != nil {
+		log.Errorf("fMint token %s not available; %s", trx.TokenAddress.String(), err.Error())
+		return nil, err
+	}
+
+	pos := fMintPosition{
+		token:    tk,
+		side:     side,
+		amount:   new(big.Int),
+		cost:     new(big.Int),
+		realized: new(big.Int),
+		fees:     new(big.Int),
+	}
+	positions[key] = &pos
+	*order = append(*order, key)
+	return &pos, nil
+}
+
+// fMintCurrentPrice provides the current oracle price of the given token
+// using the given map as a local cache.
+func (p *proxy) fMintCurrentPrice(prices map[common.Address]*big.Int, token *common.Address) (*big.Int, error) {
+	if price, ok := prices[*token]; ok {
+		return price, nil
+	}
+
+	price, err := p.DefiTokenPrice(token)
+	if err != nil {
+		return nil, err
+	}
+	prices[*token] = price.ToInt()
+	return prices[*token], nil
+}
+
+// apply updates the position with the given fMint transaction valued at the given price.
+func (pos *fMintPosition) apply(trx *types.FMintTransaction, price *big.Int) {
+	amount := trx.Amount.ToInt()
+
+	switch trx.Type {
+	case types.FMintTrxTypeDeposit, types.FMintTrxTypeMint:
+		pos.amount.Add(pos.amount, amount)
+		pos.cost.Add(pos.cost, pos.value(amount, price))
+		pos.fees.Add(pos.fees, pos.value(trx.Fee.ToInt(), price))
+
+	case types.FMintTrxTypeWithdraw, types.FMintTrxTypeRepay:
+		// nothing to close?
+		if pos.amount.Sign() == 0 {
+			return
+		}
+
+		// we can not close more than we have
+		if amount.Cmp(pos.amount) > 0 {
+			amount = pos.amount
+		}
+
+		// calculate the cost basis of the closed part at the average entry price
+		basis := new(big.Int).Div(new(big.Int).Mul(pos.cost, amount), pos.amount)
+		gain := new(big.Int).Sub(pos.value(amount, price), basis)
+		if pos.side == types.DefiTokenTypeDebt {
+			gain.Neg(gain)
+		}
+
+		pos.realized.Add(pos.realized, gain)
+		pos.cost.Sub(pos.cost, basis)
+		pos.amount = new(big.Int).Sub(pos.amount, amount)
+	}
+}
+
+// pnl calculates the profit and loss of the position at the given current price.
+func (pos *fMintPosition) pnl(price *big.Int) *types.FMintTokenPnL {
+	gain := new(big.Int).Sub(pos.value(pos.amount, price), pos.cost)
+	if pos.side == types.DefiTokenTypeDebt {
+		gain.Neg(gain)
+	}
+
+	return &types.FMintTokenPnL{
+		Token:      pos.token.Address,
+		Type:       pos.side,
+		Amount:     hexutil.Big(*pos.amount),
+		CostBasis:  hexutil.Big(*pos.cost),
+		Realized:   hexutil.Big(*pos.realized),
+		Unrealized: hexutil.Big(*gain),
+		Fees:       hexutil.Big(*pos.fees),
+	}
+}
+
+// value calculates the fUSD value of the given amount of the position token at the given price.
+func (pos *fMintPosition) value(amount *big.Int, price *big.Int) *big.Int {
+	return defiValue(pos.token, amount, price)
+}
+
+// defiValue calculates the fUSD value of the given amount of the DeFi token at the given oracle price.
+// The value is normalized to 18 decimals regardless of the token and price oracle decimals.
+func defiValue(tk *types.DefiToken, amount *big.Int, price *big.Int) *big.Int {
+	val := new(big.Int).Mul(amount, price)
+	exp := int64(tk.Decimals) + int64(tk.PriceDecimals) - fMintValueDecimals
+	if exp > 0 {
+		return val.Div(val, new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil))
+	}
+	return val.Mul(val, new(big.Int).Exp(big.NewInt(10), big.NewInt(-exp), nil))
+}
@@ -0,0 +1,75 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SnapshotFMintPositions takes snapshots of all the open fMint positions, e.g. the accounts
+// of users who ever minted with any debt outstanding, and stores them under the given slot.
+// It returns the number of positions captured.
+func (p *proxy) SnapshotFMintPositions(slot string) (int, error) {
+	users, err := p.db.FMintUsers(types.FMintTrxTypeMint)
+	if err != nil {
+		return 0, err
+	}
+
+	// the minimal ratio is stored with the snapshot so the distance to liquidation can be charted
+	var min int64
+	ds, err := p.DefiConfiguration()
+	if err == nil && ds.MinCollateralRatio4.ToInt().IsInt64() {
+		min = ds.MinCollateralRatio4.ToInt().Int64()
+	}
+
+	var epoch hexutil.Uint64
+	if ep, err := p.CurrentSealedEpoch(); err == nil && ep != nil {
+		epoch = ep.Id
+	}
+
+	now := time.Now().UTC()
+	list := make([]*types.FMintPositionSnapshot, 0, len(users))
+	for _, usr := range users {
+		fa, err := p.rpc.FMintAccount(&usr.User)
+		if err != nil {
+			p.log.Errorf("can not snapshot fMint position of %s; %s", usr.User.String(), err.Error())
+			continue
+		}
+
+		// closed positions are not captured
+		if fa.DebtValue.ToInt().Sign() == 0 {
+			continue
+		}
+
+		list = append(list, &types.FMintPositionSnapshot{
+			Address:         usr.User,
+			Epoch:           epoch,
+			Stamp:           now,
+			CollateralValue: fa.CollateralValue,
+			DebtValue:       fa.DebtValue,
+			Ratio4:          collateralRatio4(fa),
+			MinRatio4:       min,
+		})
+	}
+
+	if err := p.db.AddFMintPositionSnapshots(list, slot); err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+// FMintPositionHistory provides snapshots of the fMint position of the given address
+// taken in the given number of recent days, the oldest first.
+func (p *proxy) FMintPositionHistory(addr *common.Address, days int) ([]*types.FMintPositionSnapshot, error) {
+	return p.db.FMintPositionHistory(addr, time.Now().UTC().AddDate(0, 0, -days))
+}
@@ -0,0 +1,71 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"time"
+)
+
+// gasPriceHistoryBackfillDays represents the number of past days checked for missing gas price history.
+const gasPriceHistoryBackfillDays = 90
+
+// gasPriceHistoryCloseDelay represents the time after the midnight a day is closed,
+// so late indexed transactions of the day are still counted.
+const gasPriceHistoryCloseDelay = time.Hour
+
+// GasPriceHistory provides daily gas price distributions in the given date range.
+func (p *proxy) GasPriceHistory(from *time.Time, to *time.Time) ([]*types.DailyGasPrice, error) {
+	return p.db.GasPriceDailyList(from, to)
+}
+
+// GasPriceHistoryUpdate updates the gas price distribution of today and closes
+// the yesterday, if not closed yet. If backfill is requested, the missing days
+// of the recent history are calculated as well.
+func (p *proxy) GasPriceHistoryUpdate(backfill bool) {
+	// calculate the last midnight
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	from := today.AddDate(0, 0, -1)
+	if backfill {
+		from = today.AddDate(0, 0, -gasPriceHistoryBackfillDays)
+	}
+
+	for _, day := range p.gasPriceHistoryOpen(from, today) {
+		closed := now.After(day.Add(24*time.Hour + gasPriceHistoryCloseDelay))
+		if err := p.db.GasPriceDailyUpdate(day, closed); err != nil {
+			p.log.Criticalf("can not update gas price history of %s; %s", day.Format("2006-01-02"), err.Error())
+			return
+		}
+	}
+	p.log.Debugf("gas price history updated")
+}
+
+// gasPriceHistoryOpen provides the list of days of the given range without closed gas price history;
+// today is always on the list.
+func (p *proxy) gasPriceHistoryOpen(from time.Time, today time.Time) []time.Time {
+	list, err := p.db.GasPriceDailyList(&from, &today)
+	if err != nil {
+		return nil
+	}
+
+	closed := make(map[string]bool, len(list))
+	for _, d := range list {
+		closed[d.Day] = d.Closed
+	}
+
+	days := make([]time.Time, 0)
+	for day := from; day.Before(today); day = day.AddDate(0, 0, 1) {
+		if !closed[day.Format("2006-01-02")] {
+			days = append(days, day)
+		}
+	}
+	return append(days, today)
+}
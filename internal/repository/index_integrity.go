@@ -0,0 +1,24 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+)
+
+// IndexedBlockTransactions returns transactions of the given block stored in the off-chain database.
+func (p *proxy) IndexedBlockTransactions(num uint64) ([]*types.Transaction, error) {
+	return p.db.BlockTransactions(num)
+}
+
+// RemoveIndexedBlock removes indexed transactions of the given block from the off-chain database
+// so the block can be dispatched for indexing again.
+func (p *proxy) RemoveIndexedBlock(num uint64) (int64, error) {
+	return p.db.RemoveBlockTransactions(num)
+}
@@ -12,9 +12,12 @@ import (
 	"axis-graphql/internal/config"
 	"axis-graphql/internal/repository/rpc/contracts"
 	"axis-graphql/internal/types"
+	"encoding/json"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	etc "github.com/ethereum/go-ethereum/core/types"
@@ -25,11 +28,21 @@ type Repository interface {
 	// Account returns account at AXIS blockchain for an address, nil if not found.
 	Account(*common.Address) (*types.Account, error)
 
-	// AccountBalance returns the current balance of an account at AXIS blockchain.
-	AccountBalance(*common.Address) (*hexutil.Big, error)
+	// AccountBalance returns the balance of an account at AXIS blockchain
+	// at the given block; nil represents the latest block.
+	AccountBalance(*common.Address, *big.Int) (*hexutil.Big, error)
 
-	// AccountNonce returns the current number of sent transactions of an account at AXIS blockchain.
-	AccountNonce(*common.Address) (*hexutil.Uint64, error)
+	// AccountBalances returns the balances of the given accounts at the given block loaded
+	// in a single batch; nil block represents the latest block. Balances not available are nil.
+	AccountBalances([]common.Address, *big.Int) ([]*hexutil.Big, error)
+
+	// AccountNonce returns the number of sent transactions of an account at AXIS blockchain
+	// at the given block; nil represents the latest block.
+	AccountNonce(*common.Address, *big.Int) (*hexutil.Uint64, error)
+
+	// AccountCode returns the deployed byte code of a contract account at AXIS blockchain;
+	// the code is empty for wallet accounts.
+	AccountCode(*common.Address) (hexutil.Bytes, error)
 
 	// AccountTransactions returns list of transaction hashes for account at AXIS blockchain.
 	//
@@ -59,12 +72,35 @@ type Repository interface {
 	// AccountMarkActivity marks the latest account activity in the repository.
 	AccountMarkActivity(*common.Address, uint64) error
 
+	// AccountActivityMark adds the transaction of the given ordinal index
+	// made at the given time to the daily activity of the account.
+	AccountActivityMark(*common.Address, uint64, uint64) error
+
+	// AccountActivityHeatmap provides the daily transaction counts of the account for the last year.
+	AccountActivityHeatmap(*common.Address) ([]*types.AccountActivityDay, error)
+
+	// AccountFirstSeen returns the time stamp of the first known activity of the given account.
+	AccountFirstSeen(*types.Account) (hexutil.Uint64, error)
+
 	// BlockHeight returns the current height of the AXIS blockchain in blocks.
 	BlockHeight() (*hexutil.Big, error)
 
+	// CallBlockHeight returns the height of the block the default contract calls read the state of;
+	// it trails the current height by the configured call block lag.
+	CallBlockHeight() (*hexutil.Big, error)
+
 	// LastKnownBlock returns number of the last block known to the repository.
 	LastKnownBlock() (uint64, error)
 
+	// BlockTraces loads raw call traces of all the transactions of the given block from the node.
+	BlockTraces(uint64) ([]json.RawMessage, error)
+
+	// IsBlockFinalized checks if the block of the given number is final.
+	IsBlockFinalized(uint64) bool
+
+	// FinalityDepth provides the number of blocks required on top of a block to consider it final.
+	FinalityDepth() uint64
+
 	// UpdateLastKnownBlock update record about last known block.
 	UpdateLastKnownBlock(blockNo *hexutil.Uint64) error
 
@@ -77,6 +113,9 @@ type Repository interface {
 	// If the block is not found, ErrBlockNotFound error is returned.
 	BlockByNumber(*hexutil.Uint64) (*types.Block, error)
 
+	// ReloadBlock pulls a block of the given number from the node bypassing the cache.
+	ReloadBlock(*hexutil.Uint64) (*types.Block, error)
+
 	// BlockByHash returns a block at AXIS blockchain represented by a hash.
 	// Top block is returned if the hash is not provided.
 	// If the block is not found, ErrBlockNotFound error is returned.
@@ -103,6 +142,16 @@ type Repository interface {
 	// StoreContract updates the contract in repository.
 	StoreContract(*types.Contract) error
 
+	// ProxyImplementation resolves the current implementation address of an EIP-1967 proxy contract.
+	// Nil is returned if the contract is not a proxy.
+	ProxyImplementation(*common.Address) (*common.Address, error)
+
+	// StoreContractUpgrade stores an upgrade of a proxy contract in the persistent storage.
+	StoreContractUpgrade(*types.ContractUpgrade) error
+
+	// ContractUpgrades provides the most recent upgrades of the proxy contract.
+	ContractUpgrades(*common.Address, int32) ([]*types.ContractUpgrade, error)
+
 	// SfcVersion returns current version of the SFC contract.
 	SfcVersion() (hexutil.Uint64, error)
 
@@ -124,6 +173,32 @@ type Repository interface {
 	// CurrentSealedEpoch returns the data of the latest sealed epoch.
 	CurrentSealedEpoch() (*types.Epoch, error)
 
+	// EpochRewardsDistributed calculates the total amount of rewards distributed
+	// to validators and their delegators on sealing the given epoch.
+	EpochRewardsDistributed(hexutil.Uint64) (*big.Int, error)
+
+	// SnapshotEpochValidators pulls the validator set of the given sealed epoch
+	// from the SFC contract and stores it in the persistent storage.
+	SnapshotEpochValidators(hexutil.Uint64) ([]*types.EpochValidator, error)
+
+	// EpochValidators provides the validator set of the given sealed epoch.
+	EpochValidators(hexutil.Uint64) ([]*types.EpochValidator, error)
+
+	// Decentralization calculates stake distribution metrics of the validator set
+	// of the given sealed epoch; the latest sealed epoch is used if not specified.
+	Decentralization(*hexutil.Uint64) (*types.Decentralization, error)
+
+	// EpochDiff compares the validator sets and the supply of the given sealed epochs.
+	EpochDiff(hexutil.Uint64, hexutil.Uint64) (*types.EpochDiff, error)
+
+	// ValidatorEarnings aggregates self-stake rewards, commission earned from delegators
+	// and fees originated by the given validator in the given range of sealed epochs.
+	ValidatorEarnings(*hexutil.Big, hexutil.Uint64, hexutil.Uint64, string) (*types.ValidatorEarnings, error)
+
+	// ValidatorEstimate projects the epoch income of a prospective validator
+	// with the given self-stake and the expected amount of received delegations.
+	ValidatorEstimate(*big.Int, *big.Int) (*types.ValidatorEstimate, error)
+
 	// Epochs pulls list of epochs starting at the specified cursor.
 	Epochs(cursor *string, count int32) (*types.EpochList, error)
 
@@ -142,6 +217,10 @@ type Repository interface {
 	// IsStiContract returns true if the given address points to the STI contract.
 	IsStiContract(*common.Address) bool
 
+	// ResolveContractRegistry resolves addresses of the core contracts from the on-chain
+	// address registry and applies the changed ones; the list of applied changes is returned.
+	ResolveContractRegistry() ([]types.ContractRegistryChange, error)
+
 	// StoreTransaction adds a new incoming transaction from blockchain to the repository.
 	StoreTransaction(*types.Block, *types.Transaction) error
 
@@ -155,6 +234,9 @@ type Repository interface {
 	// Transactions returns list of transaction hashes at AXIS blockchain.
 	Transactions(*string, int32) (*types.TransactionList, error)
 
+	// TransactionsInBlockRange returns list of transactions included in blocks of the given range.
+	TransactionsInBlockRange(*hexutil.Uint64, *hexutil.Uint64, *string, int32) (*types.TransactionList, error)
+
 	// TransactionsCount returns total number of transactions in the block chain.
 	TransactionsCount() (uint64, error)
 
@@ -173,6 +255,57 @@ type Repository interface {
 	// SendTransaction sends raw signed and RLP encoded transaction to the block chain.
 	SendTransaction(hexutil.Bytes) (*types.Transaction, error)
 
+	// DecodeTransaction decodes raw RLP encoded transaction without sending it to the block chain.
+	DecodeTransaction(hexutil.Bytes) (*types.DecodedTransaction, error)
+
+	// IndexedTransaction returns a transaction from the cache, or the off-chain database, nil if not indexed.
+	IndexedTransaction(*common.Hash) (*types.Transaction, error)
+
+	// IndexedLogs returns log records matching the given filter loaded from the off-chain database.
+	IndexedLogs(*ethereum.FilterQuery) ([]etc.Log, error)
+
+	// IndexedBlockTransactions returns transactions of the given block stored in the off-chain database.
+	IndexedBlockTransactions(uint64) ([]*types.Transaction, error)
+
+	// RemoveIndexedBlock removes indexed transactions of the given block from the off-chain database.
+	RemoveIndexedBlock(uint64) (int64, error)
+
+	// ContractMethodStats provides usage statistics of methods of the given contract called in the given period.
+	ContractMethodStats(*common.Address, string) ([]types.ContractMethodStat, error)
+
+	// CreateAddressGroup registers a new named group of addresses owned by the given client.
+	CreateAddressGroup(owner string, name string, addrs []common.Address) (*types.AddressGroup, error)
+
+	// UpdateAddressGroup changes the name and/or the addresses of the group owned by the given client.
+	UpdateAddressGroup(owner string, id string, name *string, addrs []common.Address) (*types.AddressGroup, error)
+
+	// RemoveAddressGroup removes the address group owned by the given client.
+	RemoveAddressGroup(owner string, id string) (bool, error)
+
+	// AddressGroup loads the address group owned by the given client, nil if not found.
+	AddressGroup(owner string, id string) (*types.AddressGroup, error)
+
+	// AddressGroups loads all the address groups owned by the given client.
+	AddressGroups(owner string) ([]*types.AddressGroup, error)
+
+	// AddressGroupTransactions provides the list of transactions sent, or received, by any address of the group.
+	AddressGroupTransactions(*types.AddressGroup, *string, int32) (*types.TransactionList, error)
+
+	// StartAccountExport registers a new background export of the account transactions owned by the given client.
+	StartAccountExport(owner string, addr *common.Address) (*types.AccountExport, error)
+
+	// AccountExport provides the export of the given identifier owned by the given client, nil if not found.
+	AccountExport(owner string, id string) *types.AccountExport
+
+	// AccountExportUrl provides the signed download URL of the finished export.
+	AccountExportUrl(*types.AccountExport) string
+
+	// AccountExportFile verifies the signed download request of an export and opens the export file for reading.
+	AccountExportFile(id string, expires int64, sig string) (*types.AccountExportFile, error)
+
+	// AccountExportsMaintain picks up abandoned exports and removes files of expired exports.
+	AccountExportsMaintain()
+
 	// LastValidatorId returns the last validator id in AXIS blockchain.
 	LastValidatorId() (uint64, error)
 
@@ -191,6 +324,12 @@ type Repository interface {
 	// ValidatorByAddress extract a staker information by address.
 	ValidatorByAddress(*common.Address) (*types.Validator, error)
 
+	// Validators provides a consistent snapshot of the list of all validators ordered by the validator ID.
+	Validators() ([]*types.Validator, error)
+
+	// InvalidateValidator marks the validator as changed so it's re-loaded on the next read of the validators list.
+	InvalidateValidator(*hexutil.Big)
+
 	// ValidatorDowntime pulls information about validator downtime from the RPC interface.
 	ValidatorDowntime(*hexutil.Big) (uint64, uint64, error)
 
@@ -209,33 +348,61 @@ type Repository interface {
 	// RetrieveStakerInfo gets staker information from in-memory if available.
 	RetrieveStakerInfo(*hexutil.Big) *types.StakerInfo
 
+	// StakerInfoUrl extracts the staker information URL registered for the staker.
+	StakerInfoUrl(*hexutil.Big) (string, error)
+
+	// UpgradeReadiness aggregates the active stake by the node versions published by validators.
+	UpgradeReadiness(*string) (*types.UpgradeReadiness, error)
+
+	// ValidatorPubkey extracts the public key of the validator.
+	ValidatorPubkey(*hexutil.Big) ([]byte, error)
+
+	// StoreValidatorChange stores a validator management change in the persistent storage.
+	StoreValidatorChange(*types.ValidatorChange) error
+
+	// ValidatorChanges provides the most recent management changes of the validator.
+	ValidatorChanges(*hexutil.Big, int32) ([]*types.ValidatorChange, error)
+
 	// IsDelegating returns if the given address is an SFC delegator.
 	IsDelegating(*common.Address) (bool, error)
 
 	// StoreDelegation stores a delegation in the persistent repository.
 	StoreDelegation(*types.Delegation) error
 
+	// DelegationsWithoutOrigin provides a batch of delegations with the original delegation event not verified yet.
+	DelegationsWithoutOrigin(int64) ([]*types.Delegation, error)
+
+	// BackfillDelegationOrigin updates the creation time and transaction of the delegation
+	// to the original delegation event found on the chain.
+	BackfillDelegationOrigin(*types.Delegation) error
+
 	// UpdateDelegationBalance updates active balance of the given delegation.
 	UpdateDelegationBalance(*common.Address, *hexutil.Big, func(*big.Int) error) error
 
 	// Delegation returns a detail of delegation for the given address and validator ID.
 	Delegation(*common.Address, *hexutil.Big) (*types.Delegation, error)
 
-	// DelegationAmountStaked returns the current amount of staked tokens
-	// for the given delegation.
-	DelegationAmountStaked(*common.Address, *hexutil.Big) (*big.Int, error)
+	// DelegationAmountStaked returns the amount of staked tokens for the given delegation
+	// at the given block; nil represents the latest block.
+	DelegationAmountStaked(*common.Address, *hexutil.Big, *big.Int) (*big.Int, error)
 
-	// DelegationsByAddress returns a list of all delegations of a given delegator address.
-	DelegationsByAddress(*common.Address, *string, int32) (*types.DelegationList, error)
+	// DelegationsByAddress returns a list of all delegations of a given delegator address
+	// in the order and scope of the given list options.
+	DelegationsByAddress(*common.Address, *string, int32, *types.DelegationListOptions) (*types.DelegationList, error)
 
 	// DelegationsByAddressAll returns a list of all delegations of the given address un-paged.
 	DelegationsByAddressAll(addr *common.Address) ([]*types.Delegation, error)
 
-	// DelegationsOfValidator extracts a list of delegations for a validator by its ID.
-	DelegationsOfValidator(*hexutil.Big, *string, int32) (*types.DelegationList, error)
+	// DelegationsOfValidator extracts a list of delegations for a validator by its ID
+	// in the order and scope of the given list options.
+	DelegationsOfValidator(*hexutil.Big, *string, int32, *types.DelegationListOptions) (*types.DelegationList, error)
+
+	// UpdateDelegationLock updates the lock end time of the given delegation from the SFC contract.
+	UpdateDelegationLock(*common.Address, *hexutil.Big) error
 
-	// DelegationLock returns delegation lock information using SFC contract binding.
-	DelegationLock(*common.Address, *hexutil.Big) (*types.DelegationLock, error)
+	// DelegationLock returns delegation lock information using SFC contract binding
+	// at the given block; nil represents the latest block.
+	DelegationLock(*common.Address, *hexutil.Big, *big.Int) (*types.DelegationLock, error)
 
 	// DelegationUnlockPenalty returns the amount of penalty applied on given stake unlock.
 	DelegationUnlockPenalty(addr *common.Address, valID *big.Int, amount *big.Int) (hexutil.Big, error)
@@ -243,8 +410,9 @@ type Repository interface {
 	// DelegationAmountUnlocked returns delegation lock information using SFC contract binding.
 	DelegationAmountUnlocked(addr *common.Address, valID *big.Int) (hexutil.Big, error)
 
-	// PendingRewards returns a detail of pending rewards for the given delegation.
-	PendingRewards(*common.Address, *hexutil.Big) (*types.PendingRewards, error)
+	// PendingRewards returns a detail of pending rewards for the given delegation
+	// at the given block; nil represents the latest block.
+	PendingRewards(*common.Address, *hexutil.Big, *big.Int) (*types.PendingRewards, error)
 
 	// DelegationOutstandingSAXIS returns the amount of sAXIS tokens for the delegation
 	// identified by the delegator address and the staker id.
@@ -254,6 +422,37 @@ type Repository interface {
 	// for a delegation identified by the address and staker id.
 	DelegationTokenizerUnlocked(*common.Address, *hexutil.Big) (bool, error)
 
+	// BuildMintSAXISTx prepares an unsigned SFC Tokenizer transaction minting sAXIS tokens
+	// for the locked delegation of the given address to the given validator.
+	BuildMintSAXISTx(*common.Address, *hexutil.Big) (*types.PreparedTransaction, error)
+
+	// BuildBurnSAXISTx prepares an unsigned SFC Tokenizer transaction burning the given amount
+	// of sAXIS tokens minted for the delegation of the given address to the given validator.
+	BuildBurnSAXISTx(*common.Address, *hexutil.Big, *hexutil.Big) (*types.PreparedTransaction, error)
+
+	// IsSAXISToken returns true if the given address points to the sAXIS token of the SFC Tokenizer.
+	IsSAXISToken(*common.Address) bool
+
+	// TokenizerCallValidator decodes the validator of the given SFC Tokenizer mint, or redeem call;
+	// nil is returned if the transaction does not call the SFC Tokenizer directly.
+	TokenizerCallValidator(*types.Transaction) *hexutil.Big
+
+	// StoreTokenizerEvent stores the given SFC Tokenizer mint, or burn event in the persistent storage.
+	StoreTokenizerEvent(*types.TokenizerEvent) error
+
+	// TokenizerEvents provides the most recent sAXIS mints and burns of the given account.
+	TokenizerEvents(*common.Address, int32) ([]*types.TokenizerEvent, error)
+
+	// SAXISSupply provides the daily history of sAXIS tokens outstanding for the given number of days.
+	SAXISSupply(int) ([]*types.SAXISSupply, error)
+
+	// SAXISCollateral provides the amount of sAXIS tokens outstanding by validator.
+	SAXISCollateral() ([]*types.SAXISCollateral, error)
+
+	// ValidateStakeAction checks the stake action against the SFC contract constraints
+	// and provides the list of violations found.
+	ValidateStakeAction(*types.StakeAction) ([]types.StakeValidationError, error)
+
 	// DelegationFluidStakingActive signals if the delegation is upgraded to Fluid Staking model.
 	DelegationFluidStakingActive(*common.Address, *hexutil.Big) (bool, error)
 
@@ -269,6 +468,26 @@ type Repository interface {
 	// WithdrawRequests extracts a list of withdraw requests for the given address and validator.
 	WithdrawRequests(*common.Address, *hexutil.Big, *string, int32) (*types.WithdrawRequestList, error)
 
+	// WithdrawableRequests provides pending withdraw requests of the given delegation past the withdrawal period.
+	WithdrawableRequests(*common.Address, *hexutil.Big) ([]*types.WithdrawRequest, error)
+
+	// ValidatorExit provides the exit status of the given validator, the pending un-delegations against it
+	// and the projected time they become withdrawable.
+	ValidatorExit(*hexutil.Big) (*types.ValidatorExit, error)
+
+	// DelegationDustThreshold provides the amount in WEI below which a delegation is considered dust.
+	DelegationDustThreshold() *big.Int
+
+	// DelegationIsDust checks if the given active delegation amount is positive, but below the dust threshold.
+	DelegationIsDust(*big.Int) bool
+
+	// UpcomingEvents collects the known future time-based events of the given addresses
+	// and the voting deadlines of open governance proposals.
+	UpcomingEvents([]common.Address) ([]*types.CalendarEvent, error)
+
+	// DelegationNextActions provides the list of actions recommended to the given delegator on its delegations.
+	DelegationNextActions(*common.Address) ([]*types.DelegationAction, error)
+
 	// WithdrawRequestsPendingTotal is the total value of all pending withdrawal requests
 	// for the given delegator and target staker ID.
 	WithdrawRequestsPendingTotal(*common.Address, *hexutil.Big) (*big.Int, error)
@@ -280,12 +499,30 @@ type Repository interface {
 	// for the given delegator address and validator ID.
 	RewardsClaimed(adr *common.Address, valId *big.Int, since *int64, until *int64) (*big.Int, error)
 
+	// RewardsClaimedSplit returns the sums of rewards of the delegation claimed
+	// to the delegator account and re-staked into the delegation, respectively.
+	RewardsClaimedSplit(adr *common.Address, valId *big.Int) (*big.Int, *big.Int, error)
+
+	// StashedRewards returns the amount of rewards of the delegation stashed in the SFC contract
+	// at the given block; the latest state is used if the block is not specified.
+	StashedRewards(adr *common.Address, valId *big.Int, block *big.Int) (*big.Int, error)
+
 	// RewardClaims provides list of reward claims for the given criteria.
 	RewardClaims(*common.Address, *big.Int, *string, int32) (*types.RewardClaimsList, error)
 
+	// RecomputeDelegationRewards compares the most recent reward claims of the delegation
+	// with the pending rewards read from the archive state before the claims.
+	RecomputeDelegationRewards(*common.Address, *hexutil.Big, int32) (*types.RewardRecomputationReport, error)
+
 	// Price returns a price information for the given target symbol.
 	Price(sym string) (types.Price, error)
 
+	// HistoricalPrice provides the daily price of the native token in the given target symbol at the given time.
+	HistoricalPrice(string, time.Time) (float64, error)
+
+	// TaxReport builds the report of staking rewards realized by the delegator in the given calendar year.
+	TaxReport(*common.Address, int32, string) (*types.TaxReport, error)
+
 	// GasPrice provides the raw suggested value for the gas price.
 	GasPrice() (hexutil.Big, error)
 
@@ -295,6 +532,12 @@ type Repository interface {
 	// StoreGasPricePeriod stores gas price period data into the persistent storage.
 	StoreGasPricePeriod(*types.GasPricePeriod) error
 
+	// GasPriceHistory provides daily gas price distributions in the given date range.
+	GasPriceHistory(*time.Time, *time.Time) ([]*types.DailyGasPrice, error)
+
+	// GasPriceHistoryUpdate updates the daily gas price distributions of recent days.
+	GasPriceHistoryUpdate(bool)
+
 	// GasEstimate calculates the estimated amount of Gas required to perform
 	// transaction described by the input params.
 	GasEstimate(*struct {
@@ -307,6 +550,16 @@ type Repository interface {
 	// DefiConfiguration loads the current DeFi contract settings.
 	DefiConfiguration() (*types.DefiSettings, error)
 
+	// InvalidateDefiConfiguration drops the cached DeFi configuration
+	// so it's re-loaded on the next request.
+	InvalidateDefiConfiguration(bool)
+
+	// DefiOverview provides the latest network-wide aggregates of the DeFi modules.
+	DefiOverview() (*types.DefiOverview, error)
+
+	// UpdateDefiOverview re-calculates network-wide aggregates of the DeFi modules.
+	UpdateDefiOverview() (*types.DefiOverview, error)
+
 	// DefiTokens resolves list of DeFi tokens available for the DeFi functions.
 	DefiTokens() ([]types.DefiToken, error)
 
@@ -317,6 +570,10 @@ type Repository interface {
 	// from on-chain price oracle.
 	DefiTokenPrice(*common.Address) (hexutil.Big, error)
 
+	// DefiTokenPriceSourced loads the current price of the given token along with its source,
+	// i.e. the on-chain price oracle, or the AMM TWAP fallback.
+	DefiTokenPriceSourced(*common.Address) (*types.DefiTokenPrice, error)
+
 	// FMintAccount loads details of a DeFi/fMint account identified by the owner address.
 	FMintAccount(common.Address) (*types.FMintAccount, error)
 
@@ -356,6 +613,66 @@ type Repository interface {
 	// AddFMintTransaction adds the specified fMint transaction to persistent storage.
 	AddFMintTransaction(*types.FMintTransaction) error
 
+	// FMintTransactions provides a list of fMint transactions of the given user, if any.
+	FMintTransactions(*common.Address, *string, int32) (*types.FMintTransactionList, error)
+
+	// FMintAccountPnL calculates realized and unrealized profit and loss of the given fMint account.
+	FMintAccountPnL(*common.Address) (*types.FMintAccountPnL, error)
+
+	// FMintMaxMintable calculates the max amount of the given token the fMint account can mint
+	// without dropping below the minimal collateral to debt ratio.
+	FMintMaxMintable(*common.Address, *common.Address) (hexutil.Big, error)
+
+	// FMintRepayRequired calculates the debt the fMint account has to repay to reach the given collateral
+	// to debt ratio in 4 digits; in fUSD value, or in the token units if the token is specified.
+	FMintRepayRequired(*common.Address, int64, *common.Address) (hexutil.Big, error)
+
+	// FMintCollateralRatio calculates the current collateral to debt ratio of the given fMint account in 4 digits.
+	FMintCollateralRatio(*common.Address) (*int64, error)
+
+	// SnapshotFMintPositions takes snapshots of all the open fMint positions and stores them under the given slot.
+	SnapshotFMintPositions(slot string) (int, error)
+
+	// FMintPositionHistory provides snapshots of the fMint position of the given address
+	// taken in the given number of recent days, the oldest first.
+	FMintPositionHistory(addr *common.Address, days int) ([]*types.FMintPositionSnapshot, error)
+
+	// RegisterCollateralAlert registers a new collateral ratio alert of the given owner for the given fMint account.
+	RegisterCollateralAlert(string, common.Address, int64, *string) (*types.CollateralAlert, error)
+
+	// StoreCollateralAlert stores, or updates, the given collateral ratio alert.
+	StoreCollateralAlert(*types.CollateralAlert) error
+
+	// RemoveCollateralAlert removes the collateral ratio alert of the given owner.
+	RemoveCollateralAlert(string, string) (bool, error)
+
+	// CollateralAlerts loads collateral ratio alerts, optionally only those of the given owner.
+	CollateralAlerts(*string) ([]*types.CollateralAlert, error)
+
+	// RegisterBalanceAlert registers a new balance alert of the given owner
+	// for the native, or ERC20 token balance of the given address.
+	RegisterBalanceAlert(string, common.Address, *common.Address, *big.Int, *big.Int, string) (*types.BalanceAlert, error)
+
+	// StoreBalanceAlert stores, or updates, the given balance alert.
+	StoreBalanceAlert(*types.BalanceAlert) error
+
+	// RemoveBalanceAlert removes the balance alert of the given owner.
+	RemoveBalanceAlert(string, string) (bool, error)
+
+	// BalanceAlerts loads balance alerts, optionally only those of the given owner.
+	BalanceAlerts(*string) ([]*types.BalanceAlert, error)
+
+	// AccountTokenBalance loads the current balance of the address in the given ERC20 token,
+	// or the native balance, if the token is not specified.
+	AccountTokenBalance(*common.Address, *common.Address) (*big.Int, error)
+
+	// CreateSandboxKey mints a new sandbox API key for the given client address;
+	// the key record and the key itself are provided.
+	CreateSandboxKey(string) (*types.SandboxKey, string, error)
+
+	// SandboxKey provides a valid sandbox API key matching the given key; nil if there is none.
+	SandboxKey(string) (*types.SandboxKey, error)
+
 	// UniswapPairs returns list of all token pairs managed by Uniswap core.
 	UniswapPairs() ([]common.Address, error)
 
@@ -377,6 +694,10 @@ type Repository interface {
 	// self reserves of the analyzed token.
 	UniswapQuoteInput(amountIn hexutil.Big, reserveMy hexutil.Big, reserveSibling hexutil.Big) (hexutil.Big, error)
 
+	// UniswapQuoteSwap finds the best swap route between the given tokens
+	// for the input amount across known Uniswap pairs.
+	UniswapQuoteSwap(*common.Address, *common.Address, *big.Int, *common.Address, float64) (*types.SwapQuote, error)
+
 	// UniswapTokens returns list of addresses of tokens involved in an Uniswap pair.
 	UniswapTokens(*common.Address) ([]common.Address, error)
 
@@ -432,6 +753,17 @@ type Repository interface {
 	// transaction call (blockchain transaction).
 	TokenTransactionsByCall(*common.Hash) ([]*types.TokenTransaction, error)
 
+	// TokenSupplyTransactions provides a list of mints and burns of the given ERC20 token.
+	TokenSupplyTransactions(token *common.Address, cursor *string, count int32) (*types.TokenTransactionList, error)
+
+	// TokenSupplyChanges provides daily amounts of the given ERC20 token minted and burned
+	// in the given number of recent days.
+	TokenSupplyChanges(token *common.Address, days int) ([]*types.TokenSupplyChange, error)
+
+	// TokenStats provides transfer counts, unique senders and receivers and the volume
+	// of the given ERC20 token in the given period of recent days, in total and by days.
+	TokenStats(token *common.Address, period string) (*types.TokenStats, error)
+
 	// Erc20Token returns an ERC20 token for the given address, if available.
 	Erc20Token(*common.Address) (*types.Erc20Token, error)
 
@@ -443,7 +775,11 @@ type Repository interface {
 
 	// Erc20BalanceOf load the current available balance of and ERC20 token identified by the token
 	// contract address for an identified owner address.
-	Erc20BalanceOf(*common.Address, *common.Address) (hexutil.Big, error)
+	Erc20BalanceOf(*common.Address, *common.Address, *big.Int) (hexutil.Big, error)
+
+	// Erc20BalancesOf returns the balances of the given ERC20 tokens of the owner at the given block
+	// loaded in a single batch; nil block represents the latest block. Balances not available are nil.
+	Erc20BalancesOf(owner *common.Address, tokens []common.Address, block *big.Int) ([]*hexutil.Big, error)
 
 	// Erc20Allowance loads the current amount of ERC20 tokens unlocked for DeFi
 	// contract by the token owner.
@@ -464,6 +800,14 @@ type Repository interface {
 	// Erc20LogoURL provides URL address of a logo of the ERC20 token.
 	Erc20LogoURL(*common.Address) string
 
+	// BuildErc20ApproveTx prepares an unsigned ERC20 transaction approving the spender
+	// to transfer the given amount of tokens of the owner.
+	BuildErc20ApproveTx(owner *common.Address, token *common.Address, spender *common.Address, amount string) (*types.PreparedTransaction, error)
+
+	// BuildErc20TransferTx prepares an unsigned ERC20 transaction sending the given amount of tokens
+	// of the owner to the recipient; the sender's own tokens are sent if the owner is not given.
+	BuildErc20TransferTx(sender *common.Address, token *common.Address, owner *common.Address, recipient *common.Address, amount string) (*types.PreparedTransaction, error)
+
 	// StoreTokenTransaction stores ERC20/ERC721/ERC1155 transaction into the repository.
 	StoreTokenTransaction(*types.TokenTransaction) error
 
@@ -581,6 +925,140 @@ type Repository interface {
 	// TrxFlowSpeed provides speed of transaction per second for the last <sec> seconds.
 	TrxFlowSpeed(sec int32) (float64, error)
 
+	// RegisterAbi validates and stores a custom ABI of the given contract
+	// to be used for decoding contract interactions. Only admins can replace
+	// the ABI of a validated contract, or an ABI registered by another registrar;
+	// ABIs of other registrars are pending until approved by an admin.
+	RegisterAbi(*common.Address, string, string, bool) error
+
+	// ApproveAbi approves the pending custom ABI of the given contract,
+	// so it is used for decoding contract interactions.
+	ApproveAbi(*common.Address, string) error
+
+	// ContractAbi provides the ABI used to decode interactions with the given contract.
+	ContractAbi(*common.Address) (*abi.ABI, error)
+
+	// DecodeCall decodes the given contract call input using the ABI of the contract.
+	DecodeCall(*common.Address, []byte) (*types.DecodedCall, error)
+
+	// DecodeLog decodes the given event log record using the ABI of the emitting contract.
+	DecodeLog(*etc.Log) (*types.DecodedLog, error)
+
+	// ContractCall executes a read-only call of the given contract at the given block
+	// and decodes the input and the output using the ABI of the contract, if known.
+	ContractCall(*common.Address, []byte, *big.Int) (*types.ContractCallResult, error)
+
+	// FeesPaid provides a report of gas fees paid by the given address in the given time range
+	// broken down by periods of the given resolution and by contracts interacted with.
+	FeesPaid(*common.Address, *time.Time, *time.Time, string) (*types.FeesPaidReport, error)
+
+	// Counterparties provides the list of top counterparties of the given address
+	// in the given period, e.g. the addresses the account interacted with the most.
+	Counterparties(*common.Address, string, int32) ([]*types.Counterparty, error)
+
+	// AddDelegationEvent stores a delegation event used to track stake movements.
+	AddDelegationEvent(*types.DelegationEvent) error
+
+	// StakeFlows provides the list of the largest net stake movements between validators
+	// in the given period inferred from undelegations followed by delegations.
+	StakeFlows(string, int32) ([]*types.StakeFlow, error)
+
+	// StoreWebhookDelivery stores, or updates, the given webhook delivery log record.
+	StoreWebhookDelivery(*types.WebhookDelivery) error
+
+	// WebhookDeliveries loads the most recently updated webhook delivery log records
+	// optionally filtered by the delivery status.
+	WebhookDeliveries(*string, int32) ([]*types.WebhookDelivery, error)
+
+	// WebhookDeliveriesDue loads the oldest webhook deliveries waiting for the first attempt,
+	// or scheduled for a retry at, or before the given time.
+	WebhookDeliveriesDue(time.Time, int32) ([]*types.WebhookDelivery, error)
+
+	// ClaimWebhookDelivery atomically postpones the next attempt of the given due webhook delivery
+	// to the given lease end so other API server instances sharing the database skip it.
+	ClaimWebhookDelivery(*types.WebhookDelivery, time.Time) (bool, error)
+
+	// AddRewardWatch adds the delegation to the watch list of the pending rewards reminder;
+	// static watches come from the server configuration.
+	AddRewardWatch(*common.Address, uint64, bool, string) error
+
+	// RemoveRewardWatch removes the delegation from the watch list of the pending rewards reminder.
+	RemoveRewardWatch(*common.Address, uint64, string) (bool, error)
+
+	// RewardWatches loads the watch list of the pending rewards reminder
+	// with the state of the last reminder sent for each watched delegation.
+	RewardWatches() ([]*types.RewardWatch, error)
+
+	// StoreRewardReminder records the reminder of the pending rewards of the watched delegation
+	// sent at the given epoch; nil amount clears the record once the rewards were claimed.
+	StoreRewardReminder(*common.Address, uint64, uint64, *big.Int) error
+
+	// IsComplianceEnabled signals if the compliance screening hook is available.
+	IsComplianceEnabled() bool
+
+	// AccountRiskFlag provides the compliance screening result of the given address.
+	// It returns nil if the compliance screening is not enabled.
+	AccountRiskFlag(*common.Address) (*types.RiskFlag, error)
+
+	// Enrichments provides the off-chain data tags of the given address.
+	Enrichments(*common.Address) []types.EnrichmentTag
+
+	// NodeHealth provides the observed latency and error rate of the connected node.
+	NodeHealth() types.NodeHealth
+
+	// NodeConnectionEvents provides the channel of the node connection state changes.
+	NodeConnectionEvents() <-chan *types.NodeConnectionEvent
+
+	// BlockProduction provides the observed production of new blocks by the network.
+	BlockProduction() types.BlockProduction
+
+	// BlockGapEvents provides the channel of the block production state changes.
+	BlockGapEvents() <-chan *types.BlockGapEvent
+
+	// NodeInfo provides diagnostic information about the connected node.
+	NodeInfo() (*types.NodeInfo, error)
+
+	// Network provides the identity of the blockchain network served by the API.
+	Network() (*types.NetworkIdentity, error)
+
+	// IsNodeUnderPressure signals if the connected node responsiveness crossed
+	// the configured load shedding thresholds.
+	IsNodeUnderPressure() bool
+
+	// StorageHealth checks the persistent storage is available and provides the round trip time of the check.
+	StorageHealth() (time.Duration, error)
+
+	// StartReindex starts the rebuild of the given indexed collection in a shadow collection.
+	StartReindex(string) (*types.Reindex, error)
+
+	// Reindexes provides the state of the rebuilds of all the collections.
+	Reindexes() ([]*types.Reindex, error)
+
+	// ReindexStep processes the next batch of an active reindex of the given owner.
+	ReindexStep(string) (*types.Reindex, error)
+
+	// OpenStatusIncident publishes a new incident of the given severity on the status page.
+	OpenStatusIncident(string, string, *string) (*types.StatusIncident, error)
+
+	// ResolveStatusIncident marks the status incident of the given id resolved,
+	// optionally updating the message of the incident.
+	ResolveStatusIncident(string, *string) (*types.StatusIncident, error)
+
+	// StatusIncidents provides the given number of the most recent status incidents.
+	StatusIncidents(int64) ([]*types.StatusIncident, error)
+
+	// BlockHeader returns the raw header of the block by the number.
+	BlockHeader(hexutil.Uint64) (*types.BlockHeader, error)
+
+	// DagEvent returns the Lachesis consensus event by its id.
+	DagEvent(*common.Hash) (*types.DagEvent, error)
+
+	// DagEventPayload returns the Lachesis consensus event by its id including the list of transactions.
+	DagEventPayload(*common.Hash) (*types.DagEventPayload, error)
+
+	// DagHeads returns the list of ids of the head events of the given epoch.
+	DagHeads(*hexutil.Uint64) ([]common.Hash, error)
+
 	// Close and cleanup the repository.
 	Close()
 }
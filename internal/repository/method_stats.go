@@ -0,0 +1,57 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// methodStatsPeriods represents the time periods method usage statistics are available for.
+var methodStatsPeriods = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+}
+
+// ContractMethodStats provides usage statistics of methods of the given contract
+// called in the given period, i.e. "day", "week", or "month". Method signatures
+// are resolved from the contract ABI, if known.
+func (p *proxy) ContractMethodStats(addr *common.Address, period string) ([]types.ContractMethodStat, error) {
+	dur, ok := methodStatsPeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	list, err := p.db.ContractMethodStats(addr, time.Now().UTC().Add(-dur))
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve method signatures; the stats are fine without them
+	ab, err := p.ContractAbi(addr)
+	if err != nil || ab == nil {
+		return list, nil
+	}
+	for i := range list {
+		sel, err := hexutil.Decode(list[i].Selector)
+		if err != nil {
+			continue
+		}
+		if m, err := ab.MethodById(sel); err == nil {
+			sig := m.Sig
+			list[i].Name = &sig
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,272 @@
+package mock
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Account returns the account of the fixtures; unknown addresses are wallet accounts.
+func (r *Repository) Account(addr *common.Address) (*types.Account, error) {
+	if addr == nil {
+		return nil, fmt.Errorf("no address given")
+	}
+	if acc, ok := r.accounts[*addr]; ok {
+		a := acc.Account
+		return &a, nil
+	}
+	return &types.Account{Address: *addr, Type: types.AccountTypeWallet}, nil
+}
+
+// AccountBalance returns the balance of the account of the fixtures; the block is ignored.
+func (r *Repository) AccountBalance(addr *common.Address, _ *big.Int) (*hexutil.Big, error) {
+	val := new(hexutil.Big)
+	if acc, ok := r.accounts[*addr]; ok {
+		*val = acc.Balance
+	}
+	return val, nil
+}
+
+// AccountBalances returns the balances of the accounts of the fixtures; the block is ignored.
+func (r *Repository) AccountBalances(addrs []common.Address, block *big.Int) ([]*hexutil.Big, error) {
+	list := make([]*hexutil.Big, len(addrs))
+	for i := range addrs {
+		list[i], _ = r.AccountBalance(&addrs[i], block)
+	}
+	return list, nil
+}
+
+// AccountNonce returns the nonce of the account of the fixtures; the block is ignored.
+func (r *Repository) AccountNonce(addr *common.Address, _ *big.Int) (*hexutil.Uint64, error) {
+	val := new(hexutil.Uint64)
+	if acc, ok := r.accounts[*addr]; ok {
+		*val = acc.Nonce
+	}
+	return val, nil
+}
+
+// AccountCode returns the byte code of the account of the fixtures.
+func (r *Repository) AccountCode(addr *common.Address) (hexutil.Bytes, error) {
+	if acc, ok := r.accounts[*addr]; ok {
+		return acc.Code, nil
+	}
+	return hexutil.Bytes{}, nil
+}
+
+// AccountIsKnown checks if the account is part of the fixtures.
+func (r *Repository) AccountIsKnown(addr *common.Address) bool {
+	_, ok := r.accounts[*addr]
+	return ok
+}
+
+// AccountsActive returns the number of accounts of the fixtures.
+func (r *Repository) AccountsActive() (hexutil.Uint64, error) {
+	return hexutil.Uint64(len(r.accounts)), nil
+}
+
+// AccountTransactions returns the list of transactions sent, or received by the account.
+func (r *Repository) AccountTransactions(addr *common.Address, cursor *string, count int32) (*types.TransactionList, error) {
+	if addr == nil {
+		return nil, fmt.Errorf("can not get transaction list for empty account")
+	}
+
+	list := make([]*types.Transaction, 0)
+	for _, trx := range r.trxList {
+		if trx.From == *addr || (trx.To != nil && *trx.To == *addr) {
+			list = append(list, trx)
+		}
+	}
+	return transactionsPage(list, cursor, count)
+}
+
+// BlockHeight returns the number of the most recent block of the fixtures.
+func (r *Repository) BlockHeight() (*hexutil.Big, error) {
+	return (*hexutil.Big)(new(big.Int).SetUint64(r.head)), nil
+}
+
+// CallBlockHeight returns the number of the most recent block of the fixtures; there is no call block lag.
+func (r *Repository) CallBlockHeight() (*hexutil.Big, error) {
+	return r.BlockHeight()
+}
+
+// LastKnownBlock returns the number of the most recent block of the fixtures.
+func (r *Repository) LastKnownBlock() (uint64, error) {
+	return r.head, nil
+}
+
+// IsBlockFinalized signals all the blocks of the fixtures are final.
+func (r *Repository) IsBlockFinalized(num uint64) bool {
+	return num <= r.head
+}
+
+// BlockByNumber returns the block of the fixtures, the most recent one if the number is not given.
+func (r *Repository) BlockByNumber(num *hexutil.Uint64) (*types.Block, error) {
+	n := r.head
+	if num != nil {
+		n = uint64(*num)
+	}
+	if blk, ok := r.blocks[n]; ok {
+		return blk, nil
+	}
+	return nil, repository.ErrBlockNotFound
+}
+
+// BlockByHash returns the block of the fixtures by its hash.
+func (r *Repository) BlockByHash(hash *common.Hash) (*types.Block, error) {
+	if blk, ok := r.byHash[*hash]; ok {
+		return blk, nil
+	}
+	return nil, repository.ErrBlockNotFound
+}
+
+// Blocks returns the list of blocks of the fixtures starting from the given block.
+// A positive count scans to older blocks, a negative count scans to newer blocks.
+func (r *Repository) Blocks(num *uint64, count int32) (*types.BlockList, error) {
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero blocks requested")
+	}
+
+	list := types.BlockList{Collection: make([]*types.Block, 0)}
+	for n, i := r.blockListStart(num, count), int32(0); i < abs(count); i++ {
+		blk, ok := r.blocks[n]
+		if !ok {
+			break
+		}
+		list.Collection = append(list.Collection, blk)
+
+		if count > 0 {
+			if n == 0 {
+				break
+			}
+			n--
+			continue
+		}
+		n++
+	}
+
+	if count < 0 {
+		list.Reverse()
+	}
+	if len(list.Collection) > 0 {
+		list.IsStart = uint64(list.Collection[0].Number) >= r.head
+		_, below := r.blocks[uint64(list.Collection[len(list.Collection)-1].Number)-1]
+		list.IsEnd = !below
+	}
+	return &list, nil
+}
+
+// blockListStart provides the number of the first block of a block list.
+func (r *Repository) blockListStart(num *uint64, count int32) uint64 {
+	if num != nil {
+		return *num
+	}
+	if count > 0 {
+		return r.head
+	}
+
+	low := r.head
+	for n := range r.blocks {
+		if n < low {
+			low = n
+		}
+	}
+	return low
+}
+
+// Transaction returns the transaction of the fixtures by its hash.
+func (r *Repository) Transaction(hash *common.Hash) (*types.Transaction, error) {
+	if trx, ok := r.trx[*hash]; ok {
+		return trx, nil
+	}
+	return nil, repository.ErrTransactionNotFound
+}
+
+// LoadTransaction returns the transaction of the fixtures by its hash.
+func (r *Repository) LoadTransaction(hash *common.Hash) (*types.Transaction, error) {
+	return r.Transaction(hash)
+}
+
+// IndexedTransaction returns the transaction of the fixtures by its hash.
+func (r *Repository) IndexedTransaction(hash *common.Hash) (*types.Transaction, error) {
+	return r.Transaction(hash)
+}
+
+// Transactions returns the list of transactions of the fixtures starting on the given cursor.
+func (r *Repository) Transactions(cursor *string, count int32) (*types.TransactionList, error) {
+	return transactionsPage(r.trxList, cursor, count)
+}
+
+// TransactionsCount returns the number of transactions of the fixtures.
+func (r *Repository) TransactionsCount() (uint64, error) {
+	return uint64(len(r.trxList)), nil
+}
+
+// EstimateTransactionsCount returns the number of transactions of the fixtures.
+func (r *Repository) EstimateTransactionsCount() (hexutil.Uint64, error) {
+	return hexutil.Uint64(len(r.trxList)), nil
+}
+
+// GasPrice returns the gas price of the fixtures.
+func (r *Repository) GasPrice() (hexutil.Big, error) {
+	return r.fx.GasPrice, nil
+}
+
+// transactionsPage cuts a page of the given list of transactions ordered from the newest.
+// A positive count scans to older transactions, a negative count scans to newer transactions;
+// the transaction of the cursor is not included.
+func transactionsPage(list []*types.Transaction, cursor *string, count int32) (*types.TransactionList, error) {
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero transactions requested")
+	}
+
+	// find the range
+	from, to := 0, len(list)
+	if cursor != nil {
+		pos := -1
+		for i, trx := range list {
+			if types.TransactionCursor(trx) == *cursor || trx.Hash.String() == *cursor {
+				pos = i
+				break
+			}
+		}
+		if pos < 0 {
+			return nil, fmt.Errorf("transaction cursor %s not found", *cursor)
+		}
+		if count > 0 {
+			from = pos + 1
+		} else {
+			to = pos
+		}
+	}
+
+	if count > 0 && to-from > int(count) {
+		to = from + int(count)
+	}
+	if count < 0 && to-from > int(-count) {
+		from = to + int(count)
+	}
+
+	tl := types.TransactionList{
+		Collection: list[from:to],
+		Total:      uint64(len(list)),
+		IsStart:    from == 0,
+		IsEnd:      to == len(list),
+	}
+	if len(tl.Collection) > 0 {
+		tl.First = tl.Collection[0].Uid()
+		tl.Last = tl.Collection[len(tl.Collection)-1].Uid()
+	}
+	return &tl, nil
+}
+
+// abs provides the absolute value of the count.
+func abs(count int32) int32 {
+	if count < 0 {
+		return -count
+	}
+	return count
+}
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"axis-graphql/internal/types"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Fixtures represents the synthetic data served by the mock repository.
+type Fixtures struct {
+	Accounts     []*FixtureAccount    `json:"accounts"`
+	Blocks       []*types.Block       `json:"blocks"`
+	Transactions []*types.Transaction `json:"transactions"`
+	Validators   []*types.Validator   `json:"validators"`
+	GasPrice     hexutil.Big          `json:"gasPrice"`
+}
+
+// FixtureAccount represents an account of the fixtures with its state.
+type FixtureAccount struct {
+	types.Account
+	Balance hexutil.Big    `json:"balance"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	Code    hexutil.Bytes  `json:"code"`
+}
+
+// LoadFixtures loads the fixtures from the given JSON file.
+func LoadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fx Fixtures
+	if err := json.Unmarshal(data, &fx); err != nil {
+		return nil, fmt.Errorf("invalid fixtures %s; %s", path, err.Error())
+	}
+	return &fx, nil
+}
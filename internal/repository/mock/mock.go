@@ -0,0 +1,81 @@
+/*
+Package mock implements an in-memory Repository serving synthetic data loaded from fixtures.
+
+The mock repository allows to run the GraphQL server without a node and a database, e.g. to develop
+and test client integrations, and makes resolvers testable end to end. It serves accounts, blocks,
+transactions and validators of the fixtures; all other Repository methods are provided by the generated
+Unimplemented stub and fail with ErrNotImplemented. Mocks of other methods can be added by embedding
+the Repository and overriding them.
+*/
+package mock
+
+//go:generate go run ../../../cmd/mockgen -in ../interface.go -out unimplemented.go
+
+import (
+	"axis-graphql/internal/repository"
+	"axis-graphql/internal/types"
+	"errors"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNotImplemented is returned by repository methods not provided by the mock.
+var ErrNotImplemented = errors.New("not implemented by the mock repository")
+
+// make sure the mock implements the whole Repository interface
+var _ repository.Repository = (*Repository)(nil)
+
+// Repository represents the mock repository serving the data of fixtures.
+type Repository struct {
+	Unimplemented
+
+	fx       *Fixtures
+	accounts map[common.Address]*FixtureAccount
+	blocks   map[uint64]*types.Block
+	byHash   map[common.Hash]*types.Block
+	trx      map[common.Hash]*types.Transaction
+
+	// transactions ordered by their ordinal index, the newest first
+	trxList []*types.Transaction
+
+	// validators keyed by the validator id
+	validators map[uint64]*types.Validator
+	head       uint64
+}
+
+// New creates a new mock repository serving the given fixtures.
+func New(fx *Fixtures) *Repository {
+	r := Repository{
+		fx:         fx,
+		accounts:   make(map[common.Address]*FixtureAccount, len(fx.Accounts)),
+		blocks:     make(map[uint64]*types.Block, len(fx.Blocks)),
+		byHash:     make(map[common.Hash]*types.Block, len(fx.Blocks)),
+		trx:        make(map[common.Hash]*types.Transaction, len(fx.Transactions)),
+		trxList:    make([]*types.Transaction, len(fx.Transactions)),
+		validators: make(map[uint64]*types.Validator, len(fx.Validators)),
+	}
+
+	for _, acc := range fx.Accounts {
+		r.accounts[acc.Address] = acc
+	}
+	for _, blk := range fx.Blocks {
+		r.blocks[uint64(blk.Number)] = blk
+		r.byHash[blk.Hash] = blk
+		if uint64(blk.Number) > r.head {
+			r.head = uint64(blk.Number)
+		}
+	}
+	for _, val := range fx.Validators {
+		r.validators[val.Id.ToInt().Uint64()] = val
+	}
+
+	copy(r.trxList, fx.Transactions)
+	sort.Slice(r.trxList, func(i, j int) bool {
+		return r.trxList[i].Uid() > r.trxList[j].Uid()
+	})
+	for _, trx := range r.trxList {
+		r.trx[trx.Hash] = trx
+	}
+	return &r
+}
@@ -0,0 +1,83 @@
+package mock
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LastValidatorId returns the highest validator id of the fixtures.
+func (r *Repository) LastValidatorId() (uint64, error) {
+	var last uint64
+	for id := range r.validators {
+		if id > last {
+			last = id
+		}
+	}
+	return last, nil
+}
+
+// ValidatorsCount returns the number of validators of the fixtures.
+func (r *Repository) ValidatorsCount() (uint64, error) {
+	return uint64(len(r.validators)), nil
+}
+
+// IsValidator checks if the address belongs to a validator of the fixtures.
+func (r *Repository) IsValidator(addr *common.Address) (bool, error) {
+	_, err := r.ValidatorByAddress(addr)
+	return err == nil, nil
+}
+
+// ValidatorAddress returns the address of the validator of the fixtures.
+func (r *Repository) ValidatorAddress(id *hexutil.Big) (*common.Address, error) {
+	val, err := r.Validator(id)
+	if err != nil {
+		return nil, err
+	}
+	return &val.StakerAddress, nil
+}
+
+// Validator returns the validator of the fixtures by its id.
+func (r *Repository) Validator(id *hexutil.Big) (*types.Validator, error) {
+	if val, ok := r.validators[id.ToInt().Uint64()]; ok {
+		return val, nil
+	}
+	return nil, fmt.Errorf("validator #%d not found", id.ToInt().Uint64())
+}
+
+// ValidatorByAddress returns the validator of the fixtures by its address.
+func (r *Repository) ValidatorByAddress(addr *common.Address) (*types.Validator, error) {
+	for _, val := range r.validators {
+		if val.StakerAddress == *addr {
+			return val, nil
+		}
+	}
+	return nil, fmt.Errorf("validator %s not found", addr.String())
+}
+
+// Validators returns the list of validators of the fixtures ordered by their id.
+func (r *Repository) Validators() ([]*types.Validator, error) {
+	list := make([]*types.Validator, 0, len(r.validators))
+	for _, val := range r.validators {
+		list = append(list, val)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Id.ToInt().Cmp(list[j].Id.ToInt()) < 0
+	})
+	return list, nil
+}
+
+// TotalStaked returns the total stake of validators of the fixtures.
+func (r *Repository) TotalStaked() (*hexutil.Big, error) {
+	total := new(big.Int)
+	for _, val := range r.validators {
+		if val.TotalStake != nil {
+			total.Add(total, val.TotalStake.ToInt())
+		}
+	}
+	return (*hexutil.Big)(total), nil
+}
@@ -0,0 +1,1556 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mock
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/repository/rpc/contracts"
+	"axis-graphql/internal/types"
+	"encoding/json"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etc "github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"time"
+)
+
+// Unimplemented implements the Repository interface with all the methods returning
+// zero values and ErrNotImplemented, if the method returns an error.
+type Unimplemented struct{}
+
+// Account implements Repository.Account; it's not implemented.
+func (Unimplemented) Account(*common.Address) (r0 *types.Account, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountBalance implements Repository.AccountBalance; it's not implemented.
+func (Unimplemented) AccountBalance(*common.Address, *big.Int) (r0 *hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountBalances implements Repository.AccountBalances; it's not implemented.
+func (Unimplemented) AccountBalances([]common.Address, *big.Int) (r0 []*hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountNonce implements Repository.AccountNonce; it's not implemented.
+func (Unimplemented) AccountNonce(*common.Address, *big.Int) (r0 *hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountCode implements Repository.AccountCode; it's not implemented.
+func (Unimplemented) AccountCode(*common.Address) (r0 hexutil.Bytes, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountTransactions implements Repository.AccountTransactions; it's not implemented.
+func (Unimplemented) AccountTransactions(*common.Address, *string, int32) (r0 *types.TransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountsActive implements Repository.AccountsActive; it's not implemented.
+func (Unimplemented) AccountsActive() (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountIsKnown implements Repository.AccountIsKnown; it's not implemented.
+func (Unimplemented) AccountIsKnown(*common.Address) (r0 bool) {
+	return r0
+}
+
+// StoreAccount implements Repository.StoreAccount; it's not implemented.
+func (Unimplemented) StoreAccount(*types.Account) (r0 error) {
+	return ErrNotImplemented
+}
+
+// AccountMarkActivity implements Repository.AccountMarkActivity; it's not implemented.
+func (Unimplemented) AccountMarkActivity(*common.Address, uint64) (r0 error) {
+	return ErrNotImplemented
+}
+
+// AccountActivityMark implements Repository.AccountActivityMark; it's not implemented.
+func (Unimplemented) AccountActivityMark(*common.Address, uint64, uint64) (r0 error) {
+	return ErrNotImplemented
+}
+
+// AccountActivityHeatmap implements Repository.AccountActivityHeatmap; it's not implemented.
+func (Unimplemented) AccountActivityHeatmap(*common.Address) (r0 []*types.AccountActivityDay, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountFirstSeen implements Repository.AccountFirstSeen; it's not implemented.
+func (Unimplemented) AccountFirstSeen(*types.Account) (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BlockHeight implements Repository.BlockHeight; it's not implemented.
+func (Unimplemented) BlockHeight() (r0 *hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CallBlockHeight implements Repository.CallBlockHeight; it's not implemented.
+func (Unimplemented) CallBlockHeight() (r0 *hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// LastKnownBlock implements Repository.LastKnownBlock; it's not implemented.
+func (Unimplemented) LastKnownBlock() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BlockTraces implements Repository.BlockTraces; it's not implemented.
+func (Unimplemented) BlockTraces(uint64) (r0 []json.RawMessage, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsBlockFinalized implements Repository.IsBlockFinalized; it's not implemented.
+func (Unimplemented) IsBlockFinalized(uint64) (r0 bool) {
+	return r0
+}
+
+// FinalityDepth implements Repository.FinalityDepth; it's not implemented.
+func (Unimplemented) FinalityDepth() (r0 uint64) {
+	return r0
+}
+
+// UpdateLastKnownBlock implements Repository.UpdateLastKnownBlock; it's not implemented.
+func (Unimplemented) UpdateLastKnownBlock(blockNo *hexutil.Uint64) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ObservedHeaders implements Repository.ObservedHeaders; it's not implemented.
+func (Unimplemented) ObservedHeaders() (r0 chan *etc.Header) {
+	return r0
+}
+
+// BlockByNumber implements Repository.BlockByNumber; it's not implemented.
+func (Unimplemented) BlockByNumber(*hexutil.Uint64) (r0 *types.Block, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ReloadBlock implements Repository.ReloadBlock; it's not implemented.
+func (Unimplemented) ReloadBlock(*hexutil.Uint64) (r0 *types.Block, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BlockByHash implements Repository.BlockByHash; it's not implemented.
+func (Unimplemented) BlockByHash(*common.Hash) (r0 *types.Block, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Blocks implements Repository.Blocks; it's not implemented.
+func (Unimplemented) Blocks(*uint64, int32) (r0 *types.BlockList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CacheBlock implements Repository.CacheBlock; it's not implemented.
+func (Unimplemented) CacheBlock(blk *types.Block) {
+}
+
+// Contract implements Repository.Contract; it's not implemented.
+func (Unimplemented) Contract(*common.Address) (r0 *types.Contract, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Contracts implements Repository.Contracts; it's not implemented.
+func (Unimplemented) Contracts(bool, *string, int32) (r0 *types.ContractList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidateContract implements Repository.ValidateContract; it's not implemented.
+func (Unimplemented) ValidateContract(*types.Contract) (r0 error) {
+	return ErrNotImplemented
+}
+
+// StoreContract implements Repository.StoreContract; it's not implemented.
+func (Unimplemented) StoreContract(*types.Contract) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ProxyImplementation implements Repository.ProxyImplementation; it's not implemented.
+func (Unimplemented) ProxyImplementation(*common.Address) (r0 *common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreContractUpgrade implements Repository.StoreContractUpgrade; it's not implemented.
+func (Unimplemented) StoreContractUpgrade(*types.ContractUpgrade) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ContractUpgrades implements Repository.ContractUpgrades; it's not implemented.
+func (Unimplemented) ContractUpgrades(*common.Address, int32) (r0 []*types.ContractUpgrade, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SfcVersion implements Repository.SfcVersion; it's not implemented.
+func (Unimplemented) SfcVersion() (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SfcDecimalUnit implements Repository.SfcDecimalUnit; it's not implemented.
+func (Unimplemented) SfcDecimalUnit() (r0 *big.Int) {
+	return r0
+}
+
+// CurrentEpoch implements Repository.CurrentEpoch; it's not implemented.
+func (Unimplemented) CurrentEpoch() (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// LastKnownEpoch implements Repository.LastKnownEpoch; it's not implemented.
+func (Unimplemented) LastKnownEpoch() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddEpoch implements Repository.AddEpoch; it's not implemented.
+func (Unimplemented) AddEpoch(e *types.Epoch) (r0 error) {
+	return ErrNotImplemented
+}
+
+// Epoch implements Repository.Epoch; it's not implemented.
+func (Unimplemented) Epoch(*hexutil.Uint64) (r0 *types.Epoch, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CurrentSealedEpoch implements Repository.CurrentSealedEpoch; it's not implemented.
+func (Unimplemented) CurrentSealedEpoch() (r0 *types.Epoch, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// EpochRewardsDistributed implements Repository.EpochRewardsDistributed; it's not implemented.
+func (Unimplemented) EpochRewardsDistributed(hexutil.Uint64) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SnapshotEpochValidators implements Repository.SnapshotEpochValidators; it's not implemented.
+func (Unimplemented) SnapshotEpochValidators(hexutil.Uint64) (r0 []*types.EpochValidator, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// EpochValidators implements Repository.EpochValidators; it's not implemented.
+func (Unimplemented) EpochValidators(hexutil.Uint64) (r0 []*types.EpochValidator, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Decentralization implements Repository.Decentralization; it's not implemented.
+func (Unimplemented) Decentralization(*hexutil.Uint64) (r0 *types.Decentralization, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// EpochDiff implements Repository.EpochDiff; it's not implemented.
+func (Unimplemented) EpochDiff(hexutil.Uint64, hexutil.Uint64) (r0 *types.EpochDiff, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorEarnings implements Repository.ValidatorEarnings; it's not implemented.
+func (Unimplemented) ValidatorEarnings(*hexutil.Big, hexutil.Uint64, hexutil.Uint64, string) (r0 *types.ValidatorEarnings, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorEstimate implements Repository.ValidatorEstimate; it's not implemented.
+func (Unimplemented) ValidatorEstimate(*big.Int, *big.Int) (r0 *types.ValidatorEstimate, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Epochs implements Repository.Epochs; it's not implemented.
+func (Unimplemented) Epochs(cursor *string, count int32) (r0 *types.EpochList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TotalStaked implements Repository.TotalStaked; it's not implemented.
+func (Unimplemented) TotalStaked() (r0 *hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RewardsAllowed implements Repository.RewardsAllowed; it's not implemented.
+func (Unimplemented) RewardsAllowed() (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// LockingAllowed implements Repository.LockingAllowed; it's not implemented.
+func (Unimplemented) LockingAllowed() (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsSfcContract implements Repository.IsSfcContract; it's not implemented.
+func (Unimplemented) IsSfcContract(*common.Address) (r0 bool) {
+	return r0
+}
+
+// IsStiContract implements Repository.IsStiContract; it's not implemented.
+func (Unimplemented) IsStiContract(*common.Address) (r0 bool) {
+	return r0
+}
+
+// ResolveContractRegistry implements Repository.ResolveContractRegistry; it's not implemented.
+func (Unimplemented) ResolveContractRegistry() (r0 []types.ContractRegistryChange, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreTransaction implements Repository.StoreTransaction; it's not implemented.
+func (Unimplemented) StoreTransaction(*types.Block, *types.Transaction) (r0 error) {
+	return ErrNotImplemented
+}
+
+// LoadTransaction implements Repository.LoadTransaction; it's not implemented.
+func (Unimplemented) LoadTransaction(hash *common.Hash) (r0 *types.Transaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Transaction implements Repository.Transaction; it's not implemented.
+func (Unimplemented) Transaction(*common.Hash) (r0 *types.Transaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Transactions implements Repository.Transactions; it's not implemented.
+func (Unimplemented) Transactions(*string, int32) (r0 *types.TransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TransactionsInBlockRange implements Repository.TransactionsInBlockRange; it's not implemented.
+func (Unimplemented) TransactionsInBlockRange(*hexutil.Uint64, *hexutil.Uint64, *string, int32) (r0 *types.TransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TransactionsCount implements Repository.TransactionsCount; it's not implemented.
+func (Unimplemented) TransactionsCount() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// EstimateTransactionsCount implements Repository.EstimateTransactionsCount; it's not implemented.
+func (Unimplemented) EstimateTransactionsCount() (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IncTrxCountEstimate implements Repository.IncTrxCountEstimate; it's not implemented.
+func (Unimplemented) IncTrxCountEstimate(diff uint64) {
+}
+
+// UpdateTrxCountEstimate implements Repository.UpdateTrxCountEstimate; it's not implemented.
+func (Unimplemented) UpdateTrxCountEstimate(val uint64) {
+}
+
+// CacheTransaction implements Repository.CacheTransaction; it's not implemented.
+func (Unimplemented) CacheTransaction(trx *types.Transaction) {
+}
+
+// SendTransaction implements Repository.SendTransaction; it's not implemented.
+func (Unimplemented) SendTransaction(hexutil.Bytes) (r0 *types.Transaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DecodeTransaction implements Repository.DecodeTransaction; it's not implemented.
+func (Unimplemented) DecodeTransaction(hexutil.Bytes) (r0 *types.DecodedTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IndexedTransaction implements Repository.IndexedTransaction; it's not implemented.
+func (Unimplemented) IndexedTransaction(*common.Hash) (r0 *types.Transaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IndexedLogs implements Repository.IndexedLogs; it's not implemented.
+func (Unimplemented) IndexedLogs(*ethereum.FilterQuery) (r0 []etc.Log, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IndexedBlockTransactions implements Repository.IndexedBlockTransactions; it's not implemented.
+func (Unimplemented) IndexedBlockTransactions(uint64) (r0 []*types.Transaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RemoveIndexedBlock implements Repository.RemoveIndexedBlock; it's not implemented.
+func (Unimplemented) RemoveIndexedBlock(uint64) (r0 int64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ContractMethodStats implements Repository.ContractMethodStats; it's not implemented.
+func (Unimplemented) ContractMethodStats(*common.Address, string) (r0 []types.ContractMethodStat, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CreateAddressGroup implements Repository.CreateAddressGroup; it's not implemented.
+func (Unimplemented) CreateAddressGroup(owner string, name string, addrs []common.Address) (r0 *types.AddressGroup, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UpdateAddressGroup implements Repository.UpdateAddressGroup; it's not implemented.
+func (Unimplemented) UpdateAddressGroup(owner string, id string, name *string, addrs []common.Address) (r0 *types.AddressGroup, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RemoveAddressGroup implements Repository.RemoveAddressGroup; it's not implemented.
+func (Unimplemented) RemoveAddressGroup(owner string, id string) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddressGroup implements Repository.AddressGroup; it's not implemented.
+func (Unimplemented) AddressGroup(owner string, id string) (r0 *types.AddressGroup, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddressGroups implements Repository.AddressGroups; it's not implemented.
+func (Unimplemented) AddressGroups(owner string) (r0 []*types.AddressGroup, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddressGroupTransactions implements Repository.AddressGroupTransactions; it's not implemented.
+func (Unimplemented) AddressGroupTransactions(*types.AddressGroup, *string, int32) (r0 *types.TransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StartAccountExport implements Repository.StartAccountExport; it's not implemented.
+func (Unimplemented) StartAccountExport(owner string, addr *common.Address) (r0 *types.AccountExport, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountExport implements Repository.AccountExport; it's not implemented.
+func (Unimplemented) AccountExport(owner string, id string) (r0 *types.AccountExport) {
+	return r0
+}
+
+// AccountExportUrl implements Repository.AccountExportUrl; it's not implemented.
+func (Unimplemented) AccountExportUrl(*types.AccountExport) (r0 string) {
+	return r0
+}
+
+// AccountExportFile implements Repository.AccountExportFile; it's not implemented.
+func (Unimplemented) AccountExportFile(id string, expires int64, sig string) (r0 *types.AccountExportFile, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountExportsMaintain implements Repository.AccountExportsMaintain; it's not implemented.
+func (Unimplemented) AccountExportsMaintain() {
+}
+
+// LastValidatorId implements Repository.LastValidatorId; it's not implemented.
+func (Unimplemented) LastValidatorId() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorsCount implements Repository.ValidatorsCount; it's not implemented.
+func (Unimplemented) ValidatorsCount() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsValidator implements Repository.IsValidator; it's not implemented.
+func (Unimplemented) IsValidator(*common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorAddress implements Repository.ValidatorAddress; it's not implemented.
+func (Unimplemented) ValidatorAddress(*hexutil.Big) (r0 *common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Validator implements Repository.Validator; it's not implemented.
+func (Unimplemented) Validator(*hexutil.Big) (r0 *types.Validator, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorByAddress implements Repository.ValidatorByAddress; it's not implemented.
+func (Unimplemented) ValidatorByAddress(*common.Address) (r0 *types.Validator, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Validators implements Repository.Validators; it's not implemented.
+func (Unimplemented) Validators() (r0 []*types.Validator, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// InvalidateValidator implements Repository.InvalidateValidator; it's not implemented.
+func (Unimplemented) InvalidateValidator(*hexutil.Big) {
+}
+
+// ValidatorDowntime implements Repository.ValidatorDowntime; it's not implemented.
+func (Unimplemented) ValidatorDowntime(*hexutil.Big) (r0 uint64, r1 uint64, r2 error) {
+	return r0, r1, ErrNotImplemented
+}
+
+// SfcConfiguration implements Repository.SfcConfiguration; it's not implemented.
+func (Unimplemented) SfcConfiguration() (r0 *types.SfcConfig, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SfcMaxDelegatedRatio implements Repository.SfcMaxDelegatedRatio; it's not implemented.
+func (Unimplemented) SfcMaxDelegatedRatio() (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// PullStakerInfo implements Repository.PullStakerInfo; it's not implemented.
+func (Unimplemented) PullStakerInfo(*hexutil.Big) (r0 *types.StakerInfo, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreStakerInfo implements Repository.StoreStakerInfo; it's not implemented.
+func (Unimplemented) StoreStakerInfo(*hexutil.Big, *types.StakerInfo) (r0 error) {
+	return ErrNotImplemented
+}
+
+// RetrieveStakerInfo implements Repository.RetrieveStakerInfo; it's not implemented.
+func (Unimplemented) RetrieveStakerInfo(*hexutil.Big) (r0 *types.StakerInfo) {
+	return r0
+}
+
+// StakerInfoUrl implements Repository.StakerInfoUrl; it's not implemented.
+func (Unimplemented) StakerInfoUrl(*hexutil.Big) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UpgradeReadiness implements Repository.UpgradeReadiness; it's not implemented.
+func (Unimplemented) UpgradeReadiness(*string) (r0 *types.UpgradeReadiness, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorPubkey implements Repository.ValidatorPubkey; it's not implemented.
+func (Unimplemented) ValidatorPubkey(*hexutil.Big) (r0 []byte, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreValidatorChange implements Repository.StoreValidatorChange; it's not implemented.
+func (Unimplemented) StoreValidatorChange(*types.ValidatorChange) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ValidatorChanges implements Repository.ValidatorChanges; it's not implemented.
+func (Unimplemented) ValidatorChanges(*hexutil.Big, int32) (r0 []*types.ValidatorChange, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsDelegating implements Repository.IsDelegating; it's not implemented.
+func (Unimplemented) IsDelegating(*common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreDelegation implements Repository.StoreDelegation; it's not implemented.
+func (Unimplemented) StoreDelegation(*types.Delegation) (r0 error) {
+	return ErrNotImplemented
+}
+
+// DelegationsWithoutOrigin implements Repository.DelegationsWithoutOrigin; it's not implemented.
+func (Unimplemented) DelegationsWithoutOrigin(int64) (r0 []*types.Delegation, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BackfillDelegationOrigin implements Repository.BackfillDelegationOrigin; it's not implemented.
+func (Unimplemented) BackfillDelegationOrigin(*types.Delegation) (r0 error) {
+	return ErrNotImplemented
+}
+
+// UpdateDelegationBalance implements Repository.UpdateDelegationBalance; it's not implemented.
+func (Unimplemented) UpdateDelegationBalance(*common.Address, *hexutil.Big, func(*big.Int) error) (r0 error) {
+	return ErrNotImplemented
+}
+
+// Delegation implements Repository.Delegation; it's not implemented.
+func (Unimplemented) Delegation(*common.Address, *hexutil.Big) (r0 *types.Delegation, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationAmountStaked implements Repository.DelegationAmountStaked; it's not implemented.
+func (Unimplemented) DelegationAmountStaked(*common.Address, *hexutil.Big, *big.Int) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationsByAddress implements Repository.DelegationsByAddress; it's not implemented.
+func (Unimplemented) DelegationsByAddress(*common.Address, *string, int32, *types.DelegationListOptions) (r0 *types.DelegationList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationsByAddressAll implements Repository.DelegationsByAddressAll; it's not implemented.
+func (Unimplemented) DelegationsByAddressAll(addr *common.Address) (r0 []*types.Delegation, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationsOfValidator implements Repository.DelegationsOfValidator; it's not implemented.
+func (Unimplemented) DelegationsOfValidator(*hexutil.Big, *string, int32, *types.DelegationListOptions) (r0 *types.DelegationList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UpdateDelegationLock implements Repository.UpdateDelegationLock; it's not implemented.
+func (Unimplemented) UpdateDelegationLock(*common.Address, *hexutil.Big) (r0 error) {
+	return ErrNotImplemented
+}
+
+// DelegationLock implements Repository.DelegationLock; it's not implemented.
+func (Unimplemented) DelegationLock(*common.Address, *hexutil.Big, *big.Int) (r0 *types.DelegationLock, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationUnlockPenalty implements Repository.DelegationUnlockPenalty; it's not implemented.
+func (Unimplemented) DelegationUnlockPenalty(addr *common.Address, valID *big.Int, amount *big.Int) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationAmountUnlocked implements Repository.DelegationAmountUnlocked; it's not implemented.
+func (Unimplemented) DelegationAmountUnlocked(addr *common.Address, valID *big.Int) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// PendingRewards implements Repository.PendingRewards; it's not implemented.
+func (Unimplemented) PendingRewards(*common.Address, *hexutil.Big, *big.Int) (r0 *types.PendingRewards, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationOutstandingSAXIS implements Repository.DelegationOutstandingSAXIS; it's not implemented.
+func (Unimplemented) DelegationOutstandingSAXIS(*common.Address, *hexutil.Big) (r0 *hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationTokenizerUnlocked implements Repository.DelegationTokenizerUnlocked; it's not implemented.
+func (Unimplemented) DelegationTokenizerUnlocked(*common.Address, *hexutil.Big) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BuildMintSAXISTx implements Repository.BuildMintSAXISTx; it's not implemented.
+func (Unimplemented) BuildMintSAXISTx(*common.Address, *hexutil.Big) (r0 *types.PreparedTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BuildBurnSAXISTx implements Repository.BuildBurnSAXISTx; it's not implemented.
+func (Unimplemented) BuildBurnSAXISTx(*common.Address, *hexutil.Big, *hexutil.Big) (r0 *types.PreparedTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsSAXISToken implements Repository.IsSAXISToken; it's not implemented.
+func (Unimplemented) IsSAXISToken(*common.Address) (r0 bool) {
+	return r0
+}
+
+// TokenizerCallValidator implements Repository.TokenizerCallValidator; it's not implemented.
+func (Unimplemented) TokenizerCallValidator(*types.Transaction) (r0 *hexutil.Big) {
+	return r0
+}
+
+// StoreTokenizerEvent implements Repository.StoreTokenizerEvent; it's not implemented.
+func (Unimplemented) StoreTokenizerEvent(*types.TokenizerEvent) (r0 error) {
+	return ErrNotImplemented
+}
+
+// TokenizerEvents implements Repository.TokenizerEvents; it's not implemented.
+func (Unimplemented) TokenizerEvents(*common.Address, int32) (r0 []*types.TokenizerEvent, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SAXISSupply implements Repository.SAXISSupply; it's not implemented.
+func (Unimplemented) SAXISSupply(int) (r0 []*types.SAXISSupply, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SAXISCollateral implements Repository.SAXISCollateral; it's not implemented.
+func (Unimplemented) SAXISCollateral() (r0 []*types.SAXISCollateral, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidateStakeAction implements Repository.ValidateStakeAction; it's not implemented.
+func (Unimplemented) ValidateStakeAction(*types.StakeAction) (r0 []types.StakeValidationError, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationFluidStakingActive implements Repository.DelegationFluidStakingActive; it's not implemented.
+func (Unimplemented) DelegationFluidStakingActive(*common.Address, *hexutil.Big) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreWithdrawRequest implements Repository.StoreWithdrawRequest; it's not implemented.
+func (Unimplemented) StoreWithdrawRequest(*types.WithdrawRequest) (r0 error) {
+	return ErrNotImplemented
+}
+
+// UpdateWithdrawRequest implements Repository.UpdateWithdrawRequest; it's not implemented.
+func (Unimplemented) UpdateWithdrawRequest(*types.WithdrawRequest) (r0 error) {
+	return ErrNotImplemented
+}
+
+// WithdrawRequest implements Repository.WithdrawRequest; it's not implemented.
+func (Unimplemented) WithdrawRequest(*common.Address, *hexutil.Big, *hexutil.Big) (r0 *types.WithdrawRequest, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// WithdrawRequests implements Repository.WithdrawRequests; it's not implemented.
+func (Unimplemented) WithdrawRequests(*common.Address, *hexutil.Big, *string, int32) (r0 *types.WithdrawRequestList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// WithdrawableRequests implements Repository.WithdrawableRequests; it's not implemented.
+func (Unimplemented) WithdrawableRequests(*common.Address, *hexutil.Big) (r0 []*types.WithdrawRequest, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ValidatorExit implements Repository.ValidatorExit; it's not implemented.
+func (Unimplemented) ValidatorExit(*hexutil.Big) (r0 *types.ValidatorExit, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationDustThreshold implements Repository.DelegationDustThreshold; it's not implemented.
+func (Unimplemented) DelegationDustThreshold() (r0 *big.Int) {
+	return r0
+}
+
+// DelegationIsDust implements Repository.DelegationIsDust; it's not implemented.
+func (Unimplemented) DelegationIsDust(*big.Int) (r0 bool) {
+	return r0
+}
+
+// UpcomingEvents implements Repository.UpcomingEvents; it's not implemented.
+func (Unimplemented) UpcomingEvents([]common.Address) (r0 []*types.CalendarEvent, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DelegationNextActions implements Repository.DelegationNextActions; it's not implemented.
+func (Unimplemented) DelegationNextActions(*common.Address) (r0 []*types.DelegationAction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// WithdrawRequestsPendingTotal implements Repository.WithdrawRequestsPendingTotal; it's not implemented.
+func (Unimplemented) WithdrawRequestsPendingTotal(*common.Address, *hexutil.Big) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreRewardClaim implements Repository.StoreRewardClaim; it's not implemented.
+func (Unimplemented) StoreRewardClaim(*types.RewardClaim) (r0 error) {
+	return ErrNotImplemented
+}
+
+// RewardsClaimed implements Repository.RewardsClaimed; it's not implemented.
+func (Unimplemented) RewardsClaimed(adr *common.Address, valId *big.Int, since *int64, until *int64) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RewardsClaimedSplit implements Repository.RewardsClaimedSplit; it's not implemented.
+func (Unimplemented) RewardsClaimedSplit(adr *common.Address, valId *big.Int) (r0 *big.Int, r1 *big.Int, r2 error) {
+	return r0, r1, ErrNotImplemented
+}
+
+// StashedRewards implements Repository.StashedRewards; it's not implemented.
+func (Unimplemented) StashedRewards(adr *common.Address, valId *big.Int, block *big.Int) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RewardClaims implements Repository.RewardClaims; it's not implemented.
+func (Unimplemented) RewardClaims(*common.Address, *big.Int, *string, int32) (r0 *types.RewardClaimsList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RecomputeDelegationRewards implements Repository.RecomputeDelegationRewards; it's not implemented.
+func (Unimplemented) RecomputeDelegationRewards(*common.Address, *hexutil.Big, int32) (r0 *types.RewardRecomputationReport, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Price implements Repository.Price; it's not implemented.
+func (Unimplemented) Price(sym string) (r0 types.Price, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// HistoricalPrice implements Repository.HistoricalPrice; it's not implemented.
+func (Unimplemented) HistoricalPrice(string, time.Time) (r0 float64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TaxReport implements Repository.TaxReport; it's not implemented.
+func (Unimplemented) TaxReport(*common.Address, int32, string) (r0 *types.TaxReport, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GasPrice implements Repository.GasPrice; it's not implemented.
+func (Unimplemented) GasPrice() (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GasPriceExtended implements Repository.GasPriceExtended; it's not implemented.
+func (Unimplemented) GasPriceExtended() (r0 *types.GasPrice, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreGasPricePeriod implements Repository.StoreGasPricePeriod; it's not implemented.
+func (Unimplemented) StoreGasPricePeriod(*types.GasPricePeriod) (r0 error) {
+	return ErrNotImplemented
+}
+
+// GasPriceHistory implements Repository.GasPriceHistory; it's not implemented.
+func (Unimplemented) GasPriceHistory(*time.Time, *time.Time) (r0 []*types.DailyGasPrice, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GasPriceHistoryUpdate implements Repository.GasPriceHistoryUpdate; it's not implemented.
+func (Unimplemented) GasPriceHistoryUpdate(bool) {
+}
+
+// GasEstimate implements Repository.GasEstimate; it's not implemented.
+func (Unimplemented) GasEstimate(*struct {
+	From  *common.Address
+	To    *common.Address
+	Value *hexutil.Big
+	Data  *string
+}) (r0 *hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DefiConfiguration implements Repository.DefiConfiguration; it's not implemented.
+func (Unimplemented) DefiConfiguration() (r0 *types.DefiSettings, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// InvalidateDefiConfiguration implements Repository.InvalidateDefiConfiguration; it's not implemented.
+func (Unimplemented) InvalidateDefiConfiguration(bool) {
+}
+
+// DefiOverview implements Repository.DefiOverview; it's not implemented.
+func (Unimplemented) DefiOverview() (r0 *types.DefiOverview, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UpdateDefiOverview implements Repository.UpdateDefiOverview; it's not implemented.
+func (Unimplemented) UpdateDefiOverview() (r0 *types.DefiOverview, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DefiTokens implements Repository.DefiTokens; it's not implemented.
+func (Unimplemented) DefiTokens() (r0 []types.DefiToken, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DefiToken implements Repository.DefiToken; it's not implemented.
+func (Unimplemented) DefiToken(*common.Address) (r0 *types.DefiToken, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DefiTokenPrice implements Repository.DefiTokenPrice; it's not implemented.
+func (Unimplemented) DefiTokenPrice(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DefiTokenPriceSourced implements Repository.DefiTokenPriceSourced; it's not implemented.
+func (Unimplemented) DefiTokenPriceSourced(*common.Address) (r0 *types.DefiTokenPrice, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintAccount implements Repository.FMintAccount; it's not implemented.
+func (Unimplemented) FMintAccount(common.Address) (r0 *types.FMintAccount, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintTokenBalance implements Repository.FMintTokenBalance; it's not implemented.
+func (Unimplemented) FMintTokenBalance(*common.Address, *common.Address, types.DefiTokenType) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintTokenTotalBalance implements Repository.FMintTokenTotalBalance; it's not implemented.
+func (Unimplemented) FMintTokenTotalBalance(*common.Address, types.DefiTokenType) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintTokenValue implements Repository.FMintTokenValue; it's not implemented.
+func (Unimplemented) FMintTokenValue(*common.Address, *common.Address, types.DefiTokenType) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintRewardsEarned implements Repository.FMintRewardsEarned; it's not implemented.
+func (Unimplemented) FMintRewardsEarned(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintRewardsStashed implements Repository.FMintRewardsStashed; it's not implemented.
+func (Unimplemented) FMintRewardsStashed(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintCanClaimRewards implements Repository.FMintCanClaimRewards; it's not implemented.
+func (Unimplemented) FMintCanClaimRewards(*common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintCanReceiveRewards implements Repository.FMintCanReceiveRewards; it's not implemented.
+func (Unimplemented) FMintCanReceiveRewards(*common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintCanPushRewards implements Repository.FMintCanPushRewards; it's not implemented.
+func (Unimplemented) FMintCanPushRewards() (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintUsers implements Repository.FMintUsers; it's not implemented.
+func (Unimplemented) FMintUsers(int32) (r0 []*types.FMintUserTokens, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddFMintTransaction implements Repository.AddFMintTransaction; it's not implemented.
+func (Unimplemented) AddFMintTransaction(*types.FMintTransaction) (r0 error) {
+	return ErrNotImplemented
+}
+
+// FMintTransactions implements Repository.FMintTransactions; it's not implemented.
+func (Unimplemented) FMintTransactions(*common.Address, *string, int32) (r0 *types.FMintTransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintAccountPnL implements Repository.FMintAccountPnL; it's not implemented.
+func (Unimplemented) FMintAccountPnL(*common.Address) (r0 *types.FMintAccountPnL, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintMaxMintable implements Repository.FMintMaxMintable; it's not implemented.
+func (Unimplemented) FMintMaxMintable(*common.Address, *common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintRepayRequired implements Repository.FMintRepayRequired; it's not implemented.
+func (Unimplemented) FMintRepayRequired(*common.Address, int64, *common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintCollateralRatio implements Repository.FMintCollateralRatio; it's not implemented.
+func (Unimplemented) FMintCollateralRatio(*common.Address) (r0 *int64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// SnapshotFMintPositions implements Repository.SnapshotFMintPositions; it's not implemented.
+func (Unimplemented) SnapshotFMintPositions(slot string) (r0 int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FMintPositionHistory implements Repository.FMintPositionHistory; it's not implemented.
+func (Unimplemented) FMintPositionHistory(addr *common.Address, days int) (r0 []*types.FMintPositionSnapshot, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RegisterCollateralAlert implements Repository.RegisterCollateralAlert; it's not implemented.
+func (Unimplemented) RegisterCollateralAlert(string, common.Address, int64, *string) (r0 *types.CollateralAlert, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreCollateralAlert implements Repository.StoreCollateralAlert; it's not implemented.
+func (Unimplemented) StoreCollateralAlert(*types.CollateralAlert) (r0 error) {
+	return ErrNotImplemented
+}
+
+// RemoveCollateralAlert implements Repository.RemoveCollateralAlert; it's not implemented.
+func (Unimplemented) RemoveCollateralAlert(string, string) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CollateralAlerts implements Repository.CollateralAlerts; it's not implemented.
+func (Unimplemented) CollateralAlerts(*string) (r0 []*types.CollateralAlert, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RegisterBalanceAlert implements Repository.RegisterBalanceAlert; it's not implemented.
+func (Unimplemented) RegisterBalanceAlert(string, common.Address, *common.Address, *big.Int, *big.Int, string) (r0 *types.BalanceAlert, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreBalanceAlert implements Repository.StoreBalanceAlert; it's not implemented.
+func (Unimplemented) StoreBalanceAlert(*types.BalanceAlert) (r0 error) {
+	return ErrNotImplemented
+}
+
+// RemoveBalanceAlert implements Repository.RemoveBalanceAlert; it's not implemented.
+func (Unimplemented) RemoveBalanceAlert(string, string) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BalanceAlerts implements Repository.BalanceAlerts; it's not implemented.
+func (Unimplemented) BalanceAlerts(*string) (r0 []*types.BalanceAlert, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AccountTokenBalance implements Repository.AccountTokenBalance; it's not implemented.
+func (Unimplemented) AccountTokenBalance(*common.Address, *common.Address) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// CreateSandboxKey implements Repository.CreateSandboxKey; it's not implemented.
+func (Unimplemented) CreateSandboxKey(string) (r0 *types.SandboxKey, r1 string, r2 error) {
+	return r0, r1, ErrNotImplemented
+}
+
+// SandboxKey implements Repository.SandboxKey; it's not implemented.
+func (Unimplemented) SandboxKey(string) (r0 *types.SandboxKey, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapPairs implements Repository.UniswapPairs; it's not implemented.
+func (Unimplemented) UniswapPairs() (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapKnownPairs implements Repository.UniswapKnownPairs; it's not implemented.
+func (Unimplemented) UniswapKnownPairs() (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapPair implements Repository.UniswapPair; it's not implemented.
+func (Unimplemented) UniswapPair(*common.Address, *common.Address) (r0 *common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapAmountsOut implements Repository.UniswapAmountsOut; it's not implemented.
+func (Unimplemented) UniswapAmountsOut(amountIn hexutil.Big, tokens []common.Address) (r0 []hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapAmountsIn implements Repository.UniswapAmountsIn; it's not implemented.
+func (Unimplemented) UniswapAmountsIn(amountOut hexutil.Big, tokens []common.Address) (r0 []hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapQuoteInput implements Repository.UniswapQuoteInput; it's not implemented.
+func (Unimplemented) UniswapQuoteInput(amountIn hexutil.Big, reserveMy hexutil.Big, reserveSibling hexutil.Big) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapQuoteSwap implements Repository.UniswapQuoteSwap; it's not implemented.
+func (Unimplemented) UniswapQuoteSwap(*common.Address, *common.Address, *big.Int, *common.Address, float64) (r0 *types.SwapQuote, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapTokens implements Repository.UniswapTokens; it's not implemented.
+func (Unimplemented) UniswapTokens(*common.Address) (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapReserves implements Repository.UniswapReserves; it's not implemented.
+func (Unimplemented) UniswapReserves(*common.Address) (r0 []hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapReservesTimeStamp implements Repository.UniswapReservesTimeStamp; it's not implemented.
+func (Unimplemented) UniswapReservesTimeStamp(*common.Address) (r0 hexutil.Uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapCumulativePrices implements Repository.UniswapCumulativePrices; it's not implemented.
+func (Unimplemented) UniswapCumulativePrices(*common.Address) (r0 []hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapLastKValue implements Repository.UniswapLastKValue; it's not implemented.
+func (Unimplemented) UniswapLastKValue(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapPairContract implements Repository.UniswapPairContract; it's not implemented.
+func (Unimplemented) UniswapPairContract(*common.Address) (r0 *contracts.UniswapPair, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapAdd implements Repository.UniswapAdd; it's not implemented.
+func (Unimplemented) UniswapAdd(*types.Swap) (r0 error) {
+	return ErrNotImplemented
+}
+
+// LastKnownSwapBlock implements Repository.LastKnownSwapBlock; it's not implemented.
+func (Unimplemented) LastKnownSwapBlock() (r0 uint64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapUpdateLastKnownSwapBlock implements Repository.UniswapUpdateLastKnownSwapBlock; it's not implemented.
+func (Unimplemented) UniswapUpdateLastKnownSwapBlock(blkNumber uint64) (r0 error) {
+	return ErrNotImplemented
+}
+
+// UniswapFactoryContract implements Repository.UniswapFactoryContract; it's not implemented.
+func (Unimplemented) UniswapFactoryContract() (r0 *contracts.UniswapFactory, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapVolume implements Repository.UniswapVolume; it's not implemented.
+func (Unimplemented) UniswapVolume(*common.Address, int64, int64) (r0 types.DefiSwapVolume, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapTimeVolumes implements Repository.UniswapTimeVolumes; it's not implemented.
+func (Unimplemented) UniswapTimeVolumes(*common.Address, string, int64, int64) (r0 []types.DefiSwapVolume, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapTimePrices implements Repository.UniswapTimePrices; it's not implemented.
+func (Unimplemented) UniswapTimePrices(*common.Address, string, int64, int64, int32) (r0 []types.DefiTimePrice, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapTimeReserves implements Repository.UniswapTimeReserves; it's not implemented.
+func (Unimplemented) UniswapTimeReserves(*common.Address, string, int64, int64) (r0 []types.DefiTimeReserve, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// UniswapActions implements Repository.UniswapActions; it's not implemented.
+func (Unimplemented) UniswapActions(*common.Address, *string, int32, int32) (r0 *types.UniswapActionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// NativeTokenAddress implements Repository.NativeTokenAddress; it's not implemented.
+func (Unimplemented) NativeTokenAddress() (r0 *common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TokenTransactions implements Repository.TokenTransactions; it's not implemented.
+func (Unimplemented) TokenTransactions(tokenType string, token *common.Address, tokenId *big.Int, acc *common.Address, txType *int32, cursor *string, count int32) (r0 *types.TokenTransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TokenTransactionsByCall implements Repository.TokenTransactionsByCall; it's not implemented.
+func (Unimplemented) TokenTransactionsByCall(*common.Hash) (r0 []*types.TokenTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TokenSupplyTransactions implements Repository.TokenSupplyTransactions; it's not implemented.
+func (Unimplemented) TokenSupplyTransactions(token *common.Address, cursor *string, count int32) (r0 *types.TokenTransactionList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TokenSupplyChanges implements Repository.TokenSupplyChanges; it's not implemented.
+func (Unimplemented) TokenSupplyChanges(token *common.Address, days int) (r0 []*types.TokenSupplyChange, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TokenStats implements Repository.TokenStats; it's not implemented.
+func (Unimplemented) TokenStats(token *common.Address, period string) (r0 *types.TokenStats, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Token implements Repository.Erc20Token; it's not implemented.
+func (Unimplemented) Erc20Token(*common.Address) (r0 *types.Erc20Token, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20TokensList implements Repository.Erc20TokensList; it's not implemented.
+func (Unimplemented) Erc20TokensList(int32) (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Assets implements Repository.Erc20Assets; it's not implemented.
+func (Unimplemented) Erc20Assets(common.Address, int32) (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20BalanceOf implements Repository.Erc20BalanceOf; it's not implemented.
+func (Unimplemented) Erc20BalanceOf(*common.Address, *common.Address, *big.Int) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20BalancesOf implements Repository.Erc20BalancesOf; it's not implemented.
+func (Unimplemented) Erc20BalancesOf(owner *common.Address, tokens []common.Address, block *big.Int) (r0 []*hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Allowance implements Repository.Erc20Allowance; it's not implemented.
+func (Unimplemented) Erc20Allowance(*common.Address, *common.Address, *common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20TotalSupply implements Repository.Erc20TotalSupply; it's not implemented.
+func (Unimplemented) Erc20TotalSupply(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Name implements Repository.Erc20Name; it's not implemented.
+func (Unimplemented) Erc20Name(*common.Address) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Symbol implements Repository.Erc20Symbol; it's not implemented.
+func (Unimplemented) Erc20Symbol(*common.Address) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20Decimals implements Repository.Erc20Decimals; it's not implemented.
+func (Unimplemented) Erc20Decimals(*common.Address) (r0 int32, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc20LogoURL implements Repository.Erc20LogoURL; it's not implemented.
+func (Unimplemented) Erc20LogoURL(*common.Address) (r0 string) {
+	return r0
+}
+
+// BuildErc20ApproveTx implements Repository.BuildErc20ApproveTx; it's not implemented.
+func (Unimplemented) BuildErc20ApproveTx(owner *common.Address, token *common.Address, spender *common.Address, amount string) (r0 *types.PreparedTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BuildErc20TransferTx implements Repository.BuildErc20TransferTx; it's not implemented.
+func (Unimplemented) BuildErc20TransferTx(sender *common.Address, token *common.Address, owner *common.Address, recipient *common.Address, amount string) (r0 *types.PreparedTransaction, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreTokenTransaction implements Repository.StoreTokenTransaction; it's not implemented.
+func (Unimplemented) StoreTokenTransaction(*types.TokenTransaction) (r0 error) {
+	return ErrNotImplemented
+}
+
+// Erc165SupportsInterface implements Repository.Erc165SupportsInterface; it's not implemented.
+func (Unimplemented) Erc165SupportsInterface(contract *common.Address, interfaceID [4]byte) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721Contract implements Repository.Erc721Contract; it's not implemented.
+func (Unimplemented) Erc721Contract(*common.Address) (r0 *types.Erc721Contract, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721ContractsList implements Repository.Erc721ContractsList; it's not implemented.
+func (Unimplemented) Erc721ContractsList(int32) (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721Name implements Repository.Erc721Name; it's not implemented.
+func (Unimplemented) Erc721Name(*common.Address) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721Symbol implements Repository.Erc721Symbol; it's not implemented.
+func (Unimplemented) Erc721Symbol(*common.Address) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721TotalSupply implements Repository.Erc721TotalSupply; it's not implemented.
+func (Unimplemented) Erc721TotalSupply(token *common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721BalanceOf implements Repository.Erc721BalanceOf; it's not implemented.
+func (Unimplemented) Erc721BalanceOf(token *common.Address, owner *common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721TokenURI implements Repository.Erc721TokenURI; it's not implemented.
+func (Unimplemented) Erc721TokenURI(token *common.Address, tokenId *big.Int) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721OwnerOf implements Repository.Erc721OwnerOf; it's not implemented.
+func (Unimplemented) Erc721OwnerOf(token *common.Address, tokenId *big.Int) (r0 common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721GetApproved implements Repository.Erc721GetApproved; it's not implemented.
+func (Unimplemented) Erc721GetApproved(token *common.Address, tokenId *big.Int) (r0 common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc721IsApprovedForAll implements Repository.Erc721IsApprovedForAll; it's not implemented.
+func (Unimplemented) Erc721IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc1155ContractsList implements Repository.Erc1155ContractsList; it's not implemented.
+func (Unimplemented) Erc1155ContractsList(int32) (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc1155Uri implements Repository.Erc1155Uri; it's not implemented.
+func (Unimplemented) Erc1155Uri(token *common.Address, tokenId *big.Int) (r0 string, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc1155BalanceOf implements Repository.Erc1155BalanceOf; it's not implemented.
+func (Unimplemented) Erc1155BalanceOf(token *common.Address, owner *common.Address, tokenId *big.Int) (r0 *big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc1155BalanceOfBatch implements Repository.Erc1155BalanceOfBatch; it's not implemented.
+func (Unimplemented) Erc1155BalanceOfBatch(token *common.Address, owners *[]common.Address, tokenIds []*big.Int) (r0 []*big.Int, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Erc1155IsApprovedForAll implements Repository.Erc1155IsApprovedForAll; it's not implemented.
+func (Unimplemented) Erc1155IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceContractBy implements Repository.GovernanceContractBy; it's not implemented.
+func (Unimplemented) GovernanceContractBy(*common.Address) (r0 *config.GovernanceContract, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceProposalsCount implements Repository.GovernanceProposalsCount; it's not implemented.
+func (Unimplemented) GovernanceProposalsCount(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceProposal implements Repository.GovernanceProposal; it's not implemented.
+func (Unimplemented) GovernanceProposal(*common.Address, *hexutil.Big) (r0 *types.GovernanceProposal, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceProposalState implements Repository.GovernanceProposalState; it's not implemented.
+func (Unimplemented) GovernanceProposalState(*common.Address, *hexutil.Big) (r0 *types.GovernanceProposalState, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceOptionState implements Repository.GovernanceOptionState; it's not implemented.
+func (Unimplemented) GovernanceOptionState(*common.Address, *hexutil.Big, *hexutil.Big) (r0 *types.GovernanceOptionState, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceOptionStates implements Repository.GovernanceOptionStates; it's not implemented.
+func (Unimplemented) GovernanceOptionStates(*common.Address, *hexutil.Big, int) (r0 []*types.GovernanceOptionState, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceVote implements Repository.GovernanceVote; it's not implemented.
+func (Unimplemented) GovernanceVote(*common.Address, *hexutil.Big, *common.Address, *common.Address) (r0 *types.GovernanceVote, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceProposals implements Repository.GovernanceProposals; it's not implemented.
+func (Unimplemented) GovernanceProposals([]*common.Address, *string, int32, bool) (r0 *types.GovernanceProposalList, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceProposalFee implements Repository.GovernanceProposalFee; it's not implemented.
+func (Unimplemented) GovernanceProposalFee(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// GovernanceTotalWeight implements Repository.GovernanceTotalWeight; it's not implemented.
+func (Unimplemented) GovernanceTotalWeight(*common.Address) (r0 hexutil.Big, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FLendGetLendingPool implements Repository.FLendGetLendingPool; it's not implemented.
+func (Unimplemented) FLendGetLendingPool() (r0 *contracts.ILendingPool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FLendGetLendingPoolReserveData implements Repository.FLendGetLendingPoolReserveData; it's not implemented.
+func (Unimplemented) FLendGetLendingPoolReserveData(*common.Address) (r0 *types.ReserveData, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FLendGetUserAccountData implements Repository.FLendGetUserAccountData; it's not implemented.
+func (Unimplemented) FLendGetUserAccountData(*common.Address) (r0 *types.FLendUserAccountData, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FLendGetReserveList implements Repository.FLendGetReserveList; it's not implemented.
+func (Unimplemented) FLendGetReserveList() (r0 []common.Address, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FLendGetUserDepositHistory implements Repository.FLendGetUserDepositHistory; it's not implemented.
+func (Unimplemented) FLendGetUserDepositHistory(*common.Address, *common.Address) (r0 []*types.FLendDeposit, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TrxFlowVolume implements Repository.TrxFlowVolume; it's not implemented.
+func (Unimplemented) TrxFlowVolume(from *time.Time, to *time.Time) (r0 []*types.DailyTrxVolume, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TrxGasSpeed implements Repository.TrxGasSpeed; it's not implemented.
+func (Unimplemented) TrxGasSpeed(from *time.Time, to *time.Time) (r0 float64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// TrxFlowUpdate implements Repository.TrxFlowUpdate; it's not implemented.
+func (Unimplemented) TrxFlowUpdate() {
+}
+
+// TrxFlowSpeed implements Repository.TrxFlowSpeed; it's not implemented.
+func (Unimplemented) TrxFlowSpeed(sec int32) (r0 float64, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RegisterAbi implements Repository.RegisterAbi; it's not implemented.
+func (Unimplemented) RegisterAbi(*common.Address, string, string, bool) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ApproveAbi implements Repository.ApproveAbi; it's not implemented.
+func (Unimplemented) ApproveAbi(*common.Address, string) (r0 error) {
+	return ErrNotImplemented
+}
+
+// ContractAbi implements Repository.ContractAbi; it's not implemented.
+func (Unimplemented) ContractAbi(*common.Address) (r0 *abi.ABI, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DecodeCall implements Repository.DecodeCall; it's not implemented.
+func (Unimplemented) DecodeCall(*common.Address, []byte) (r0 *types.DecodedCall, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DecodeLog implements Repository.DecodeLog; it's not implemented.
+func (Unimplemented) DecodeLog(*etc.Log) (r0 *types.DecodedLog, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ContractCall implements Repository.ContractCall; it's not implemented.
+func (Unimplemented) ContractCall(*common.Address, []byte, *big.Int) (r0 *types.ContractCallResult, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// FeesPaid implements Repository.FeesPaid; it's not implemented.
+func (Unimplemented) FeesPaid(*common.Address, *time.Time, *time.Time, string) (r0 *types.FeesPaidReport, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Counterparties implements Repository.Counterparties; it's not implemented.
+func (Unimplemented) Counterparties(*common.Address, string, int32) (r0 []*types.Counterparty, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddDelegationEvent implements Repository.AddDelegationEvent; it's not implemented.
+func (Unimplemented) AddDelegationEvent(*types.DelegationEvent) (r0 error) {
+	return ErrNotImplemented
+}
+
+// StakeFlows implements Repository.StakeFlows; it's not implemented.
+func (Unimplemented) StakeFlows(string, int32) (r0 []*types.StakeFlow, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreWebhookDelivery implements Repository.StoreWebhookDelivery; it's not implemented.
+func (Unimplemented) StoreWebhookDelivery(*types.WebhookDelivery) (r0 error) {
+	return ErrNotImplemented
+}
+
+// WebhookDeliveries implements Repository.WebhookDeliveries; it's not implemented.
+func (Unimplemented) WebhookDeliveries(*string, int32) (r0 []*types.WebhookDelivery, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// WebhookDeliveriesDue implements Repository.WebhookDeliveriesDue; it's not implemented.
+func (Unimplemented) WebhookDeliveriesDue(time.Time, int32) (r0 []*types.WebhookDelivery, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ClaimWebhookDelivery implements Repository.ClaimWebhookDelivery; it's not implemented.
+func (Unimplemented) ClaimWebhookDelivery(*types.WebhookDelivery, time.Time) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// AddRewardWatch implements Repository.AddRewardWatch; it's not implemented.
+func (Unimplemented) AddRewardWatch(*common.Address, uint64, bool, string) (r0 error) {
+	return ErrNotImplemented
+}
+
+// RemoveRewardWatch implements Repository.RemoveRewardWatch; it's not implemented.
+func (Unimplemented) RemoveRewardWatch(*common.Address, uint64, string) (r0 bool, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// RewardWatches implements Repository.RewardWatches; it's not implemented.
+func (Unimplemented) RewardWatches() (r0 []*types.RewardWatch, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StoreRewardReminder implements Repository.StoreRewardReminder; it's not implemented.
+func (Unimplemented) StoreRewardReminder(*common.Address, uint64, uint64, *big.Int) (r0 error) {
+	return ErrNotImplemented
+}
+
+// IsComplianceEnabled implements Repository.IsComplianceEnabled; it's not implemented.
+func (Unimplemented) IsComplianceEnabled() (r0 bool) {
+	return r0
+}
+
+// AccountRiskFlag implements Repository.AccountRiskFlag; it's not implemented.
+func (Unimplemented) AccountRiskFlag(*common.Address) (r0 *types.RiskFlag, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Enrichments implements Repository.Enrichments; it's not implemented.
+func (Unimplemented) Enrichments(*common.Address) (r0 []types.EnrichmentTag) {
+	return r0
+}
+
+// NodeHealth implements Repository.NodeHealth; it's not implemented.
+func (Unimplemented) NodeHealth() (r0 types.NodeHealth) {
+	return r0
+}
+
+// NodeConnectionEvents implements Repository.NodeConnectionEvents; it's not implemented.
+func (Unimplemented) NodeConnectionEvents() (r0 <-chan *types.NodeConnectionEvent) {
+	return r0
+}
+
+// BlockProduction implements Repository.BlockProduction; it's not implemented.
+func (Unimplemented) BlockProduction() (r0 types.BlockProduction) {
+	return r0
+}
+
+// BlockGapEvents implements Repository.BlockGapEvents; it's not implemented.
+func (Unimplemented) BlockGapEvents() (r0 <-chan *types.BlockGapEvent) {
+	return r0
+}
+
+// NodeInfo implements Repository.NodeInfo; it's not implemented.
+func (Unimplemented) NodeInfo() (r0 *types.NodeInfo, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Network implements Repository.Network; it's not implemented.
+func (Unimplemented) Network() (r0 *types.NetworkIdentity, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// IsNodeUnderPressure implements Repository.IsNodeUnderPressure; it's not implemented.
+func (Unimplemented) IsNodeUnderPressure() (r0 bool) {
+	return r0
+}
+
+// StorageHealth implements Repository.StorageHealth; it's not implemented.
+func (Unimplemented) StorageHealth() (r0 time.Duration, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StartReindex implements Repository.StartReindex; it's not implemented.
+func (Unimplemented) StartReindex(string) (r0 *types.Reindex, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Reindexes implements Repository.Reindexes; it's not implemented.
+func (Unimplemented) Reindexes() (r0 []*types.Reindex, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ReindexStep implements Repository.ReindexStep; it's not implemented.
+func (Unimplemented) ReindexStep(string) (r0 *types.Reindex, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// OpenStatusIncident implements Repository.OpenStatusIncident; it's not implemented.
+func (Unimplemented) OpenStatusIncident(string, string, *string) (r0 *types.StatusIncident, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// ResolveStatusIncident implements Repository.ResolveStatusIncident; it's not implemented.
+func (Unimplemented) ResolveStatusIncident(string, *string) (r0 *types.StatusIncident, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// StatusIncidents implements Repository.StatusIncidents; it's not implemented.
+func (Unimplemented) StatusIncidents(int64) (r0 []*types.StatusIncident, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// BlockHeader implements Repository.BlockHeader; it's not implemented.
+func (Unimplemented) BlockHeader(hexutil.Uint64) (r0 *types.BlockHeader, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DagEvent implements Repository.DagEvent; it's not implemented.
+func (Unimplemented) DagEvent(*common.Hash) (r0 *types.DagEvent, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DagEventPayload implements Repository.DagEventPayload; it's not implemented.
+func (Unimplemented) DagEventPayload(*common.Hash) (r0 *types.DagEventPayload, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// DagHeads implements Repository.DagHeads; it's not implemented.
+func (Unimplemented) DagHeads(*hexutil.Uint64) (r0 []common.Hash, r1 error) {
+	return r0, ErrNotImplemented
+}
+
+// Close implements Repository.Close; it's not implemented.
+func (Unimplemented) Close() {
+}
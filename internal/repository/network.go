@@ -0,0 +1,71 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Network provides the identity of the blockchain network served by the API.
+// The identity does not change while the server runs, so it's loaded only once.
+func (p *proxy) Network() (*types.NetworkIdentity, error) {
+	p.networkLock.Lock()
+	defer p.networkLock.Unlock()
+
+	if p.network != nil {
+		return p.network, nil
+	}
+
+	ni, err := p.loadNetwork()
+	if err != nil {
+		return nil, err
+	}
+	p.network = ni
+	return ni, nil
+}
+
+// loadNetwork collects the identity of the blockchain network from the configuration and the node.
+func (p *proxy) loadNetwork() (*types.NetworkIdentity, error) {
+	id, err := p.rpc.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	var zero hexutil.Uint64
+	gen, err := p.BlockByNumber(&zero)
+	if err != nil {
+		p.log.Errorf("genesis block not available; %s", err.Error())
+		return nil, err
+	}
+
+	ni := types.NetworkIdentity{
+		ChainID:     hexutil.Big(*id),
+		Name:        p.cfg.Network.Name,
+		Symbol:      p.cfg.Network.Symbol,
+		Decimals:    p.cfg.Network.Decimals,
+		SfcContract: p.cfg.Staking.SFCContract,
+		GenesisHash: gen.Hash,
+		GenesisTime: gen.TimeStamp,
+	}
+	if p.cfg.Staking.TokenizerContract != (common.Address{}) {
+		ni.TokenizerContract = &p.cfg.Staking.TokenizerContract
+	}
+
+	// the fMint may not be deployed on the network
+	ds, err := p.DefiConfiguration()
+	if err != nil {
+		p.log.Warningf("fMint contract address not available; %s", err.Error())
+	} else if ds.FMintContract != (common.Address{}) {
+		ni.FMintContract = &ds.FMintContract
+	}
+	return &ni, nil
+}
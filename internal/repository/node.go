@@ -0,0 +1,56 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+)
+
+// NodeHealth provides the observed latency and error rate of the connected node.
+func (p *proxy) NodeHealth() types.NodeHealth {
+	return p.rpc.NodeHealth()
+}
+
+// NodeConnectionEvents provides the channel of the node connection state changes.
+func (p *proxy) NodeConnectionEvents() <-chan *types.NodeConnectionEvent {
+	return p.rpc.ConnectionEvents()
+}
+
+// BlockProduction provides the observed production of new blocks by the network.
+func (p *proxy) BlockProduction() types.BlockProduction {
+	return p.rpc.BlockProduction()
+}
+
+// BlockGapEvents provides the channel of the block production state changes.
+func (p *proxy) BlockGapEvents() <-chan *types.BlockGapEvent {
+	return p.rpc.BlockGapEvents()
+}
+
+// NodeInfo provides diagnostic information about the connected node.
+func (p *proxy) NodeInfo() (*types.NodeInfo, error) {
+	return p.rpc.NodeInfo()
+}
+
+// IsNodeUnderPressure signals if the connected node responsiveness crossed
+// the configured load shedding thresholds.
+func (p *proxy) IsNodeUnderPressure() bool {
+	// shedding disabled?
+	if !p.cfg.LoadShedding.Enabled {
+		return false
+	}
+
+	// no calls means we don't know anything about the node yet
+	nh := p.rpc.NodeHealth()
+	if nh.Calls == 0 {
+		return false
+	}
+
+	return (p.cfg.LoadShedding.MaxLatency > 0 && nh.Latency > p.cfg.LoadShedding.MaxLatency) ||
+		(p.cfg.LoadShedding.MaxErrorRate > 0 && nh.ErrorRate > p.cfg.LoadShedding.MaxErrorRate)
+}
@@ -0,0 +1,165 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// priceHistoryApiAddress is the REST API endpoint of the historical daily prices.
+const priceHistoryApiAddress = "https://min-api.cryptocompare.com/data/pricehistorical?"
+
+// priceHistoryDailyApiAddress is the REST API endpoint of the daily price history ranges.
+const priceHistoryDailyApiAddress = "https://min-api.cryptocompare.com/data/v2/histoday?"
+
+// priceHistoryDailyMaxDays represents the max number of days loaded by a single daily history request.
+const priceHistoryDailyMaxDays = 2000
+
+// HistoricalPrice provides the daily price of the native token in the given target symbol
+// at the given time. Past prices don't change, so they are kept in memory once known.
+func (p *proxy) HistoricalPrice(sym string, at time.Time) (float64, error) {
+	// check the symbol validity
+	if !p.isValidPriceSymbol(sym) {
+		return 0, fmt.Errorf("unknown price symbol requested")
+	}
+
+	// try the known prices first
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	key := fmt.Sprintf("%s/%s", strings.ToUpper(sym), day.Format("2006-01-02"))
+	if pri, ok := p.historicalPrices.Load(key); ok {
+		return pri.(float64), nil
+	}
+
+	// call for the price inside a named request group
+	pri, err, _ := p.apiRequestGroup.Do(key, func() (interface{}, error) {
+		return p.makeHistoricalPriceRequest(sym, day)
+	})
+	if err != nil {
+		p.log.Errorf("price [%s] of %s not available; %s", sym, day.Format("2006-01-02"), err.Error())
+		return 0, err
+	}
+
+	p.historicalPrices.Store(key, pri)
+	return pri.(float64), nil
+}
+
+// makeHistoricalPriceRequest executes a request to remote API to pull the historical daily price.
+func (p *proxy) makeHistoricalPriceRequest(sym string, day time.Time) (float64, error) {
+	url := fmt.Sprintf("%sfsym=%s&%s%s&ts=%d", priceHistoryApiAddress, ownPriceSymbol, priceApiTargetSymbolVar, sym, day.Unix())
+
+	client := &http.Client{Timeout: time.Second * pricePullRequestTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return 0, fmt.Errorf("can not query price API; %s", err.Error())
+	}
+
+	// don't forget to close
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			p.log.Errorf("error closing price API request; %s", err.Error())
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("can not read price API response; %s", err.Error())
+	}
+
+	// the response maps the source symbol to the target symbols
+	var data map[string]map[string]float64
+	if err := json.Unmarshal(body, &data); err != nil {
+		return 0, fmt.Errorf("can not decode price API response; %s", err.Error())
+	}
+
+	for to, pri := range data[ownPriceSymbol] {
+		if strings.EqualFold(to, sym) {
+			return pri, nil
+		}
+	}
+	return 0, fmt.Errorf("price not found in price API response")
+}
+
+// preloadHistoricalPrices loads daily prices of the native token in the given target symbol
+// for the whole range of days by a single request so following HistoricalPrice calls
+// of the range are served from memory.
+func (p *proxy) preloadHistoricalPrices(sym string, from time.Time, to time.Time) error {
+	sym = strings.ToUpper(sym)
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	days := int(to.Sub(from).Hours() / 24)
+	if days < 0 || days >= priceHistoryDailyMaxDays {
+		return fmt.Errorf("invalid price history range")
+	}
+
+	// do we miss any day of the range?
+	missing := false
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if _, ok := p.historicalPrices.Load(fmt.Sprintf("%s/%s", sym, day.Format("2006-01-02"))); !ok {
+			missing = true
+			break
+		}
+	}
+	if !missing {
+		return nil
+	}
+
+	// the range ends on the given day; the limit is the number of days before it
+	url := fmt.Sprintf("%sfsym=%s&tsym=%s&limit=%d&toTs=%d", priceHistoryDailyApiAddress, ownPriceSymbol, sym, days, to.Unix())
+	client := &http.Client{Timeout: time.Second * pricePullRequestTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("can not query price history API; %s", err.Error())
+	}
+
+	// don't forget to close
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			p.log.Errorf("error closing price history API request; %s", err.Error())
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("can not read price history API response; %s", err.Error())
+	}
+
+	var data struct {
+		Response string `json:"Response"`
+		Message  string `json:"Message"`
+		Data     struct {
+			Data []struct {
+				Time  int64   `json:"time"`
+				Close float64 `json:"close"`
+			} `json:"Data"`
+		} `json:"Data"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Errorf("can not decode price history API response; %s", err.Error())
+	}
+	if data.Response != "Success" {
+		return fmt.Errorf("price history API failed; %s", data.Message)
+	}
+
+	// today is not closed yet, keep it for the regular request
+	today := time.Now().UTC().Format("2006-01-02")
+	for _, row := range data.Data.Data {
+		day := time.Unix(row.Time, 0).UTC().Format("2006-01-02")
+		if day == today || row.Close == 0 {
+			continue
+		}
+		p.historicalPrices.Store(fmt.Sprintf("%s/%s", sym, day), row.Close)
+	}
+	return nil
+}
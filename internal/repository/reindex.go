@@ -0,0 +1,28 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import "axis-graphql/internal/types"
+
+// StartReindex starts the rebuild of the given indexed collection in a shadow collection.
+// The API keeps serving from the live collection until the shadow one atomically replaces it.
+func (p *proxy) StartReindex(name string) (*types.Reindex, error) {
+	return p.db.StartReindex(name)
+}
+
+// Reindexes provides the state of the rebuilds of all the collections, the most recent first.
+func (p *proxy) Reindexes() ([]*types.Reindex, error) {
+	return p.db.Reindexes()
+}
+
+// ReindexStep processes the next batch of an active reindex owned, or taken over, by the given owner.
+// Nil is returned if no active reindex is available to the owner.
+func (p *proxy) ReindexStep(owner string) (*types.Reindex, error) {
+	return p.db.ReindexStep(owner)
+}
@@ -14,6 +14,7 @@ import (
 	"axis-graphql/internal/repository/cache"
 	"axis-graphql/internal/repository/db"
 	"axis-graphql/internal/repository/rpc"
+	"axis-graphql/internal/types"
 	"fmt"
 	"sync"
 
@@ -55,6 +56,13 @@ func R() Repository {
 	return repo
 }
 
+// SetRepository replaces the Repository instance provided by R() with the given implementation,
+// e.g. a mock repository serving synthetic data. It has to be called before the first use of R().
+func SetRepository(r Repository) {
+	onceRepo.Do(func() {})
+	repo = r
+}
+
 // Proxy represents Repository interface implementation and controls access to data
 // trough several low level bridges.
 type proxy struct {
@@ -75,6 +83,48 @@ type proxy struct {
 
 	// smart contract compilers
 	solCompiler string
+
+	// parsed contract ABIs used for decoding
+	abis sync.Map
+
+	// identity of the network, loaded on the first use
+	network     *types.NetworkIdentity
+	networkLock sync.Mutex
+
+	// cached DeFi configuration, invalidated by fMint config change events
+	defiConfig     *types.DefiSettings
+	defiConfigLock sync.RWMutex
+
+	// network-wide DeFi aggregates, refreshed by the DeFi overview monitor
+	defiOverview     *types.DefiOverview
+	defiOverviewLock sync.RWMutex
+
+	// compliance screening hook and recent screening results
+	compliance ComplianceHook
+	riskFlags  sync.Map
+
+	// recent AMM TWAP prices of tokens not priced by the oracle
+	twapPrices sync.Map
+
+	// in-memory list of validators updated by validator changes
+	validators validatorList
+
+	// daily historical prices of the native token
+	historicalPrices sync.Map
+
+	// serializes resolutions of the core contracts from the on-chain registry
+	registryLock sync.Mutex
+
+	// off-chain data enrichers, their cached results and refresh slots
+	enrichers   []Enricher
+	enrichments sync.Map
+	enrichSlots chan struct{}
+
+	// asynchronous account transactions exports
+	exports *accountExports
+
+	// cache invalidation bus shared with other API replicas, if configured
+	cacheBus *cacheBus
 }
 
 // newRepository creates new instance of Repository implementation, namely proxy structure.
@@ -106,8 +156,26 @@ func newRepository() Repository {
 
 		// keep reference to the SOL compiler
 		solCompiler: cfg.Compiler.DefaultSolCompilerPath,
+
+		// compliance screening, if configured
+		compliance: newComplianceHook(&cfg.Compliance),
+
+		// off-chain data enrichers registered by the operator
+		enrichers:   registeredEnrichers(),
+		enrichSlots: make(chan struct{}, cfg.Enrichment.Workers),
+
+		// account exports built in the background
+		exports: newAccountExports(&cfg.AccountExport),
 	}
 
+	// resolve the core contracts from the on-chain registry, if configured
+	if _, err := p.ResolveContractRegistry(); err != nil {
+		log.Criticalf("contract registry not available, using configured addresses; %s", err.Error())
+	}
+
+	// share cache invalidations with other replicas
+	p.openCacheBus()
+
 	// return the proxy
 	return &p
 }
@@ -142,7 +210,7 @@ func connect(cfg *config.Config, log logger.Logger) (*cache.MemBridge, *db.Mongo
 	}
 
 	// create new Lachesis RPC bridge
-	rpcBridge, err := rpc.New(cfg, log)
+	rpcBridge, err := rpc.New(cfg, logger.Module(logger.SubsystemRpc))
 	if err != nil {
 		log.Criticalf("can not connect Lachesis RPC interface, %s", err.Error())
 		return nil, nil, nil, err
@@ -156,6 +224,7 @@ func (p *proxy) Close() {
 	p.log.Notice("repository is closing")
 
 	// close connections
+	p.closeCacheBus()
 	p.db.Close()
 	p.rpc.Close()
 
@@ -0,0 +1,83 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RecomputeDelegationRewards compares the most recent reward claims of the delegation derived
+// from the SFC events with the pending rewards read from the archive state right before
+// the claim block. The node has to keep the archive state for the check to succeed.
+func (p *proxy) RecomputeDelegationRewards(addr *common.Address, valID *hexutil.Big, count int32) (*types.RewardRecomputationReport, error) {
+	list, err := p.RewardClaims(addr, valID.ToInt(), nil, count)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := types.RewardRecomputationReport{
+		Address:     *addr,
+		ValidatorId: *valID,
+		Entries:     make([]types.RewardRecomputation, 0, len(list.Collection)),
+	}
+	for _, rc := range list.Collection {
+		rr, err := p.recomputeRewardClaim(rc)
+		if err != nil {
+			return nil, err
+		}
+
+		rep.Checked++
+		if !rr.Match {
+			rep.Mismatched++
+		}
+		rep.Entries = append(rep.Entries, *rr)
+	}
+	return &rep, nil
+}
+
+// recomputeRewardClaim reads the pending rewards of the claim from the state of the block before the claim.
+func (p *proxy) recomputeRewardClaim(rc *types.RewardClaim) (*types.RewardRecomputation, error) {
+	trx, err := p.Transaction(&rc.ClaimTrx)
+	if err != nil {
+		return nil, err
+	}
+	if trx.BlockNumber == nil || *trx.BlockNumber == 0 {
+		return nil, fmt.Errorf("claim %s not in a block", rc.ClaimTrx.String())
+	}
+
+	state, err := p.rpc.PendingRewardsAt(&rc.Delegator, rc.ToValidatorId.ToInt(), new(big.Int).SetUint64(uint64(*trx.BlockNumber)-1))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RewardRecomputation{
+		ClaimTrx:    rc.ClaimTrx,
+		BlockNumber: *trx.BlockNumber,
+		IsDelegated: rc.IsDelegated,
+		Indexed:     rc.Amount,
+		Recomputed:  hexutil.Big(*state),
+		Match:       withinTolerance(state, rc.Amount.ToInt(), p.cfg.Integrity.Tolerance),
+	}, nil
+}
+
+// withinTolerance checks if the actual value is within the relative tolerance of the expected value.
+func withinTolerance(expected *big.Int, actual *big.Int, tolerance float64) bool {
+	if expected.Sign() == 0 {
+		return actual.Sign() == 0
+	}
+
+	diff := new(big.Float).SetInt(new(big.Int).Abs(new(big.Int).Sub(expected, actual)))
+	ratio, _ := new(big.Float).Quo(diff, new(big.Float).SetInt(expected)).Float64()
+	return ratio <= tolerance
+}
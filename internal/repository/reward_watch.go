@@ -0,0 +1,48 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AddRewardWatch adds the delegation to the watch list of the pending rewards reminder;
+// static watches come from the server configuration.
+func (p *proxy) AddRewardWatch(addr *common.Address, valID uint64, static bool, by string) error {
+	if err := p.db.AddRewardWatch(addr, valID, static); err != nil {
+		return err
+	}
+	p.log.Noticef("pending rewards of %s to #%d watched by %s", addr.String(), valID, by)
+	return nil
+}
+
+// RemoveRewardWatch removes the delegation from the watch list of the pending rewards reminder.
+func (p *proxy) RemoveRewardWatch(addr *common.Address, valID uint64, by string) (bool, error) {
+	ok, err := p.db.RemoveRewardWatch(addr, valID)
+	if err != nil || !ok {
+		return ok, err
+	}
+	p.log.Noticef("pending rewards of %s to #%d not watched anymore, removed by %s", addr.String(), valID, by)
+	return true, nil
+}
+
+// RewardWatches loads the watch list of the pending rewards reminder
+// with the state of the last reminder sent for each watched delegation.
+func (p *proxy) RewardWatches() ([]*types.RewardWatch, error) {
+	return p.db.RewardWatches()
+}
+
+// StoreRewardReminder records the reminder of the pending rewards of the watched delegation
+// sent at the given epoch; nil amount clears the record once the rewards were claimed.
+func (p *proxy) StoreRewardReminder(addr *common.Address, valID uint64, epoch uint64, amount *big.Int) error {
+	return p.db.StoreRewardReminder(addr, valID, epoch, amount)
+}
@@ -14,15 +14,17 @@ We strongly discourage opening Lachesis RPC interface for unrestricted Internet
 package rpc
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // AccountBalance reads balance of account from Lachesis node.
-func (axis *AxisBridge) AccountBalance(addr *common.Address) (*hexutil.Big, error) {
+func (axis *AxisBridge) AccountBalance(addr *common.Address, block *big.Int) (*hexutil.Big, error) {
 	// use RPC to make the call
 	var balance string
-	err := axis.rpc.Call(&balance, "axis_getBalance", addr.Hex(), "latest")
+	err := axis.call(&balance, "axis_getBalance", addr.Hex(), axis.blockNumberArg(block))
 	if err != nil {
 		axis.log.Errorf("can not get balance of account [%s]", addr.Hex())
 		return nil, err
@@ -39,10 +41,16 @@ func (axis *AxisBridge) AccountBalance(addr *common.Address) (*hexutil.Big, erro
 }
 
 // AccountNonce returns the total number of transaction of account from Lachesis node.
-func (axis *AxisBridge) AccountNonce(addr *common.Address) (uint64, error) {
+// The latest block is read if no block is given, so new transactions can be prepared with the nonce.
+func (axis *AxisBridge) AccountNonce(addr *common.Address, block *big.Int) (uint64, error) {
+	blk := "latest"
+	if block != nil {
+		blk = axis.blockNumberArg(block)
+	}
+
 	// use RPC to make the call
 	var nonce string
-	err := axis.rpc.Call(&nonce, "axis_getTransactionCount", addr.Hex(), "latest")
+	err := axis.call(&nonce, "axis_getTransactionCount", addr.Hex(), blk)
 	if err != nil {
 		axis.log.Errorf("can not get number of transaction of account [%s]", addr.Hex())
 		return 0, err
@@ -57,3 +65,15 @@ func (axis *AxisBridge) AccountNonce(addr *common.Address) (uint64, error) {
 
 	return val, nil
 }
+
+// AccountCode returns the deployed byte code of the contract account from Lachesis node.
+func (axis *AxisBridge) AccountCode(addr *common.Address) (hexutil.Bytes, error) {
+	// use RPC to make the call
+	var code hexutil.Bytes
+	err := axis.call(&code, "axis_getCode", addr.Hex(), "latest")
+	if err != nil {
+		axis.log.Errorf("can not get code of account [%s]", addr.Hex())
+		return nil, err
+	}
+	return code, nil
+}
@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"axis-graphql/internal/repository/rpc/contracts"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// Erc20Abi returns a parsed ABI of the generic ERC20 token contract.
+func (axis *AxisBridge) Erc20Abi() *abi.ABI {
+	if nil == axis.erc20Abi {
+		ab, err := abi.JSON(strings.NewReader(contracts.ERCTwentyABI))
+		if err != nil {
+			axis.log.Criticalf("failed to parse ERC20 contract ABI; %s", err.Error())
+			panic(err)
+		}
+		axis.erc20Abi = &ab
+	}
+	return axis.erc20Abi
+}
+
+// AccountBalances reads balances of the given accounts at the given block by a single batch
+// of calls to the node. Balances not available are nil in the list.
+func (axis *AxisBridge) AccountBalances(addrs []common.Address, block *big.Int) ([]*hexutil.Big, error) {
+	batch := make([]ethrpc.BatchElem, len(addrs))
+	for i := range addrs {
+		batch[i] = ethrpc.BatchElem{
+			Method: "axis_getBalance",
+			Args:   []interface{}{addrs[i].Hex(), axis.blockNumberArg(block)},
+			Result: new(hexutil.Big),
+		}
+	}
+
+	if err := axis.batchCall(batch); err != nil {
+		axis.log.Errorf("can not get balances of %d accounts; %s", len(addrs), err.Error())
+		return nil, err
+	}
+
+	list := make([]*hexutil.Big, len(addrs))
+	for i, el := range batch {
+		if el.Error != nil {
+			axis.log.Debugf("balance of account %s not available; %s", addrs[i].String(), el.Error.Error())
+			continue
+		}
+		list[i] = el.Result.(*hexutil.Big)
+	}
+	return list, nil
+}
+
+// Erc20BalancesOf reads balances of the given ERC20 tokens of the owner at the given block
+// by a single batch of calls to the node. Balances of addresses not implementing the token
+// interface are nil in the list.
+func (axis *AxisBridge) Erc20BalancesOf(owner *common.Address, tokens []common.Address, block *big.Int) (_ []*hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc20BalancesOf(%v, %d tokens)", owner, len(tokens))
+
+	data, err := axis.Erc20Abi().Pack("balanceOf", *owner)
+	if err != nil {
+		axis.log.Errorf("can not pack ERC20 balance call; %s", err.Error())
+		return nil, err
+	}
+
+	batch := make([]ethrpc.BatchElem, len(tokens))
+	for i := range tokens {
+		batch[i] = ethrpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{map[string]interface{}{
+				"to":   tokens[i],
+				"data": hexutil.Bytes(data),
+			}, axis.blockNumberArg(block)},
+			Result: new(hexutil.Bytes),
+		}
+	}
+
+	if err := axis.batchCall(batch); err != nil {
+		axis.log.Errorf("can not get %d ERC20 balances of %s; %s", len(tokens), owner.String(), err.Error())
+		return nil, err
+	}
+
+	list := make([]*hexutil.Big, len(tokens))
+	for i, el := range batch {
+		if el.Error != nil {
+			axis.log.Debugf("ERC20 %s balance of %s not available; %s", tokens[i].String(), owner.String(), el.Error.Error())
+			continue
+		}
+
+		// the call of an address without the code returns nothing
+		out := *el.Result.(*hexutil.Bytes)
+		if len(out) < 32 {
+			continue
+		}
+		list[i] = (*hexutil.Big)(new(big.Int).SetBytes(out[:32]))
+	}
+	return list, nil
+}
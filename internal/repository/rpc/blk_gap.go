@@ -0,0 +1,139 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
+	"sync"
+	"time"
+
+	etc "github.com/ethereum/go-ethereum/core/types"
+)
+
+// rpcBlockGapEventsCapacity represents the capacity of the block gap events channel.
+const rpcBlockGapEventsCapacity = 16
+
+// blockGap tracks the time between new blocks observed on the node
+// to detect abnormal gaps signaling a chain halt.
+type blockGap struct {
+	mu     sync.RWMutex
+	status types.BlockProduction
+}
+
+// observed records a new block head; the finished gap is returned if the chain was stalled.
+func (bg *blockGap) observed(h *etc.Header) (gap time.Duration, resumed bool) {
+	bg.mu.Lock()
+	defer bg.mu.Unlock()
+
+	now := time.Now().UTC()
+	if bg.status.Stalled {
+		gap = now.Sub(bg.status.LastBlockTime)
+		bg.status.Stalled = false
+		bg.status.LastGap = gap
+		if gap > bg.status.LongestGap {
+			bg.status.LongestGap = gap
+		}
+		resumed = true
+	}
+
+	bg.status.LastBlock = h.Number.Uint64()
+	bg.status.LastBlockTime = now
+	return gap, resumed
+}
+
+// stalled checks the time since the last block; true is returned only once per gap,
+// when the gap crosses the threshold.
+func (bg *blockGap) stalled() (gap time.Duration, crossed bool) {
+	bg.mu.Lock()
+	defer bg.mu.Unlock()
+
+	// nothing observed yet, or the gap already reported
+	if bg.status.LastBlockTime.IsZero() || bg.status.Stalled {
+		return 0, false
+	}
+
+	gap = time.Since(bg.status.LastBlockTime)
+	if gap <= bg.status.Threshold {
+		return gap, false
+	}
+
+	bg.status.Stalled = true
+	bg.status.Gaps++
+	return gap, true
+}
+
+// get provides a copy of the current block production status.
+func (bg *blockGap) get() types.BlockProduction {
+	bg.mu.RLock()
+	defer bg.mu.RUnlock()
+	return bg.status
+}
+
+// BlockProduction provides the observed production of new blocks by the network.
+func (axis *AxisBridge) BlockProduction() types.BlockProduction {
+	return axis.gap.get()
+}
+
+// BlockGapEvents provides the channel of block production state changes.
+func (axis *AxisBridge) BlockGapEvents() <-chan *types.BlockGapEvent {
+	return axis.gapEvents
+}
+
+// headObserved records the new block head and notifies the subscriber
+// if it ended an abnormal gap.
+func (axis *AxisBridge) headObserved(h *etc.Header) {
+	gap, resumed := axis.gap.observed(h)
+	if !resumed {
+		return
+	}
+
+	metrics.Gauge("chain/stalled").Update(0)
+	axis.log.Noticef("new block #%d observed after %s", h.Number.Uint64(), gap.String())
+	axis.notifyBlockGap(&types.BlockGapEvent{
+		Stalled:   false,
+		LastBlock: h.Number.Uint64(),
+		Gap:       gap,
+		Stamp:     time.Now().UTC(),
+	})
+}
+
+// checkBlockGap checks the time since the last block and notifies
+// the subscriber if it crossed the threshold.
+func (axis *AxisBridge) checkBlockGap() {
+	gap, crossed := axis.gap.stalled()
+	metrics.Gauge("chain/head/age").Update(gap.Milliseconds())
+	if !crossed {
+		return
+	}
+
+	st := axis.gap.get()
+	metrics.Gauge("chain/stalled").Update(1)
+	metrics.Counter("chain/gaps").Inc(1)
+	axis.log.Criticalf("no new block for %s since #%d", gap.String(), st.LastBlock)
+	axis.notifyBlockGap(&types.BlockGapEvent{
+		Stalled:   true,
+		LastBlock: st.LastBlock,
+		Gap:       gap,
+		Stamp:     time.Now().UTC(),
+	})
+}
+
+// notifyBlockGap posts the block gap event to the subscriber; the event is dropped if nobody listens.
+func (axis *AxisBridge) notifyBlockGap(ev *types.BlockGapEvent) {
+	select {
+	case axis.gapEvents <- ev:
+	default:
+	}
+}
@@ -14,19 +14,31 @@ We strongly discourage opening Lachesis RPC interface for unrestricted Internet
 package rpc
 
 import (
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
 	"context"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
-// axisHeadsObserverSubscribeTick represents the time between subscription attempts.
-const axisHeadsObserverSubscribeTick = 30 * time.Second
-
 // observeBlocks collects new blocks from the blockchain network
 // and posts them into the proxy channel for processing.
+// A broken node connection, e.g. on the node restart, is re-established
+// with exponential back-off and the operator is notified about the outage.
+// Abnormal gaps between new blocks are detected and reported, too.
 func (axis *AxisBridge) observeBlocks() {
 	var sub ethereum.Subscription
+
+	// check the block gaps with reasonable resolution
+	var gapCheck <-chan time.Time
+	if th := axis.gap.get().Threshold; th > 0 {
+		ticker := time.NewTicker(th / 4)
+		defer ticker.Stop()
+		gapCheck = ticker.C
+	}
+
 	defer func() {
 		if sub != nil {
 			sub.Unsubscribe()
@@ -36,16 +48,17 @@ func (axis *AxisBridge) observeBlocks() {
 	}()
 
 	sub = axis.blockSubscription()
+	if sub == nil {
+		axis.connectionLost("block subscription not available")
+	} else {
+		metrics.Gauge("node/connected").Update(1)
+	}
+
 	for {
-		// re-subscribe if the subscription ref is not valid
+		// reconnect if the subscription ref is not valid
 		if sub == nil {
-			tm := time.NewTimer(axisHeadsObserverSubscribeTick)
-			select {
-			case <-axis.sigClose:
+			if sub = axis.reconnect(); sub == nil {
 				return
-			case <-tm.C:
-				sub = axis.blockSubscription()
-				continue
 			}
 		}
 
@@ -53,20 +66,113 @@ func (axis *AxisBridge) observeBlocks() {
 		select {
 		case <-axis.sigClose:
 			return
+		case h := <-axis.heads:
+			axis.survive(func() { axis.headObserved(h) }, "headObserved(#%d)", h.Number)
+			select {
+			case axis.headers <- h:
+			case <-axis.sigClose:
+				return
+			}
+		case <-gapCheck:
+			axis.survive(axis.checkBlockGap, "checkBlockGap()")
 		case err := <-sub.Err():
-			axis.log.Errorf("block subscription failed; %s", err.Error())
+			reason := "subscription closed"
+			if err != nil {
+				reason = err.Error()
+			}
+			axis.log.Criticalf("block subscription failed; %s", reason)
+			axis.connectionLost(reason)
 			sub = nil
 		}
 	}
 }
 
 // blockSubscription provides a subscription for new blocks received
-// by the connected blockchain node.
+// by the connected blockchain node. Nodes not supporting subscriptions,
+// e.g. connected over HTTP, are polled for new blocks instead.
 func (axis *AxisBridge) blockSubscription() ethereum.Subscription {
-	sub, err := axis.rpc.EthSubscribe(context.Background(), axis.headers, "newHeads")
+	sub, err := axis.rpc.EthSubscribe(context.Background(), axis.heads, "newHeads")
+	if err == ethrpc.ErrNotificationsUnsupported {
+		// avoid returning a typed nil
+		if bp := axis.blockPolling(); bp != nil {
+			return bp
+		}
+		return nil
+	}
 	if err != nil {
 		axis.log.Criticalf("can not observe new blocks; %s", err.Error())
 		return nil
 	}
 	return sub
 }
+
+// reconnect re-establishes the node connection by renewing the block subscription;
+// the client re-dials the node on the first call after the connection broke.
+// The delay between attempts doubles up to the configured max delay.
+// Nil is returned if the bridge is closed before the connection is restored.
+func (axis *AxisBridge) reconnect() ethereum.Subscription {
+	delay := axis.reconnectDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	for attempt := 1; ; attempt++ {
+		tm := time.NewTimer(delay)
+		select {
+		case <-axis.sigClose:
+			tm.Stop()
+			return nil
+		case <-tm.C:
+		}
+
+		axis.log.Noticef("reconnecting the node, attempt #%d", attempt)
+		if sub := axis.blockSubscription(); sub != nil {
+			axis.connectionRestored(attempt)
+			return sub
+		}
+
+		if delay *= 2; delay > axis.reconnectMaxDelay {
+			delay = axis.reconnectMaxDelay
+		}
+	}
+}
+
+// connectionLost records the loss of the node connection and notifies the subscriber.
+func (axis *AxisBridge) connectionLost(reason string) {
+	axis.connLost = time.Now().UTC()
+	metrics.Gauge("node/connected").Update(0)
+
+	axis.notifyConnection(&types.NodeConnectionEvent{
+		Connected: false,
+		Reason:    reason,
+		Stamp:     axis.connLost,
+	})
+}
+
+// connectionRestored records the node connection has been re-established and notifies the subscriber.
+func (axis *AxisBridge) connectionRestored(attempts int) {
+	now := time.Now().UTC()
+	metrics.Gauge("node/connected").Update(1)
+	metrics.Counter("node/reconnects").Inc(1)
+	axis.log.Noticef("node connection restored after %d attempts", attempts)
+
+	axis.notifyConnection(&types.NodeConnectionEvent{
+		Connected: true,
+		Attempts:  attempts,
+		Downtime:  now.Sub(axis.connLost),
+		Stamp:     now,
+	})
+}
+
+// notifyConnection posts the connection event to the subscriber; the event is dropped if nobody listens.
+func (axis *AxisBridge) notifyConnection(ev *types.NodeConnectionEvent) {
+	select {
+	case axis.connEvents <- ev:
+	default:
+	}
+}
+
+// ConnectionEvents provides the channel of node connection state changes.
+func (axis *AxisBridge) ConnectionEvents() <-chan *types.NodeConnectionEvent {
+	return axis.connEvents
+}
@@ -0,0 +1,217 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/metrics"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etc "github.com/ethereum/go-ethereum/core/types"
+)
+
+// pollCallTimeout represents the timeout of a single node call of the new blocks polling.
+const pollCallTimeout = 5 * time.Second
+
+// pollMaxFailures represents the number of consecutive failed polls after which
+// the node connection is considered lost; a single failed call, e.g. a timeout
+// of a busy node, only delays the next poll.
+const pollMaxFailures = 3
+
+// pollMaxBackfill represents the max number of blocks missed between two polls pushed to the observer;
+// older blocks are left to the block scanner.
+const pollMaxBackfill = 64
+
+// blockPoller emulates the new heads subscription of a node not supporting subscriptions
+// by polling eth_blockNumber. The polling interval follows a half of the observed block time,
+// within the configured bounds, so new blocks are picked up quickly without flooding the node.
+type blockPoller struct {
+	axis      *AxisBridge
+	interval  time.Duration
+	blockTime time.Duration
+	last      uint64
+	lastTime  time.Time
+	err       chan error
+	quit      chan struct{}
+	once      sync.Once
+}
+
+// blockPolling starts polling the node for new blocks; nil is returned if the node is not available.
+func (axis *AxisBridge) blockPolling() *blockPoller {
+	bp := blockPoller{
+		axis:     axis,
+		interval: axis.pollMin,
+		err:      make(chan error, 1),
+		quit:     make(chan struct{}),
+	}
+
+	// make sure the node is available and learn the current head
+	top, err := bp.blockNumber()
+	if err != nil {
+		axis.log.Criticalf("can not poll new blocks; %s", err.Error())
+		return nil
+	}
+	bp.last, bp.lastTime = top, time.Now()
+
+	axis.log.Noticef("node does not support subscriptions, polling new blocks from #%d", top)
+	go bp.run()
+	return &bp
+}
+
+// Err provides the channel of the polling failure.
+func (bp *blockPoller) Err() <-chan error {
+	return bp.err
+}
+
+// Unsubscribe terminates the polling.
+func (bp *blockPoller) Unsubscribe() {
+	bp.once.Do(func() {
+		close(bp.quit)
+	})
+}
+
+// run polls the node for new blocks until terminated, or until the node fails
+// the given number of polls in a row. Failed polls are retried with a growing delay.
+func (bp *blockPoller) run() {
+	tm := time.NewTimer(bp.interval)
+	defer tm.Stop()
+
+	var failures int
+	for {
+		select {
+		case <-bp.quit:
+			return
+		case <-tm.C:
+		}
+
+		if err := bp.poll(); err != nil {
+			failures++
+			if failures >= pollMaxFailures {
+				bp.err <- err
+				return
+			}
+
+			bp.axis.log.Errorf("block poll %d of %d failed; %s", failures, pollMaxFailures, err.Error())
+			metrics.Counter("node/poll_failures").Inc(1)
+			tm.Reset(bp.backoff(failures))
+			continue
+		}
+
+		failures = 0
+		metrics.Gauge("node/poll_interval").Update(bp.interval.Milliseconds())
+		tm.Reset(bp.interval)
+	}
+}
+
+// backoff provides the delay of the next poll after the given number of failed polls in a row;
+// the delay doubles on each failure, up to the max polling interval, if configured.
+func (bp *blockPoller) backoff(failures int) time.Duration {
+	delay := bp.interval << uint(failures)
+	if bp.axis.pollMax > 0 && delay > bp.axis.pollMax {
+		delay = bp.axis.pollMax
+	}
+	return delay
+}
+
+// poll checks the node for new blocks and pushes their headers to the observer.
+// A panic of the poll is reported as the polling failure.
+func (bp *blockPoller) poll() (err error) {
+	defer bp.axis.isolate(&err, "poll(#%d)", bp.last)
+
+	top, err := bp.blockNumber()
+	if err != nil {
+		return err
+	}
+
+	// no new block yet; slow down a bit in case the block time grew
+	if top <= bp.last {
+		bp.adjust(bp.interval * 5 / 4)
+		return nil
+	}
+
+	// learn the block time and poll twice per block
+	now := time.Now()
+	observed := now.Sub(bp.lastTime) / time.Duration(top-bp.last)
+	if bp.blockTime == 0 {
+		bp.blockTime = observed
+	} else {
+		bp.blockTime = (bp.blockTime*4 + observed) / 5
+	}
+	bp.adjust(bp.blockTime / 2)
+
+	from := bp.last + 1
+	if top-bp.last > pollMaxBackfill {
+		from = top - pollMaxBackfill + 1
+	}
+	for num := from; num <= top; num++ {
+		h, err := bp.header(num)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case bp.axis.heads <- h:
+		case <-bp.quit:
+			return nil
+		}
+
+		// a failed poll is retried from the first block not pushed yet
+		bp.last, bp.lastTime = num, now
+	}
+	return nil
+}
+
+// adjust sets the polling interval within the configured bounds.
+func (bp *blockPoller) adjust(iv time.Duration) {
+	if iv < bp.axis.pollMin {
+		iv = bp.axis.pollMin
+	}
+	if bp.axis.pollMax > 0 && iv > bp.axis.pollMax {
+		iv = bp.axis.pollMax
+	}
+	if iv <= 0 {
+		iv = time.Second
+	}
+	bp.interval = iv
+}
+
+// blockNumber pulls the number of the latest block of the node.
+func (bp *blockPoller) blockNumber() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pollCallTimeout)
+	defer cancel()
+
+	var num hexutil.Uint64
+	if err := bp.axis.callContext(ctx, &num, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return uint64(num), nil
+}
+
+// header pulls the header of the block of the given number.
+func (bp *blockPoller) header(num uint64) (*etc.Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pollCallTimeout)
+	defer cancel()
+
+	var h *etc.Header
+	if err := bp.axis.callContext(ctx, &h, "eth_getBlockByNumber", hexutil.EncodeUint64(num), false); err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, fmt.Errorf("block #%d not found", num)
+	}
+	return h, nil
+}
@@ -33,13 +33,28 @@ const (
 func (axis *AxisBridge) MustBlockHeight() *big.Int {
 	var val hexutil.Big
 	// axis_blockNumber
-	if err := axis.rpc.Call(&val, "axis_blockNumber"); err != nil {
+	if err := axis.call(&val, "axis_blockNumber"); err != nil {
 		axis.log.Errorf("failed block height check; %s", err.Error())
 		return nil
 	}
 	return val.ToInt()
 }
 
+// CallBlockHeight returns the height of the block the default contract calls read the state of;
+// it trails the current block height by the configured call block lag.
+func (axis *AxisBridge) CallBlockHeight() (*hexutil.Big, error) {
+	if cb := axis.callBlockNumber(); cb != nil {
+		return (*hexutil.Big)(cb), nil
+	}
+
+	// no head observed yet, or no lag at all
+	h, err := axis.BlockHeight()
+	if err != nil || axis.callBlockLag == 0 || h.ToInt().Uint64() <= axis.callBlockLag {
+		return h, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(h.ToInt().Uint64() - axis.callBlockLag)), nil
+}
+
 // BlockHeight returns the current block height of the AXIS blockchain.
 func (axis *AxisBridge) BlockHeight() (*hexutil.Big, error) {
 	// keep track of the operation
@@ -48,7 +63,7 @@ func (axis *AxisBridge) BlockHeight() (*hexutil.Big, error) {
 	// call for data
 	var height hexutil.Big
 
-	err := axis.rpc.Call(&height, "axis_blockNumber") // axis_blockNumber
+	err := axis.call(&height, "axis_blockNumber") // axis_blockNumber
 	if err != nil {
 		axis.log.Error("block height could not be obtained")
 		return nil, err
@@ -67,7 +82,7 @@ func (axis *AxisBridge) Block(numTag *string) (*types.Block, error) {
 
 	// call for data
 	var block types.Block
-	err := axis.rpc.Call(&block, "axis_getBlockByNumber", numTag, false)
+	err := axis.call(&block, "axis_getBlockByNumber", numTag, false)
 	if err != nil {
 		axis.log.Error("block could not be extracted")
 		return nil, err
@@ -92,7 +107,7 @@ func (axis *AxisBridge) BlockByHash(hash *string) (*types.Block, error) {
 
 	// call for data
 	var block types.Block
-	err := axis.rpc.Call(&block, "axis_getBlockByHash", hash, false)
+	err := axis.call(&block, "axis_getBlockByHash", hash, false)
 	if err != nil {
 		axis.log.Error("block could not be extracted")
 		return nil, err
@@ -0,0 +1,40 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockHeader returns the raw header of the block by the number directly from the node.
+func (axis *AxisBridge) BlockHeader(num hexutil.Uint64) (*types.BlockHeader, error) {
+	// keep track of the operation
+	axis.log.Debugf("loading header of block #%d", uint64(num))
+
+	// call for data; we use nil-able pointer to detect the block not found situation
+	var hdr *types.BlockHeader
+	if err := axis.call(&hdr, "axis_getBlockByNumber", num.String(), false); err != nil {
+		axis.log.Errorf("block header #%d could not be extracted; %s", uint64(num), err.Error())
+		return nil, err
+	}
+
+	if hdr == nil {
+		axis.log.Debugf("block #%d not found", uint64(num))
+		return nil, fmt.Errorf("block not found")
+	}
+	return hdr, nil
+}
@@ -17,12 +17,16 @@ import (
 	"axis-graphql/internal/config"
 	"axis-graphql/internal/logger"
 	"axis-graphql/internal/repository/rpc/contracts"
+	"axis-graphql/internal/types"
 	"context"
+	"math/big"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	etc "github.com/ethereum/go-ethereum/core/types"
 	eth "github.com/ethereum/go-ethereum/ethclient"
 	axis "github.com/ethereum/go-ethereum/rpc"
@@ -32,10 +36,13 @@ import (
 // rpcHeadProxyChannelCapacity represents the capacity of the new received blocks proxy channel.
 const rpcHeadProxyChannelCapacity = 10000
 
+// rpcConnectionEventsCapacity represents the capacity of the node connection events channel.
+const rpcConnectionEventsCapacity = 16
+
 // AxisBridge represents Lachesis RPC abstraction layer.
 type AxisBridge struct {
 	rpc *axis.Client
-	eth *eth.Client
+	eth *ethClient
 	log logger.Logger
 	cg  *singleflight.Group
 
@@ -49,13 +56,37 @@ type AxisBridge struct {
 	fLendCfg fLendConfig
 
 	// common contracts
-	sfcAbi      *abi.ABI
-	sfcContract *contracts.SfcContract
+	sfcAbi       *abi.ABI
+	sfcContract  *contracts.SfcContract
+	tokenizerAbi *abi.ABI
+	erc20Abi     *abi.ABI
 
 	// received blocks proxy
 	wg       *sync.WaitGroup
 	sigClose chan bool
 	headers  chan *etc.Header
+	heads    chan *etc.Header
+
+	// node responsiveness tracking
+	health      *nodeHealth
+	healthProbe time.Duration
+
+	// node connection recovery
+	reconnectDelay    time.Duration
+	reconnectMaxDelay time.Duration
+	connLost          time.Time
+	connEvents        chan *types.NodeConnectionEvent
+
+	// new blocks polling of nodes without subscriptions support
+	pollMin time.Duration
+	pollMax time.Duration
+
+	// block production tracking
+	gap       *blockGap
+	gapEvents chan *types.BlockGapEvent
+
+	// number of blocks default contract calls lag behind the observed head
+	callBlockLag uint64
 }
 
 // New creates new Lachesis RPC connection bridge.
@@ -67,9 +98,10 @@ func New(cfg *config.Config, log logger.Logger) (*AxisBridge, error) {
 	}
 
 	// build the bridge structure using the con we have
+	health := new(nodeHealth)
 	br := &AxisBridge{
 		rpc: cli,
-		eth: con,
+		eth: &ethClient{Client: con, health: health},
 		log: log,
 		cg:  new(singleflight.Group),
 
@@ -86,6 +118,27 @@ func New(cfg *config.Config, log logger.Logger) (*AxisBridge, error) {
 		wg:       new(sync.WaitGroup),
 		sigClose: make(chan bool, 1),
 		headers:  make(chan *etc.Header, rpcHeadProxyChannelCapacity),
+		heads:    make(chan *etc.Header, rpcHeadProxyChannelCapacity),
+
+		// node health tracking
+		health:      health,
+		healthProbe: cfg.LoadShedding.ProbeInterval,
+
+		// node connection recovery
+		reconnectDelay:    cfg.Lachesis.ReconnectDelay,
+		reconnectMaxDelay: cfg.Lachesis.ReconnectMaxDelay,
+		connEvents:        make(chan *types.NodeConnectionEvent, rpcConnectionEventsCapacity),
+
+		// new blocks polling fallback
+		pollMin: cfg.Lachesis.PollMinInterval,
+		pollMax: cfg.Lachesis.PollMaxInterval,
+
+		// block production tracking
+		gap:       &blockGap{status: types.BlockProduction{Threshold: cfg.Lachesis.BlockGap}},
+		gapEvents: make(chan *types.BlockGapEvent, rpcBlockGapEventsCapacity),
+
+		// default contract calls block
+		callBlockLag: callBlockLag(&cfg.Lachesis, log),
 	}
 
 	// inform about the local address of the API node
@@ -125,11 +178,17 @@ func connect(cfg *config.Config, log logger.Logger) (*axis.Client, *eth.Client,
 func (axis *AxisBridge) run() {
 	axis.wg.Add(1)
 	go axis.observeBlocks()
+
+	// probe the node health only if we have a reasonable interval
+	if axis.healthProbe > 0 {
+		axis.wg.Add(1)
+		go axis.observeHealth()
+	}
 }
 
 // terminate kills the bridge threads to end the bridge gracefully.
 func (axis *AxisBridge) terminate() {
-	axis.sigClose <- true
+	close(axis.sigClose)
 	axis.wg.Wait()
 	axis.log.Noticef("rpc threads terminated")
 }
@@ -152,6 +211,31 @@ func (axis *AxisBridge) Connection() *axis.Client {
 	return axis.rpc
 }
 
+// CallOptsAt creates call options reading the state of the given block; nil represents the latest block.
+func (axis *AxisBridge) CallOptsAt(block *big.Int) *bind.CallOpts {
+	if block == nil {
+		return axis.DefaultCallOpts()
+	}
+	return &bind.CallOpts{
+		Pending:     false,
+		From:        axis.sigConfig.Address,
+		BlockNumber: block,
+		Context:     context.Background(),
+	}
+}
+
+// blockNumberArg encodes the block number argument of an RPC call; nil represents the block
+// of the default contract calls, so the configured call block lag applies to these reads as well.
+func (axis *AxisBridge) blockNumberArg(block *big.Int) string {
+	if block == nil {
+		block = axis.callBlockNumber()
+	}
+	if block == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(block)
+}
+
 // DefaultCallOpts creates a default record for call options.
 func (axis *AxisBridge) DefaultCallOpts() *bind.CallOpts {
 	// get the default call opts only once if called in parallel
@@ -159,13 +243,43 @@ func (axis *AxisBridge) DefaultCallOpts() *bind.CallOpts {
 		return &bind.CallOpts{
 			Pending:     false,
 			From:        axis.sigConfig.Address,
-			BlockNumber: nil,
+			BlockNumber: axis.callBlockNumber(),
 			Context:     context.Background(),
 		}, nil
 	})
 	return co.(*bind.CallOpts)
 }
 
+// callBlockNumber provides the block number read by the default contract calls;
+// nil represents the latest block, which is also used until a head is observed.
+func (axis *AxisBridge) callBlockNumber() *big.Int {
+	if axis.callBlockLag == 0 {
+		return nil
+	}
+
+	head := axis.gap.get().LastBlock
+	if head <= axis.callBlockLag {
+		return nil
+	}
+	return new(big.Int).SetUint64(head - axis.callBlockLag)
+}
+
+// callBlockLag derives the number of blocks default contract calls lag behind the head
+// from the configured call block tag.
+func callBlockLag(cfg *config.Lachesis, log logger.Logger) uint64 {
+	switch cfg.CallBlock {
+	case config.CallBlockLatest, "":
+		return 0
+	case config.CallBlockSafe:
+		return (cfg.FinalityDepth + 1) / 2
+	case config.CallBlockFinalized:
+		return cfg.FinalityDepth
+	}
+
+	log.Warningf("unknown call block %s, using %s", cfg.CallBlock, config.CallBlockLatest)
+	return 0
+}
+
 // SfcContract returns instance of SFC contract for interaction.
 func (axis *AxisBridge) SfcContract() *contracts.SfcContract {
 	// lazy create SFC contract instance
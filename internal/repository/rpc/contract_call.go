@@ -0,0 +1,50 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// contractCallGasLimit represents the gas available to a read-only contract call,
+// so a call can not keep the node busy executing expensive contract code.
+const contractCallGasLimit = 25000000
+
+// ContractCall executes a read-only call of the given contract with the given input data
+// and provides the raw output of the call. The state of the given block is read, the block
+// of the default contract calls is used if the block is not specified, or if the block
+// is more recent than the configured call block lag allows.
+func (axis *AxisBridge) ContractCall(to *common.Address, input []byte, block *big.Int) (_ []byte, err error) {
+	defer axis.isolate(&err, "ContractCall(%v)", to)
+
+	if cb := axis.callBlockNumber(); block == nil || (cb != nil && block.Cmp(cb) > 0) {
+		block = cb
+	}
+
+	data, err := axis.eth.CallContract(context.Background(), ethereum.CallMsg{
+		From: axis.sigConfig.Address,
+		To:   to,
+		Gas:  contractCallGasLimit,
+		Data: input,
+	}, block)
+	if err != nil {
+		axis.log.Debugf("call of contract %s failed; %s", to.String(), err.Error())
+		return nil, err
+	}
+	return data, nil
+}
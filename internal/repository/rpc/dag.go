@@ -0,0 +1,80 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DagEvent returns the Lachesis consensus event by its id.
+// The node has to expose the dag namespace of the RPC interface.
+func (axis *AxisBridge) DagEvent(id *common.Hash) (*types.DagEvent, error) {
+	// keep track of the operation
+	axis.log.Debugf("loading DAG event %s", id.String())
+
+	var ev *types.DagEvent
+	if err := axis.call(&ev, "dag_getEvent", id.String()); err != nil {
+		axis.log.Errorf("DAG event %s could not be extracted; %s", id.String(), err.Error())
+		return nil, err
+	}
+
+	if ev == nil {
+		return nil, fmt.Errorf("event %s not found", id.String())
+	}
+	return ev, nil
+}
+
+// DagEventPayload returns the Lachesis consensus event by its id including the list of transactions.
+func (axis *AxisBridge) DagEventPayload(id *common.Hash) (*types.DagEventPayload, error) {
+	// keep track of the operation
+	axis.log.Debugf("loading DAG event %s payload", id.String())
+
+	// we want only hashes of the transactions
+	var ev *types.DagEventPayload
+	if err := axis.call(&ev, "dag_getEventPayload", id.String(), false); err != nil {
+		axis.log.Errorf("DAG event %s payload could not be extracted; %s", id.String(), err.Error())
+		return nil, err
+	}
+
+	if ev == nil {
+		return nil, fmt.Errorf("event %s not found", id.String())
+	}
+	return ev, nil
+}
+
+// DagHeads returns the list of ids of the head events of the given epoch.
+// Current epoch is used if the epoch is not specified.
+func (axis *AxisBridge) DagHeads(epoch *hexutil.Uint64) ([]common.Hash, error) {
+	// the node expects block number like epoch identifier; "latest" means the current epoch
+	ep := BlockTypeLatest
+	if epoch != nil {
+		ep = epoch.String()
+	}
+
+	var heads []hexutil.Bytes
+	if err := axis.call(&heads, "dag_getHeads", ep); err != nil {
+		axis.log.Errorf("DAG heads of epoch %s could not be extracted; %s", ep, err.Error())
+		return nil, err
+	}
+
+	list := make([]common.Hash, len(heads))
+	for i, h := range heads {
+		list[i] = common.BytesToHash(h)
+	}
+	return list, nil
+}
@@ -35,7 +35,9 @@ type fLendConfig struct {
 }
 
 // FLendGetLendingPool resolves Lending pool contract instance
-func (axis *AxisBridge) FLendGetLendingPool() (*contracts.ILendingPool, error) {
+func (axis *AxisBridge) FLendGetLendingPool() (_ *contracts.ILendingPool, err error) {
+	defer axis.isolate(&err, "FLendGetLendingPool()")
+
 	// get the lending pool contract
 	lp, err := contracts.NewILendingPool(axis.fLendCfg.lendigPoolAddress, axis.eth)
 	if err != nil {
@@ -46,7 +48,8 @@ func (axis *AxisBridge) FLendGetLendingPool() (*contracts.ILendingPool, error) {
 }
 
 // FLendGetLendingPoolReserveData resolves reserve data
-func (axis *AxisBridge) FLendGetLendingPoolReserveData(assetAddress *common.Address) (*types.ReserveData, error) {
+func (axis *AxisBridge) FLendGetLendingPoolReserveData(assetAddress *common.Address) (_ *types.ReserveData, err error) {
+	defer axis.isolate(&err, "FLendGetLendingPoolReserveData(%v)", assetAddress)
 
 	// get the lending pool contract
 	lp, err := axis.FLendGetLendingPool()
@@ -55,7 +58,7 @@ func (axis *AxisBridge) FLendGetLendingPoolReserveData(assetAddress *common.Addr
 		return nil, err
 	}
 
-	rd, err := lp.GetReserveData(&bind.CallOpts{}, *assetAddress)
+	rd, err := lp.GetReserveData(axis.DefaultCallOpts(), *assetAddress)
 	if err != nil {
 		axis.log.Errorf("Cannot get reserve data for asset %s: %s", assetAddress.String(), err.Error())
 		return nil, err
@@ -81,7 +84,8 @@ func (axis *AxisBridge) FLendGetLendingPoolReserveData(assetAddress *common.Addr
 }
 
 // FLendGetReserveList resolves list of reserve addresses
-func (axis *AxisBridge) FLendGetReserveList() ([]common.Address, error) {
+func (axis *AxisBridge) FLendGetReserveList() (_ []common.Address, err error) {
+	defer axis.isolate(&err, "FLendGetReserveList()")
 
 	// get the lending pool contract
 	lp, err := axis.FLendGetLendingPool()
@@ -90,7 +94,7 @@ func (axis *AxisBridge) FLendGetReserveList() ([]common.Address, error) {
 		return nil, err
 	}
 
-	rl, err := lp.GetReservesList(&bind.CallOpts{})
+	rl, err := lp.GetReservesList(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Cannot get reserves list: %s", err.Error())
 		return nil, err
@@ -99,7 +103,8 @@ func (axis *AxisBridge) FLendGetReserveList() ([]common.Address, error) {
 }
 
 // FLendGetUserAccountData resolves user account data for fLend
-func (axis *AxisBridge) FLendGetUserAccountData(userAddress *common.Address) (*types.FLendUserAccountData, error) {
+func (axis *AxisBridge) FLendGetUserAccountData(userAddress *common.Address) (_ *types.FLendUserAccountData, err error) {
+	defer axis.isolate(&err, "FLendGetUserAccountData(%v)", userAddress)
 
 	// get the lending pool contract
 	lp, err := axis.FLendGetLendingPool()
@@ -108,13 +113,13 @@ func (axis *AxisBridge) FLendGetUserAccountData(userAddress *common.Address) (*t
 		return nil, err
 	}
 
-	ua, err := lp.GetUserAccountData(&bind.CallOpts{}, *userAddress)
+	ua, err := lp.GetUserAccountData(axis.DefaultCallOpts(), *userAddress)
 	if err != nil {
 		axis.log.Errorf("Cannot get user account data for address %s: %s", userAddress.String(), err.Error())
 		return nil, err
 	}
 
-	uc, err := lp.GetUserConfiguration(&bind.CallOpts{}, *userAddress)
+	uc, err := lp.GetUserConfiguration(axis.DefaultCallOpts(), *userAddress)
 	if err != nil {
 		axis.log.Errorf("Cannot get user account configuration data for address %s: %s", userAddress.String(), err.Error())
 		return nil, err
@@ -133,7 +138,9 @@ func (axis *AxisBridge) FLendGetUserAccountData(userAddress *common.Address) (*t
 }
 
 // FLendGetUserDepositHistory resolves deposit event history data for specified user and asset address
-func (axis *AxisBridge) FLendGetUserDepositHistory(userAddress *common.Address, assetAddress *common.Address) ([]*types.FLendDeposit, error) {
+func (axis *AxisBridge) FLendGetUserDepositHistory(userAddress *common.Address, assetAddress *common.Address) (_ []*types.FLendDeposit, err error) {
+	defer axis.isolate(&err, "FLendGetUserDepositHistory(%v, %v)", userAddress, assetAddress)
+
 	// create user filter
 	userFilter := make([]common.Address, 0)
 	if userAddress != nil {
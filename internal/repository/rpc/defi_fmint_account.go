@@ -34,9 +34,10 @@ import (
 const fMintRewardsPushTimeBarrier = time.Duration(-70) * time.Minute
 
 // FMintAccount loads details of a DeFi/fMint protocol account identified by the owner address.
-func (axis *AxisBridge) FMintAccount(owner *common.Address) (*types.FMintAccount, error) {
+func (axis *AxisBridge) FMintAccount(owner *common.Address) (_ *types.FMintAccount, err error) {
+	defer axis.isolate(&err, "FMintAccount(%v)", owner)
+
 	// make the container
-	var err error
 	da := types.FMintAccount{Address: *owner}
 
 	// load list of collateral tokens
@@ -61,9 +62,11 @@ func (axis *AxisBridge) FMintAccount(owner *common.Address) (*types.FMintAccount
 }
 
 // FMintPoolBalance loads balance of an fMint token from the given pool contract.
-func (axis *AxisBridge) FMintPoolBalance(pool *contracts.DeFiTokenStorage, owner *common.Address, token *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) FMintPoolBalance(pool *contracts.DeFiTokenStorage, owner *common.Address, token *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintPoolBalance(%v, %v, %v)", pool, owner, token)
+
 	// get the collateral token balance
-	val, err := pool.BalanceOf(nil, *owner, *token)
+	val, err := pool.BalanceOf(axis.DefaultCallOpts(), *owner, *token)
 	if err != nil {
 		axis.log.Debugf("pool balance failed on token %s, account %s; %s", token.String(), owner.String(), err.Error())
 		return hexutil.Big{}, err
@@ -79,8 +82,9 @@ func (axis *AxisBridge) FMintPoolBalance(pool *contracts.DeFiTokenStorage, owner
 }
 
 // FMintTokenBalance loads balance of a single DeFi token in fMint contract by it's address.
-func (axis *AxisBridge) FMintTokenBalance(owner *common.Address, token *common.Address, tp types.DefiTokenType) (hexutil.Big, error) {
-	var err error
+func (axis *AxisBridge) FMintTokenBalance(owner *common.Address, token *common.Address, tp types.DefiTokenType) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintTokenBalance(%v, %v, %v)", owner, token, tp)
+
 	var pool *contracts.DeFiTokenStorage
 
 	// pull the right value based to token type
@@ -107,8 +111,9 @@ func (axis *AxisBridge) FMintTokenBalance(owner *common.Address, token *common.A
 }
 
 // FMintTokenTotalBalance loads total balance of a single DeFi token by it's address.
-func (axis *AxisBridge) FMintTokenTotalBalance(token *common.Address, tp types.DefiTokenType) (hexutil.Big, error) {
-	var err error
+func (axis *AxisBridge) FMintTokenTotalBalance(token *common.Address, tp types.DefiTokenType) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintTokenTotalBalance(%v, %v)", token, tp)
+
 	var pool *contracts.DeFiTokenStorage
 
 	// pull the right value based to token type
@@ -132,7 +137,7 @@ func (axis *AxisBridge) FMintTokenTotalBalance(token *common.Address, tp types.D
 	}
 
 	// get the collateral token balance
-	val, err := pool.TotalBalance(nil, *token)
+	val, err := pool.TotalBalance(axis.DefaultCallOpts(), *token)
 	if err != nil {
 		axis.log.Debugf("pool total balance failed on token %s", token.String(), err.Error())
 		return hexutil.Big{}, err
@@ -148,7 +153,9 @@ func (axis *AxisBridge) FMintTokenTotalBalance(token *common.Address, tp types.D
 }
 
 // FMintTokenValue loads value of a single DeFi token by it's address in fUSD.
-func (axis *AxisBridge) FMintTokenValue(owner *common.Address, token *common.Address, tp types.DefiTokenType) (hexutil.Big, error) {
+func (axis *AxisBridge) FMintTokenValue(owner *common.Address, token *common.Address, tp types.DefiTokenType) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintTokenValue(%v, %v, %v)", owner, token, tp)
+
 	// get the balance
 	balance, err := axis.FMintTokenBalance(owner, token, tp)
 	if err != nil {
@@ -169,7 +176,9 @@ func (axis *AxisBridge) FMintTokenValue(owner *common.Address, token *common.Add
 }
 
 // FMintTokenPrice loads the current price of the given token from on-chain price oracle.
-func (axis *AxisBridge) FMintTokenPrice(token *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) FMintTokenPrice(token *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintTokenPrice(%v)", token)
+
 	// get the price oracle address
 	oracle, err := axis.fMintCfg.priceOracleProxyContract()
 	if err != nil {
@@ -177,7 +186,7 @@ func (axis *AxisBridge) FMintTokenPrice(token *common.Address) (hexutil.Big, err
 	}
 
 	// get the price for the given token from oracle
-	val, err := oracle.GetPrice(nil, *token)
+	val, err := oracle.GetPrice(axis.DefaultCallOpts(), *token)
 	if err != nil {
 		axis.log.Errorf("price not available for token %s; %s", token.String(), err.Error())
 		return hexutil.Big{}, nil
@@ -192,6 +201,26 @@ func (axis *AxisBridge) FMintTokenPrice(token *common.Address) (hexutil.Big, err
 	return hexutil.Big(*val), nil
 }
 
+// FMintExtendedPrice loads the price of the given token used by the fMint contract
+// along with the digits correction of the price, i.e. value = amount * price / digits.
+func (axis *AxisBridge) FMintExtendedPrice(token *common.Address) (_ *big.Int, _ *big.Int, err error) {
+	defer axis.isolate(&err, "FMintExtendedPrice(%v)", token)
+
+	// connect the contract
+	contract, err := axis.fMintCfg.fMintMinterContract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// get the price and digits
+	ep, err := contract.GetExtendedPrice(axis.DefaultCallOpts(), *token)
+	if err != nil {
+		axis.log.Errorf("extended price not available for token %s; %s", token.String(), err.Error())
+		return nil, nil, err
+	}
+	return ep.Price, ep.Digits, nil
+}
+
 // fMintAccountTokensValue loads total value status of a given fMint account.
 func (axis *AxisBridge) fMintAccountValue(owner common.Address) (hexutil.Big, hexutil.Big, error) {
 	// connect the contract
@@ -201,14 +230,14 @@ func (axis *AxisBridge) fMintAccountValue(owner common.Address) (hexutil.Big, he
 	}
 
 	// get joined collateral value
-	cValue, err := contract.CollateralValueOf(nil, owner, common.Address{}, new(big.Int))
+	cValue, err := contract.CollateralValueOf(axis.DefaultCallOpts(), owner, common.Address{}, new(big.Int))
 	if err != nil {
 		axis.log.Errorf("joined collateral value loader failed")
 		return hexutil.Big{}, hexutil.Big{}, err
 	}
 
 	// get joined debt value
-	dValue, err := contract.DebtValueOf(nil, owner, common.Address{}, new(big.Int))
+	dValue, err := contract.DebtValueOf(axis.DefaultCallOpts(), owner, common.Address{}, new(big.Int))
 	if err != nil {
 		axis.log.Errorf("joined debt value loader failed")
 		return hexutil.Big{}, hexutil.Big{}, err
@@ -220,7 +249,9 @@ func (axis *AxisBridge) fMintAccountValue(owner common.Address) (hexutil.Big, he
 
 // FMintRewardsEarned resolves the total amount of rewards
 // accumulated on the account for the excessive collateral deposits.
-func (axis *AxisBridge) FMintRewardsEarned(addr *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) FMintRewardsEarned(addr *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintRewardsEarned(%v)", addr)
+
 	// connect the contract
 	contract, err := axis.fMintCfg.fMintRewardsDistribution()
 	if err != nil {
@@ -254,7 +285,9 @@ func (axis *AxisBridge) FMintRewardsEarned(addr *common.Address) (hexutil.Big, e
 
 // FMintRewardsStashed resolves the total amount of rewards
 // accumulated on the account for the excessive collateral deposits.
-func (axis *AxisBridge) FMintRewardsStashed(addr *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) FMintRewardsStashed(addr *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "FMintRewardsStashed(%v)", addr)
+
 	// connect the contract
 	contract, err := axis.fMintCfg.fMintRewardsDistribution()
 	if err != nil {
@@ -262,7 +295,7 @@ func (axis *AxisBridge) FMintRewardsStashed(addr *common.Address) (hexutil.Big,
 	}
 
 	// get the rewards
-	rw, err := contract.RewardStash(nil, *addr)
+	rw, err := contract.RewardStash(axis.DefaultCallOpts(), *addr)
 	if err != nil {
 		axis.log.Errorf("can not calculate stashed rewards; %s", err.Error())
 		return hexutil.Big{}, err
@@ -273,7 +306,9 @@ func (axis *AxisBridge) FMintRewardsStashed(addr *common.Address) (hexutil.Big,
 
 // FMintCanClaimRewards resolves the fMint account flag for being allowed
 // to claim earned rewards.
-func (axis *AxisBridge) FMintCanClaimRewards(addr *common.Address) (bool, error) {
+func (axis *AxisBridge) FMintCanClaimRewards(addr *common.Address) (_ bool, err error) {
+	defer axis.isolate(&err, "FMintCanClaimRewards(%v)", addr)
+
 	// connect the contract
 	contract, err := axis.fMintCfg.fMintMinterContract()
 	if err != nil {
@@ -282,7 +317,7 @@ func (axis *AxisBridge) FMintCanClaimRewards(addr *common.Address) (bool, error)
 	}
 
 	// ask if the claim is possible
-	flag, err := contract.RewardCanClaim(nil, *addr)
+	flag, err := contract.RewardCanClaim(axis.DefaultCallOpts(), *addr)
 	if err != nil {
 		axis.log.Errorf("can not check rewards claim flag; %s", err.Error())
 		return false, err
@@ -294,7 +329,9 @@ func (axis *AxisBridge) FMintCanClaimRewards(addr *common.Address) (bool, error)
 // FMintCanReceiveRewards resolves the fMint account flag for being eligible
 // to receive earned rewards. If the collateral to debt ration drop below
 // certain value, earned rewards are burned.
-func (axis *AxisBridge) FMintCanReceiveRewards(addr *common.Address) (bool, error) {
+func (axis *AxisBridge) FMintCanReceiveRewards(addr *common.Address) (_ bool, err error) {
+	defer axis.isolate(&err, "FMintCanReceiveRewards(%v)", addr)
+
 	// connect the contract
 	contract, err := axis.fMintCfg.fMintMinterContract()
 	if err != nil {
@@ -303,7 +340,7 @@ func (axis *AxisBridge) FMintCanReceiveRewards(addr *common.Address) (bool, erro
 	}
 
 	// ask if the claim is possible
-	flag, err := contract.RewardIsEligible(nil, *addr)
+	flag, err := contract.RewardIsEligible(axis.DefaultCallOpts(), *addr)
 	if err != nil {
 		axis.log.Errorf("can not check rewards eligibility flag; %s", err.Error())
 		return false, err
@@ -314,7 +351,9 @@ func (axis *AxisBridge) FMintCanReceiveRewards(addr *common.Address) (bool, erro
 
 // FMintCanPushRewards signals if there are any rewards unlocked
 // on the rewards distribution contract and can be pushed to accounts.
-func (axis *AxisBridge) FMintCanPushRewards() (bool, error) {
+func (axis *AxisBridge) FMintCanPushRewards() (_ bool, err error) {
+	defer axis.isolate(&err, "FMintCanPushRewards()")
+
 	// connect the contract
 	contract, err := axis.fMintCfg.fMintRewardsDistribution()
 	if err != nil {
@@ -322,7 +361,7 @@ func (axis *AxisBridge) FMintCanPushRewards() (bool, error) {
 	}
 
 	// get the last time rewards were pushed
-	lastPush, err := contract.LastRewardPush(nil)
+	lastPush, err := contract.LastRewardPush(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("can not check rewards last push; %s", err.Error())
 		return false, err
@@ -202,6 +202,15 @@ func (fmc *fMintConfig) pullContractAddress(name string) (common.Address, error)
 	return *adr, nil
 }
 
+// FMintResetAddresses drops all the fMint contract addresses resolved
+// so far so they are re-loaded from the AddressProvider on the next use.
+func (axis *AxisBridge) FMintResetAddresses() {
+	axis.fMintCfg.contracts.Range(func(key, _ interface{}) bool {
+		axis.fMintCfg.contracts.Delete(key)
+		return true
+	})
+}
+
 // loadAddress loads a specified contract address from the AddressProvider.
 func (fmc *fMintConfig) loadAddress(name string) (*common.Address, error) {
 	// connect the Address Provider
@@ -216,7 +225,7 @@ func (fmc *fMintConfig) loadAddress(name string) (*common.Address, error) {
 	copy(id[:], name)
 
 	// try to get the address
-	addr, err := ap.GetAddress(nil, id)
+	addr, err := ap.GetAddress(fmc.bridge.DefaultCallOpts(), id)
 	if err != nil {
 		fmc.bridge.log.Errorf("[%s] can not get address of %s; %s", fmc.addressProvider.String(), name, err.Error())
 		return nil, err
@@ -29,7 +29,9 @@ import (
 type tConfigItemsLoaders map[*hexutil.Big]func(*bind.CallOpts) (*big.Int, error)
 
 // DefiConfiguration resolves the current DeFi contract settings.
-func (axis *AxisBridge) DefiConfiguration() (*types.DefiSettings, error) {
+func (axis *AxisBridge) DefiConfiguration() (_ *types.DefiSettings, err error) {
+	defer axis.isolate(&err, "DefiConfiguration()")
+
 	// access the contract
 	contract, err := axis.fMintCfg.fMintMinterContract()
 	if err != nil {
@@ -112,7 +114,7 @@ func (axis *AxisBridge) pullSetOfDefiConfigValues(loaders tConfigItemsLoaders) e
 // tradeFee4 pulls DeFi trading fee from the Liquidity Pool contract.
 func (axis *AxisBridge) pullDefiConfigValue(cf func(*bind.CallOpts) (*big.Int, error)) (hexutil.Big, error) {
 	// pull the trading fee value
-	val, err := cf(nil)
+	val, err := cf(axis.DefaultCallOpts())
 	if err != nil {
 		return hexutil.Big{}, err
 	}
@@ -27,7 +27,9 @@ import (
 )
 
 // DefiTokens resolves list of DeFi tokens available for the DeFi functions.
-func (axis *AxisBridge) DefiTokens() ([]types.DefiToken, error) {
+func (axis *AxisBridge) DefiTokens() (_ []types.DefiToken, err error) {
+	defer axis.isolate(&err, "DefiTokens()")
+
 	// connect the contract
 	contract, err := axis.fMintCfg.tokenRegistryContract()
 	if err != nil {
@@ -39,7 +41,9 @@ func (axis *AxisBridge) DefiTokens() ([]types.DefiToken, error) {
 
 // DefiTokenList creates a list of addresses / identifiers of all the ERC20 tokens
 // involved with the fMint protocol.
-func (axis *AxisBridge) DefiTokenList() ([]common.Address, error) {
+func (axis *AxisBridge) DefiTokenList() (_ []common.Address, err error) {
+	defer axis.isolate(&err, "DefiTokenList()")
+
 	// connect the contract
 	contract, err := axis.fMintCfg.tokenRegistryContract()
 	if err != nil {
@@ -50,7 +54,9 @@ func (axis *AxisBridge) DefiTokenList() ([]common.Address, error) {
 }
 
 // DefiToken loads details of a single DeFi token by it's address.
-func (axis *AxisBridge) DefiToken(token *common.Address) (*types.DefiToken, error) {
+func (axis *AxisBridge) DefiToken(token *common.Address) (_ *types.DefiToken, err error) {
+	defer axis.isolate(&err, "DefiToken(%v)", token)
+
 	// connect the contract
 	contract, err := axis.fMintCfg.tokenRegistryContract()
 	if err != nil {
@@ -67,7 +73,7 @@ func (axis *AxisBridge) defiTokenAddressList(
 	fItem func(*bind.CallOpts, *big.Int) (common.Address, error),
 ) ([]common.Address, error) {
 	// get the number of tokens in the reference aggregator
-	count, err := fCount(nil)
+	count, err := fCount(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("can not get tokens range; %s", err.Error())
 		return nil, err
@@ -80,7 +86,7 @@ func (axis *AxisBridge) defiTokenAddressList(
 	// load all the tokens in the contract
 	for i := uint64(0); i < count.Uint64(); i++ {
 		// read the indexed token from contract
-		list[i], err = fItem(nil, index.SetUint64(i))
+		list[i], err = fItem(axis.DefaultCallOpts(), index.SetUint64(i))
 		if err != nil {
 			axis.log.Errorf("token %d address not found; %s", i, err.Error())
 			return nil, err
@@ -93,7 +99,7 @@ func (axis *AxisBridge) defiTokenAddressList(
 // defiTokenDetail loads details of a token specified by the token address.
 func (axis *AxisBridge) defiTokenDetail(contract *contracts.DefiFMintTokenRegistry, token *common.Address) (*types.DefiToken, error) {
 	// get the token details
-	tk, err := contract.Tokens(nil, *token)
+	tk, err := contract.Tokens(axis.DefaultCallOpts(), *token)
 	if err != nil {
 		axis.log.Errorf("token %s not found; %s", token.String(), err.Error())
 		return nil, err
@@ -25,7 +25,9 @@ import (
 //go:generate tools/abigen.sh --abi ./contracts/abi/erc1155.abi --pkg contracts --type ERC1155 --out ./contracts/erc1155_token.go
 
 // Erc1155Uri provides URI of Metadata JSON Schema of the ERC1155 token.
-func (axis *AxisBridge) Erc1155Uri(token *common.Address, tokenId *big.Int) (string, error) {
+func (axis *AxisBridge) Erc1155Uri(token *common.Address, tokenId *big.Int) (_ string, err error) {
+	defer axis.isolate(&err, "Erc1155Uri(%v, %v)", token, tokenId)
+
 	// connect the contract
 	contract, err := contracts.NewERC1155(*token, axis.eth)
 	if err != nil {
@@ -44,7 +46,9 @@ func (axis *AxisBridge) Erc1155Uri(token *common.Address, tokenId *big.Int) (str
 }
 
 // Erc1155BalanceOf provides amount of tokens owned by given owner in given ERC1155 contract.
-func (axis *AxisBridge) Erc1155BalanceOf(token *common.Address, owner *common.Address, tokenId *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) Erc1155BalanceOf(token *common.Address, owner *common.Address, tokenId *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "Erc1155BalanceOf(%v, %v, %v)", token, owner, tokenId)
+
 	// connect the contract
 	contract, err := contracts.NewERC1155(*token, axis.eth)
 	if err != nil {
@@ -62,7 +66,9 @@ func (axis *AxisBridge) Erc1155BalanceOf(token *common.Address, owner *common.Ad
 }
 
 // Erc1155BalanceOfBatch provides amounts of tokens owned by given owners in given ERC1155 contract.
-func (axis *AxisBridge) Erc1155BalanceOfBatch(token *common.Address, owners *[]common.Address, tokenIds []*big.Int) ([]*big.Int, error) {
+func (axis *AxisBridge) Erc1155BalanceOfBatch(token *common.Address, owners *[]common.Address, tokenIds []*big.Int) (_ []*big.Int, err error) {
+	defer axis.isolate(&err, "Erc1155BalanceOfBatch(%v, %v, %v)", token, owners, tokenIds)
+
 	// connect the contract
 	contract, err := contracts.NewERC1155(*token, axis.eth)
 	if err != nil {
@@ -80,7 +86,9 @@ func (axis *AxisBridge) Erc1155BalanceOfBatch(token *common.Address, owners *[]c
 }
 
 // Erc1155IsApprovedForAll provides information about operator approved to manipulate with tokens of given owner.
-func (axis *AxisBridge) Erc1155IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (bool, error) {
+func (axis *AxisBridge) Erc1155IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (_ bool, err error) {
+	defer axis.isolate(&err, "Erc1155IsApprovedForAll(%v, %v, %v)", token, owner, operator)
+
 	// connect the contract
 	contract, err := contracts.NewERC1155(*token, axis.eth)
 	if err != nil {
@@ -21,7 +21,9 @@ import (
 
 //go:generate tools/abigen.sh --abi ./contracts/abi/erc165.abi --pkg contracts --type ERC165 --out ./contracts/erc165.go
 
-func (axis *AxisBridge) Erc165SupportsInterface(address *common.Address, interfaceID [4]byte) (bool, error) {
+func (axis *AxisBridge) Erc165SupportsInterface(address *common.Address, interfaceID [4]byte) (_ bool, err error) {
+	defer axis.isolate(&err, "Erc165SupportsInterface(%v, %v)", address, interfaceID)
+
 	// connect the contract
 	contract, err := contracts.NewERC165(*address, axis.eth)
 	if err != nil {
@@ -25,7 +25,9 @@ import (
 //go:generate tools/abigen.sh --abi ./contracts/abi/WAXIS.abi --pkg contracts --type ErcWrappedFtm --out ./contracts/erc20WAXIS_token.go
 
 // Erc20Name provides information about the name of the ERC20 token.
-func (axis *AxisBridge) Erc20Name(token *common.Address) (string, error) {
+func (axis *AxisBridge) Erc20Name(token *common.Address) (_ string, err error) {
+	defer axis.isolate(&err, "Erc20Name(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -44,7 +46,9 @@ func (axis *AxisBridge) Erc20Name(token *common.Address) (string, error) {
 }
 
 // Erc20Symbol provides information about the symbol of the ERC20 token.
-func (axis *AxisBridge) Erc20Symbol(token *common.Address) (string, error) {
+func (axis *AxisBridge) Erc20Symbol(token *common.Address) (_ string, err error) {
+	defer axis.isolate(&err, "Erc20Symbol(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -63,7 +67,9 @@ func (axis *AxisBridge) Erc20Symbol(token *common.Address) (string, error) {
 }
 
 // Erc20Decimals provides information about the decimals of the ERC20 token.
-func (axis *AxisBridge) Erc20Decimals(token *common.Address) (int32, error) {
+func (axis *AxisBridge) Erc20Decimals(token *common.Address) (_ int32, err error) {
+	defer axis.isolate(&err, "Erc20Decimals(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -82,8 +88,10 @@ func (axis *AxisBridge) Erc20Decimals(token *common.Address) (int32, error) {
 }
 
 // Erc20BalanceOf loads the current available balance of and ERC20 token identified by the token
-// contract address for an identified owner address.
-func (axis *AxisBridge) Erc20BalanceOf(token *common.Address, owner *common.Address) (hexutil.Big, error) {
+// contract address for an identified owner address at the given block; nil represents the latest block.
+func (axis *AxisBridge) Erc20BalanceOf(token *common.Address, owner *common.Address, block *big.Int) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc20BalanceOf(%v, %v, %v)", token, owner, block)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -92,7 +100,7 @@ func (axis *AxisBridge) Erc20BalanceOf(token *common.Address, owner *common.Addr
 	}
 
 	// get the balance
-	val, err := contract.BalanceOf(nil, *owner)
+	val, err := contract.BalanceOf(axis.CallOptsAt(block), *owner)
 	if err != nil {
 		axis.log.Errorf("can not ERC20 %s balance for %s; %s", token.String(), owner.String(), err.Error())
 		return hexutil.Big{}, err
@@ -112,7 +120,9 @@ func (axis *AxisBridge) Erc20BalanceOf(token *common.Address, owner *common.Addr
 
 // Erc20Allowance loads the current amount of ERC20 tokens unlocked for DeFi
 // contract by the token owner.
-func (axis *AxisBridge) Erc20Allowance(token *common.Address, owner *common.Address, spender *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) Erc20Allowance(token *common.Address, owner *common.Address, spender *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc20Allowance(%v, %v, %v)", token, owner, spender)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -146,7 +156,9 @@ func (axis *AxisBridge) Erc20Allowance(token *common.Address, owner *common.Addr
 }
 
 // Erc20TotalSupply provides information about all available tokens
-func (axis *AxisBridge) Erc20TotalSupply(token *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) Erc20TotalSupply(token *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc20TotalSupply(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERCTwenty(*token, axis.eth)
 	if err != nil {
@@ -170,3 +182,19 @@ func (axis *AxisBridge) Erc20TotalSupply(token *common.Address) (hexutil.Big, er
 	// return the account balance
 	return hexutil.Big(*val), nil
 }
+
+// Erc20CallData packs call data of the given ERC20 token contract method with the given arguments.
+func (axis *AxisBridge) Erc20CallData(method string, args ...interface{}) ([]byte, error) {
+	ab, err := contracts.ERCTwentyMetaData.GetAbi()
+	if err != nil {
+		axis.log.Criticalf("failed to parse ERC20 contract ABI; %s", err.Error())
+		return nil, err
+	}
+
+	cd, err := ab.Pack(method, args...)
+	if err != nil {
+		axis.log.Errorf("can not pack ERC20 %s call; %s", method, err.Error())
+		return nil, err
+	}
+	return cd, nil
+}
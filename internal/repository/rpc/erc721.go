@@ -24,7 +24,9 @@ import (
 //go:generate tools/abigen.sh --abi ./contracts/abi/erc721.abi --pkg contracts --type ERC721 --out ./contracts/erc721_token.go
 
 // Erc721Name provides information about the name of the ERC721 token.
-func (axis *AxisBridge) Erc721Name(token *common.Address) (string, error) {
+func (axis *AxisBridge) Erc721Name(token *common.Address) (_ string, err error) {
+	defer axis.isolate(&err, "Erc721Name(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -43,7 +45,9 @@ func (axis *AxisBridge) Erc721Name(token *common.Address) (string, error) {
 }
 
 // Erc721Symbol provides information about the symbol of the ERC721 token.
-func (axis *AxisBridge) Erc721Symbol(token *common.Address) (string, error) {
+func (axis *AxisBridge) Erc721Symbol(token *common.Address) (_ string, err error) {
+	defer axis.isolate(&err, "Erc721Symbol(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -62,7 +66,9 @@ func (axis *AxisBridge) Erc721Symbol(token *common.Address) (string, error) {
 }
 
 // Erc721BalanceOf provides amount of NFT tokens owned by given owner in given ERC721 contract.
-func (axis *AxisBridge) Erc721BalanceOf(token *common.Address, owner *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) Erc721BalanceOf(token *common.Address, owner *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc721BalanceOf(%v, %v)", token, owner)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -90,7 +96,9 @@ func (axis *AxisBridge) Erc721BalanceOf(token *common.Address, owner *common.Add
 }
 
 // Erc721TotalSupply provides information about all available tokens
-func (axis *AxisBridge) Erc721TotalSupply(token *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) Erc721TotalSupply(token *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "Erc721TotalSupply(%v)", token)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -116,7 +124,9 @@ func (axis *AxisBridge) Erc721TotalSupply(token *common.Address) (hexutil.Big, e
 }
 
 // Erc721TokenURI provides URI of Metadata JSON Schema of the ERC721 token.
-func (axis *AxisBridge) Erc721TokenURI(token *common.Address, tokenId *big.Int) (string, error) {
+func (axis *AxisBridge) Erc721TokenURI(token *common.Address, tokenId *big.Int) (_ string, err error) {
+	defer axis.isolate(&err, "Erc721TokenURI(%v, %v)", token, tokenId)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -135,7 +145,9 @@ func (axis *AxisBridge) Erc721TokenURI(token *common.Address, tokenId *big.Int)
 }
 
 // Erc721OwnerOf provides information about NFT token ownership
-func (axis *AxisBridge) Erc721OwnerOf(token *common.Address, tokenId *big.Int) (common.Address, error) {
+func (axis *AxisBridge) Erc721OwnerOf(token *common.Address, tokenId *big.Int) (_ common.Address, err error) {
+	defer axis.isolate(&err, "Erc721OwnerOf(%v, %v)", token, tokenId)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -153,7 +165,9 @@ func (axis *AxisBridge) Erc721OwnerOf(token *common.Address, tokenId *big.Int) (
 }
 
 // Erc721GetApproved provides information about operator approved to manipulate with the NFT token.
-func (axis *AxisBridge) Erc721GetApproved(token *common.Address, tokenId *big.Int) (common.Address, error) {
+func (axis *AxisBridge) Erc721GetApproved(token *common.Address, tokenId *big.Int) (_ common.Address, err error) {
+	defer axis.isolate(&err, "Erc721GetApproved(%v, %v)", token, tokenId)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
@@ -171,7 +185,9 @@ func (axis *AxisBridge) Erc721GetApproved(token *common.Address, tokenId *big.In
 }
 
 // Erc721IsApprovedForAll provides information about operator approved to manipulate with NFT tokens of given owner.
-func (axis *AxisBridge) Erc721IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (bool, error) {
+func (axis *AxisBridge) Erc721IsApprovedForAll(token *common.Address, owner *common.Address, operator *common.Address) (_ bool, err error) {
+	defer axis.isolate(&err, "Erc721IsApprovedForAll(%v, %v, %v)", token, owner, operator)
+
 	// connect the contract
 	contract, err := contracts.NewERC721(*token, axis.eth)
 	if err != nil {
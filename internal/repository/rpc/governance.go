@@ -36,7 +36,9 @@ type govProposalExtended struct {
 
 // GovernanceProposalsCount provides the total number of proposals
 // in a given Governance contract.
-func (axis *AxisBridge) GovernanceProposalsCount(gov *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) GovernanceProposalsCount(gov *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "GovernanceProposalsCount(%v)", gov)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -56,7 +58,9 @@ func (axis *AxisBridge) GovernanceProposalsCount(gov *common.Address) (hexutil.B
 
 // GovernanceProposal provides a detail of Proposal of a governance contract
 // specified by its id.
-func (axis *AxisBridge) GovernanceProposal(gov *common.Address, id *hexutil.Big) (*types.GovernanceProposal, error) {
+func (axis *AxisBridge) GovernanceProposal(gov *common.Address, id *hexutil.Big) (_ *types.GovernanceProposal, err error) {
+	defer axis.isolate(&err, "GovernanceProposal(%v, %v)", gov, id)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -110,7 +114,9 @@ func (axis *AxisBridge) governanceProposalDetail(gc *contracts.Governance, govId
 
 // GovernanceProposalState provides a state of Proposal of a governance contract
 // specified by its id.
-func (axis *AxisBridge) GovernanceProposalState(gov *common.Address, id *hexutil.Big) (*types.GovernanceProposalState, error) {
+func (axis *AxisBridge) GovernanceProposalState(gov *common.Address, id *hexutil.Big) (_ *types.GovernanceProposalState, err error) {
+	defer axis.isolate(&err, "GovernanceProposalState(%v, %v)", gov, id)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -168,7 +174,9 @@ func govConvertScales(sc []*big.Int) []hexutil.Uint64 {
 
 // GovernanceProposalDetails provides a detail of Proposal of a governance contract
 // specified by its id.
-func (axis *AxisBridge) GovernanceProposalDetails(prop *common.Address) (*govProposalExtended, error) {
+func (axis *AxisBridge) GovernanceProposalDetails(prop *common.Address) (_ *govProposalExtended, err error) {
+	defer axis.isolate(&err, "GovernanceProposalDetails(%v)", prop)
+
 	// get the proposal contract
 	pp, err := contracts.NewGovernanceProposal(*prop, axis.eth)
 	if err != nil {
@@ -197,7 +205,9 @@ func (axis *AxisBridge) GovernanceProposalDetails(prop *common.Address) (*govPro
 }
 
 // GovernanceOptionState returns a state of the given option of a proposal.
-func (axis *AxisBridge) GovernanceOptionState(gov *common.Address, propId *hexutil.Big, optId *hexutil.Big) (*types.GovernanceOptionState, error) {
+func (axis *AxisBridge) GovernanceOptionState(gov *common.Address, propId *hexutil.Big, optId *hexutil.Big) (_ *types.GovernanceOptionState, err error) {
+	defer axis.isolate(&err, "GovernanceOptionState(%v, %v, %v)", gov, propId, optId)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -217,7 +227,9 @@ func (axis *AxisBridge) GovernanceOptionState(gov *common.Address, propId *hexut
 }
 
 // GovernanceOptionStates returns a list of states of options of a proposal.
-func (axis *AxisBridge) GovernanceOptionStates(gov *common.Address, propId *hexutil.Big, optRange int) ([]*types.GovernanceOptionState, error) {
+func (axis *AxisBridge) GovernanceOptionStates(gov *common.Address, propId *hexutil.Big, optRange int) (_ []*types.GovernanceOptionState, err error) {
+	defer axis.isolate(&err, "GovernanceOptionStates(%v, %v, %v)", gov, propId, optRange)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -241,7 +253,9 @@ func (axis *AxisBridge) GovernanceOptionStates(gov *common.Address, propId *hexu
 }
 
 // GovernanceOptionStateById returns a state of the given option of a proposal.
-func (axis *AxisBridge) GovernanceOptionStateById(gc *contracts.Governance, propId *hexutil.Big, optId *hexutil.Big) (*types.GovernanceOptionState, error) {
+func (axis *AxisBridge) GovernanceOptionStateById(gc *contracts.Governance, propId *hexutil.Big, optId *hexutil.Big) (_ *types.GovernanceOptionState, err error) {
+	defer axis.isolate(&err, "GovernanceOptionStateById(%v, %v, %v)", gc, propId, optId)
+
 	// get the state
 	data, err := gc.ProposalOptionState(nil, propId.ToInt(), optId.ToInt())
 	if err != nil {
@@ -262,7 +276,9 @@ func (axis *AxisBridge) GovernanceVote(
 	gov *common.Address,
 	propId *hexutil.Big,
 	from *common.Address,
-	delegatedTo *common.Address) (*types.GovernanceVote, error) {
+	delegatedTo *common.Address) (_ *types.GovernanceVote, err error) {
+	defer axis.isolate(&err, "GovernanceVote(%v, %v, %v, %v)", gov, propId, from, delegatedTo)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -293,7 +309,9 @@ func (axis *AxisBridge) GovernanceVote(
 }
 
 // GovernanceProposalsBy loads list of proposals of the given Governance contract.
-func (axis *AxisBridge) GovernanceProposalsBy(gov *common.Address) ([]*types.GovernanceProposal, error) {
+func (axis *AxisBridge) GovernanceProposalsBy(gov *common.Address) (_ []*types.GovernanceProposal, err error) {
+	defer axis.isolate(&err, "GovernanceProposalsBy(%v)", gov)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -335,7 +353,9 @@ func (axis *AxisBridge) GovernanceProposalsBy(gov *common.Address) ([]*types.Gov
 
 // GovernanceProposalFee returns the fee payable for a new proposal
 // in given Governance contract context.
-func (axis *AxisBridge) GovernanceProposalFee(gov *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) GovernanceProposalFee(gov *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "GovernanceProposalFee(%v)", gov)
+
 	// get the contract
 	gc, err := contracts.NewGovernance(*gov, axis.eth)
 	if err != nil {
@@ -356,7 +376,9 @@ func (axis *AxisBridge) GovernanceProposalFee(gov *common.Address) (hexutil.Big,
 // GovernanceTotalWeight returns the total available voting weight for all proposals
 // of a governance contract. The address given must be the Governable contract linked
 // to the core Governance.
-func (axis *AxisBridge) GovernanceTotalWeight(ge *common.Address) (*hexutil.Big, error) {
+func (axis *AxisBridge) GovernanceTotalWeight(ge *common.Address) (_ *hexutil.Big, err error) {
+	defer axis.isolate(&err, "GovernanceTotalWeight(%v)", ge)
+
 	// get the contract
 	goe, err := contracts.NewGovernable(*ge, axis.eth)
 	if err != nil {
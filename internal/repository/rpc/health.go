@@ -0,0 +1,267 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etc "github.com/ethereum/go-ethereum/core/types"
+	eth "github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// nodeHealthSmoothing represents the weight of a new observation window
+// in the exponentially weighted moving average of the node health values.
+const nodeHealthSmoothing = 0.2
+
+const (
+	// nodeCallState represents the class of contract state reads.
+	nodeCallState = "state"
+
+	// nodeCallTrace represents the class of transaction traces.
+	nodeCallTrace = "trace"
+
+	// nodeCallChain represents the class of blocks, transactions and other chain data reads.
+	nodeCallChain = "chain"
+)
+
+// nodeCallWindow collects node calls of a class made since the last health update.
+type nodeCallWindow struct {
+	calls uint64
+	fails uint64
+	rtt   time.Duration
+}
+
+// nodeHealth tracks the responsiveness of the connected node.
+type nodeHealth struct {
+	mu     sync.RWMutex
+	health types.NodeHealth
+	window map[string]*nodeCallWindow
+}
+
+// nodeStateCalls represents the RPC methods reading the contract and account state,
+// without the namespace prefix; both eth_ and axis_ namespaces of the node are recognized.
+var nodeStateCalls = map[string]bool{
+	"call":                true,
+	"estimateGas":         true,
+	"getBalance":          true,
+	"getCode":             true,
+	"getStorageAt":        true,
+	"getTransactionCount": true,
+}
+
+// nodeCallClass provides the class of the node call of the given RPC method.
+func nodeCallClass(method string) string {
+	switch {
+	case strings.HasPrefix(method, "trace_") || strings.HasPrefix(method, "debug_"):
+		return nodeCallTrace
+	case strings.HasPrefix(method, "eth_") && nodeStateCalls[strings.TrimPrefix(method, "eth_")],
+		strings.HasPrefix(method, "axis_") && nodeStateCalls[strings.TrimPrefix(method, "axis_")]:
+		return nodeCallState
+	}
+	return nodeCallChain
+}
+
+// record registers a finished node call of the given class. Errors reported by the node
+// itself, e.g. reverted contract calls, do not signal a struggling node and are not counted.
+func (nh *nodeHealth) record(class string, rtt time.Duration, err error) {
+	var re ethrpc.Error
+	failed := err != nil && !errors.As(err, &re)
+
+	nh.mu.Lock()
+	defer nh.mu.Unlock()
+
+	if nh.window == nil {
+		nh.window = make(map[string]*nodeCallWindow)
+	}
+	w, ok := nh.window[class]
+	if !ok {
+		w = new(nodeCallWindow)
+		nh.window[class] = w
+	}
+
+	w.calls++
+	w.rtt += rtt
+	if failed {
+		w.fails++
+	}
+}
+
+// update folds the node calls made since the last update into the smoothed health values.
+func (nh *nodeHealth) update() {
+	nh.mu.Lock()
+	defer nh.mu.Unlock()
+
+	if nh.health.Classes == nil {
+		nh.health.Classes = make(map[string]types.NodeCallHealth)
+	}
+
+	var total nodeCallWindow
+	for class, w := range nh.window {
+		nh.health.Classes[class] = smoothed(nh.health.Classes[class], w)
+		total.calls += w.calls
+		total.fails += w.fails
+		total.rtt += w.rtt
+	}
+	if total.calls == 0 {
+		return
+	}
+
+	nh.health.NodeCallHealth = smoothed(nh.health.NodeCallHealth, &total)
+	nh.health.Updated = time.Now().UTC()
+	nh.window = nil
+}
+
+// smoothed adds the calls of the given window into the smoothed health values.
+func smoothed(ch types.NodeCallHealth, w *nodeCallWindow) types.NodeCallHealth {
+	if w.calls == 0 {
+		return ch
+	}
+
+	rtt := w.rtt / time.Duration(w.calls)
+	fail := float64(w.fails) / float64(w.calls)
+
+	// the first window sets the baseline
+	if ch.Calls == 0 {
+		ch.Latency = rtt
+		ch.ErrorRate = fail
+	} else {
+		ch.Latency = time.Duration(nodeHealthSmoothing*float64(rtt) + (1-nodeHealthSmoothing)*float64(ch.Latency))
+		ch.ErrorRate = nodeHealthSmoothing*fail + (1-nodeHealthSmoothing)*ch.ErrorRate
+	}
+
+	ch.Calls += w.calls
+	return ch
+}
+
+// get provides a copy of the current health values.
+func (nh *nodeHealth) get() types.NodeHealth {
+	nh.mu.RLock()
+	defer nh.mu.RUnlock()
+
+	h := nh.health
+	h.Classes = make(map[string]types.NodeCallHealth, len(nh.health.Classes))
+	for class, ch := range nh.health.Classes {
+		h.Classes[class] = ch
+	}
+	return h
+}
+
+// NodeHealth provides the current smoothed latency and error rate of the node calls.
+func (axis *AxisBridge) NodeHealth() types.NodeHealth {
+	return axis.health.get()
+}
+
+// call makes an RPC call to the node and records its outcome in the node health.
+func (axis *AxisBridge) call(result interface{}, method string, args ...interface{}) error {
+	return axis.callContext(context.Background(), result, method, args...)
+}
+
+// callContext makes an RPC call to the node with the given context
+// and records its outcome in the node health.
+func (axis *AxisBridge) callContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	start := time.Now()
+	err := axis.rpc.CallContext(ctx, result, method, args...)
+	axis.health.record(nodeCallClass(method), time.Since(start), err)
+	return err
+}
+
+// batchCall makes a batch of RPC calls to the node and records its outcome in the node health.
+// The batch is classified by its first call.
+func (axis *AxisBridge) batchCall(batch []ethrpc.BatchElem) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	err := axis.rpc.BatchCall(batch)
+	axis.health.record(nodeCallClass(batch[0].Method), time.Since(start), err)
+	return err
+}
+
+// ethClient represents the node client of contract bindings recording outcome
+// of the node calls in the node health.
+type ethClient struct {
+	*eth.Client
+	health *nodeHealth
+}
+
+// CallContract executes a contract call and records its outcome.
+func (ec *ethClient) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	start := time.Now()
+	res, err := ec.Client.CallContract(ctx, msg, block)
+	ec.health.record(nodeCallState, time.Since(start), err)
+	return res, err
+}
+
+// CodeAt loads the code of the given contract and records the outcome of the call.
+func (ec *ethClient) CodeAt(ctx context.Context, contract common.Address, block *big.Int) ([]byte, error) {
+	start := time.Now()
+	res, err := ec.Client.CodeAt(ctx, contract, block)
+	ec.health.record(nodeCallChain, time.Since(start), err)
+	return res, err
+}
+
+// FilterLogs executes a log filter query and records its outcome.
+func (ec *ethClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]etc.Log, error) {
+	start := time.Now()
+	res, err := ec.Client.FilterLogs(ctx, q)
+	ec.health.record(nodeCallChain, time.Since(start), err)
+	return res, err
+}
+
+// observeHealth periodically folds the outcome of the node calls into the node health.
+// The node is probed by a cheap call so the health is known even if the API is idle.
+func (axis *AxisBridge) observeHealth() {
+	defer func() {
+		axis.log.Noticef("node health observer done")
+		axis.wg.Done()
+	}()
+
+	ticker := time.NewTicker(axis.healthProbe)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-axis.sigClose:
+			return
+		case <-ticker.C:
+			axis.survive(func() {
+				axis.probeHealth()
+				axis.health.update()
+			}, "probeHealth()")
+		}
+	}
+}
+
+// probeHealth makes a single cheap call to the node; the probe fails
+// if the node does not respond within the probe interval.
+func (axis *AxisBridge) probeHealth() {
+	ctx, cancel := context.WithTimeout(context.Background(), axis.healthProbe)
+	defer cancel()
+
+	var head hexutil.Uint64
+	if err := axis.callContext(ctx, &head, "eth_blockNumber"); err != nil {
+		axis.log.Errorf("node health probe failed; %s", err.Error())
+	}
+}
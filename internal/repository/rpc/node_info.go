@@ -0,0 +1,80 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/types"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NodeInfo collects diagnostic information about the connected node.
+func (axis *AxisBridge) NodeInfo() (*types.NodeInfo, error) {
+	// keep track of the operation
+	axis.log.Debugf("collecting node info")
+
+	var ni types.NodeInfo
+	if err := axis.call(&ni.Version, "web3_clientVersion"); err != nil {
+		axis.log.Errorf("can not get node version; %s", err.Error())
+		return nil, err
+	}
+
+	var peers hexutil.Uint64
+	if err := axis.call(&peers, "net_peerCount"); err != nil {
+		axis.log.Errorf("can not get node peer count; %s", err.Error())
+		return nil, err
+	}
+	ni.PeerCount = uint64(peers)
+
+	var err error
+	ni.ChainID, err = axis.eth.ChainID(context.Background())
+	if err != nil {
+		axis.log.Errorf("can not get chain id; %s", err.Error())
+		return nil, err
+	}
+
+	// the sync progress is nil if the node is not syncing
+	sp, err := axis.eth.SyncProgress(context.Background())
+	if err != nil {
+		axis.log.Errorf("can not get sync progress; %s", err.Error())
+		return nil, err
+	}
+	if sp != nil {
+		ni.Syncing = true
+		ni.CurrentBlock = sp.CurrentBlock
+		ni.HighestBlock = sp.HighestBlock
+		return &ni, nil
+	}
+
+	// not syncing, the current block is the head
+	ni.CurrentBlock, err = axis.eth.BlockNumber(context.Background())
+	if err != nil {
+		axis.log.Errorf("can not get block height; %s", err.Error())
+		return nil, err
+	}
+	ni.HighestBlock = ni.CurrentBlock
+	return &ni, nil
+}
+
+// ChainID provides the id of the chain the node is connected to.
+func (axis *AxisBridge) ChainID() (*big.Int, error) {
+	id, err := axis.eth.ChainID(context.Background())
+	if err != nil {
+		axis.log.Errorf("can not get chain id; %s", err.Error())
+		return nil, err
+	}
+	return id, nil
+}
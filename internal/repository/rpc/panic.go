@@ -0,0 +1,56 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/metrics"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError represents an error of a bridge call which panicked, i.e. on decoding
+// an unexpected contract response. The panic is isolated within the call
+// so the calling goroutine keeps running.
+type PanicError struct {
+	Call   string
+	Reason string
+}
+
+// Error returns the human-readable description of the error.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("bridge call %s failed unexpectedly; %s", e.Call, e.Reason)
+}
+
+// isolate recovers a panic of the bridge call and turns it into the PanicError
+// stored into the error result of the call. It must be deferred directly by the bridge method,
+// i.e. defer axis.isolate(&err, "ValidatorPubkey(%d)", valID).
+func (axis *AxisBridge) isolate(err *error, call string, args ...interface{}) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	sig := fmt.Sprintf(call, args...)
+	metrics.Counter("rpc/panics").Inc(1)
+	axis.log.Criticalf("bridge call %s panicked; %v\n%s", sig, r, debug.Stack())
+	*err = &PanicError{Call: sig, Reason: fmt.Sprint(r)}
+}
+
+// survive runs a step of a bridge observer isolated from panics,
+// so the observer goroutine keeps running if the step panics.
+func (axis *AxisBridge) survive(step func(), call string, args ...interface{}) {
+	var err error
+	defer axis.isolate(&err, call, args...)
+	step()
+}
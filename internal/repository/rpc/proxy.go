@@ -0,0 +1,82 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// eip1967ImplementationSlot is the storage slot of the implementation address of an EIP-1967 proxy.
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+	// eip1967BeaconSlot is the storage slot of the beacon address of an EIP-1967 beacon proxy.
+	eip1967BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+
+	// beaconImplementationCall is the call data of the implementation() call of a proxy beacon.
+	beaconImplementationCall = common.FromHex("0x5c60da1b")
+)
+
+// ProxyImplementation resolves the current implementation address of an EIP-1967 proxy contract.
+// Both the direct and the beacon proxy patterns are recognized; nil is returned if the contract is not a proxy.
+func (axis *AxisBridge) ProxyImplementation(addr *common.Address) (_ *common.Address, err error) {
+	defer axis.isolate(&err, "ProxyImplementation(%v)", addr)
+
+	// direct proxy keeps the implementation address in the slot
+	impl, err := axis.storageAddress(addr, eip1967ImplementationSlot)
+	if err != nil || impl != nil {
+		return impl, err
+	}
+
+	// beacon proxy needs to ask the beacon for the implementation
+	beacon, err := axis.storageAddress(addr, eip1967BeaconSlot)
+	if err != nil || beacon == nil {
+		return nil, err
+	}
+
+	data, err := axis.eth.CallContract(context.Background(), ethereum.CallMsg{
+		To:   beacon,
+		Data: beaconImplementationCall,
+	}, nil)
+	if err != nil {
+		axis.log.Errorf("can not get implementation of beacon %s; %s", beacon.String(), err.Error())
+		return nil, err
+	}
+	if len(data) != 32 {
+		axis.log.Errorf("invalid implementation of beacon %s; expected 32 bytes, received %d bytes", beacon.String(), len(data))
+		return nil, nil
+	}
+
+	impl = new(common.Address)
+	*impl = common.BytesToAddress(data)
+	return impl, nil
+}
+
+// storageAddress reads an address from the given storage slot of the contract; nil is returned for an empty slot.
+func (axis *AxisBridge) storageAddress(addr *common.Address, slot common.Hash) (*common.Address, error) {
+	data, err := axis.eth.StorageAt(context.Background(), *addr, slot, nil)
+	if err != nil {
+		axis.log.Errorf("can not read storage slot %s of %s; %s", slot.String(), addr.String(), err.Error())
+		return nil, err
+	}
+
+	val := common.BytesToAddress(data)
+	if val == (common.Address{}) {
+		return nil, nil
+	}
+	return &val, nil
+}
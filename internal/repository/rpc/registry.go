@@ -0,0 +1,57 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/repository/rpc/contracts"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RegistryAddress resolves the address registered under the given name in the on-chain
+// address registry; the registry implements the fMint AddressProvider interface.
+// The zero address is returned for names not registered.
+func (axis *AxisBridge) RegistryAddress(registry common.Address, name string) (_ common.Address, err error) {
+	defer axis.isolate(&err, "RegistryAddress(%v, %v)", registry, name)
+
+	ap, err := contracts.NewDefiFMintAddressProvider(registry, axis.eth)
+	if err != nil {
+		axis.log.Errorf("can not access address registry contract; %s", err.Error())
+		return common.Address{}, err
+	}
+
+	// make the id
+	var id [32]byte
+	copy(id[:], name)
+
+	addr, err := ap.GetAddress(axis.DefaultCallOpts(), id)
+	if err != nil {
+		axis.log.Errorf("[%s] can not get address of %s; %s", registry.String(), name, err.Error())
+		return common.Address{}, err
+	}
+	return addr, nil
+}
+
+// ResetSfcContract drops the SFC contract instance so it's bound
+// to the currently configured SFC address on the next use.
+func (axis *AxisBridge) ResetSfcContract() {
+	axis.sfcContract = nil
+}
+
+// SetFMintAddressProvider replaces the address of the fMint AddressProvider and drops
+// the fMint contract addresses resolved from the previous one.
+func (axis *AxisBridge) SetFMintAddressProvider(addr common.Address) {
+	axis.fMintCfg.addressProvider = addr
+	axis.FMintResetAddresses()
+}
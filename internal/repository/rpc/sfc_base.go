@@ -29,11 +29,12 @@ import (
 const sfcFirstLockEpoch uint64 = 1600
 
 // SfcVersion returns current version of the SFC contract as a single number.
-func (axis *AxisBridge) SfcVersion() (hexutil.Uint64, error) {
+func (axis *AxisBridge) SfcVersion() (_ hexutil.Uint64, err error) {
+	defer axis.isolate(&err, "SfcVersion()")
+
 	// get the version information from the contract
 	var ver [3]byte
-	var err error
-	ver, err = axis.SfcContract().Version(nil)
+	ver, err = axis.SfcContract().Version(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Criticalf("failed to get the SFC version; %s", err.Error())
 		return 0, err
@@ -42,7 +43,9 @@ func (axis *AxisBridge) SfcVersion() (hexutil.Uint64, error) {
 }
 
 // CurrentEpoch extract the current epoch id from SFC smart contract.
-func (axis *AxisBridge) CurrentEpoch() (hexutil.Uint64, error) {
+func (axis *AxisBridge) CurrentEpoch() (_ hexutil.Uint64, err error) {
+	defer axis.isolate(&err, "CurrentEpoch()")
+
 	// get the value from the contract
 	epoch, err := axis.SfcContract().CurrentEpoch(axis.DefaultCallOpts())
 	if err != nil {
@@ -53,7 +56,9 @@ func (axis *AxisBridge) CurrentEpoch() (hexutil.Uint64, error) {
 }
 
 // CurrentSealedEpoch extract the current sealed epoch id from SFC smart contract.
-func (axis *AxisBridge) CurrentSealedEpoch() (hexutil.Uint64, error) {
+func (axis *AxisBridge) CurrentSealedEpoch() (_ hexutil.Uint64, err error) {
+	defer axis.isolate(&err, "CurrentSealedEpoch()")
+
 	// get the value from the contract
 	epoch, err := axis.SfcContract().CurrentSealedEpoch(axis.DefaultCallOpts())
 	if err != nil {
@@ -64,9 +69,11 @@ func (axis *AxisBridge) CurrentSealedEpoch() (hexutil.Uint64, error) {
 }
 
 // Epoch extract information about an epoch from SFC smart contract.
-func (axis *AxisBridge) Epoch(id hexutil.Uint64) (*types.Epoch, error) {
+func (axis *AxisBridge) Epoch(id hexutil.Uint64) (_ *types.Epoch, err error) {
+	defer axis.isolate(&err, "Epoch(%v)", id)
+
 	// extract epoch snapshot
-	epo, err := axis.SfcContract().GetEpochSnapshot(nil, big.NewInt(int64(id)))
+	epo, err := axis.SfcContract().GetEpochSnapshot(axis.DefaultCallOpts(), big.NewInt(int64(id)))
 	if err != nil {
 		axis.log.Errorf("failed to extract epoch information: %s", err.Error())
 		return nil, err
@@ -85,15 +92,19 @@ func (axis *AxisBridge) Epoch(id hexutil.Uint64) (*types.Epoch, error) {
 }
 
 // RewardsAllowed returns if the rewards can be manipulated with.
-func (axis *AxisBridge) RewardsAllowed() (bool, error) {
+func (axis *AxisBridge) RewardsAllowed() (_ bool, err error) {
+	defer axis.isolate(&err, "RewardsAllowed()")
+
 	axis.log.Debug("rewards lock always open")
 	return true, nil
 }
 
 // LockingAllowed indicates if the stake locking has been enabled in SFC.
-func (axis *AxisBridge) LockingAllowed() (bool, error) {
+func (axis *AxisBridge) LockingAllowed() (_ bool, err error) {
+	defer axis.isolate(&err, "LockingAllowed()")
+
 	// get the current sealed epoch value from the contract
-	epoch, err := axis.SfcContract().CurrentSealedEpoch(nil)
+	epoch, err := axis.SfcContract().CurrentSealedEpoch(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("failed to get the current sealed epoch: %s", err.Error())
 		return false, err
@@ -103,36 +114,50 @@ func (axis *AxisBridge) LockingAllowed() (bool, error) {
 }
 
 // TotalStaked returns the total amount of staked tokens.
-func (axis *AxisBridge) TotalStaked() (*big.Int, error) {
+func (axis *AxisBridge) TotalStaked() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "TotalStaked()")
+
 	return axis.SfcContract().TotalStake(axis.DefaultCallOpts())
 }
 
 // SfcMinValidatorStake extracts a value of minimal validator self stake.
-func (axis *AxisBridge) SfcMinValidatorStake() (*big.Int, error) {
+func (axis *AxisBridge) SfcMinValidatorStake() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcMinValidatorStake()")
+
 	return axis.SfcContract().MinSelfStake(axis.DefaultCallOpts())
 }
 
 // SfcMaxDelegatedRatio extracts a ratio between self delegation and received stake.
-func (axis *AxisBridge) SfcMaxDelegatedRatio() (*big.Int, error) {
+func (axis *AxisBridge) SfcMaxDelegatedRatio() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcMaxDelegatedRatio()")
+
 	return axis.SfcContract().MaxDelegatedRatio(axis.DefaultCallOpts())
 }
 
 // SfcMinLockupDuration extracts a minimal lockup duration.
-func (axis *AxisBridge) SfcMinLockupDuration() (*big.Int, error) {
+func (axis *AxisBridge) SfcMinLockupDuration() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcMinLockupDuration()")
+
 	return axis.SfcContract().MinLockupDuration(axis.DefaultCallOpts())
 }
 
 // SfcMaxLockupDuration extracts a maximal lockup duration.
-func (axis *AxisBridge) SfcMaxLockupDuration() (*big.Int, error) {
+func (axis *AxisBridge) SfcMaxLockupDuration() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcMaxLockupDuration()")
+
 	return axis.SfcContract().MaxLockupDuration(axis.DefaultCallOpts())
 }
 
 // SfcWithdrawalPeriodEpochs extracts a minimal number of epochs between un-delegate and withdraw.
-func (axis *AxisBridge) SfcWithdrawalPeriodEpochs() (*big.Int, error) {
+func (axis *AxisBridge) SfcWithdrawalPeriodEpochs() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcWithdrawalPeriodEpochs()")
+
 	return axis.SfcContract().WithdrawalPeriodEpochs(axis.DefaultCallOpts())
 }
 
 // SfcWithdrawalPeriodTime extracts a minimal number of seconds between un-delegate and withdraw.
-func (axis *AxisBridge) SfcWithdrawalPeriodTime() (*big.Int, error) {
+func (axis *AxisBridge) SfcWithdrawalPeriodTime() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "SfcWithdrawalPeriodTime()")
+
 	return axis.SfcContract().WithdrawalPeriodTime(axis.DefaultCallOpts())
 }
@@ -23,27 +23,36 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	etc "github.com/ethereum/go-ethereum/core/types"
 )
 
 // AmountStaked returns the current amount at stake for the given staker address and target validator
-func (axis *AxisBridge) AmountStaked(addr *common.Address, valID *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) AmountStaked(addr *common.Address, valID *big.Int, block *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "AmountStaked(%v, %v, %v)", addr, valID, block)
+
 	// keep track of the operation
 	axis.log.Debugf("verifying amount staked by %s to %d", addr.String(), valID.Uint64())
-	return axis.SfcContract().GetStake(axis.DefaultCallOpts(), *addr, valID)
+	return axis.SfcContract().GetStake(axis.CallOptsAt(block), *addr, valID)
 }
 
 // AmountStakeLocked returns the current locked amount at stake for the given staker address and target validator.
-func (axis *AxisBridge) AmountStakeLocked(addr *common.Address, valID *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) AmountStakeLocked(addr *common.Address, valID *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "AmountStakeLocked(%v, %v)", addr, valID)
+
 	return axis.SfcContract().GetLockedStake(axis.DefaultCallOpts(), *addr, valID)
 }
 
 // AmountStakeUnlocked returns the current unlocked amount at stake for the given staker address and target validator.
-func (axis *AxisBridge) AmountStakeUnlocked(addr *common.Address, valID *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) AmountStakeUnlocked(addr *common.Address, valID *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "AmountStakeUnlocked(%v, %v)", addr, valID)
+
 	return axis.SfcContract().GetUnlockedStake(axis.DefaultCallOpts(), *addr, valID)
 }
 
 // StakeUnlockPenalty returns the expected penalty of a premature stake unlock.
-func (axis *AxisBridge) StakeUnlockPenalty(addr *common.Address, valID *big.Int, amount *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) StakeUnlockPenalty(addr *common.Address, valID *big.Int, amount *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "StakeUnlockPenalty(%v, %v, %v)", addr, valID, amount)
+
 	// pack call data
 	cd, err := axis.SfcAbi().Pack("unlockStake", valID, amount)
 	if err != nil {
@@ -73,7 +82,9 @@ func (axis *AxisBridge) StakeUnlockPenalty(addr *common.Address, valID *big.Int,
 }
 
 // PendingRewards returns a detail of delegation rewards waiting to be claimed for the given delegation.
-func (axis *AxisBridge) PendingRewards(addr *common.Address, valID *big.Int) (*types.PendingRewards, error) {
+func (axis *AxisBridge) PendingRewards(addr *common.Address, valID *big.Int, block *big.Int) (_ *types.PendingRewards, err error) {
+	defer axis.isolate(&err, "PendingRewards(%v, %v, %v)", addr, valID, block)
+
 	// prep the empty value
 	pr := types.PendingRewards{
 		Address: *addr,
@@ -82,7 +93,7 @@ func (axis *AxisBridge) PendingRewards(addr *common.Address, valID *big.Int) (*t
 	}
 
 	// get the pending rewards amount
-	amo, err := axis.SfcContract().PendingRewards(axis.DefaultCallOpts(), *addr, valID)
+	amo, err := axis.SfcContract().PendingRewards(axis.CallOptsAt(block), *addr, valID)
 	if err != nil {
 		axis.log.Criticalf("can not calculate pending rewards of %s to %d; %s", addr.String(), valID.Uint64(), err.Error())
 		return &pr, nil
@@ -93,18 +104,39 @@ func (axis *AxisBridge) PendingRewards(addr *common.Address, valID *big.Int) (*t
 	return &pr, nil
 }
 
-// DelegationLock returns delegation lock information using SFC contract binding.
-func (axis *AxisBridge) DelegationLock(addr *common.Address, valID *hexutil.Big) (dll *types.DelegationLock, err error) {
-	// recover from panic here
-	defer func() {
-		if r := recover(); r != nil {
-			axis.log.Criticalf("can not get SFC lock status on delegation %s to %d; SFC call panic", addr.String(), valID.String())
-			dll = &types.DelegationLock{}
-		}
-	}()
+// PendingRewardsAt returns the amount of delegation rewards waiting to be claimed
+// at the given block. The state of past blocks is available on archive nodes only.
+func (axis *AxisBridge) PendingRewardsAt(addr *common.Address, valID *big.Int, block *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "PendingRewardsAt(%v, %v, %v)", addr, valID, block)
+
+	amo, err := axis.SfcContract().PendingRewards(axis.CallOptsAt(block), *addr, valID)
+	if err != nil {
+		axis.log.Errorf("can not get pending rewards of %s to %d at #%d; %s", addr.String(), valID.Uint64(), block.Uint64(), err.Error())
+		return nil, err
+	}
+	return amo, nil
+}
+
+// StashedRewards returns the amount of delegation rewards stashed in the SFC contract
+// for the given delegation; stashed rewards are not claimed yet.
+func (axis *AxisBridge) StashedRewards(addr *common.Address, valID *big.Int, block *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "StashedRewards(%v, %v, %v)", addr, valID, block)
+
+	amo, err := axis.SfcContract().RewardsStash(axis.CallOptsAt(block), *addr, valID)
+	if err != nil {
+		axis.log.Errorf("can not get stashed rewards of %s to %d; %s", addr.String(), valID.Uint64(), err.Error())
+		return nil, err
+	}
+	return amo, nil
+}
+
+// DelegationLock returns delegation lock information using SFC contract binding
+// at the given block; nil represents the latest block.
+func (axis *AxisBridge) DelegationLock(addr *common.Address, valID *hexutil.Big, block *big.Int) (_ *types.DelegationLock, err error) {
+	defer axis.isolate(&err, "DelegationLock(%v, %v, %v)", addr, valID, block)
 
 	// get staker locking detail
-	lock, err := axis.SfcContract().GetLockupInfo(axis.DefaultCallOpts(), *addr, valID.ToInt())
+	lock, err := axis.SfcContract().GetLockupInfo(axis.CallOptsAt(block), *addr, valID.ToInt())
 	if err != nil {
 		axis.log.Errorf("delegation lock query failed; %v", err)
 		return nil, err
@@ -127,7 +159,9 @@ func (axis *AxisBridge) DelegationLock(addr *common.Address, valID *hexutil.Big)
 
 // DelegationOutstandingSAXIS returns the amount of sAXIS tokens for the delegation
 // identified by the delegator address and the stakerId.
-func (axis *AxisBridge) DelegationOutstandingSAXIS(addr *common.Address, valID *big.Int) (*big.Int, error) {
+func (axis *AxisBridge) DelegationOutstandingSAXIS(addr *common.Address, valID *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "DelegationOutstandingSAXIS(%v, %v)", addr, valID)
+
 	// log action
 	axis.log.Debugf("checking outstanding sAXIS of %s to %d", addr.String(), valID.Uint64())
 
@@ -144,7 +178,9 @@ func (axis *AxisBridge) DelegationOutstandingSAXIS(addr *common.Address, valID *
 
 // DelegationTokenizerUnlocked returns the status of SFC Tokenizer lock
 // for a delegation identified by the address and staker id.
-func (axis *AxisBridge) DelegationTokenizerUnlocked(addr *common.Address, valID *big.Int) (bool, error) {
+func (axis *AxisBridge) DelegationTokenizerUnlocked(addr *common.Address, valID *big.Int) (_ bool, err error) {
+	defer axis.isolate(&err, "DelegationTokenizerUnlocked(%v, %v)", addr, valID)
+
 	// log action
 	axis.log.Debugf("checking SFC tokenizer lock of %s to %d", addr.String(), valID.Uint64())
 
@@ -164,3 +200,39 @@ func (axis *AxisBridge) DelegationTokenizerUnlocked(addr *common.Address, valID
 
 	return lock, nil
 }
+
+// sfcDelegatedTopics represents the topics of the delegation creation events of all the SFC versions.
+var sfcDelegatedTopics = []common.Hash{
+	// SFC3::Delegated(address indexed delegator, uint256 indexed toValidatorID, uint256 amount)
+	common.HexToHash("0x9a8f44850296624dadfd9c246d17e47171d35727a181bd090aa14bbbe00238bb"),
+	// SFC1::CreatedDelegation(address indexed delegator, uint256 indexed toStakerID, uint256 amount)
+	common.HexToHash("0xfd8c857fb9acd6f4ad59b8621a2a77825168b7b4b76de9586d08e00d4ed462be"),
+}
+
+// DelegationOrigin locates the earliest delegation event of the given delegator to the given validator
+// on the chain. Nil is returned if the node does not know any such event.
+func (axis *AxisBridge) DelegationOrigin(addr *common.Address, valID *big.Int) (_ *etc.Log, err error) {
+	defer axis.isolate(&err, "DelegationOrigin(%v, %v)", addr, valID)
+
+	logs, err := axis.eth.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int),
+		Addresses: []common.Address{axis.sfcConfig.SFCContract},
+		Topics: [][]common.Hash{
+			sfcDelegatedTopics,
+			{common.BytesToHash(addr.Bytes())},
+			{common.BigToHash(valID)},
+		},
+	})
+	if err != nil {
+		axis.log.Errorf("delegation origin of %s to %d not available; %s", addr.String(), valID.Uint64(), err.Error())
+		return nil, err
+	}
+
+	// logs are ordered by the chain position, the first one is the origin
+	for i := range logs {
+		if !logs[i].Removed {
+			return &logs[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// decimalUnit represents the fixed point unit used by the SFC contract.
+var decimalUnit = new(big.Int).SetUint64(1000000000000000000)
+
+// EpochRewardsDistributed calculates the total amount of rewards distributed to validators
+// and their delegators on sealing the given epoch, including the validators' commission.
+// The amount is derived from the accumulated reward per token progress of each validator.
+func (axis *AxisBridge) EpochRewardsDistributed(id hexutil.Uint64) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "EpochRewardsDistributed(%v)", id)
+
+	// we need the previous epoch to calculate the progress
+	if id < 1 {
+		return new(big.Int), nil
+	}
+	epoch := new(big.Int).SetUint64(uint64(id))
+	prev := new(big.Int).SetUint64(uint64(id) - 1)
+
+	// get the commission in effect when the epoch was sealed to restore the full reward
+	opts, err := axis.epochCallOpts(epoch)
+	if err != nil {
+		return nil, err
+	}
+	com, err := axis.SfcContract().ValidatorCommission(opts)
+	if err != nil {
+		axis.log.Errorf("can not get validator commission of epoch #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+	share := new(big.Int).Sub(decimalUnit, com)
+
+	// get the list of validators of the epoch
+	ids, err := axis.SfcContract().GetEpochValidatorIDs(axis.DefaultCallOpts(), epoch)
+	if err != nil {
+		axis.log.Errorf("can not get validators of epoch #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+
+	total := new(big.Int)
+	for _, vid := range ids {
+		reward, err := axis.epochValidatorReward(epoch, prev, vid)
+		if err != nil {
+			return nil, err
+		}
+
+		// restore the commission part of the reward
+		if share.Sign() > 0 {
+			reward = new(big.Int).Div(new(big.Int).Mul(reward, decimalUnit), share)
+		}
+		total.Add(total, reward)
+	}
+	return total, nil
+}
+
+// epochValidatorReward calculates the amount of rewards distributed to delegators
+// of the given validator on sealing the given epoch.
+func (axis *AxisBridge) epochValidatorReward(epoch *big.Int, prev *big.Int, vid *big.Int) (*big.Int, error) {
+	// the accumulated reward per token progress
+	rpt, err := axis.SfcContract().GetEpochAccumulatedRewardPerToken(axis.DefaultCallOpts(), epoch, vid)
+	if err != nil {
+		axis.log.Errorf("can not get reward per token of #%d in epoch #%d; %s", vid.Uint64(), epoch.Uint64(), err.Error())
+		return nil, err
+	}
+
+	rptPrev, err := axis.SfcContract().GetEpochAccumulatedRewardPerToken(axis.DefaultCallOpts(), prev, vid)
+	if err != nil {
+		axis.log.Errorf("can not get reward per token of #%d in epoch #%d; %s", vid.Uint64(), prev.Uint64(), err.Error())
+		return nil, err
+	}
+
+	// the stake the rewards were distributed to
+	stake, err := axis.SfcContract().GetEpochReceivedStake(axis.DefaultCallOpts(), epoch, vid)
+	if err != nil {
+		axis.log.Errorf("can not get received stake of #%d in epoch #%d; %s", vid.Uint64(), epoch.Uint64(), err.Error())
+		return nil, err
+	}
+
+	reward := new(big.Int).Sub(rpt, rptPrev)
+	return reward.Div(reward.Mul(reward, stake), decimalUnit), nil
+}
+
+// epochCallOpts provides call options reading the state of the last block of the given sealed epoch.
+func (axis *AxisBridge) epochCallOpts(epoch *big.Int) (*bind.CallOpts, error) {
+	es, err := axis.SfcContract().GetEpochSnapshot(axis.DefaultCallOpts(), epoch)
+	if err != nil {
+		axis.log.Errorf("can not get snapshot of epoch #%d; %s", epoch.Uint64(), err.Error())
+		return nil, err
+	}
+
+	blk, err := axis.blockAtTime(es.EndTime.Uint64())
+	if err != nil {
+		axis.log.Errorf("can not find the last block of epoch #%d; %s", epoch.Uint64(), err.Error())
+		return nil, err
+	}
+	return axis.CallOptsAt(blk), nil
+}
+
+// blockAtTime finds the last block created at, or before the given UNIX time
+// by a binary search over the block headers.
+func (axis *AxisBridge) blockAtTime(ts uint64) (*big.Int, error) {
+	var head hexutil.Uint64
+	if err := axis.call(&head, "eth_blockNumber"); err != nil {
+		return nil, err
+	}
+
+	lo, hi := uint64(0), uint64(head)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+
+		var hdr struct {
+			TimeStamp hexutil.Uint64 `json:"timestamp"`
+		}
+		if err := axis.call(&hdr, "eth_getBlockByNumber", hexutil.EncodeUint64(mid), false); err != nil {
+			return nil, err
+		}
+
+		if uint64(hdr.TimeStamp) <= ts {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return new(big.Int).SetUint64(lo), nil
+}
+
+// ValidatorCommission provides the commission the validators take from rewards of their delegators
+// in the fixed point decimal unit of the SFC contract.
+func (axis *AxisBridge) ValidatorCommission() (_ *big.Int, err error) {
+	defer axis.isolate(&err, "ValidatorCommission()")
+
+	return axis.SfcContract().ValidatorCommission(axis.DefaultCallOpts())
+}
+
+// ValidatorRewardPerToken provides the reward per token of the given validator
+// accumulated up to the given sealed epoch.
+func (axis *AxisBridge) ValidatorRewardPerToken(id hexutil.Uint64, valID *big.Int) (_ *big.Int, err error) {
+	defer axis.isolate(&err, "ValidatorRewardPerToken(%v, %v)", id, valID)
+
+	rpt, err := axis.SfcContract().GetEpochAccumulatedRewardPerToken(axis.DefaultCallOpts(), new(big.Int).SetUint64(uint64(id)), valID)
+	if err != nil {
+		axis.log.Errorf("can not get reward per token of #%d in epoch #%d; %s", valID.Uint64(), uint64(id), err.Error())
+		return nil, err
+	}
+	return rpt, nil
+}
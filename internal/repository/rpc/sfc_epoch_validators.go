@@ -0,0 +1,77 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EpochValidators loads the validator set of the given sealed epoch
+// from the SFC epoch snapshot, including the stake received by each validator.
+func (axis *AxisBridge) EpochValidators(id hexutil.Uint64) (_ []*types.EpochValidator, err error) {
+	defer axis.isolate(&err, "EpochValidators(%v)", id)
+
+	// keep track of the operation
+	axis.log.Debugf("loading validator set of epoch #%d", uint64(id))
+	epoch := new(big.Int).SetUint64(uint64(id))
+
+	// get the list of validators of the epoch
+	ids, err := axis.SfcContract().GetEpochValidatorIDs(axis.DefaultCallOpts(), epoch)
+	if err != nil {
+		axis.log.Errorf("can not get validators of epoch #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+
+	list := make([]*types.EpochValidator, 0, len(ids))
+	for _, vid := range ids {
+		ev, err := axis.epochValidator(id, epoch, vid)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ev)
+	}
+	return list, nil
+}
+
+// epochValidator loads snapshot details of the given validator in the given epoch.
+func (axis *AxisBridge) epochValidator(id hexutil.Uint64, epoch *big.Int, vid *big.Int) (*types.EpochValidator, error) {
+	stake, err := axis.SfcContract().GetEpochReceivedStake(axis.DefaultCallOpts(), epoch, vid)
+	if err != nil {
+		axis.log.Errorf("can not get received stake of #%d in epoch #%d; %s", vid.Uint64(), uint64(id), err.Error())
+		return nil, err
+	}
+
+	fee, err := axis.SfcContract().GetEpochAccumulatedOriginatedTxsFee(axis.DefaultCallOpts(), epoch, vid)
+	if err != nil {
+		axis.log.Errorf("can not get originated fee of #%d in epoch #%d; %s", vid.Uint64(), uint64(id), err.Error())
+		return nil, err
+	}
+
+	uptime, err := axis.SfcContract().GetEpochAccumulatedUptime(axis.DefaultCallOpts(), epoch, vid)
+	if err != nil {
+		axis.log.Errorf("can not get uptime of #%d in epoch #%d; %s", vid.Uint64(), uint64(id), err.Error())
+		return nil, err
+	}
+
+	return &types.EpochValidator{
+		Epoch:         id,
+		ValidatorId:   hexutil.Big(*vid),
+		ReceivedStake: hexutil.Big(*stake),
+		OriginatedFee: hexutil.Big(*fee),
+		Uptime:        hexutil.Uint64(uptime.Uint64()),
+	}, nil
+}
@@ -0,0 +1,97 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"axis-graphql/internal/repository/rpc/contracts"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SfcTokenizerAbi returns a parsed ABI of the SFC Tokenizer contract.
+func (axis *AxisBridge) SfcTokenizerAbi() *abi.ABI {
+	if nil == axis.tokenizerAbi {
+		ab, err := abi.JSON(strings.NewReader(contracts.SfcTokenizerABI))
+		if err != nil {
+			axis.log.Criticalf("failed to parse SFC Tokenizer contract ABI; %s", err.Error())
+			panic(err)
+		}
+		axis.tokenizerAbi = &ab
+	}
+	return axis.tokenizerAbi
+}
+
+// SfcTokenizerAddress returns the address of the SFC Tokenizer contract.
+func (axis *AxisBridge) SfcTokenizerAddress() common.Address {
+	return axis.sfcConfig.TokenizerContract
+}
+
+// SAXISTokenAddress returns the address of the sAXIS ERC20 token minted by the SFC Tokenizer.
+func (axis *AxisBridge) SAXISTokenAddress() common.Address {
+	return axis.sfcConfig.TokenizedStakeToken
+}
+
+// MintSAXISCallData packs call data of the SFC Tokenizer call minting sAXIS tokens
+// for the locked delegation to the given validator.
+func (axis *AxisBridge) MintSAXISCallData(valID *big.Int) (_ []byte, err error) {
+	defer axis.isolate(&err, "MintSAXISCallData(%v)", valID)
+
+	cd, err := axis.SfcTokenizerAbi().Pack("mintSAXIS", valID)
+	if err != nil {
+		axis.log.Errorf("can not pack sAXIS mint call to %d; %s", valID.Uint64(), err.Error())
+		return nil, err
+	}
+	return cd, nil
+}
+
+// RedeemSAXISCallData packs call data of the SFC Tokenizer call burning the given
+// amount of sAXIS tokens issued for the delegation to the given validator.
+func (axis *AxisBridge) RedeemSAXISCallData(valID *big.Int, amount *big.Int) (_ []byte, err error) {
+	defer axis.isolate(&err, "RedeemSAXISCallData(%v, %v)", valID, amount)
+
+	cd, err := axis.SfcTokenizerAbi().Pack("redeemSAXIS", valID, amount)
+	if err != nil {
+		axis.log.Errorf("can not pack sAXIS redeem call of %s to %d; %s", amount.String(), valID.Uint64(), err.Error())
+		return nil, err
+	}
+	return cd, nil
+}
+
+// SfcTokenizerCallValidator decodes the validator id from the call data of an SFC Tokenizer
+// call minting, or redeeming sAXIS tokens; nil is returned for any other call.
+func (axis *AxisBridge) SfcTokenizerCallValidator(data []byte) *big.Int {
+	if len(data) < 4 {
+		return nil
+	}
+
+	m, err := axis.SfcTokenizerAbi().MethodById(data[:4])
+	if err != nil || (m.Name != "mintSAXIS" && m.Name != "redeemSAXIS") {
+		return nil
+	}
+
+	// the validator id is the first argument of both calls
+	args, err := m.Inputs.Unpack(data[4:])
+	if err != nil || len(args) == 0 {
+		axis.log.Errorf("can not decode SFC Tokenizer %s call; %v", m.Name, err)
+		return nil
+	}
+	id, ok := args[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+	return id
+}
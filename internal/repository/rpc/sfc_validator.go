@@ -23,13 +23,15 @@ import (
 )
 
 // ValidatorDowntime pulls information about validator downtime from the RPC interface.
-func (axis *AxisBridge) ValidatorDowntime(valID *hexutil.Big) (uint64, uint64, error) {
+func (axis *AxisBridge) ValidatorDowntime(valID *hexutil.Big) (_ uint64, _ uint64, err error) {
+	defer axis.isolate(&err, "ValidatorDowntime(%v)", valID)
+
 	// use rather the public API, it should be faster since it does not involve contract call
 	var dt struct {
 		Blocks hexutil.Uint64 `json:"offlineBlocks"`
 		Time   hexutil.Uint64 `json:"offlineTime"`
 	}
-	if err := axis.rpc.Call(&dt, "abft_getDowntime", valID); err != nil {
+	if err := axis.call(&dt, "abft_getDowntime", valID); err != nil {
 		axis.log.Errorf("failed to get downtime of validator #%d; %s", valID.ToInt().Uint64(), err.Error())
 		return 0, 0, err
 	}
@@ -38,10 +40,12 @@ func (axis *AxisBridge) ValidatorDowntime(valID *hexutil.Big) (uint64, uint64, e
 }
 
 // ValidatorEpochUptime pulls information about validator uptime on the given epoch.
-func (axis *AxisBridge) ValidatorEpochUptime(valID *hexutil.Big) (uint64, error) {
+func (axis *AxisBridge) ValidatorEpochUptime(valID *hexutil.Big) (_ uint64, err error) {
+	defer axis.isolate(&err, "ValidatorEpochUptime(%v)", valID)
+
 	// use rather the public API, it should be faster since it does not involve contract call
 	var ut hexutil.Uint64
-	if err := axis.rpc.Call(&ut, "abft_getEpochUptime", valID); err != nil {
+	if err := axis.call(&ut, "abft_getEpochUptime", valID); err != nil {
 		axis.log.Errorf("failed to get epoch uptime of validator #%d; %s", valID.ToInt().Uint64(), err.Error())
 		return 0, err
 	}
@@ -50,9 +54,11 @@ func (axis *AxisBridge) ValidatorEpochUptime(valID *hexutil.Big) (uint64, error)
 }
 
 // LastValidatorId returns the last staker id in AXIS blockchain.
-func (axis *AxisBridge) LastValidatorId() (uint64, error) {
+func (axis *AxisBridge) LastValidatorId() (_ uint64, err error) {
+	defer axis.isolate(&err, "LastValidatorId()")
+
 	// get the value from the contract
-	sl, err := axis.SfcContract().LastValidatorID(nil)
+	sl, err := axis.SfcContract().LastValidatorID(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("failed to get the last staker ID: %s", err.Error())
 		return 0, err
@@ -61,7 +67,9 @@ func (axis *AxisBridge) LastValidatorId() (uint64, error) {
 }
 
 // ValidatorsCount returns the number of validators in AXIS blockchain.
-func (axis *AxisBridge) ValidatorsCount() (uint64, error) {
+func (axis *AxisBridge) ValidatorsCount() (_ uint64, err error) {
+	defer axis.isolate(&err, "ValidatorsCount()")
+
 	// get the value from the contract
 	epoch, err := axis.SfcContract().CurrentEpoch(axis.DefaultCallOpts())
 	if err != nil {
@@ -70,7 +78,7 @@ func (axis *AxisBridge) ValidatorsCount() (uint64, error) {
 	}
 
 	// get the value from the contract
-	val, err := axis.SfcContract().GetEpochValidatorIDs(nil, epoch)
+	val, err := axis.SfcContract().GetEpochValidatorIDs(axis.DefaultCallOpts(), epoch)
 	if err != nil {
 		axis.log.Errorf("failed to get the list of validators; %s", err.Error())
 		return 0, err
@@ -81,7 +89,9 @@ func (axis *AxisBridge) ValidatorsCount() (uint64, error) {
 }
 
 // Validator extract a staker information by numeric id.
-func (axis *AxisBridge) Validator(valID *big.Int) (*types.Validator, error) {
+func (axis *AxisBridge) Validator(valID *big.Int) (_ *types.Validator, err error) {
+	defer axis.isolate(&err, "Validator(%v)", valID)
+
 	// no validator id?
 	if valID == nil {
 		return nil, fmt.Errorf("validator ID not provided")
@@ -95,7 +105,7 @@ func (axis *AxisBridge) Validator(valID *big.Int) (*types.Validator, error) {
 // validatorById loads details of a validator with the specified ID.
 func (axis *AxisBridge) validatorById(valID *big.Int) (*types.Validator, error) {
 	// call for data
-	val, err := axis.SfcContract().GetValidator(nil, valID)
+	val, err := axis.SfcContract().GetValidator(axis.DefaultCallOpts(), valID)
 	if err != nil {
 		axis.log.Criticalf("failed to load validator #%d from SFC; %s", valID.Uint64(), err.Error())
 		return nil, err
@@ -134,9 +144,11 @@ func (axis *AxisBridge) validatorById(valID *big.Int) (*types.Validator, error)
 }
 
 // ValidatorAddress extract a staker address for the given staker ID.
-func (axis *AxisBridge) ValidatorAddress(valID *big.Int) (*common.Address, error) {
+func (axis *AxisBridge) ValidatorAddress(valID *big.Int) (_ *common.Address, err error) {
+	defer axis.isolate(&err, "ValidatorAddress(%v)", valID)
+
 	// do we have an address call?
-	val, err := axis.SfcContract().GetValidator(nil, valID)
+	val, err := axis.SfcContract().GetValidator(axis.DefaultCallOpts(), valID)
 	if err != nil {
 		axis.log.Error("validator information could not be extracted")
 		return nil, err
@@ -153,12 +165,14 @@ func (axis *AxisBridge) ValidatorAddress(valID *big.Int) (*common.Address, error
 }
 
 // IsValidator returns if the given address is an SFC validator.
-func (axis *AxisBridge) IsValidator(addr *common.Address) (bool, error) {
+func (axis *AxisBridge) IsValidator(addr *common.Address) (_ bool, err error) {
+	defer axis.isolate(&err, "IsValidator(%v)", addr)
+
 	// keep track of the operation
 	axis.log.Debugf("verifying validator address %s", addr.String())
 
 	// try to get the id
-	id, err := axis.SfcContract().GetValidatorID(nil, *addr)
+	id, err := axis.SfcContract().GetValidatorID(axis.DefaultCallOpts(), *addr)
 	if err != nil {
 		axis.log.Criticalf("can not check validator at %s; %s", addr.String(), err.Error())
 		return false, err
@@ -168,7 +182,9 @@ func (axis *AxisBridge) IsValidator(addr *common.Address) (bool, error) {
 }
 
 // ValidatorByAddress extracts a validator information by address.
-func (axis *AxisBridge) ValidatorByAddress(addr *common.Address) (*types.Validator, error) {
+func (axis *AxisBridge) ValidatorByAddress(addr *common.Address) (_ *types.Validator, err error) {
+	defer axis.isolate(&err, "ValidatorByAddress(%v)", addr)
+
 	// no validator id?
 	if addr == nil {
 		return nil, fmt.Errorf("validator address not provided")
@@ -191,3 +207,15 @@ func (axis *AxisBridge) ValidatorByAddress(addr *common.Address) (*types.Validat
 	}
 	return axis.validatorById(id)
 }
+
+// ValidatorPubkey extracts the public key of the validator with the given ID.
+func (axis *AxisBridge) ValidatorPubkey(valID *big.Int) (_ []byte, err error) {
+	defer axis.isolate(&err, "ValidatorPubkey(%v)", valID)
+
+	pk, err := axis.SfcContract().GetValidatorPubkey(axis.DefaultCallOpts(), valID)
+	if err != nil {
+		axis.log.Errorf("can not get public key of validator #%d; %s", valID.Uint64(), err.Error())
+		return nil, err
+	}
+	return pk, nil
+}
@@ -37,7 +37,9 @@ const stiRequestTimeout = 2 * time.Second
 var stiNameCheckRegex = regexp.MustCompile(`^[\w\d\s.\-_'$()]+$`)
 
 // StakerInfo extracts an extended staker information from smart contact by their id.
-func (axis *AxisBridge) StakerInfo(id *hexutil.Big) (*types.StakerInfo, error) {
+func (axis *AxisBridge) StakerInfo(id *hexutil.Big) (_ *types.StakerInfo, err error) {
+	defer axis.isolate(&err, "StakerInfo(%v)", id)
+
 	if id == nil {
 		return nil, fmt.Errorf("validator ID not given")
 	}
@@ -69,6 +71,24 @@ func (axis *AxisBridge) StakerInfo(id *hexutil.Big) (*types.StakerInfo, error) {
 	return axis.downloadStakerInfo(stUrl)
 }
 
+// StakerInfoUrl extracts the staker information URL registered for the staker by their id.
+func (axis *AxisBridge) StakerInfoUrl(id *hexutil.Big) (_ string, err error) {
+	defer axis.isolate(&err, "StakerInfoUrl(%v)", id)
+
+	contract, err := contracts.NewStakerInfoContract(axis.sfcConfig.StiContract, axis.eth)
+	if err != nil {
+		axis.log.Criticalf("failed to instantiate STI contract: %v", err)
+		return "", err
+	}
+
+	stUrl, err := contract.GetInfo(nil, (*big.Int)(id))
+	if err != nil {
+		axis.log.Errorf("failed to get the staker information URL: %v", err)
+		return "", err
+	}
+	return stUrl, nil
+}
+
 // downloadStakerInfo tries to download staker information from the given URL address.
 func (axis *AxisBridge) downloadStakerInfo(stUrl string) (*types.StakerInfo, error) {
 	// log what we are about to do
@@ -0,0 +1,36 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockTraces loads raw call traces of all the transactions of the given block.
+// The node must have the tracing API enabled.
+func (axis *AxisBridge) BlockTraces(num uint64) (_ []json.RawMessage, err error) {
+	defer axis.isolate(&err, "BlockTraces(%v)", num)
+
+	// keep track of the operation
+	axis.log.Debugf("loading traces of block #%d", num)
+
+	var list []json.RawMessage
+	if err := axis.call(&list, "trace_block", hexutil.EncodeUint64(num)); err != nil {
+		axis.log.Errorf("can not load traces of block #%d; %s", num, err.Error())
+		return nil, err
+	}
+	return list, nil
+}
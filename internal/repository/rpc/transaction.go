@@ -28,7 +28,7 @@ func (axis *AxisBridge) Transaction(hash *common.Hash) (*types.Transaction, erro
 
 	// call for data
 	var trx types.Transaction
-	err := axis.rpc.Call(&trx, "axis_getTransactionByHash", hash)
+	err := axis.call(&trx, "axis_getTransactionByHash", hash)
 	if err != nil {
 		axis.log.Error("transaction could not be extracted")
 		return nil, err
@@ -47,7 +47,7 @@ func (axis *AxisBridge) Transaction(hash *common.Hash) (*types.Transaction, erro
 		}
 
 		// call for the transaction receipt data
-		err := axis.rpc.Call(&rec, "axis_getTransactionReceipt", hash)
+		err := axis.call(&rec, "axis_getTransactionReceipt", hash)
 		if err != nil {
 			axis.log.Errorf("can not get receipt for transaction %s", hash)
 			return nil, err
@@ -73,7 +73,7 @@ func (axis *AxisBridge) SendTransaction(tx hexutil.Bytes) (*common.Hash, error)
 	axis.log.Debug("sending new transaction to block chain")
 
 	var hash common.Hash
-	err := axis.rpc.Call(&hash, "eth_sendRawTransaction", tx)
+	err := axis.call(&hash, "eth_sendRawTransaction", tx)
 	if err != nil {
 		axis.log.Error("transaction could not be sent")
 		return nil, err
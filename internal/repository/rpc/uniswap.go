@@ -28,7 +28,9 @@ import (
 //go:generate tools/abigen.sh --abi ./contracts/abi/uniswap-router.abi --pkg contracts --type UniswapRouter --out ./contracts/uniswap_router.go
 
 // NativeTokenAddress returns an address of native token.
-func (axis *AxisBridge) NativeTokenAddress() (*common.Address, error) {
+func (axis *AxisBridge) NativeTokenAddress() (_ *common.Address, err error) {
+	defer axis.isolate(&err, "NativeTokenAddress()")
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapRouter(axis.uniswapConfig.Router, axis.eth)
 	if err != nil {
@@ -37,7 +39,7 @@ func (axis *AxisBridge) NativeTokenAddress() (*common.Address, error) {
 	}
 
 	// get the native token address
-	adr, err := contract.WETH(nil)
+	adr, err := contract.WETH(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Native token address not available; %s", err.Error())
 		return nil, err
@@ -47,7 +49,9 @@ func (axis *AxisBridge) NativeTokenAddress() (*common.Address, error) {
 }
 
 // UniswapPair returns an address of an Uniswap pair for the given tokens.
-func (axis *AxisBridge) UniswapPair(tokenA *common.Address, tokenB *common.Address) (*common.Address, error) {
+func (axis *AxisBridge) UniswapPair(tokenA *common.Address, tokenB *common.Address) (_ *common.Address, err error) {
+	defer axis.isolate(&err, "UniswapPair(%v, %v)", tokenA, tokenB)
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapFactory(axis.uniswapConfig.Core, axis.eth)
 	if err != nil {
@@ -56,7 +60,7 @@ func (axis *AxisBridge) UniswapPair(tokenA *common.Address, tokenB *common.Addre
 	}
 
 	// try to get the pair
-	pair, err := contract.GetPair(nil, *tokenA, *tokenB)
+	pair, err := contract.GetPair(axis.DefaultCallOpts(), *tokenA, *tokenB)
 	if err != nil {
 		axis.log.Errorf("Uniswap pair not found for tokens %s and %s; %s", tokenA.String(), tokenB.String(), err.Error())
 		return nil, err
@@ -66,7 +70,9 @@ func (axis *AxisBridge) UniswapPair(tokenA *common.Address, tokenB *common.Addre
 }
 
 // UniswapPairs returns list of all token pairs managed by Uniswap core.
-func (axis *AxisBridge) UniswapPairs(whiteListedOnly bool) ([]common.Address, error) {
+func (axis *AxisBridge) UniswapPairs(whiteListedOnly bool) (_ []common.Address, err error) {
+	defer axis.isolate(&err, "UniswapPairs(%v)", whiteListedOnly)
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapFactory(axis.uniswapConfig.Core, axis.eth)
 	if err != nil {
@@ -75,7 +81,7 @@ func (axis *AxisBridge) UniswapPairs(whiteListedOnly bool) ([]common.Address, er
 	}
 
 	// get the number of pairs
-	length, err := contract.AllPairsLength(nil)
+	length, err := contract.AllPairsLength(axis.DefaultCallOpts())
 	if err != nil || length == nil {
 		axis.log.Error("Uniswap pairs array length not available")
 		return nil, err
@@ -88,7 +94,7 @@ func (axis *AxisBridge) UniswapPairs(whiteListedOnly bool) ([]common.Address, er
 	index := new(big.Int)
 	for i := uint64(0); i < length.Uint64(); i++ {
 		// get the pair address
-		adr, err := contract.AllPairs(nil, index.SetUint64(i))
+		adr, err := contract.AllPairs(axis.DefaultCallOpts(), index.SetUint64(i))
 		if err != nil {
 			axis.log.Errorf("error loading Uniswap pair; %s", err.Error())
 			continue
@@ -127,7 +133,9 @@ func (axis *AxisBridge) UniswapQuoteInput(
 	amountA hexutil.Big,
 	reserveA hexutil.Big,
 	reserveB hexutil.Big,
-) (hexutil.Big, error) {
+) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapQuoteInput(%v, %v, %v)", amountA, reserveA, reserveB)
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapRouter(axis.uniswapConfig.Router, axis.eth)
 	if err != nil {
@@ -136,7 +144,7 @@ func (axis *AxisBridge) UniswapQuoteInput(
 	}
 
 	// get the quote amount
-	amount, err := contract.Quote(nil, amountA.ToInt(), reserveA.ToInt(), reserveB.ToInt())
+	amount, err := contract.Quote(axis.DefaultCallOpts(), amountA.ToInt(), reserveA.ToInt(), reserveB.ToInt())
 	if err != nil {
 		axis.log.Errorf("can not calculate Uniswap quote; %s", err.Error())
 		return hexutil.Big{}, err
@@ -147,7 +155,9 @@ func (axis *AxisBridge) UniswapQuoteInput(
 
 // UniswapAmountsOut resolves a list of output amounts for the given
 // input amount and a list of tokens to be used to make the swap operation.
-func (axis *AxisBridge) UniswapAmountsOut(amountIn hexutil.Big, tokens []common.Address) ([]hexutil.Big, error) {
+func (axis *AxisBridge) UniswapAmountsOut(amountIn hexutil.Big, tokens []common.Address) (_ []hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapAmountsOut(%v, %v)", amountIn, tokens)
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapRouter(axis.uniswapConfig.Router, axis.eth)
 	if err != nil {
@@ -160,7 +170,9 @@ func (axis *AxisBridge) UniswapAmountsOut(amountIn hexutil.Big, tokens []common.
 
 // UniswapAmountsIn resolves a list of input amounts for the given
 // output amount and a list of tokens to be used to make the swap operation.
-func (axis *AxisBridge) UniswapAmountsIn(amountOut hexutil.Big, tokens []common.Address) ([]hexutil.Big, error) {
+func (axis *AxisBridge) UniswapAmountsIn(amountOut hexutil.Big, tokens []common.Address) (_ []hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapAmountsIn(%v, %v)", amountOut, tokens)
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapRouter(axis.uniswapConfig.Router, axis.eth)
 	if err != nil {
@@ -179,7 +191,7 @@ func (axis *AxisBridge) uniswapLoadAmounts(
 	tokens []common.Address,
 ) ([]hexutil.Big, error) {
 	// get the amounts list
-	out, err := loader(nil, amount, tokens)
+	out, err := loader(axis.DefaultCallOpts(), amount, tokens)
 	if err != nil {
 		axis.log.Errorf("can not load swap amounts; %s", err.Error())
 		return nil, err
@@ -194,8 +206,28 @@ func (axis *AxisBridge) uniswapLoadAmounts(
 	return list, nil
 }
 
+// UniswapRouterCallData packs call data of the given Uniswap router contract method with the given arguments.
+func (axis *AxisBridge) UniswapRouterCallData(method string, args ...interface{}) (_ []byte, err error) {
+	defer axis.isolate(&err, "UniswapRouterCallData(%v, %v)", method, args)
+
+	ab, err := contracts.UniswapRouterMetaData.GetAbi()
+	if err != nil {
+		axis.log.Criticalf("failed to parse Uniswap router contract ABI; %s", err.Error())
+		return nil, err
+	}
+
+	cd, err := ab.Pack(method, args...)
+	if err != nil {
+		axis.log.Errorf("can not pack Uniswap router %s call; %s", method, err.Error())
+		return nil, err
+	}
+	return cd, nil
+}
+
 // UniswapTokens returns list of addresses of tokens involved in a Uniswap pair.
-func (axis *AxisBridge) UniswapTokens(pair *common.Address) ([]common.Address, error) {
+func (axis *AxisBridge) UniswapTokens(pair *common.Address) (_ []common.Address, err error) {
+	defer axis.isolate(&err, "UniswapTokens(%v)", pair)
+
 	// get the pair contract if possible
 	contract, err := contracts.NewUniswapPair(*pair, axis.eth)
 	if err != nil {
@@ -207,14 +239,14 @@ func (axis *AxisBridge) UniswapTokens(pair *common.Address) ([]common.Address, e
 	tokens := make([]common.Address, 2)
 
 	// get the first token
-	tokens[0], err = contract.Token0(nil)
+	tokens[0], err = contract.Token0(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Uniswap pair %s token A not found; %s", pair.String(), err.Error())
 		return nil, err
 	}
 
 	// get the second token
-	tokens[1], err = contract.Token1(nil)
+	tokens[1], err = contract.Token1(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Uniswap pair %s token B not found; %s", pair.String(), err.Error())
 		return nil, err
@@ -224,7 +256,9 @@ func (axis *AxisBridge) UniswapTokens(pair *common.Address) ([]common.Address, e
 }
 
 // UniswapReserves returns list of token reserve amounts in a Uniswap pair.
-func (axis *AxisBridge) UniswapReserves(pair *common.Address) ([]hexutil.Big, error) {
+func (axis *AxisBridge) UniswapReserves(pair *common.Address) (_ []hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapReserves(%v)", pair)
+
 	// get the reserves record from the contract
 	rs, err := axis.uniswapReservesRecord(pair)
 	if err != nil {
@@ -241,7 +275,9 @@ func (axis *AxisBridge) UniswapReserves(pair *common.Address) ([]hexutil.Big, er
 }
 
 // UniswapReservesTimeStamp returns the timestamp of the reserves of a Uniswap pair.
-func (axis *AxisBridge) UniswapReservesTimeStamp(pair *common.Address) (hexutil.Uint64, error) {
+func (axis *AxisBridge) UniswapReservesTimeStamp(pair *common.Address) (_ hexutil.Uint64, err error) {
+	defer axis.isolate(&err, "UniswapReservesTimeStamp(%v)", pair)
+
 	// get the reserves record from the contract
 	rs, err := axis.uniswapReservesRecord(pair)
 	if err != nil {
@@ -253,7 +289,9 @@ func (axis *AxisBridge) UniswapReservesTimeStamp(pair *common.Address) (hexutil.
 }
 
 // UniswapCumulativePrices returns list of token cumulative prices of a Uniswap pair.
-func (axis *AxisBridge) UniswapCumulativePrices(pair *common.Address) ([]hexutil.Big, error) {
+func (axis *AxisBridge) UniswapCumulativePrices(pair *common.Address) (_ []hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapCumulativePrices(%v)", pair)
+
 	// get the pair contract if possible
 	contract, err := contracts.NewUniswapPair(*pair, axis.eth)
 	if err != nil {
@@ -266,7 +304,7 @@ func (axis *AxisBridge) UniswapCumulativePrices(pair *common.Address) ([]hexutil
 	var price *big.Int
 
 	// get the first token price
-	price, err = contract.Price0CumulativeLast(nil)
+	price, err = contract.Price0CumulativeLast(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Uniswap pair %s cumulative price A not available; %s", pair.String(), err.Error())
 		return nil, err
@@ -276,7 +314,7 @@ func (axis *AxisBridge) UniswapCumulativePrices(pair *common.Address) ([]hexutil
 	prices[0] = hexutil.Big(*price)
 
 	// get the second token price
-	price, err = contract.Price1CumulativeLast(nil)
+	price, err = contract.Price1CumulativeLast(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Uniswap pair %s cumulative price B not available; %s", pair.String(), err.Error())
 		return nil, err
@@ -301,12 +339,14 @@ func (axis *AxisBridge) uniswapReservesRecord(pair *common.Address) (*struct {
 	}
 
 	// try to get the reserves
-	rs, err := contract.GetReserves(nil)
+	rs, err := contract.GetReserves(axis.DefaultCallOpts())
 	return &rs, err
 }
 
 // UniswapLastKValue returns the last value of the pool control coefficient.
-func (axis *AxisBridge) UniswapLastKValue(pair *common.Address) (hexutil.Big, error) {
+func (axis *AxisBridge) UniswapLastKValue(pair *common.Address) (_ hexutil.Big, err error) {
+	defer axis.isolate(&err, "UniswapLastKValue(%v)", pair)
+
 	// get the pair contract if possible
 	contract, err := contracts.NewUniswapPair(*pair, axis.eth)
 	if err != nil {
@@ -315,7 +355,7 @@ func (axis *AxisBridge) UniswapLastKValue(pair *common.Address) (hexutil.Big, er
 	}
 
 	// try to get the reserves
-	k, err := contract.KLast(nil)
+	k, err := contract.KLast(axis.DefaultCallOpts())
 	if err != nil {
 		axis.log.Errorf("Uniswap pair %s coefficient K not available; %s", pair.String(), err.Error())
 		return hexutil.Big{}, err
@@ -325,7 +365,9 @@ func (axis *AxisBridge) UniswapLastKValue(pair *common.Address) (hexutil.Big, er
 }
 
 // UniswapPairContract returns instance of this contract according to given pair address
-func (axis *AxisBridge) UniswapPairContract(pairAddres *common.Address) (*contracts.UniswapPair, error) {
+func (axis *AxisBridge) UniswapPairContract(pairAddres *common.Address) (_ *contracts.UniswapPair, err error) {
+	defer axis.isolate(&err, "UniswapPairContract(%v)", pairAddres)
+
 	contract, err := contracts.NewUniswapPair(*pairAddres, axis.eth)
 	if err != nil {
 		axis.log.Errorf("Uniswap pair contract %s not found; %s", pairAddres.String(), err.Error())
@@ -335,7 +377,9 @@ func (axis *AxisBridge) UniswapPairContract(pairAddres *common.Address) (*contra
 }
 
 // UniswapFactoryContract returns an instance of an Uniswap factory
-func (axis *AxisBridge) UniswapFactoryContract() (*contracts.UniswapFactory, error) {
+func (axis *AxisBridge) UniswapFactoryContract() (_ *contracts.UniswapFactory, err error) {
+	defer axis.isolate(&err, "UniswapFactoryContract()")
+
 	// get the router contract if possible
 	contract, err := contracts.NewUniswapFactory(axis.uniswapConfig.Core, axis.eth)
 	if err != nil {
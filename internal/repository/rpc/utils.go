@@ -27,7 +27,7 @@ func (axis *AxisBridge) GasPrice() (hexutil.Big, error) {
 
 	// call for data
 	var price hexutil.Big
-	err := axis.rpc.Call(&price, "axis_gasPrice")
+	err := axis.call(&price, "axis_gasPrice")
 	if err != nil {
 		axis.log.Error("current gas price could not be obtained")
 		return price, err
@@ -48,7 +48,7 @@ func (axis *AxisBridge) GasEstimate(trx *struct {
 	axis.log.Debugf("calling for gas amount estimation")
 
 	var val hexutil.Uint64
-	err := axis.rpc.Call(&val, "axis_estimateGas", trx)
+	err := axis.call(&val, "axis_estimateGas", trx)
 	if err != nil {
 		// missing required argument? incompatibility between old and new RPC API
 		if strings.Contains(err.Error(), "missing value") {
@@ -77,7 +77,7 @@ func (axis *AxisBridge) GasEstimateWithBlock(trx *struct {
 	axis.log.Debugf("calling for gas amount estimation with block details")
 
 	var val hexutil.Uint64
-	err := axis.rpc.Call(&val, "axis_estimateGas", trx, BlockTypeLatest)
+	err := axis.call(&val, "axis_estimateGas", trx, BlockTypeLatest)
 	if err != nil {
 		// return error
 		axis.log.Errorf("can not estimate gas; %s", err.Error())
@@ -0,0 +1,100 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SandboxKeyPrefix is the prefix of all sandbox API keys; it allows to skip
+// the sandbox key lookup for other keys.
+const SandboxKeyPrefix = "sbx_"
+
+// CreateSandboxKey mints a new sandbox API key for the given client address.
+// The key is provided only here, the repository keeps its hash.
+func (p *proxy) CreateSandboxKey(client string) (*types.SandboxKey, string, error) {
+	// make the key
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("can not generate sandbox key; %s", err.Error())
+	}
+	key := SandboxKeyPrefix + hex.EncodeToString(raw)
+	id := sandboxKeyHash(key)
+
+	now := time.Now().UTC()
+	sk := types.SandboxKey{
+		ID:      id,
+		Name:    "sandbox-" + id[:12],
+		Client:  client,
+		Created: now,
+		Expires: now.Add(p.cfg.Sandbox.KeyTTL),
+	}
+
+	// claim a free slot of the client quota
+	ok, err := p.db.ClaimSandboxSlot(client, p.cfg.Sandbox.MaxKeysPerClient, id, sk.Expires)
+	if err != nil {
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", fmt.Errorf("sandbox keys limit of %d reached", p.cfg.Sandbox.MaxKeysPerClient)
+	}
+
+	if err := p.db.AddSandboxKey(&sk); err != nil {
+		p.db.ReleaseSandboxSlot(id)
+		return nil, "", err
+	}
+
+	p.log.Noticef("sandbox key %s minted for %s", sk.Name, client)
+	return &sk, key, nil
+}
+
+// SandboxKey provides a valid sandbox API key matching the given key; nil if there is none.
+func (p *proxy) SandboxKey(key string) (*types.SandboxKey, error) {
+	if !strings.HasPrefix(key, SandboxKeyPrefix) {
+		return nil, nil
+	}
+
+	// try the cache first
+	id := sandboxKeyHash(key)
+	if sk := p.cache.PullSandboxKey(id); sk != nil {
+		if sk.Expires.After(time.Now()) {
+			return sk, nil
+		}
+		p.cache.EvictSandboxKey(id)
+		return nil, nil
+	}
+
+	// we already know the key is not valid
+	if p.cache.CheckSandboxKeyMiss(id) {
+		return nil, nil
+	}
+
+	sk, err := p.db.SandboxKey(id)
+	if err != nil {
+		return nil, err
+	}
+	if sk == nil {
+		p.cache.PushSandboxKeyMiss(id)
+		return nil, nil
+	}
+	p.cache.PushSandboxKey(sk)
+	return sk, nil
+}
+
+// sandboxKeyHash calculates the hash of the sandbox key used as its identifier.
+func sandboxKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
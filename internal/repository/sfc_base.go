@@ -114,6 +114,12 @@ func (p *proxy) CurrentSealedEpoch() (*types.Epoch, error) {
 	return p.Epoch(&id)
 }
 
+// EpochRewardsDistributed calculates the total amount of rewards distributed
+// to validators and their delegators on sealing the given epoch.
+func (p *proxy) EpochRewardsDistributed(id hexutil.Uint64) (*big.Int, error) {
+	return p.rpc.EpochRewardsDistributed(id)
+}
+
 // TotalStaked calculates current total staked amount for all stakers.
 func (p *proxy) TotalStaked() (*hexutil.Big, error) {
 	// try cache first
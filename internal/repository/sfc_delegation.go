@@ -12,6 +12,7 @@ import (
 	"axis-graphql/internal/repository/db"
 	"axis-graphql/internal/types"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -34,13 +35,50 @@ func (p *proxy) IsDelegating(addr *common.Address) (bool, error) {
 
 // StoreDelegation stores the delegation in persistent database.
 func (p *proxy) StoreDelegation(dl *types.Delegation) error {
+	// the stake of the validator changed
+	p.InvalidateValidator(dl.ToStakerId)
 	return p.db.AddDelegation(dl)
 }
 
+// DelegationsWithoutOrigin provides a batch of delegations with the original delegation event not verified yet.
+func (p *proxy) DelegationsWithoutOrigin(count int64) ([]*types.Delegation, error) {
+	return p.db.DelegationsWithoutOrigin(count)
+}
+
+// BackfillDelegationOrigin updates the creation time and transaction of the given delegation
+// to the original delegation event found on the chain. The origin is marked as known
+// even if the node does not know the event, so the delegation is not checked again.
+func (p *proxy) BackfillDelegationOrigin(dl *types.Delegation) error {
+	lg, err := p.rpc.DelegationOrigin(&dl.Address, dl.ToStakerId.ToInt())
+	if err != nil {
+		return err
+	}
+
+	if lg != nil {
+		hdr, err := p.rpc.BlockHeader(hexutil.Uint64(lg.BlockNumber))
+		if err != nil {
+			return err
+		}
+		dl.Transaction = lg.TxHash
+		dl.CreatedTime = hdr.TimeStamp
+	}
+	dl.OriginKnown = true
+
+	if err := p.db.UpdateDelegationOrigin(dl); err != nil {
+		return err
+	}
+	dl.Index = dl.OrdinalIndex()
+	p.cache.PushDelegation(dl)
+	return nil
+}
+
 // UpdateDelegationBalance updates active balance of the given delegation.
 func (p *proxy) UpdateDelegationBalance(addr *common.Address, valID *hexutil.Big, unknownDelegation func(*big.Int) error) error {
+	// the stake of the validator changed
+	p.InvalidateValidator(valID)
+
 	// pull the current value
-	val, err := p.DelegationAmountStaked(addr, valID)
+	val, err := p.DelegationAmountStaked(addr, valID, nil)
 	if err != nil {
 		p.log.Errorf("delegation balance not available for %s to %d; %s", addr.String(), valID.ToInt().Uint64(), err.Error())
 		return err
@@ -107,9 +145,10 @@ func (p *proxy) Delegation(adr *common.Address, valID *hexutil.Big) (*types.Dele
 	return dlg, nil
 }
 
-// DelegationAmountStaked returns the current amount of staked tokens for the given delegation.
-func (p *proxy) DelegationAmountStaked(addr *common.Address, valID *hexutil.Big) (*big.Int, error) {
-	val, err := p.rpc.AmountStaked(addr, (*big.Int)(valID))
+// DelegationAmountStaked returns the amount of staked tokens for the given delegation
+// at the given block; nil represents the latest block.
+func (p *proxy) DelegationAmountStaked(addr *common.Address, valID *hexutil.Big, block *big.Int) (*big.Int, error) {
+	val, err := p.rpc.AmountStaked(addr, (*big.Int)(valID), block)
 	if err != nil {
 		p.log.Errorf("can not get amount delegated by %s to %d; %s", addr.String(), valID.ToInt().Uint64(), err.Error())
 		return nil, err
@@ -119,10 +158,11 @@ func (p *proxy) DelegationAmountStaked(addr *common.Address, valID *hexutil.Big)
 	return val, nil
 }
 
-// DelegationsByAddress returns a list of all delegations of a given delegator address.
-func (p *proxy) DelegationsByAddress(addr *common.Address, cursor *string, count int32) (*types.DelegationList, error) {
+// DelegationsByAddress returns a list of all delegations of a given delegator address
+// in the order and scope of the given list options.
+func (p *proxy) DelegationsByAddress(addr *common.Address, cursor *string, count int32, opt *types.DelegationListOptions) (*types.DelegationList, error) {
 	p.log.Debugf("loading delegations of %s", addr.String())
-	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationAddress, Value: addr.String()}})
+	return p.delegationList(bson.D{{Key: types.FiDelegationAddress, Value: addr.String()}}, cursor, count, opt)
 }
 
 // DelegationsByAddressAll returns a list of all delegations of the given address un-paged.
@@ -131,16 +171,27 @@ func (p *proxy) DelegationsByAddressAll(addr *common.Address) ([]*types.Delegati
 	return p.db.DelegationsAll(&bson.D{{Key: types.FiDelegationAddress, Value: addr.String()}})
 }
 
-// DelegationsOfValidator extract a list of delegations for a given validator.
-func (p *proxy) DelegationsOfValidator(valID *hexutil.Big, cursor *string, count int32) (*types.DelegationList, error) {
+// DelegationsOfValidator extract a list of delegations for a given validator
+// in the order and scope of the given list options.
+func (p *proxy) DelegationsOfValidator(valID *hexutil.Big, cursor *string, count int32, opt *types.DelegationListOptions) (*types.DelegationList, error) {
 	p.log.Debugf("loading delegations of #%d", valID.ToInt().Uint64())
-	return p.db.Delegations(cursor, count, &bson.D{{Key: types.FiDelegationToValidator, Value: valID.String()}})
+	return p.delegationList(bson.D{{Key: types.FiDelegationToValidator, Value: valID.String()}}, cursor, count, opt)
+}
+
+// UpdateDelegationLock updates the lock end time of the given delegation from the SFC contract.
+func (p *proxy) UpdateDelegationLock(addr *common.Address, valID *hexutil.Big) error {
+	lock, err := p.rpc.DelegationLock(addr, valID, nil)
+	if err != nil {
+		p.log.Errorf("lock of %s to #%d not available; %s", addr.String(), valID.ToInt().Uint64(), err.Error())
+		return err
+	}
+	return p.db.UpdateDelegationLock(addr, valID, time.Unix(int64(lock.LockedUntil), 0).UTC())
 }
 
 // DelegationLock returns delegation lock information using SFC contract binding.
-func (p *proxy) DelegationLock(addr *common.Address, valID *hexutil.Big) (*types.DelegationLock, error) {
+func (p *proxy) DelegationLock(addr *common.Address, valID *hexutil.Big, block *big.Int) (*types.DelegationLock, error) {
 	p.log.Debugf("loading lock information for %s to #%d", addr.String(), valID.ToInt().Uint64())
-	return p.rpc.DelegationLock(addr, valID)
+	return p.rpc.DelegationLock(addr, valID, block)
 }
 
 // DelegationAmountUnlocked returns delegation lock information using SFC contract binding.
@@ -167,10 +218,11 @@ func (p *proxy) DelegationUnlockPenalty(addr *common.Address, valID *big.Int, am
 	return hexutil.Big(*val), nil
 }
 
-// PendingRewards returns a detail of pending rewards for the given delegation address and validator ID.
-func (p *proxy) PendingRewards(addr *common.Address, valID *hexutil.Big) (*types.PendingRewards, error) {
+// PendingRewards returns a detail of pending rewards for the given delegation address and validator ID
+// at the given block; nil represents the latest block.
+func (p *proxy) PendingRewards(addr *common.Address, valID *hexutil.Big, block *big.Int) (*types.PendingRewards, error) {
 	p.log.Debugf("loading pending rewards of %s to #%d", addr.String(), valID.ToInt().Uint64())
-	return p.rpc.PendingRewards(addr, valID.ToInt())
+	return p.rpc.PendingRewards(addr, valID.ToInt(), block)
 }
 
 // DelegationOutstandingSAXIS returns the amount of sAXIS tokens for the delegation
@@ -0,0 +1,136 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DelegationDustThreshold provides the amount in WEI below which an active delegation,
+// or its pending rewards, is considered dust.
+func (p *proxy) DelegationDustThreshold() *big.Int {
+	val, _ := new(big.Float).Mul(big.NewFloat(p.cfg.Staking.DustThreshold), new(big.Float).SetInt(p.SfcDecimalUnit())).Int(nil)
+	return val
+}
+
+// DelegationIsDust checks if the given active delegation amount is positive, but below the dust threshold.
+func (p *proxy) DelegationIsDust(amount *big.Int) bool {
+	return amount != nil && amount.Sign() > 0 && amount.Cmp(p.DelegationDustThreshold()) < 0
+}
+
+// WithdrawableRequests provides pending withdraw requests of the given delegation
+// past the withdrawal period, i.e. those which can be withdrawn right now.
+// Only the time part of the withdrawal period can be verified from the request record.
+func (p *proxy) WithdrawableRequests(addr *common.Address, valID *hexutil.Big) ([]*types.WithdrawRequest, error) {
+	cfg, err := p.SfcConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	wl, err := p.pendingWithdrawRequests(addr, valID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uint64(time.Now().UTC().Unix())
+	list := make([]*types.WithdrawRequest, 0)
+	for _, wr := range wl {
+		if withdrawPeriodPassed(wr, cfg, now) {
+			list = append(list, wr)
+		}
+	}
+	return list, nil
+}
+
+// DelegationNextActions provides the list of actions recommended to the given delegator
+// on its delegations, e.g. claiming rewards, withdrawing un-delegated stake,
+// re-locking expired locks, or un-delegating dust delegations.
+func (p *proxy) DelegationNextActions(addr *common.Address) ([]*types.DelegationAction, error) {
+	dl, err := p.DelegationsByAddressAll(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*types.DelegationAction, 0)
+	for _, dlg := range dl {
+		acts, err := p.delegationActions(dlg)
+		if err != nil {
+			p.log.Errorf("actions of %s to #%d not available; %s", addr.String(), dlg.ToStakerId.ToInt().Uint64(), err.Error())
+			return nil, err
+		}
+		list = append(list, acts...)
+	}
+	return list, nil
+}
+
+// delegationActions collects the actions recommended on the given delegation.
+func (p *proxy) delegationActions(dlg *types.Delegation) ([]*types.DelegationAction, error) {
+	list := make([]*types.DelegationAction, 0)
+	valID := dlg.ToStakerId.ToInt().Uint64()
+
+	// pending rewards worth the claim
+	pr, err := p.PendingRewards(&dlg.Address, dlg.ToStakerId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Amount.ToInt().Sign() > 0 && !p.DelegationIsDust(pr.Amount.ToInt()) {
+		list = append(list, &types.DelegationAction{
+			Action:      types.DelegationActionClaim,
+			ValidatorID: *dlg.ToStakerId,
+			Amount:      pr.Amount,
+			Reason:      fmt.Sprintf("pending rewards of the delegation to #%d can be claimed", valID),
+		})
+	}
+
+	// un-delegated stake ready for withdraw
+	wl, err := p.WithdrawableRequests(&dlg.Address, dlg.ToStakerId)
+	if err != nil {
+		return nil, err
+	}
+	for _, wr := range wl {
+		list = append(list, &types.DelegationAction{
+			Action:            types.DelegationActionWithdraw,
+			ValidatorID:       *dlg.ToStakerId,
+			Amount:            *wr.Amount,
+			WithdrawRequestID: wr.WithdrawRequestID,
+			Reason:            fmt.Sprintf("withdrawal period of request #%d to #%d passed", wr.WithdrawRequestID.ToInt().Uint64(), valID),
+		})
+	}
+
+	// expired lock
+	lock, err := p.DelegationLock(&dlg.Address, dlg.ToStakerId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if lock.IsExpired() {
+		list = append(list, &types.DelegationAction{
+			Action:      types.DelegationActionRelock,
+			ValidatorID: *dlg.ToStakerId,
+			Amount:      lock.LockedAmount,
+			Reason:      fmt.Sprintf("lock of the delegation to #%d expired", valID),
+		})
+	}
+
+	// dust delegation
+	if dlg.AmountDelegated != nil && p.DelegationIsDust(dlg.AmountDelegated.ToInt()) {
+		list = append(list, &types.DelegationAction{
+			Action:      types.DelegationActionUndelegateDust,
+			ValidatorID: *dlg.ToStakerId,
+			Amount:      *dlg.AmountDelegated,
+			Reason:      fmt.Sprintf("delegation to #%d is below the dust threshold", valID),
+		})
+	}
+	return list, nil
+}
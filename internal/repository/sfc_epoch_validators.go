@@ -0,0 +1,54 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SnapshotEpochValidators pulls the validator set of the given sealed epoch
+// from the SFC contract and stores it in the persistent storage.
+func (p *proxy) SnapshotEpochValidators(id hexutil.Uint64) ([]*types.EpochValidator, error) {
+	list, err := p.rpc.EpochValidators(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the set for later use
+	if err := p.db.StoreEpochValidators(list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// EpochValidators provides the validator set of the given sealed epoch.
+// Epochs not captured by the epoch scanner yet are pulled from the SFC contract on demand.
+func (p *proxy) EpochValidators(id hexutil.Uint64) ([]*types.EpochValidator, error) {
+	// try the stored snapshot first
+	list, err := p.db.EpochValidators(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) > 0 {
+		return list, nil
+	}
+
+	// only sealed epochs have a validator set snapshot
+	sealed, err := p.rpc.CurrentSealedEpoch()
+	if err != nil {
+		return nil, err
+	}
+	if id > sealed {
+		return nil, fmt.Errorf("epoch #%d not sealed yet", uint64(id))
+	}
+	return p.SnapshotEpochValidators(id)
+}
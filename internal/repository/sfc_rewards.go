@@ -84,3 +84,31 @@ func (p *proxy) RewardsClaimed(adr *common.Address, valId *big.Int, since *int64
 	}
 	return p.db.RewardsSumValue(&fi)
 }
+
+// RewardsClaimedSplit returns the sums of rewards of the delegation claimed
+// to the delegator account and re-staked into the delegation, respectively.
+func (p *proxy) RewardsClaimedSplit(adr *common.Address, valId *big.Int) (*big.Int, *big.Int, error) {
+	filter := func(restake bool) *bson.D {
+		return &bson.D{
+			{Key: types.FiRewardClaimAddress, Value: adr.String()},
+			{Key: types.FiRewardClaimToValidator, Value: (*hexutil.Big)(valId).String()},
+			{Key: types.FiRewardClaimIsRestake, Value: restake},
+		}
+	}
+
+	claimed, err := p.db.RewardsSumValue(filter(false))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restaked, err := p.db.RewardsSumValue(filter(true))
+	if err != nil {
+		return nil, nil, err
+	}
+	return claimed, restaked, nil
+}
+
+// StashedRewards returns the amount of rewards of the delegation stashed in the SFC contract.
+func (p *proxy) StashedRewards(adr *common.Address, valId *big.Int, block *big.Int) (*big.Int, error) {
+	return p.rpc.StashedRewards(adr, valId, block)
+}
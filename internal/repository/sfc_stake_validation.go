@@ -0,0 +1,264 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// stakeValidationWithdrawScan is the max number of pending withdraw requests inspected by withdraw validation.
+const stakeValidationWithdrawScan = 50
+
+// sfcValidatorStatusOK is the status of an active SFC validator.
+const sfcValidatorStatusOK = 0
+
+// stakeValidation collects constraint violations of a stake action.
+type stakeValidation struct {
+	act  *types.StakeAction
+	list []types.StakeValidationError
+}
+
+// fail records a violated constraint.
+func (sv *stakeValidation) fail(code string, field string, msg string, args ...interface{}) {
+	ve := types.StakeValidationError{Code: code, Message: fmt.Sprintf(msg, args...)}
+	if field != "" {
+		ve.Field = &field
+	}
+	sv.list = append(sv.list, ve)
+}
+
+// ValidateStakeAction checks the given stake action against the SFC contract constraints
+// and provides the list of violations; an empty list means the action is expected to pass.
+func (p *proxy) ValidateStakeAction(act *types.StakeAction) ([]types.StakeValidationError, error) {
+	sv := stakeValidation{act: act, list: make([]types.StakeValidationError, 0)}
+
+	// the amount is required by all the actions, except the withdraw
+	if act.Action != types.StakeActionWithdraw && act.Amount.ToInt().Sign() <= 0 {
+		sv.fail("ZERO_AMOUNT", "amount", "amount must be positive")
+	}
+
+	// the target validator must exist
+	val, err := p.Validator(&act.ValidatorID)
+	if err != nil {
+		sv.fail("VALIDATOR_NOT_FOUND", "validatorID", "validator #%d does not exist", act.ValidatorID.ToInt().Uint64())
+		return sv.list, nil
+	}
+
+	cfg, err := p.SfcConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	switch act.Action {
+	case types.StakeActionDelegate:
+		err = p.validateDelegate(&sv, val, cfg)
+	case types.StakeActionUndelegate:
+		err = p.validateUndelegate(&sv, val, cfg)
+	case types.StakeActionLock:
+		err = p.validateLock(&sv, val, cfg)
+	case types.StakeActionUnlock:
+		err = p.validateUnlock(&sv)
+	case types.StakeActionWithdraw:
+		err = p.validateWithdraw(&sv, cfg)
+	default:
+		sv.fail("UNKNOWN_ACTION", "action", "unknown stake action %s", act.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sv.list, nil
+}
+
+// validateDelegate checks a new delegation against the balance, the validator status and saturation.
+func (p *proxy) validateDelegate(sv *stakeValidation, val *types.Validator, cfg *types.SfcConfig) error {
+	if uint64(val.Status) != sfcValidatorStatusOK {
+		sv.fail("VALIDATOR_NOT_ACTIVE", "validatorID", "validator #%d is not active", val.Id.ToInt().Uint64())
+	}
+
+	// the delegator must have enough tokens
+	balance, err := p.AccountBalance(&sv.act.Address, nil)
+	if err != nil {
+		return err
+	}
+	if balance.ToInt().Cmp(sv.act.Amount.ToInt()) < 0 {
+		sv.fail("INSUFFICIENT_BALANCE", "amount", "account balance is lower than the amount")
+	}
+
+	// the validator must not be over saturated after the delegation
+	self, err := p.DelegationAmountStaked(&val.StakerAddress, &val.Id, nil)
+	if err != nil {
+		return err
+	}
+	if val.StakerAddress == sv.act.Address {
+		self = new(big.Int).Add(self, sv.act.Amount.ToInt())
+	}
+	received := new(big.Int).Add(val.TotalStake.ToInt(), sv.act.Amount.ToInt())
+	if received.Cmp(p.stakeDelegationLimit(self, cfg)) > 0 {
+		sv.fail("VALIDATOR_SATURATED", "amount", "validator #%d delegations limit would be exceeded", val.Id.ToInt().Uint64())
+	}
+	return nil
+}
+
+// validateUndelegate checks an un-delegation against the unlocked stake and the validator self stake rules.
+func (p *proxy) validateUndelegate(sv *stakeValidation, val *types.Validator, cfg *types.SfcConfig) error {
+	unlocked, err := p.DelegationAmountUnlocked(&sv.act.Address, sv.act.ValidatorID.ToInt())
+	if err != nil {
+		return err
+	}
+	if unlocked.ToInt().Cmp(sv.act.Amount.ToInt()) < 0 {
+		sv.fail("NOT_ENOUGH_UNLOCKED_STAKE", "amount", "not enough unlocked stake")
+		return nil
+	}
+
+	// only the validator self stake is limited further
+	if val.StakerAddress != sv.act.Address {
+		return nil
+	}
+
+	self, err := p.DelegationAmountStaked(&val.StakerAddress, &val.Id, nil)
+	if err != nil {
+		return err
+	}
+	self.Sub(self, sv.act.Amount.ToInt())
+	if self.Sign() == 0 {
+		return nil
+	}
+
+	if self.Cmp(cfg.MinValidatorStake.ToInt()) < 0 {
+		sv.fail("INSUFFICIENT_SELF_STAKE", "amount", "remaining self stake would be below the minimal validator stake")
+	}
+	received := new(big.Int).Sub(val.TotalStake.ToInt(), sv.act.Amount.ToInt())
+	if received.Cmp(p.stakeDelegationLimit(self, cfg)) > 0 {
+		sv.fail("VALIDATOR_SATURATED", "amount", "validator #%d delegations limit would be exceeded", val.Id.ToInt().Uint64())
+	}
+	return nil
+}
+
+// validateLock checks a stake lock against the lock bounds, the stake available and the validator lock.
+func (p *proxy) validateLock(sv *stakeValidation, val *types.Validator, cfg *types.SfcConfig) error {
+	allowed, err := p.LockingAllowed()
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		sv.fail("LOCKING_NOT_ALLOWED", "", "stake locking is not enabled")
+	}
+	if uint64(val.Status) != sfcValidatorStatusOK {
+		sv.fail("VALIDATOR_NOT_ACTIVE", "validatorID", "validator #%d is not active", val.Id.ToInt().Uint64())
+	}
+
+	// lock duration bounds
+	dur := new(big.Int).SetUint64(uint64(sv.act.Duration))
+	if dur.Cmp(cfg.MinLockupDuration.ToInt()) < 0 || dur.Cmp(cfg.MaxLockupDuration.ToInt()) > 0 {
+		sv.fail("INVALID_LOCK_DURATION", "duration", "lock duration must be between %d and %d seconds",
+			cfg.MinLockupDuration.ToInt().Uint64(), cfg.MaxLockupDuration.ToInt().Uint64())
+	}
+
+	// existing lock of the delegation
+	now := uint64(time.Now().UTC().Unix())
+	lock, err := p.DelegationLock(&sv.act.Address, &sv.act.ValidatorID, nil)
+	if err != nil {
+		return err
+	}
+	if uint64(lock.LockedUntil) > now {
+		sv.fail("ALREADY_LOCKED", "", "the delegation is already locked")
+	}
+
+	// only the stake not locked yet can be locked
+	staked, err := p.DelegationAmountStaked(&sv.act.Address, &sv.act.ValidatorID, nil)
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Sub(staked, lock.LockedAmount.ToInt()).Cmp(sv.act.Amount.ToInt()) < 0 {
+		sv.fail("NOT_ENOUGH_STAKE", "amount", "not enough stake available for locking")
+	}
+
+	// delegations can not be locked longer than the validator self stake
+	if val.StakerAddress != sv.act.Address {
+		vl, err := p.DelegationLock(&val.StakerAddress, &val.Id, nil)
+		if err != nil {
+			return err
+		}
+		if now+uint64(sv.act.Duration) > uint64(vl.LockedUntil) {
+			sv.fail("VALIDATOR_LOCK_ENDS_EARLIER", "duration", "validator #%d lockup period ends earlier", val.Id.ToInt().Uint64())
+		}
+	}
+	return nil
+}
+
+// validateUnlock checks a stake unlock against the locked stake of the delegation.
+func (p *proxy) validateUnlock(sv *stakeValidation) error {
+	lock, err := p.DelegationLock(&sv.act.Address, &sv.act.ValidatorID, nil)
+	if err != nil {
+		return err
+	}
+	if uint64(lock.LockedUntil) <= uint64(time.Now().UTC().Unix()) || lock.LockedAmount.ToInt().Sign() == 0 {
+		sv.fail("NOT_LOCKED", "", "the delegation is not locked")
+		return nil
+	}
+	if lock.LockedAmount.ToInt().Cmp(sv.act.Amount.ToInt()) < 0 {
+		sv.fail("NOT_ENOUGH_LOCKED_STAKE", "amount", "not enough locked stake")
+	}
+	return nil
+}
+
+// validateWithdraw checks there is a pending withdraw request past the withdrawal period.
+// Only the time part of the withdrawal period can be verified from the request record.
+func (p *proxy) validateWithdraw(sv *stakeValidation, cfg *types.SfcConfig) error {
+	wl, err := p.pendingWithdrawRequests(&sv.act.Address, &sv.act.ValidatorID)
+	if err != nil {
+		return err
+	}
+	if len(wl) == 0 {
+		sv.fail("NO_WITHDRAW_REQUEST", "", "no pending withdraw request found")
+		return nil
+	}
+
+	// any request ready?
+	now := uint64(time.Now().UTC().Unix())
+	for _, wr := range wl {
+		if withdrawPeriodPassed(wr, cfg, now) {
+			return nil
+		}
+	}
+	sv.fail("WITHDRAWAL_PERIOD_NOT_PASSED", "", "the withdrawal period of pending requests did not pass yet")
+	return nil
+}
+
+// pendingWithdrawRequests loads pending withdraw requests of the given delegation,
+// up to the max number of requests inspected.
+func (p *proxy) pendingWithdrawRequests(addr *common.Address, valID *hexutil.Big) ([]*types.WithdrawRequest, error) {
+	wl, err := p.db.Withdrawals(nil, stakeValidationWithdrawScan, &bson.D{
+		{Key: types.FiWithdrawalAddress, Value: addr.String()},
+		{Key: types.FiWithdrawalToValidator, Value: valID.String()},
+		{Key: types.FiWithdrawalFinTrx, Value: bson.D{{Key: "$type", Value: 10}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wl.Collection, nil
+}
+
+// withdrawPeriodPassed checks if the time part of the withdrawal period of the given request passed.
+func withdrawPeriodPassed(wr *types.WithdrawRequest, cfg *types.SfcConfig, now uint64) bool {
+	return uint64(wr.CreatedTime)+cfg.WithdrawalPeriodTime.ToInt().Uint64() <= now
+}
+
+// stakeDelegationLimit calculates the max amount of stake the validator with the given self stake can receive.
+func (p *proxy) stakeDelegationLimit(self *big.Int, cfg *types.SfcConfig) *big.Int {
+	limit := new(big.Int).Mul(self, cfg.MaxDelegatedRatio.ToInt())
+	return limit.Div(limit, p.SfcDecimalUnit())
+}
@@ -0,0 +1,139 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BuildMintSAXISTx prepares an unsigned SFC Tokenizer transaction minting sAXIS tokens
+// for the locked delegation of the given address to the given validator.
+func (p *proxy) BuildMintSAXISTx(addr *common.Address, valID *hexutil.Big) (*types.PreparedTransaction, error) {
+	// only locked stake can be tokenized
+	locked, err := p.rpc.AmountStakeLocked(addr, valID.ToInt())
+	if err != nil {
+		p.log.Errorf("can not get locked stake of %s to %d; %s", addr.String(), valID.ToInt().Uint64(), err.Error())
+		return nil, err
+	}
+
+	// the tokenizer releases the delegation only if nothing has been minted for it yet;
+	// a held delegation has some sAXIS outstanding we need to account for
+	unlocked, err := p.DelegationTokenizerUnlocked(addr, valID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := new(big.Int).Set(locked)
+	if !unlocked {
+		outstanding, err := p.DelegationOutstandingSAXIS(addr, valID)
+		if err != nil {
+			return nil, err
+		}
+		amount.Sub(amount, outstanding.ToInt())
+	}
+
+	// anything left to mint?
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("no locked stake of %s to %d available for sAXIS minting", addr.String(), valID.ToInt().Uint64())
+	}
+
+	// pack the call
+	data, err := p.rpc.MintSAXISCallData(valID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return p.prepareTransaction(addr, p.rpc.SfcTokenizerAddress(), data, amount)
+}
+
+// BuildBurnSAXISTx prepares an unsigned SFC Tokenizer transaction burning the given amount
+// of sAXIS tokens minted for the delegation of the given address to the given validator.
+func (p *proxy) BuildBurnSAXISTx(addr *common.Address, valID *hexutil.Big, amount *hexutil.Big) (*types.PreparedTransaction, error) {
+	// check the amount
+	if amount.ToInt().Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount of sAXIS to burn")
+	}
+
+	// the delegation is not locked by the tokenizer, there is nothing to burn
+	unlocked, err := p.DelegationTokenizerUnlocked(addr, valID)
+	if err != nil {
+		return nil, err
+	}
+	if unlocked {
+		return nil, fmt.Errorf("no sAXIS outstanding for delegation of %s to %d", addr.String(), valID.ToInt().Uint64())
+	}
+
+	// we can not burn more than has been minted for the delegation
+	outstanding, err := p.DelegationOutstandingSAXIS(addr, valID)
+	if err != nil {
+		return nil, err
+	}
+	if outstanding.ToInt().Cmp(amount.ToInt()) < 0 {
+		return nil, fmt.Errorf("burn amount exceeds outstanding sAXIS of %s to %d", addr.String(), valID.ToInt().Uint64())
+	}
+
+	// the delegator must hold enough sAXIS tokens
+	token := p.rpc.SAXISTokenAddress()
+	balance, err := p.rpc.Erc20BalanceOf(&token, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+	if balance.ToInt().Cmp(amount.ToInt()) < 0 {
+		return nil, fmt.Errorf("insufficient sAXIS balance of %s", addr.String())
+	}
+
+	// pack the call
+	data, err := p.rpc.RedeemSAXISCallData(valID.ToInt(), amount.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return p.prepareTransaction(addr, p.rpc.SfcTokenizerAddress(), data, amount.ToInt())
+}
+
+// prepareTransaction builds an unsigned transaction for the given sender, recipient and call data
+// with the current nonce, gas price and estimated gas.
+func (p *proxy) prepareTransaction(from *common.Address, to common.Address, data []byte, amount *big.Int) (*types.PreparedTransaction, error) {
+	// get the sender nonce
+	nonce, err := p.AccountNonce(from, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the current gas price
+	price, err := p.rpc.GasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	// estimate gas; a failed estimate means the transaction would be reverted
+	cd := hexutil.Encode(data)
+	gas, err := p.GasEstimate(&struct {
+		From  *common.Address
+		To    *common.Address
+		Value *hexutil.Big
+		Data  *string
+	}{From: from, To: &to, Data: &cd})
+	if err != nil {
+		return nil, fmt.Errorf("transaction would fail; %s", err.Error())
+	}
+
+	return &types.PreparedTransaction{
+		From:     *from,
+		To:       to,
+		Data:     data,
+		Gas:      *gas,
+		GasPrice: price,
+		Nonce:    *nonce,
+		Amount:   hexutil.Big(*amount),
+	}, nil
+}
@@ -0,0 +1,53 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"bytes"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// IsSAXISToken returns true if the given address points to the sAXIS token of the SFC Tokenizer.
+func (p *proxy) IsSAXISToken(addr *common.Address) bool {
+	return bytes.Equal(addr.Bytes(), p.cfg.Staking.TokenizedStakeToken.Bytes())
+}
+
+// TokenizerCallValidator decodes the validator of the given SFC Tokenizer mint, or redeem call;
+// nil is returned if the transaction does not call the SFC Tokenizer directly.
+func (p *proxy) TokenizerCallValidator(trx *types.Transaction) *hexutil.Big {
+	if trx == nil || trx.To == nil || !bytes.Equal(trx.To.Bytes(), p.cfg.Staking.TokenizerContract.Bytes()) {
+		return nil
+	}
+	return (*hexutil.Big)(p.rpc.SfcTokenizerCallValidator(trx.InputData))
+}
+
+// StoreTokenizerEvent stores the given SFC Tokenizer mint, or burn event in the persistent storage.
+func (p *proxy) StoreTokenizerEvent(te *types.TokenizerEvent) error {
+	return p.db.AddTokenizerEvent(te)
+}
+
+// TokenizerEvents provides the most recent sAXIS mints and burns of the given account.
+func (p *proxy) TokenizerEvents(addr *common.Address, count int32) ([]*types.TokenizerEvent, error) {
+	return p.db.TokenizerEvents(addr, int64(count))
+}
+
+// SAXISSupply provides the daily history of sAXIS tokens outstanding for the given number of days.
+func (p *proxy) SAXISSupply(days int) ([]*types.SAXISSupply, error) {
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+	return p.db.SAXISSupply(since)
+}
+
+// SAXISCollateral provides the amount of sAXIS tokens outstanding by validator.
+func (p *proxy) SAXISCollateral() ([]*types.SAXISCollateral, error) {
+	return p.db.SAXISCollateral()
+}
@@ -0,0 +1,125 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// validatorExitTimelineSize is the max number of pending un-delegations listed on a validator exit timeline.
+const validatorExitTimelineSize = 50
+
+// ValidatorExit provides the exit status of the given validator, the pending un-delegations against it
+// and the projected time they become withdrawable. The SFC counts the withdrawal period of a stake
+// from its un-delegation, or from the validator deactivation, whichever comes first.
+func (p *proxy) ValidatorExit(valID *hexutil.Big) (*types.ValidatorExit, error) {
+	val, err := p.Validator(valID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := p.SfcConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	ve := types.ValidatorExit{
+		ValidatorID:      val.Id,
+		Status:           val.Status,
+		IsDeactivated:    uint64(val.Status) != sfcValidatorStatusOK && val.DeactivatedEpoch > 0,
+		DeactivatedEpoch: val.DeactivatedEpoch,
+		DeactivatedTime:  val.DeactivatedTime,
+		Timeline:         make([]types.ValidatorExitStep, 0),
+	}
+
+	// the withdrawal period of the deactivated stake
+	if ve.IsDeactivated {
+		if err := p.validatorExitPeriod(&ve, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	// pending un-delegations
+	filter := bson.D{
+		{Key: types.FiWithdrawalToValidator, Value: valID.String()},
+		{Key: types.FiWithdrawalFinTrx, Value: bson.D{{Key: "$type", Value: 10}}},
+	}
+	count, err := p.db.WithdrawalCountFiltered(&filter)
+	if err != nil {
+		return nil, err
+	}
+	ve.PendingCount = hexutil.Uint64(count)
+	if count == 0 {
+		return &ve, nil
+	}
+
+	amount, err := p.db.WithdrawalsSumValue(&filter)
+	if err != nil {
+		return nil, err
+	}
+	ve.PendingAmount = hexutil.Big(*amount)
+
+	wl, err := p.db.Withdrawals(nil, validatorExitTimelineSize, &filter)
+	if err != nil {
+		return nil, err
+	}
+	ve.Timeline = validatorExitTimeline(&ve, wl.Collection, cfg)
+	return &ve, nil
+}
+
+// validatorExitPeriod sets the end of the withdrawal period of the deactivated validator stake.
+// Both the epochs and the time part of the period must pass.
+func (p *proxy) validatorExitPeriod(ve *types.ValidatorExit, cfg *types.SfcConfig) error {
+	epoch := hexutil.Uint64(uint64(ve.DeactivatedEpoch) + cfg.WithdrawalPeriodEpochs.ToInt().Uint64())
+	ts := hexutil.Uint64(uint64(ve.DeactivatedTime) + cfg.WithdrawalPeriodTime.ToInt().Uint64())
+	ve.WithdrawableEpoch, ve.WithdrawableTime = &epoch, &ts
+
+	current, err := p.CurrentEpoch()
+	if err != nil {
+		return err
+	}
+	ve.IsWithdrawable = current >= epoch && uint64(time.Now().UTC().Unix()) >= uint64(ts)
+	return nil
+}
+
+// validatorExitTimeline builds the list of pending un-delegations ordered by the time they become withdrawable.
+func validatorExitTimeline(ve *types.ValidatorExit, wl []*types.WithdrawRequest, cfg *types.SfcConfig) []types.ValidatorExitStep {
+	list := make([]types.ValidatorExitStep, 0, len(wl))
+	for _, wr := range wl {
+		// the deactivation of the validator starts the period early
+		start := wr.CreatedTime
+		if ve.IsDeactivated && ve.DeactivatedTime < start {
+			start = ve.DeactivatedTime
+		}
+
+		step := types.ValidatorExitStep{
+			Address:          wr.Address,
+			RequestedTime:    wr.CreatedTime,
+			WithdrawableTime: hexutil.Uint64(uint64(start) + cfg.WithdrawalPeriodTime.ToInt().Uint64()),
+		}
+		if wr.WithdrawRequestID != nil {
+			step.WithdrawRequestID = *wr.WithdrawRequestID
+		}
+		if wr.Amount != nil {
+			step.Amount = hexutil.Big(*new(big.Int).Set(wr.Amount.ToInt()))
+		}
+		list = append(list, step)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].WithdrawableTime < list[j].WithdrawableTime
+	})
+	return list
+}
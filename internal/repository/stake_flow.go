@@ -0,0 +1,189 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// stakeFlowPairWindow represents the max time between an undelegation and a delegation
+// of the same delegator to be considered a move of the stake; it covers the withdrawal period.
+const stakeFlowPairWindow = 30 * 24 * time.Hour
+
+// stakeFlowKey represents a directed pair of validators.
+type stakeFlowKey struct {
+	from uint64
+	to   uint64
+}
+
+// stakeFlowSum represents the stake moved between a pair of validators.
+type stakeFlowSum struct {
+	amount     *big.Int
+	moves      uint64
+	delegators map[string]bool
+}
+
+// AddDelegationEvent stores a delegation event used to track stake movements.
+func (p *proxy) AddDelegationEvent(ev *types.DelegationEvent) error {
+	return p.db.AddDelegationEvent(ev)
+}
+
+// StakeFlows provides the list of the largest net stake movements between validators in the given period.
+// A movement is inferred if a delegator undelegates from a validator and delegates to another one
+// within the pairing window; undelegated stake is matched to delegations in the order of time.
+func (p *proxy) StakeFlows(period string, count int32) ([]*types.StakeFlow, error) {
+	// get the period start
+	length, ok := types.CounterpartyPeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	var since, pairSince *time.Time
+	if length > 0 {
+		t := time.Now().UTC().Add(-length)
+		ps := t.Add(-stakeFlowPairWindow)
+		since, pairSince = &t, &ps
+	}
+
+	dels, err := p.db.DelegationEvents(since)
+	if err != nil {
+		return nil, err
+	}
+	unds, err := p.db.Undelegations(pairSince)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := stakeFlowsPaired(unds, dels)
+	return stakeFlowsNet(flows, int(count)), nil
+}
+
+// stakeFlowsPaired pairs undelegations with subsequent delegations of the same delegators
+// and sums the stake moved between each directed pair of validators.
+func stakeFlowsPaired(unds []*types.WithdrawRequest, dels []*types.DelegationEvent) map[stakeFlowKey]*stakeFlowSum {
+	// undelegated stake waiting for a delegation, per delegator in the order of time
+	type pending struct {
+		from   uint64
+		stamp  time.Time
+		amount *big.Int
+	}
+	queues := make(map[string][]*pending)
+	flows := make(map[stakeFlowKey]*stakeFlowSum)
+
+	ui := 0
+	for _, dl := range dels {
+		// queue undelegations made before the delegation
+		for ; ui < len(unds) && int64(unds[ui].CreatedTime) <= dl.Stamp.Unix(); ui++ {
+			adr := unds[ui].Address.String()
+			queues[adr] = append(queues[adr], &pending{
+				from:   unds[ui].StakerID.ToInt().Uint64(),
+				stamp:  time.Unix(int64(unds[ui].CreatedTime), 0),
+				amount: new(big.Int).Set(unds[ui].Amount.ToInt()),
+			})
+		}
+
+		adr := dl.Address.String()
+		amount, ok := new(big.Int).SetString(dl.Amount, 0)
+		if !ok {
+			continue
+		}
+
+		// consume the undelegated stake, oldest first; expired stake is not a move anymore
+		q := queues[adr]
+		for len(q) > 0 && amount.Sign() > 0 {
+			un := q[0]
+			if dl.Stamp.Sub(un.stamp) > stakeFlowPairWindow {
+				q = q[1:]
+				continue
+			}
+
+			moved := new(big.Int).Set(amount)
+			if un.amount.Cmp(moved) < 0 {
+				moved.Set(un.amount)
+			}
+			un.amount.Sub(un.amount, moved)
+			amount.Sub(amount, moved)
+			if un.amount.Sign() == 0 {
+				q = q[1:]
+			}
+
+			// stake returned to the same validator did not move
+			if un.from == dl.ToValidator {
+				continue
+			}
+
+			key := stakeFlowKey{from: un.from, to: dl.ToValidator}
+			fl, ok := flows[key]
+			if !ok {
+				fl = &stakeFlowSum{amount: new(big.Int), delegators: make(map[string]bool)}
+				flows[key] = fl
+			}
+			fl.amount.Add(fl.amount, moved)
+			fl.moves++
+			fl.delegators[adr] = true
+		}
+		queues[adr] = q
+	}
+	return flows
+}
+
+// stakeFlowsNet nets opposite flows of each pair of validators and provides
+// the given number of the largest net flows.
+func stakeFlowsNet(flows map[stakeFlowKey]*stakeFlowSum, count int) []*types.StakeFlow {
+	list := make([]*types.StakeFlow, 0)
+	for key, fl := range flows {
+		// each pair is processed once, from the lower validator id
+		back, ok := flows[stakeFlowKey{from: key.to, to: key.from}]
+		if ok && key.from > key.to {
+			continue
+		}
+
+		sf := types.StakeFlow{
+			FromValidator: key.from,
+			ToValidator:   key.to,
+			Amount:        new(big.Int).Set(fl.amount),
+			Moves:         fl.moves,
+		}
+		delegators := len(fl.delegators)
+		if ok {
+			sf.Amount.Sub(sf.Amount, back.amount)
+			sf.Moves += back.moves
+			for adr := range back.delegators {
+				if !fl.delegators[adr] {
+					delegators++
+				}
+			}
+		}
+		sf.Delegators = uint64(delegators)
+
+		// keep the direction of the net flow
+		if sf.Amount.Sign() < 0 {
+			sf.FromValidator, sf.ToValidator = sf.ToValidator, sf.FromValidator
+			sf.Amount.Neg(sf.Amount)
+		}
+		if sf.Amount.Sign() > 0 {
+			list = append(list, &sf)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if c := list[i].Amount.Cmp(list[j].Amount); c != 0 {
+			return c > 0
+		}
+		return list[i].FromValidator < list[j].FromValidator
+	})
+	if len(list) > count {
+		list = list[:count]
+	}
+	return list
+}
@@ -0,0 +1,72 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// OpenStatusIncident publishes a new incident of the given severity on the status page.
+func (p *proxy) OpenStatusIncident(severity string, title string, msg *string) (*types.StatusIncident, error) {
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("can not generate incident identifier; %s", err.Error())
+	}
+
+	si := types.StatusIncident{
+		ID:       hex.EncodeToString(id),
+		Severity: severity,
+		Title:    title,
+		Message:  msg,
+		Started:  time.Now().UTC(),
+	}
+	if err := p.db.StoreStatusIncident(&si); err != nil {
+		return nil, err
+	}
+	return &si, nil
+}
+
+// ResolveStatusIncident marks the status incident of the given id resolved,
+// optionally updating the message of the incident.
+func (p *proxy) ResolveStatusIncident(id string, msg *string) (*types.StatusIncident, error) {
+	si, err := p.db.StatusIncident(id)
+	if err != nil {
+		return nil, err
+	}
+	if si == nil {
+		return nil, fmt.Errorf("status incident %s not found", id)
+	}
+
+	if si.Resolved == nil {
+		now := time.Now().UTC()
+		si.Resolved = &now
+	}
+	if msg != nil {
+		si.Message = msg
+	}
+
+	if err := p.db.StoreStatusIncident(si); err != nil {
+		return nil, err
+	}
+	return si, nil
+}
+
+// StatusIncidents provides the given number of the most recent status incidents.
+func (p *proxy) StatusIncidents(count int64) ([]*types.StatusIncident, error) {
+	return p.db.StatusIncidents(count)
+}
+
+// StorageHealth checks the persistent storage is available and provides the round trip time of the check.
+func (p *proxy) StorageHealth() (time.Duration, error) {
+	return p.db.Ping()
+}
@@ -0,0 +1,97 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// taxReportMaxEntries represents the max number of reward claims included in a tax report;
+// reports of delegators with more claims in the year are flagged as truncated.
+const taxReportMaxEntries = 10000
+
+// TaxReport builds the report of staking rewards claimed, or re-staked by the delegator
+// in the given calendar year with the fiat value of the rewards at the day of the claim.
+func (p *proxy) TaxReport(addr *common.Address, year int32, currency string) (*types.TaxReport, error) {
+	// check the symbol validity
+	currency = strings.ToUpper(currency)
+	if !p.isValidPriceSymbol(currency) {
+		return nil, fmt.Errorf("unknown currency %s", currency)
+	}
+
+	// the year must have already started
+	from := time.Date(int(year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	if from.After(time.Now().UTC()) {
+		return nil, fmt.Errorf("year %d not available", year)
+	}
+
+	// load one more claim to learn if the report is complete
+	list, err := p.db.RewardClaimsInRange(addr, from, from.AddDate(1, 0, 0), taxReportMaxEntries+1)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := types.TaxReport{Address: *addr, Year: year, Currency: currency}
+	if len(list) > taxReportMaxEntries {
+		list = list[:taxReportMaxEntries]
+		rep.Truncated = true
+	}
+	rep.Entries = make([]types.TaxReportEntry, len(list))
+
+	// get the daily prices of the whole range at once; missing days are loaded one by one below
+	if len(list) > 0 {
+		first := time.Unix(int64(list[0].Claimed), 0).UTC()
+		last := time.Unix(int64(list[len(list)-1].Claimed), 0).UTC()
+		if err := p.preloadHistoricalPrices(currency, first, last); err != nil {
+			p.log.Warningf("can not preload %s price history; %s", currency, err.Error())
+		}
+	}
+
+	total := new(big.Int)
+	for i, rc := range list {
+		price, err := p.HistoricalPrice(currency, time.Unix(int64(rc.Claimed), 0).UTC())
+		if err != nil {
+			return nil, err
+		}
+
+		rep.Entries[i] = newTaxReportEntry(rc, price)
+		rep.TotalFiatValue += rep.Entries[i].FiatValue
+		total.Add(total, rc.Amount.ToInt())
+	}
+
+	rep.TotalAmount = hexutil.Big(*total)
+	return &rep, nil
+}
+
+// newTaxReportEntry creates a tax report entry of the reward claim valued by the given price.
+func newTaxReportEntry(rc *types.RewardClaim, price float64) types.TaxReportEntry {
+	typ := types.TaxReportEntryClaim
+	if rc.IsDelegated {
+		typ = types.TaxReportEntryRestake
+	}
+
+	// amount of tokens in the native units
+	amount, _ := new(big.Float).Quo(new(big.Float).SetInt(rc.Amount.ToInt()), big.NewFloat(1e18)).Float64()
+	return types.TaxReportEntry{
+		Type:        typ,
+		TimeStamp:   rc.Claimed,
+		ValidatorId: rc.ToValidatorId,
+		TrxHash:     rc.ClaimTrx,
+		Amount:      rc.Amount,
+		Price:       price,
+		FiatValue:   amount * price,
+	}
+}
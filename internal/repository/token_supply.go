@@ -0,0 +1,47 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenSupplyTransactions provides a list of mints and burns of the given ERC20 token.
+func (p *proxy) TokenSupplyTransactions(token *common.Address, cursor *string, count int32) (*types.TokenTransactionList, error) {
+	return p.db.TokenSupplyTransactions(token, cursor, count)
+}
+
+// TokenSupplyChanges provides daily amounts of the given ERC20 token minted and burned
+// in the given number of recent days.
+func (p *proxy) TokenSupplyChanges(token *common.Address, days int) ([]*types.TokenSupplyChange, error) {
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+	return p.db.TokenSupplyChanges(token, since)
+}
+
+// TokenStats provides transfer counts, unique senders and receivers and the volume
+// of the given ERC20 token in the given period of recent days, in total and by days.
+func (p *proxy) TokenStats(token *common.Address, period string) (*types.TokenStats, error) {
+	days, ok := types.TokenStatsPeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+	stats, err := p.db.TokenTransferStats(token, since)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.Period = period
+	return stats, nil
+}
@@ -0,0 +1,16 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import "encoding/json"
+
+// BlockTraces loads raw call traces of all the transactions of the given block from the node.
+func (p *proxy) BlockTraces(num uint64) ([]json.RawMessage, error) {
+	return p.rpc.BlockTraces(num)
+}
@@ -10,8 +10,10 @@ package repository
 
 import (
 	"axis-graphql/internal/repository/cache"
+	"axis-graphql/internal/repository/db"
 	"axis-graphql/internal/types"
 	"errors"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -132,6 +134,16 @@ func (p *proxy) Transactions(cursor *string, count int32) (*types.TransactionLis
 	return p.db.Transactions(cursor, count, nil)
 }
 
+// TransactionsInBlockRange pulls list of transactions included in blocks of the given range
+// starting on the specified cursor. Both range boundaries are inclusive and optional.
+func (p *proxy) TransactionsInBlockRange(from *hexutil.Uint64, to *hexutil.Uint64, cursor *string, count int32) (*types.TransactionList, error) {
+	// check the range
+	if from != nil && to != nil && *from > *to {
+		return nil, fmt.Errorf("invalid block range %d to %d", uint64(*from), uint64(*to))
+	}
+	return p.db.Transactions(cursor, count, db.TransactionBlockRangeFilter(from, to))
+}
+
 // StoreGasPricePeriod stores the given gas price period data in the persistent storage
 func (p *proxy) StoreGasPricePeriod(gp *types.GasPricePeriod) error {
 	return p.db.AddGasPricePeriod(gp)
@@ -0,0 +1,189 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/repository/rpc/contracts"
+	"axis-graphql/internal/types"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// knownAbiSources represents the list of well known contract ABIs used to decode
+// calls of contracts without a known ABI of their own.
+var knownAbiSources = []string{
+	contracts.SfcContractABI,
+	contracts.ERCTwentyABI,
+	contracts.ERC721ABI,
+	contracts.ErcWrappedFtmABI,
+	contracts.SfcTokenizerABI,
+}
+
+// knownAbis represents the parsed well known contract ABIs.
+var knownAbis struct {
+	once sync.Once
+	list []*abi.ABI
+}
+
+// DecodeTransaction decodes the given raw RLP encoded transaction without sending it
+// to the block chain. Both signed and unsigned transactions are accepted; the sender
+// is recovered from the signature of signed transactions.
+func (p *proxy) DecodeTransaction(raw hexutil.Bytes) (*types.DecodedTransaction, error) {
+	tx, err := parseRawTransaction(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw transaction; %s", err.Error())
+	}
+
+	dt := types.DecodedTransaction{
+		Type:      hexutil.Uint64(tx.Type()),
+		To:        tx.To(),
+		Nonce:     hexutil.Uint64(tx.Nonce()),
+		Gas:       hexutil.Uint64(tx.Gas()),
+		GasPrice:  hexutil.Big(*tx.GasPrice()),
+		Value:     hexutil.Big(*tx.Value()),
+		InputData: tx.Data(),
+	}
+
+	// dynamic fee transactions
+	if tx.Type() == etypes.DynamicFeeTxType {
+		dt.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		dt.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+
+	// recover the sender of signed transaction
+	v, r, s := tx.RawSignatureValues()
+	dt.Signed = r.Sign() != 0 || s.Sign() != 0
+	if dt.Signed {
+		if err := decodedTransactionSender(tx, &dt); err != nil {
+			return nil, err
+		}
+	} else if tx.Type() != etypes.LegacyTxType {
+		dt.ChainId = (*hexutil.Big)(tx.ChainId())
+	} else if v.Sign() != 0 {
+		// unsigned EIP-155 payload carries the chain id in place of V
+		dt.ChainId = (*hexutil.Big)(v)
+	}
+
+	// decode the call
+	if tx.To() != nil && len(tx.Data()) >= 4 {
+		dt.Call, err = p.decodeKnownCall(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &dt, nil
+}
+
+// decodedTransactionSender recovers the sender of the signed transaction.
+func decodedTransactionSender(tx *etypes.Transaction, dt *types.DecodedTransaction) error {
+	var signer etypes.Signer = etypes.HomesteadSigner{}
+	if tx.Protected() {
+		signer = etypes.LatestSignerForChainID(tx.ChainId())
+		dt.ChainId = (*hexutil.Big)(tx.ChainId())
+	}
+
+	from, err := etypes.Sender(signer, tx)
+	if err != nil {
+		return fmt.Errorf("can not recover transaction sender; %s", err.Error())
+	}
+
+	hash := tx.Hash()
+	dt.From = &from
+	dt.Hash = &hash
+	return nil
+}
+
+// decodeKnownCall decodes the call input of the transaction using the ABI
+// of the recipient contract, or any of the well known ABIs.
+func (p *proxy) decodeKnownCall(tx *etypes.Transaction) (*types.DecodedCall, error) {
+	dc, err := p.DecodeCall(tx.To(), tx.Data())
+	if err != nil || dc != nil {
+		return dc, err
+	}
+
+	// try the well known ABIs
+	for _, ab := range wellKnownAbis() {
+		if _, err := ab.MethodById(tx.Data()[:4]); err != nil {
+			continue
+		}
+
+		dc, err := decodeCallByAbi(ab, tx.Data())
+		if err == nil && dc != nil {
+			return dc, nil
+		}
+	}
+	return nil, nil
+}
+
+// wellKnownAbis provides the list of parsed well known contract ABIs.
+func wellKnownAbis() []*abi.ABI {
+	knownAbis.once.Do(func() {
+		for _, src := range knownAbiSources {
+			ab, err := abi.JSON(strings.NewReader(src))
+			if err != nil {
+				log.Criticalf("can not parse well known ABI; %s", err.Error())
+				continue
+			}
+			knownAbis.list = append(knownAbis.list, &ab)
+		}
+	})
+	return knownAbis.list
+}
+
+// parseRawTransaction decodes the given raw transaction. Unsigned transactions
+// are accepted as the RLP encoded payload without the signature fields.
+func parseRawTransaction(raw []byte) (*etypes.Transaction, error) {
+	tx := new(etypes.Transaction)
+	err := tx.UnmarshalBinary(raw)
+	if err == nil {
+		return tx, nil
+	}
+
+	// try to add an empty signature to unsigned payload
+	padded, perr := padUnsignedTransaction(raw)
+	if perr != nil {
+		return nil, err
+	}
+	if err := tx.UnmarshalBinary(padded); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// padUnsignedTransaction appends empty signature values to the RLP list
+// of an unsigned transaction payload, keeping the typed envelope prefix, if any.
+func padUnsignedTransaction(raw []byte) ([]byte, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty transaction")
+	}
+
+	// typed transaction envelope starts with the type byte
+	var prefix []byte
+	if raw[0] <= 0x7f {
+		prefix, raw = raw[:1], raw[1:]
+	}
+
+	var fields []rlp.RawValue
+	if err := rlp.DecodeBytes(raw, &fields); err != nil {
+		return nil, err
+	}
+	fields = append(fields, rlp.EmptyString, rlp.EmptyString, rlp.EmptyString)
+
+	enc, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, prefix...), enc...), nil
+}
@@ -0,0 +1,189 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// swapRouteMaxHops represents the max number of pairs a quoted swap route goes through.
+	swapRouteMaxHops = 3
+
+	// swapDeadline represents the validity period of the quoted router call.
+	swapDeadline = 20 * time.Minute
+
+	// uniswapFeeNumerator and uniswapFeeDenominator represent the 0.3% swap fee of Uniswap pairs.
+	uniswapFeeNumerator   = 997
+	uniswapFeeDenominator = 1000
+)
+
+// swapEdge represents a pair connecting two tokens in the direction of a swap.
+type swapEdge struct {
+	pair       common.Address
+	to         common.Address
+	reserveIn  *big.Int
+	reserveOut *big.Int
+}
+
+// swapRoute represents a candidate route of a swap.
+type swapRoute struct {
+	path      []common.Address
+	edges     []*swapEdge
+	amountOut *big.Int
+}
+
+// UniswapQuoteSwap finds the best swap route between the given tokens for the input amount
+// across known Uniswap pairs. Direct routes and routes of up to three hops are considered.
+// The router call data are encoded if the recipient is given. Nil is returned if no route exists.
+func (p *proxy) UniswapQuoteSwap(tokenIn *common.Address, tokenOut *common.Address, amountIn *big.Int, recipient *common.Address, slippage float64) (*types.SwapQuote, error) {
+	if *tokenIn == *tokenOut {
+		return nil, fmt.Errorf("input and output tokens must differ")
+	}
+	if amountIn.Sign() <= 0 {
+		return nil, fmt.Errorf("input amount must be positive")
+	}
+	if slippage < 0 || slippage >= 1 {
+		return nil, fmt.Errorf("slippage tolerance must be in range <0, 1)")
+	}
+
+	graph, err := p.swapGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	// find the route with the best output
+	var best *swapRoute
+	visited := map[common.Address]bool{*tokenIn: true}
+	swapRoutes(graph, &swapRoute{path: []common.Address{*tokenIn}, amountOut: amountIn}, *tokenOut, visited, func(r *swapRoute) {
+		if best == nil || r.amountOut.Cmp(best.amountOut) > 0 {
+			best = r
+		}
+	})
+	if best == nil || best.amountOut.Sign() == 0 {
+		return nil, nil
+	}
+	return p.swapQuote(best, amountIn, recipient, slippage)
+}
+
+// swapGraph builds the graph of tokens connected by known Uniswap pairs with non-empty reserves.
+func (p *proxy) swapGraph() (map[common.Address][]*swapEdge, error) {
+	pairs, err := p.UniswapPairs()
+	if err != nil {
+		return nil, err
+	}
+
+	graph := make(map[common.Address][]*swapEdge)
+	for i := range pairs {
+		tokens, err := p.UniswapTokens(&pairs[i])
+		if err != nil || len(tokens) != 2 {
+			continue
+		}
+
+		res, err := p.UniswapReserves(&pairs[i])
+		if err != nil || len(res) != 2 || res[0].ToInt().Sign() == 0 || res[1].ToInt().Sign() == 0 {
+			continue
+		}
+
+		graph[tokens[0]] = append(graph[tokens[0]], &swapEdge{pair: pairs[i], to: tokens[1], reserveIn: res[0].ToInt(), reserveOut: res[1].ToInt()})
+		graph[tokens[1]] = append(graph[tokens[1]], &swapEdge{pair: pairs[i], to: tokens[0], reserveIn: res[1].ToInt(), reserveOut: res[0].ToInt()})
+	}
+	return graph, nil
+}
+
+// swapRoutes walks routes from the last token of the given route to the target token
+// not visiting any token twice and reports each complete route to the callback.
+func swapRoutes(graph map[common.Address][]*swapEdge, route *swapRoute, target common.Address, visited map[common.Address]bool, found func(*swapRoute)) {
+	if len(route.edges) >= swapRouteMaxHops {
+		return
+	}
+
+	for _, e := range graph[route.path[len(route.path)-1]] {
+		if visited[e.to] {
+			continue
+		}
+
+		next := &swapRoute{
+			path:      append(append(make([]common.Address, 0, len(route.path)+1), route.path...), e.to),
+			edges:     append(append(make([]*swapEdge, 0, len(route.edges)+1), route.edges...), e),
+			amountOut: uniswapAmountOut(route.amountOut, e.reserveIn, e.reserveOut),
+		}
+		if e.to == target {
+			found(next)
+			continue
+		}
+
+		visited[e.to] = true
+		swapRoutes(graph, next, target, visited, found)
+		visited[e.to] = false
+	}
+}
+
+// uniswapAmountOut calculates the output amount of a swap in a pair with the given reserves.
+func uniswapAmountOut(amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) *big.Int {
+	in := new(big.Int).Mul(amountIn, big.NewInt(uniswapFeeNumerator))
+	num := new(big.Int).Mul(in, reserveOut)
+	den := new(big.Int).Add(new(big.Int).Mul(reserveIn, big.NewInt(uniswapFeeDenominator)), in)
+	return num.Div(num, den)
+}
+
+// swapQuote builds the quote of the given swap route.
+func (p *proxy) swapQuote(r *swapRoute, amountIn *big.Int, recipient *common.Address, slippage float64) (*types.SwapQuote, error) {
+	q := types.SwapQuote{
+		Path:        r.path,
+		Pairs:       make([]common.Address, len(r.edges)),
+		AmountIn:    hexutil.Big(*amountIn),
+		AmountOut:   hexutil.Big(*r.amountOut),
+		PriceImpact: swapPriceImpact(r, amountIn),
+		Router:      p.cfg.DeFi.Uniswap.Router,
+		Deadline:    hexutil.Uint64(time.Now().Add(swapDeadline).Unix()),
+	}
+	for i, e := range r.edges {
+		q.Pairs[i] = e.pair
+	}
+
+	// the minimal output respects the slippage tolerance; parts per million are used for the precision
+	tol := big.NewInt(int64((1 - slippage) * 1e6))
+	min := new(big.Int).Mul(r.amountOut, tol)
+	q.AmountOutMin = hexutil.Big(*min.Div(min, big.NewInt(1e6)))
+
+	// encode the router call if we know the recipient
+	if recipient != nil {
+		cd, err := p.rpc.UniswapRouterCallData("swapExactTokensForTokens",
+			amountIn, q.AmountOutMin.ToInt(), q.Path, *recipient, new(big.Int).SetUint64(uint64(q.Deadline)))
+		if err != nil {
+			return nil, err
+		}
+		q.CallData = (*hexutil.Bytes)(&cd)
+	}
+	return &q, nil
+}
+
+// swapPriceImpact calculates the relative difference between the output at the mid-price
+// of the route pairs, reduced by the swap fees, and the expected output of the route.
+func swapPriceImpact(r *swapRoute, amountIn *big.Int) float64 {
+	mid := new(big.Float).SetInt(amountIn)
+	fee := new(big.Float).Quo(big.NewFloat(uniswapFeeNumerator), big.NewFloat(uniswapFeeDenominator))
+	for _, e := range r.edges {
+		mid.Mul(mid, new(big.Float).Quo(new(big.Float).SetInt(e.reserveOut), new(big.Float).SetInt(e.reserveIn)))
+		mid.Mul(mid, fee)
+	}
+	if mid.Sign() == 0 {
+		return 0
+	}
+
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(r.amountOut), mid).Float64()
+	return 1 - ratio
+}
@@ -0,0 +1,80 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// UpgradeReadiness aggregates the stake of active validators by the node versions
+// published in their staker info metadata. If the required version is given,
+// the stake of validators running the version, or newer, is summarized, too.
+func (p *proxy) UpgradeReadiness(required *string) (*types.UpgradeReadiness, error) {
+	list, err := p.Validators()
+	if err != nil {
+		return nil, err
+	}
+
+	total := new(big.Int)
+	ready := new(big.Int)
+	versions := make(map[string]*types.NodeVersionStake)
+	for _, val := range list {
+		// only active validators are considered
+		if val.Status != 0 || val.TotalStake == nil {
+			continue
+		}
+
+		ver := p.validatorNodeVersion(&val.Id)
+		nvs, ok := versions[ver]
+		if !ok {
+			nvs = &types.NodeVersionStake{Version: ver}
+			versions[ver] = nvs
+		}
+		nvs.Validators++
+		nvs.Stake = hexutil.Big(*new(big.Int).Add(nvs.Stake.ToInt(), val.TotalStake.ToInt()))
+		total.Add(total, val.TotalStake.ToInt())
+
+		if required != nil && ver != types.NodeVersionUnknown && types.CompareNodeVersions(ver, *required) >= 0 {
+			ready.Add(ready, val.TotalStake.ToInt())
+		}
+	}
+
+	// sort the versions by stake, the largest first
+	res := &types.UpgradeReadiness{
+		TotalStake:      hexutil.Big(*total),
+		RequiredVersion: required,
+		ReadyStake:      hexutil.Big(*ready),
+		Versions:        make([]*types.NodeVersionStake, 0, len(versions)),
+	}
+	for _, nvs := range versions {
+		res.Versions = append(res.Versions, nvs)
+	}
+	sort.Slice(res.Versions, func(i, j int) bool {
+		if c := res.Versions[i].Stake.ToInt().Cmp(res.Versions[j].Stake.ToInt()); c != 0 {
+			return c > 0
+		}
+		return res.Versions[i].Version < res.Versions[j].Version
+	})
+	return res, nil
+}
+
+// validatorNodeVersion provides the node version published by the validator
+// in the cached staker info metadata.
+func (p *proxy) validatorNodeVersion(id *hexutil.Big) string {
+	info := p.cache.PullStakerInfo(id)
+	if info == nil || info.NodeVersion == nil || strings.TrimSpace(*info.NodeVersion) == "" {
+		return types.NodeVersionUnknown
+	}
+	return strings.TrimSpace(*info.NodeVersion)
+}
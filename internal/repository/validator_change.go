@@ -0,0 +1,49 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StakerInfoUrl extracts the staker information URL registered for the staker.
+func (p *proxy) StakerInfoUrl(id *hexutil.Big) (string, error) {
+	return p.rpc.StakerInfoUrl(id)
+}
+
+// ValidatorPubkey extracts the public key of the validator.
+func (p *proxy) ValidatorPubkey(id *hexutil.Big) ([]byte, error) {
+	return p.rpc.ValidatorPubkey(id.ToInt())
+}
+
+// StoreValidatorChange stores a validator management change in the persistent storage.
+// The previous value of the same kind is attached to the change, if known.
+func (p *proxy) StoreValidatorChange(vc *types.ValidatorChange) error {
+	last, err := p.db.LastValidatorChange(&vc.ValidatorId, vc.Type)
+	if err != nil {
+		return err
+	}
+
+	// link the previous value; a re-processed change keeps what it had
+	switch {
+	case last == nil:
+	case last.Pk() == vc.Pk():
+		vc.Previous = last.Previous
+	case last.OrdinalIndex() < vc.OrdinalIndex():
+		vc.Previous = &last.Value
+	}
+	return p.db.AddValidatorChange(vc)
+}
+
+// ValidatorChanges provides the most recent management changes of the validator.
+func (p *proxy) ValidatorChanges(id *hexutil.Big, count int32) ([]*types.ValidatorChange, error) {
+	return p.db.ValidatorChanges(id, int64(count))
+}
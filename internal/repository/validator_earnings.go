@@ -0,0 +1,139 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// validatorEarningsMaxEpochs represents the max number of epochs covered by a validator earnings report.
+const validatorEarningsMaxEpochs = 500
+
+// decimalUnit represents the fixed point unit used by the SFC contract.
+var decimalUnit = new(big.Int).SetUint64(1000000000000000000)
+
+// ValidatorEarnings aggregates self-stake rewards, commission earned from delegators
+// and fees originated by the given validator in the given range of sealed epochs.
+// The self-stake rewards are derived from the current self-stake of the validator.
+func (p *proxy) ValidatorEarnings(valID *hexutil.Big, from hexutil.Uint64, to hexutil.Uint64, resolution string) (*types.ValidatorEarnings, error) {
+	if from < 1 || from > to {
+		return nil, fmt.Errorf("invalid epoch range #%d to #%d", uint64(from), uint64(to))
+	}
+	if uint64(to-from) >= validatorEarningsMaxEpochs {
+		return nil, fmt.Errorf("too many epochs, at most %d epochs can be reported", validatorEarningsMaxEpochs)
+	}
+
+	// get the self-stake of the validator
+	val, err := p.rpc.Validator(valID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	self, err := p.rpc.AmountStaked(&val.StakerAddress, valID.ToInt(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the share of rewards left to delegators after the commission
+	com, err := p.rpc.ValidatorCommission()
+	if err != nil {
+		return nil, err
+	}
+	share := new(big.Int).Sub(decimalUnit, com)
+
+	// the reward per token progress starts at the end of the previous epoch
+	rptPrev, err := p.rpc.ValidatorRewardPerToken(from-1, valID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	ve := types.ValidatorEarnings{
+		ValidatorId:      *valID,
+		FromEpoch:        from,
+		ToEpoch:          to,
+		Resolution:       resolution,
+		SelfStake:        self,
+		SelfStakeRewards: new(big.Int),
+		Commission:       new(big.Int),
+		OriginatedFee:    new(big.Int),
+		Periods:          make([]*types.ValidatorEarningsPeriod, 0),
+	}
+
+	var pe *types.ValidatorEarningsPeriod
+	for id := from; id <= to; id++ {
+		rpt, err := p.rpc.ValidatorRewardPerToken(id, valID.ToInt())
+		if err != nil {
+			return nil, err
+		}
+		progress := new(big.Int).Sub(rpt, rptPrev)
+		rptPrev = rpt
+
+		ep, err := p.Epoch(&id)
+		if err != nil {
+			return nil, err
+		}
+		stake, fee, err := p.validatorEpochStakeAndFee(id, valID)
+		if err != nil {
+			return nil, err
+		}
+
+		// open a new period, if needed
+		date := time.Unix(int64(ep.EndTime), 0).UTC().Format("2006-01-02")
+		if pe == nil || resolution == types.ValidatorEarningsResolutionEpoch || pe.Date != date {
+			pe = &types.ValidatorEarningsPeriod{
+				Date:             date,
+				FromEpoch:        id,
+				SelfStakeRewards: new(big.Int),
+				Commission:       new(big.Int),
+				OriginatedFee:    new(big.Int),
+			}
+			ve.Periods = append(ve.Periods, pe)
+		}
+		pe.ToEpoch = id
+		pe.End = ep.EndTime
+
+		// rewards of the self-stake and commission restored from the rewards of all the delegators
+		selfReward := new(big.Int).Div(new(big.Int).Mul(progress, self), decimalUnit)
+		commission := new(big.Int)
+		if share.Sign() > 0 {
+			reward := new(big.Int).Div(new(big.Int).Mul(progress, stake), decimalUnit)
+			commission.Sub(new(big.Int).Div(new(big.Int).Mul(reward, decimalUnit), share), reward)
+		}
+
+		pe.SelfStakeRewards.Add(pe.SelfStakeRewards, selfReward)
+		pe.Commission.Add(pe.Commission, commission)
+		pe.OriginatedFee.Add(pe.OriginatedFee, fee)
+		ve.SelfStakeRewards.Add(ve.SelfStakeRewards, selfReward)
+		ve.Commission.Add(ve.Commission, commission)
+		ve.OriginatedFee.Add(ve.OriginatedFee, fee)
+	}
+	return &ve, nil
+}
+
+// validatorEpochStakeAndFee provides the stake received by the given validator
+// and the fees it originated in the given sealed epoch.
+func (p *proxy) validatorEpochStakeAndFee(id hexutil.Uint64, valID *hexutil.Big) (*big.Int, *big.Int, error) {
+	list, err := p.EpochValidators(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, ev := range list {
+		if ev.ValidatorId.ToInt().Cmp(valID.ToInt()) == 0 {
+			return ev.ReceivedStake.ToInt(), ev.OriginatedFee.ToInt(), nil
+		}
+	}
+
+	// the validator was not a member of the epoch validator set
+	return new(big.Int), new(big.Int), nil
+}
@@ -0,0 +1,89 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidatorEstimate projects the epoch income of a prospective validator with the given self-stake
+// and the expected amount of received delegations. The reward rate and the total stake of the latest
+// sealed epoch are used, the base rewards are assumed to be split by the stake with full uptime.
+func (p *proxy) ValidatorEstimate(self *big.Int, delegation *big.Int) (*types.ValidatorEstimate, error) {
+	if self.Sign() < 0 || delegation.Sign() < 0 {
+		return nil, fmt.Errorf("stake amounts can not be negative")
+	}
+
+	cfg, err := p.SfcConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	com, err := p.rpc.ValidatorCommission()
+	if err != nil {
+		return nil, err
+	}
+
+	// get the reward rate and the duration of the latest sealed epoch
+	ep, err := p.CurrentSealedEpoch()
+	if err != nil {
+		return nil, err
+	}
+	duration := hexutil.Uint64(0)
+	if ep.Id > 1 {
+		id := ep.Id - 1
+		prev, err := p.Epoch(&id)
+		if err != nil {
+			return nil, err
+		}
+		if ep.EndTime > prev.EndTime {
+			duration = ep.EndTime - prev.EndTime
+		}
+	}
+
+	ve := types.ValidatorEstimate{
+		Epoch:               ep.Id,
+		EpochDuration:       duration,
+		SelfStake:           self,
+		ExpectedDelegation:  delegation,
+		MinSelfStake:        cfg.MinValidatorStake.ToInt(),
+		CommissionRate:      com,
+		EffectiveDelegation: new(big.Int).Set(delegation),
+		SelfStakeRewards:    new(big.Int),
+		Commission:          new(big.Int),
+	}
+
+	// the total stake received, including the self-stake, is limited by the max delegated ratio
+	ve.DelegationLimit = p.stakeDelegationLimit(self, cfg)
+	if room := new(big.Int).Sub(ve.DelegationLimit, self); ve.EffectiveDelegation.Cmp(room) > 0 {
+		if room.Sign() < 0 {
+			room.SetUint64(0)
+		}
+		ve.EffectiveDelegation = room
+	}
+
+	// the stake of the new validator joins the current total stake
+	stake := new(big.Int).Add(self, ve.EffectiveDelegation)
+	total := new(big.Int).Add(ep.StakeTotalAmount.ToInt(), stake)
+	if stake.Sign() == 0 || duration == 0 {
+		return &ve, nil
+	}
+
+	// rewards of the validator stake in the epoch
+	pool := new(big.Int).Mul(ep.BaseRewardPerSecond.ToInt(), new(big.Int).SetUint64(uint64(duration)))
+	pool.Div(pool.Mul(pool, stake), total)
+
+	ve.SelfStakeRewards.Div(new(big.Int).Mul(pool, self), stake)
+	ve.Commission.Div(new(big.Int).Mul(pool, ve.EffectiveDelegation), stake)
+	ve.Commission.Div(ve.Commission.Mul(ve.Commission, com), decimalUnit)
+	return &ve, nil
+}
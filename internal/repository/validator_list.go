@@ -0,0 +1,193 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// validatorList represents the in-memory list of validators kept up to date
+// by delta updates of validators changed since the last read.
+type validatorList struct {
+	lock sync.Mutex
+
+	// list is the current snapshot ordered by the validator ID;
+	// a published snapshot is never modified, changes create a new one
+	list []*types.Validator
+
+	// dirty is the set of validators changed since the snapshot was made
+	dirty map[uint64]bool
+}
+
+// Validators provides a consistent snapshot of the list of all validators ordered by the validator ID.
+// The list is kept in memory; only validators changed since the last read are re-loaded from the SFC contract.
+func (p *proxy) Validators() ([]*types.Validator, error) {
+	p.validators.lock.Lock()
+	list, changed := p.validators.list, len(p.validators.dirty) > 0
+	p.validators.lock.Unlock()
+
+	if list != nil && !changed {
+		return list, nil
+	}
+
+	// sync the list only once for parallel requests
+	val, err, _ := p.apiRequestGroup.Do("validators", func() (interface{}, error) {
+		return p.syncValidators()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.([]*types.Validator), nil
+}
+
+// InvalidateValidator marks the validator as changed so it's re-loaded on the next read of the validators list.
+// The invalidation is shared with other API replicas over the cache bus.
+func (p *proxy) InvalidateValidator(id *hexutil.Big) {
+	p.invalidateValidator(id)
+	p.broadcastInvalidation(cacheBusValidator, id.ToInt().String())
+}
+
+// invalidateValidator marks the validator as changed in the validators list of this replica.
+func (p *proxy) invalidateValidator(id *hexutil.Big) {
+	p.validators.lock.Lock()
+	p.validators.markDirty(id.ToInt().Uint64())
+	p.validators.lock.Unlock()
+}
+
+// syncValidators applies changes of validators to the in-memory list and publishes a new snapshot.
+func (p *proxy) syncValidators() ([]*types.Validator, error) {
+	// take over the pending changes
+	p.validators.lock.Lock()
+	list, dirty := p.validators.list, p.validators.dirty
+	p.validators.dirty = nil
+	p.validators.lock.Unlock()
+
+	var err error
+	var failed []uint64
+	if list == nil {
+		list, failed, err = p.loadValidators()
+	} else {
+		list, failed = p.updateValidators(list, dirty)
+	}
+
+	// keep the changes for the next attempt on failure
+	if err != nil {
+		p.validators.lock.Lock()
+		for id := range dirty {
+			p.validators.markDirty(id)
+		}
+		p.validators.lock.Unlock()
+		return nil, err
+	}
+
+	// validators we failed to load are re-tried on the next read
+	p.validators.lock.Lock()
+	for _, id := range failed {
+		p.validators.markDirty(id)
+	}
+	p.validators.list = list
+	p.validators.lock.Unlock()
+	return list, nil
+}
+
+// loadValidators loads the full list of validators from the SFC contract.
+// The IDs of validators which failed to load are returned along with the list.
+func (p *proxy) loadValidators() ([]*types.Validator, []uint64, error) {
+	top, err := p.rpc.LastValidatorId()
+	if err != nil {
+		p.log.Errorf("can not get the highest validator id; %s", err.Error())
+		return nil, nil, err
+	}
+
+	list := make([]*types.Validator, 0, top)
+	failed := make([]uint64, 0)
+	for i := uint64(1); i <= top; i++ {
+		val, err := p.rpc.Validator(new(big.Int).SetUint64(i))
+		if err != nil {
+			p.log.Criticalf("can not load validator #%d; %s", i, err.Error())
+			failed = append(failed, i)
+			continue
+		}
+
+		// validator not valid?
+		if val.Id.ToInt().Uint64() == 0 {
+			p.log.Debugf("validator #%d has invalid ID", i)
+			continue
+		}
+		list = append(list, val)
+	}
+
+	p.log.Debugf("%d validators loaded, %d failed", len(list), len(failed))
+	return list, failed, nil
+}
+
+// updateValidators creates a new snapshot of the list with the given validators re-loaded.
+// Validators not on the list yet are added. Validators which failed to load keep their previous
+// state in the snapshot; their IDs are returned along with the list.
+func (p *proxy) updateValidators(list []*types.Validator, dirty map[uint64]bool) ([]*types.Validator, []uint64) {
+	res, failed := mergeValidators(list, dirty, func(id uint64) (*types.Validator, error) {
+		val, err := p.rpc.Validator(new(big.Int).SetUint64(id))
+		if err != nil {
+			p.log.Errorf("can not re-load validator #%d; %s", id, err.Error())
+		}
+		return val, err
+	})
+
+	p.log.Debugf("%d validators updated, %d failed", len(dirty)-len(failed), len(failed))
+	return res, failed
+}
+
+// mergeValidators creates a new snapshot of the list with the given validators re-loaded
+// by the given loader. Validators which failed to load are kept as they are and their IDs are returned.
+func mergeValidators(list []*types.Validator, dirty map[uint64]bool, load func(uint64) (*types.Validator, error)) ([]*types.Validator, []uint64) {
+	// index the current snapshot
+	idx := make(map[uint64]*types.Validator, len(list)+len(dirty))
+	for _, val := range list {
+		idx[val.Id.ToInt().Uint64()] = val
+	}
+
+	// re-load the changed validators
+	failed := make([]uint64, 0)
+	for id := range dirty {
+		val, err := load(id)
+		if err != nil {
+			failed = append(failed, id)
+			continue
+		}
+
+		// validator not valid?
+		if val.Id.ToInt().Uint64() == 0 {
+			delete(idx, id)
+			continue
+		}
+		idx[id] = val
+	}
+
+	res := make([]*types.Validator, 0, len(idx))
+	for _, val := range idx {
+		res = append(res, val)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Id.ToInt().Cmp(res[j].Id.ToInt()) < 0
+	})
+	return res, failed
+}
+
+// markDirty adds the validator to the set of changed validators; the caller holds the lock.
+func (vl *validatorList) markDirty(id uint64) {
+	if vl.dirty == nil {
+		vl.dirty = make(map[uint64]bool)
+	}
+	vl.dirty[id] = true
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+)
+
+// testValidator creates a validator of the given ID and status.
+func testValidator(id uint64, status uint64) *types.Validator {
+	return &types.Validator{Id: hexutil.Big(*new(big.Int).SetUint64(id)), Status: hexutil.Uint64(status)}
+}
+
+func TestMergeValidatorsPartialFailure(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	list := []*types.Validator{testValidator(1, 0), testValidator(2, 0), testValidator(3, 0)}
+	dirty := map[uint64]bool{1: true, 2: true, 3: true, 4: true}
+
+	res, failed := mergeValidators(list, dirty, func(id uint64) (*types.Validator, error) {
+		switch id {
+		case 2:
+			return nil, fmt.Errorf("node timeout")
+		case 3:
+			// removed validator
+			return testValidator(0, 0), nil
+		}
+		return testValidator(id, 1), nil
+	})
+
+	// the failed validator keeps its previous state, the others are updated
+	g.Expect(failed).To(gomega.Equal([]uint64{2}))
+	g.Expect(res).To(gomega.HaveLen(3))
+	g.Expect(res[0]).To(gomega.Equal(testValidator(1, 1)))
+	g.Expect(res[1]).To(gomega.BeIdenticalTo(list[1]))
+	g.Expect(res[2]).To(gomega.Equal(testValidator(4, 1)))
+}
@@ -0,0 +1,41 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"axis-graphql/internal/types"
+	"time"
+)
+
+// StoreWebhookDelivery stores, or updates, the given webhook delivery log record.
+func (p *proxy) StoreWebhookDelivery(wd *types.WebhookDelivery) error {
+	return p.db.StoreWebhookDelivery(wd)
+}
+
+// WebhookDeliveries loads the most recently updated webhook delivery log records
+// optionally filtered by the delivery status.
+func (p *proxy) WebhookDeliveries(status *string, count int32) ([]*types.WebhookDelivery, error) {
+	return p.db.WebhookDeliveries(status, count)
+}
+
+// WebhookDeliveriesDue loads the oldest webhook deliveries waiting for the first attempt,
+// or scheduled for a retry at, or before the given time.
+func (p *proxy) WebhookDeliveriesDue(until time.Time, count int32) ([]*types.WebhookDelivery, error) {
+	return p.db.WebhookDeliveriesDue(until, count)
+}
+
+// ClaimWebhookDelivery atomically postpones the next attempt of the given due webhook delivery
+// to the given lease end so other API server instances sharing the database skip it.
+func (p *proxy) ClaimWebhookDelivery(wd *types.WebhookDelivery, lease time.Time) (bool, error) {
+	ok, err := p.db.ClaimWebhookDelivery(wd, lease)
+	if err == nil && ok {
+		wd.NextAttempt = &lease
+	}
+	return ok, err
+}
@@ -0,0 +1,70 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fmt"
+	"time"
+)
+
+// accountExportJanitorInterval represents the interval of account exports maintenance.
+const accountExportJanitorInterval = time.Minute
+
+// accountExportJanitor represents a service picking up account exports abandoned
+// by their workers and removing files of expired account exports.
+type accountExportJanitor struct {
+	service
+	ticker *time.Ticker
+}
+
+// name returns the name of the service used by orchestrator.
+func (aj *accountExportJanitor) name() string {
+	return "account export janitor"
+}
+
+// init prepares the account export janitor.
+func (aj *accountExportJanitor) init() {
+	aj.sigStop = make(chan bool, 1)
+}
+
+// run starts the account export janitor.
+func (aj *accountExportJanitor) run() {
+	// make sure we are orchestrated
+	if aj.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", aj.name()))
+	}
+
+	// signal orchestrator we started and go
+	aj.mgr.started(aj)
+	go aj.execute()
+}
+
+// close terminates the account export janitor.
+func (aj *accountExportJanitor) close() {
+	if aj.ticker != nil {
+		aj.ticker.Stop()
+	}
+	if aj.sigStop != nil {
+		aj.sigStop <- true
+	}
+}
+
+// execute runs the scheduled maintenance of account exports.
+func (aj *accountExportJanitor) execute() {
+	defer func() {
+		close(aj.sigStop)
+		aj.mgr.finished(aj)
+	}()
+
+	// pick up exports abandoned before the restart right away
+	repo.AccountExportsMaintain()
+
+	aj.ticker = time.NewTicker(accountExportJanitorInterval)
+	for {
+		select {
+		case <-aj.sigStop:
+			return
+		case <-aj.ticker.C:
+			repo.AccountExportsMaintain()
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// dlgOriginBatchSize represents the number of delegations verified in a single batch.
+	dlgOriginBatchSize = 100
+
+	// dlgOriginInterval represents the delay between checks for delegations with unknown origin;
+	// delegations created ad-hoc by later events on the stake are picked up this way.
+	dlgOriginInterval = 10 * time.Minute
+)
+
+// dlgOriginBackfill represents a service locating the original delegation events
+// of delegations created from later events on the stake, e.g. delegations predating
+// the API deployment, so their creation time reflects the real age of the stake.
+type dlgOriginBackfill struct {
+	service
+	ticker *time.Ticker
+}
+
+// name returns the name of the service used by orchestrator.
+func (dob *dlgOriginBackfill) name() string {
+	return "delegation origin backfill"
+}
+
+// init prepares the delegation origin backfill.
+func (dob *dlgOriginBackfill) init() {
+	dob.sigStop = make(chan bool, 1)
+}
+
+// run starts the delegation origin backfill.
+func (dob *dlgOriginBackfill) run() {
+	// make sure we are orchestrated
+	if dob.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", dob.name()))
+	}
+
+	// signal orchestrator we started and go
+	dob.mgr.started(dob)
+	go dob.execute()
+}
+
+// close terminates the delegation origin backfill.
+func (dob *dlgOriginBackfill) close() {
+	if dob.ticker != nil {
+		dob.ticker.Stop()
+	}
+	if dob.sigStop != nil {
+		dob.sigStop <- true
+	}
+}
+
+// execute runs the backfill on start and periodically after that.
+func (dob *dlgOriginBackfill) execute() {
+	defer func() {
+		close(dob.sigStop)
+		dob.mgr.finished(dob)
+	}()
+
+	dob.ticker = time.NewTicker(dlgOriginInterval)
+	for {
+		if !dob.backfill() {
+			return
+		}
+
+		select {
+		case <-dob.sigStop:
+			return
+		case <-dob.ticker.C:
+		}
+	}
+}
+
+// backfill processes batches of delegations with unknown origin until none remain.
+// It returns false if the service has been requested to stop.
+func (dob *dlgOriginBackfill) backfill() bool {
+	var done int
+	for {
+		list, err := repo.DelegationsWithoutOrigin(dlgOriginBatchSize)
+		if err != nil {
+			log.Errorf("can not load delegations without origin; %s", err.Error())
+			return true
+		}
+		if len(list) == 0 {
+			if done > 0 {
+				log.Noticef("origin of %d delegations backfilled", done)
+			}
+			return true
+		}
+
+		for _, dl := range list {
+			select {
+			case <-dob.sigStop:
+				return false
+			default:
+			}
+
+			// the node may be unavailable; retry on the next round
+			if err := repo.BackfillDelegationOrigin(dl); err != nil {
+				log.Errorf("can not backfill origin of delegation %s to #%d; %s", dl.Address.String(), dl.ToStakerId.ToInt().Uint64(), err.Error())
+				return true
+			}
+			done++
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// balanceAlertEvent represents the name of the webhook event sent on balance threshold crossing.
+const balanceAlertEvent = "account.balance"
+
+// balanceTouchQueueCapacity represents the capacity of the queue of touched balances.
+const balanceTouchQueueCapacity = 5000
+
+// balanceTouch represents a balance of an account touched by a processed block.
+type balanceTouch struct {
+	addr  common.Address
+	token *common.Address
+	block uint64
+}
+
+// balanceMonitor represents a service watching native and ERC20 token balances
+// of accounts with registered alerts. The balance is checked each time the account
+// is touched by a transaction, or a token transfer; the alert webhook is notified
+// when the balance crosses any of the alert thresholds.
+type balanceMonitor struct {
+	service
+	cfg     *config.BalanceAlerts
+	ticker  *time.Ticker
+	inTouch chan *balanceTouch
+	alerts  map[string][]*types.BalanceAlert
+}
+
+// balanceAlertKey builds the key of the watched balance of the given address and token.
+func balanceAlertKey(addr string, token *string) string {
+	if token == nil {
+		return strings.ToLower(addr)
+	}
+	return strings.ToLower(addr + "/" + *token)
+}
+
+// name returns the name of the service used by orchestrator.
+func (bm *balanceMonitor) name() string {
+	return "balance monitor"
+}
+
+// init prepares the balance monitor to perform its function.
+func (bm *balanceMonitor) init() {
+	bm.sigStop = make(chan bool, 1)
+	bm.inTouch = make(chan *balanceTouch, balanceTouchQueueCapacity)
+	bm.alerts = make(map[string][]*types.BalanceAlert)
+}
+
+// run starts the balance monitor.
+func (bm *balanceMonitor) run() {
+	// make sure we are orchestrated
+	if bm.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", bm.name()))
+	}
+
+	// signal orchestrator we started and go
+	bm.mgr.started(bm)
+	go bm.execute()
+}
+
+// close terminates the balance monitor.
+func (bm *balanceMonitor) close() {
+	if bm.ticker != nil {
+		bm.ticker.Stop()
+	}
+	if bm.sigStop != nil {
+		bm.sigStop <- true
+	}
+}
+
+// touch queues a check of the given balance; the touch is dropped if the monitor is congested.
+func (bm *balanceMonitor) touch(addr common.Address, token *common.Address, block uint64) {
+	select {
+	case bm.inTouch <- &balanceTouch{addr: addr, token: token, block: block}:
+	default:
+		log.Warningf("balance check of %s skipped, queue full", addr.String())
+	}
+}
+
+// execute runs the balance checks of touched accounts.
+func (bm *balanceMonitor) execute() {
+	defer func() {
+		close(bm.sigStop)
+		bm.mgr.finished(bm)
+	}()
+
+	bm.reload()
+	bm.ticker = time.NewTicker(bm.cfg.Refresh)
+	for {
+		select {
+		case <-bm.sigStop:
+			return
+		case <-bm.ticker.C:
+			bm.reload()
+		case bt := <-bm.inTouch:
+			bm.check(bt)
+		}
+	}
+}
+
+// reload refreshes the registered alerts so new and removed alerts are picked up.
+func (bm *balanceMonitor) reload() {
+	list, err := repo.BalanceAlerts(nil)
+	if err != nil {
+		log.Errorf("can not load balance alerts; %s", err.Error())
+		return
+	}
+
+	alerts := make(map[string][]*types.BalanceAlert)
+	for _, ba := range list {
+		key := balanceAlertKey(ba.Address, ba.Token)
+		alerts[key] = append(alerts[key], ba)
+	}
+	bm.alerts = alerts
+}
+
+// check verifies the touched balance against all the alerts registered for it.
+func (bm *balanceMonitor) check(bt *balanceTouch) {
+	var tok *string
+	if bt.token != nil {
+		t := bt.token.String()
+		tok = &t
+	}
+
+	alerts, ok := bm.alerts[balanceAlertKey(bt.addr.String(), tok)]
+	if !ok {
+		return
+	}
+
+	// the balance is loaded once for all the alerts
+	bal, err := repo.AccountTokenBalance(&bt.addr, bt.token)
+	if err != nil {
+		log.Errorf("can not check balance of %s; %s", bt.addr.String(), err.Error())
+		return
+	}
+
+	for _, ba := range alerts {
+		bm.checkAlert(ba, bal, bt)
+	}
+}
+
+// checkAlert verifies if the given balance crossed any of the thresholds of the alert
+// and notifies about the crossing.
+func (bm *balanceMonitor) checkAlert(ba *types.BalanceAlert, bal *big.Int, bt *balanceTouch) {
+	min := decodeBalanceThreshold(ba.Min)
+	max := decodeBalanceThreshold(ba.Max)
+
+	state := types.BalanceState(bal, min, max)
+	if state == ba.State {
+		return
+	}
+
+	// update the alert state
+	now := time.Now().UTC()
+	ba.State = state
+	ba.Balance = hexutil.EncodeBig(bal)
+	ba.Triggered = &now
+	if err := repo.StoreBalanceAlert(ba); err != nil {
+		log.Errorf("can not update balance alert %s; %s", ba.ID, err.Error())
+	}
+
+	ev := types.BalanceAlertEvent{
+		AlertID:     ba.ID,
+		Owner:       ba.Owner,
+		Address:     bt.addr,
+		Token:       bt.token,
+		Balance:     hexutil.Big(*bal),
+		Min:         (*hexutil.Big)(min),
+		Max:         (*hexutil.Big)(max),
+		State:       types.BalanceAlertStateName(state),
+		BlockNumber: hexutil.Uint64(bt.block),
+		Stamp:       now.Unix(),
+	}
+	log.Infof("balance of %s crossed alert %s thresholds, now %s", ba.Address, ba.ID, ev.State)
+
+	if err := bm.mgr.whd.dispatch(ba.Webhook, balanceAlertEvent, ev); err != nil {
+		log.Errorf("can not send balance alert %s; %s", ba.ID, err.Error())
+	}
+}
+
+// decodeBalanceThreshold decodes the hex encoded threshold of a balance alert, if any.
+func decodeBalanceThreshold(val *string) *big.Int {
+	if val == nil {
+		return nil
+	}
+
+	v, err := hexutil.DecodeBig(*val)
+	if err != nil {
+		log.Errorf("invalid balance alert threshold %s; %s", *val, err.Error())
+		return nil
+	}
+	return v
+}
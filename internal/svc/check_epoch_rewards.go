@@ -0,0 +1,170 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// integrityCheckEpochRewards represents the name of the epoch rewards integrity check.
+const integrityCheckEpochRewards = "epoch rewards"
+
+// integrityCheckDelegationRewards represents the name of the delegation rewards recomputation check.
+const integrityCheckDelegationRewards = "delegation rewards"
+
+// ercMaxEpochsPerRun represents the max number of epochs checked in a single run
+// so the check does not put too much load on the node.
+const ercMaxEpochsPerRun = 25
+
+// epochRewardsChecker represents a service comparing rewards distributed on sealed epochs
+// with the rewards expected from the epoch fee and base reward per second.
+type epochRewardsChecker struct {
+	service
+	cfg     *config.Integrity
+	il      *integrityLog
+	ticker  *time.Ticker
+	last    uint64
+	txShare *big.Float
+}
+
+// name returns the name of the service used by orchestrator.
+func (erc *epochRewardsChecker) name() string {
+	return "epoch rewards checker"
+}
+
+// init prepares the epoch rewards checker.
+func (erc *epochRewardsChecker) init() {
+	erc.sigStop = make(chan bool, 1)
+	erc.txShare = big.NewFloat(erc.cfg.TxRewardShare)
+}
+
+// run starts the epoch rewards checker.
+func (erc *epochRewardsChecker) run() {
+	// make sure we are orchestrated
+	if erc.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", erc.name()))
+	}
+
+	// signal orchestrator we started and go
+	erc.mgr.started(erc)
+	go erc.execute()
+}
+
+// close terminates the epoch rewards checker.
+func (erc *epochRewardsChecker) close() {
+	if erc.ticker != nil {
+		erc.ticker.Stop()
+	}
+	if erc.sigStop != nil {
+		erc.sigStop <- true
+	}
+}
+
+// execute runs the scheduled epoch rewards checks.
+func (erc *epochRewardsChecker) execute() {
+	defer func() {
+		close(erc.sigStop)
+		erc.mgr.finished(erc)
+	}()
+
+	erc.ticker = time.NewTicker(erc.cfg.Interval)
+	for {
+		select {
+		case <-erc.sigStop:
+			return
+		case <-erc.ticker.C:
+			erc.checkSealed()
+		}
+	}
+}
+
+// checkSealed checks sealed epochs not verified yet.
+func (erc *epochRewardsChecker) checkSealed() {
+	sealed, err := repo.CurrentSealedEpoch()
+	if err != nil {
+		log.Errorf("can not get sealed epoch for rewards check; %s", err.Error())
+		return
+	}
+
+	// start with the configured depth on the first run;
+	// the first epoch has no predecessor to be checked against
+	top := uint64(sealed.Id)
+	if erc.last == 0 {
+		erc.last = 1
+		if top > erc.cfg.Depth+1 {
+			erc.last = top - erc.cfg.Depth
+		}
+	}
+
+	// do not overload the node, the rest will be done next time
+	for i := 0; i < ercMaxEpochsPerRun && erc.last < top; i++ {
+		next := erc.last + 1
+		if err := erc.check(next); err != nil {
+			log.Errorf("can not check rewards of epoch #%d; %s", next, err.Error())
+			return
+		}
+
+		erc.last = next
+		metrics.Gauge("integrity/epoch/last").Update(int64(next))
+	}
+}
+
+// check verifies the distributed rewards of the given epoch.
+func (erc *epochRewardsChecker) check(id uint64) error {
+	ep, err := repo.Epoch((*hexutil.Uint64)(&id))
+	if err != nil {
+		return err
+	}
+
+	pid := id - 1
+	prev, err := repo.Epoch((*hexutil.Uint64)(&pid))
+	if err != nil {
+		return err
+	}
+
+	// expected rewards = base reward for the epoch duration + share of the epoch fee
+	duration := new(big.Int).SetUint64(uint64(ep.EndTime) - uint64(prev.EndTime))
+	expected := new(big.Int).Mul(ep.BaseRewardPerSecond.ToInt(), duration)
+	txReward, _ := new(big.Float).Mul(new(big.Float).SetInt(ep.EpochFee.ToInt()), erc.txShare).Int(nil)
+	expected.Add(expected, txReward)
+
+	// rewards distributed
+	actual, err := repo.EpochRewardsDistributed(hexutil.Uint64(id))
+	if err != nil {
+		return err
+	}
+
+	// within the tolerance?
+	if erc.withinTolerance(expected, actual) {
+		erc.il.passed(integrityCheckEpochRewards)
+		return nil
+	}
+
+	log.Warningf("epoch #%d rewards mismatch; expected %s, distributed %s", id, expected.String(), actual.String())
+	metrics.Counter("integrity/epoch/discrepancies").Inc(1)
+	erc.il.failed(types.IntegrityIssue{
+		Check:    integrityCheckEpochRewards,
+		Subject:  fmt.Sprintf("epoch #%d", id),
+		Expected: expected.String(),
+		Actual:   actual.String(),
+		Detail:   fmt.Sprintf("fee %s, base reward per second %s, duration %ds", ep.EpochFee.ToInt().String(), ep.BaseRewardPerSecond.ToInt().String(), duration.Uint64()),
+	})
+	return nil
+}
+
+// withinTolerance checks if the actual value is within the configured tolerance of the expected value.
+func (erc *epochRewardsChecker) withinTolerance(expected *big.Int, actual *big.Int) bool {
+	if expected.Sign() == 0 {
+		return actual.Sign() == 0
+	}
+
+	diff := new(big.Float).SetInt(new(big.Int).Abs(new(big.Int).Sub(expected, actual)))
+	ratio, _ := new(big.Float).Quo(diff, new(big.Float).SetInt(expected)).Float64()
+	return ratio <= erc.cfg.Tolerance
+}
@@ -0,0 +1,249 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// integrityCheckIndex represents the name of the index sampling integrity check.
+const integrityCheckIndex = "index sample"
+
+// isSafeDistance represents the number of the most recent indexed blocks excluded from sampling
+// since they may still be in the processing queue.
+const isSafeDistance = 256
+
+// isMaxRepairRange represents the max number of consecutive blocks re-indexed on a single mismatch.
+const isMaxRepairRange = 100
+
+// indexSampler represents a service comparing randomly picked indexed blocks
+// with the node and re-indexing blocks with discrepancies found.
+type indexSampler struct {
+	service
+	cfg    *config.Integrity
+	il     *integrityLog
+	ticker *time.Ticker
+	rnd    *rand.Rand
+}
+
+// name returns the name of the service used by orchestrator.
+func (is *indexSampler) name() string {
+	return "index sampler"
+}
+
+// init prepares the index sampler.
+func (is *indexSampler) init() {
+	is.sigStop = make(chan bool, 1)
+	is.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// run starts the index sampler.
+func (is *indexSampler) run() {
+	// make sure we are orchestrated
+	if is.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", is.name()))
+	}
+
+	// signal orchestrator we started and go
+	is.mgr.started(is)
+	go is.execute()
+}
+
+// close terminates the index sampler.
+func (is *indexSampler) close() {
+	if is.ticker != nil {
+		is.ticker.Stop()
+	}
+	if is.sigStop != nil {
+		is.sigStop <- true
+	}
+}
+
+// execute runs the scheduled index sampling.
+func (is *indexSampler) execute() {
+	defer func() {
+		close(is.sigStop)
+		is.mgr.finished(is)
+	}()
+
+	is.ticker = time.NewTicker(is.cfg.SampleInterval)
+	for {
+		select {
+		case <-is.sigStop:
+			return
+		case <-is.ticker.C:
+			if !is.sample() {
+				return
+			}
+		}
+	}
+}
+
+// sample checks randomly picked indexed blocks and repairs those with discrepancies.
+// It returns false if the service received the terminate signal.
+func (is *indexSampler) sample() bool {
+	top, err := repo.LastKnownBlock()
+	if err != nil {
+		log.Errorf("can not get last known block for index sampling; %s", err.Error())
+		return true
+	}
+	if top <= isSafeDistance {
+		return true
+	}
+	top -= isSafeDistance
+
+	for i := 0; i < is.cfg.SampleSize; i++ {
+		num := uint64(is.rnd.Int63n(int64(top))) + 1
+
+		issue, err := is.check(num)
+		if err != nil {
+			log.Errorf("can not check indexed block #%d; %s", num, err.Error())
+			continue
+		}
+
+		metrics.Gauge("integrity/index/last").Update(int64(num))
+		if issue == nil {
+			is.il.passed(integrityCheckIndex)
+			continue
+		}
+
+		log.Warningf("indexed block #%d mismatch; %s", num, issue.Detail)
+		metrics.Counter("integrity/index/discrepancies").Inc(1)
+		is.il.failed(*issue)
+
+		if !is.repair(num, top) {
+			return false
+		}
+	}
+	return true
+}
+
+// check compares the indexed transactions of the given block with the block
+// and transaction receipts provided by the node. An issue is returned on mismatch.
+func (is *indexSampler) check(num uint64) (*types.IntegrityIssue, error) {
+	blk, err := repo.BlockByNumber((*hexutil.Uint64)(&num))
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := repo.IndexedBlockTransactions(num)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions count
+	if len(list) != len(blk.Txs) {
+		return indexIssue(num, "transactions count", fmt.Sprintf("%d", len(blk.Txs)), fmt.Sprintf("%d", len(list))), nil
+	}
+
+	known := make(map[common.Hash]bool, len(blk.Txs))
+	for _, th := range blk.Txs {
+		known[*th] = true
+	}
+
+	for _, trx := range list {
+		if !known[trx.Hash] {
+			return indexIssue(num, "unexpected transaction", "", trx.Hash.String()), nil
+		}
+		if trx.BlockHash == nil || *trx.BlockHash != blk.Hash {
+			return indexIssue(num, fmt.Sprintf("block hash of %s", trx.Hash.String()), blk.Hash.String(), hashString(trx.BlockHash)), nil
+		}
+
+		// compare the receipt
+		rc, err := repo.LoadTransaction(&trx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if uint64Value(rc.Status) != uint64Value(trx.Status) {
+			return indexIssue(num, fmt.Sprintf("status of %s", trx.Hash.String()), fmt.Sprintf("%d", uint64Value(rc.Status)), fmt.Sprintf("%d", uint64Value(trx.Status))), nil
+		}
+		if uint64Value(rc.GasUsed) != uint64Value(trx.GasUsed) {
+			return indexIssue(num, fmt.Sprintf("gas used by %s", trx.Hash.String()), fmt.Sprintf("%d", uint64Value(rc.GasUsed)), fmt.Sprintf("%d", uint64Value(trx.GasUsed))), nil
+		}
+	}
+	return nil, nil
+}
+
+// repair re-indexes the block with discrepancies and the following blocks
+// until a block matching the node is found. It returns false if the service
+// received the terminate signal.
+func (is *indexSampler) repair(num uint64, top uint64) bool {
+	for i := 0; i < isMaxRepairRange && num <= top; i++ {
+		// the first block is known to be broken, check the others
+		if i > 0 {
+			issue, err := is.check(num)
+			if err != nil {
+				log.Errorf("can not check indexed block #%d; %s", num, err.Error())
+				return true
+			}
+			if issue == nil {
+				return true
+			}
+			is.il.failed(*issue)
+		}
+
+		if !is.reindex(num) {
+			return false
+		}
+		metrics.Counter("integrity/index/repaired").Inc(1)
+		num++
+	}
+	return true
+}
+
+// reindex removes the indexed transactions of the block and sends the block
+// to the block dispatcher to be indexed again.
+func (is *indexSampler) reindex(num uint64) bool {
+	blk, err := repo.BlockByNumber((*hexutil.Uint64)(&num))
+	if err != nil {
+		log.Errorf("block #%d not available for re-indexing; %s", num, err.Error())
+		return true
+	}
+
+	if _, err := repo.RemoveIndexedBlock(num); err != nil {
+		log.Errorf("can not remove indexed block #%d; %s", num, err.Error())
+		return true
+	}
+
+	select {
+	case is.mgr.bld.inRepair <- blk:
+	case <-is.sigStop:
+		is.sigStop <- true
+		return false
+	}
+	return true
+}
+
+// indexIssue builds an index integrity issue of the given block.
+func indexIssue(num uint64, detail string, expected string, actual string) *types.IntegrityIssue {
+	return &types.IntegrityIssue{
+		Check:    integrityCheckIndex,
+		Subject:  fmt.Sprintf("block #%d", num),
+		Expected: expected,
+		Actual:   actual,
+		Detail:   detail,
+	}
+}
+
+// hashString provides the string representation of an optional hash.
+func hashString(h *common.Hash) string {
+	if h == nil {
+		return ""
+	}
+	return h.String()
+}
+
+// uint64Value provides the value of an optional number, zero if not available.
+func uint64Value(v *hexutil.Uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+	return uint64(*v)
+}
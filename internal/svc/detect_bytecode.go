@@ -0,0 +1,97 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+)
+
+// opPush1 and opPush32 represent the range of EVM push op codes; the data of the push follows the op code.
+const (
+	opPush1  = 0x60
+	opPush32 = 0x7f
+)
+
+// selectorSet represents a set of function selectors found in a contract byte code.
+type selectorSet map[[4]byte]bool
+
+// tokenSelectors lists function selectors a token contract byte code must contain
+// to be recognized as an implementation of the token standard. The list is ordered
+// so the more specific standards are checked first.
+var tokenSelectors = []struct {
+	accountType string
+	selectors   [][4]byte
+}{
+	{accountType: types.AccountTypeERC1155Contract, selectors: [][4]byte{
+		{0x00, 0xfd, 0xd5, 0x8e}, // balanceOf(address,uint256)
+		{0x4e, 0x12, 0x73, 0xf4}, // balanceOfBatch(address[],uint256[])
+		{0xf2, 0x42, 0x43, 0x2a}, // safeTransferFrom(address,address,uint256,uint256,bytes)
+		{0x2e, 0xb2, 0xc2, 0xd6}, // safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)
+		{0xa2, 0x2c, 0xb4, 0x65}, // setApprovalForAll(address,bool)
+	}},
+	{accountType: types.AccountTypeERC721Contract, selectors: [][4]byte{
+		{0x70, 0xa0, 0x82, 0x31}, // balanceOf(address)
+		{0x63, 0x52, 0x21, 0x1e}, // ownerOf(uint256)
+		{0x42, 0x84, 0x2e, 0x0e}, // safeTransferFrom(address,address,uint256)
+		{0x23, 0xb8, 0x72, 0xdd}, // transferFrom(address,address,uint256)
+		{0x08, 0x18, 0x12, 0xfc}, // getApproved(uint256)
+		{0xa2, 0x2c, 0xb4, 0x65}, // setApprovalForAll(address,bool)
+	}},
+	{accountType: types.AccountTypeERC20Token, selectors: [][4]byte{
+		{0x18, 0x16, 0x0d, 0xdd}, // totalSupply()
+		{0x70, 0xa0, 0x82, 0x31}, // balanceOf(address)
+		{0xa9, 0x05, 0x9c, 0xbb}, // transfer(address,uint256)
+		{0x23, 0xb8, 0x72, 0xdd}, // transferFrom(address,address,uint256)
+		{0x09, 0x5e, 0xa7, 0xb3}, // approve(address,uint256)
+		{0xdd, 0x62, 0xed, 0x3e}, // allowance(address,address)
+	}},
+}
+
+// codeSelectors collects candidates for function selectors from the given contract byte code.
+// Compilers compare the call selector with constants pushed to the stack by the dispatcher;
+// selectors with leading zero bytes are pushed by shorter push op codes.
+func codeSelectors(code []byte) selectorSet {
+	set := make(selectorSet)
+	for i := 0; i < len(code); i++ {
+		op := code[i]
+		if op < opPush1 || op > opPush32 {
+			continue
+		}
+
+		size := int(op-opPush1) + 1
+		if size <= 4 && i+size < len(code) {
+			var sel [4]byte
+			copy(sel[4-size:], code[i+1:i+1+size])
+			set[sel] = true
+		}
+
+		// skip the pushed data so it's not decoded as op codes
+		i += size
+	}
+	return set
+}
+
+// tokenStandardOf provides the account type of the token standard implemented
+// by the given contract byte code, or an empty string if no standard was recognized.
+func tokenStandardOf(code []byte) string {
+	if len(code) == 0 {
+		return ""
+	}
+
+	set := codeSelectors(code)
+	for _, ts := range tokenSelectors {
+		if set.containsAll(ts.selectors) {
+			return ts.accountType
+		}
+	}
+	return ""
+}
+
+// containsAll checks if all the given selectors are in the set.
+func (ss selectorSet) containsAll(list [][4]byte) bool {
+	for _, sel := range list {
+		if !ss[sel] {
+			return false
+		}
+	}
+	return true
+}
@@ -84,6 +84,14 @@ func (acd *accDispatcher) process(acc *eventAcc) error {
 	// log what we do
 	log.Debugf("account %s received for processing", acc.addr.String())
 
+	// count the transaction in the daily activity of the account
+	if err := repo.AccountActivityMark(acc.addr, uint64(acc.blk.TimeStamp), acc.trx.Uid()); err != nil {
+		log.Errorf("can not mark daily activity of %s; %s", acc.addr.String(), err.Error())
+	}
+
+	// the native balance of the account may have changed
+	acd.mgr.balanceTouched(*acc.addr, nil, uint64(acc.blk.Number))
+
 	// check if the account is new; if we already know it, we are done
 	if repo.AccountIsKnown(acc.addr) {
 		return repo.AccountMarkActivity(acc.addr, uint64(acc.blk.TimeStamp))
@@ -113,6 +121,7 @@ func (acd *accDispatcher) wallet(acc *eventAcc) error {
 		Address:      *acc.addr,
 		ContractTx:   acc.deploy,
 		Type:         acc.act,
+		FirstSeen:    acc.blk.TimeStamp,
 		LastActivity: acc.blk.TimeStamp,
 		TrxCounter:   1,
 	})
@@ -193,6 +202,12 @@ func (acd *accDispatcher) detectContract(addr *common.Address, block *types.Bloc
 		return contract, types.AccountTypeERC20Token, nil
 	}
 
+	// the byte code tells which standard the contract implements even if it doesn't answer
+	// the probes above, e.g. a token without ERC-165 support, or the optional name and symbol
+	if contract, accountType := acd.detectByCode(addr, block, trx); contract != nil {
+		return contract, accountType, nil
+	}
+
 	// log that the detection failed
 	log.Noticef("unknown contract at %s", addr.String())
 
@@ -200,6 +215,36 @@ func (acd *accDispatcher) detectContract(addr *common.Address, block *types.Bloc
 	return types.NewGenericContract(addr, block, trx), types.AccountTypeContract, nil
 }
 
+// detectByCode identifies token contracts by function selectors found in the contract byte code.
+func (acd *accDispatcher) detectByCode(addr *common.Address, block *types.Block, trx *types.Transaction) (*types.Contract, string) {
+	code, err := repo.AccountCode(addr)
+	if err != nil {
+		log.Errorf("can not analyze code of contract %s; %s", addr.String(), err.Error())
+		return nil, ""
+	}
+
+	switch tokenStandardOf(code) {
+	case types.AccountTypeERC1155Contract:
+		log.Noticef("ERC1155 multi-token detected by code at %s", addr.String())
+		return types.NewErcTokenContract(addr, "", block, trx, types.AccountTypeERC1155Contract, contracts.ERC1155MetaData.ABI), types.AccountTypeERC1155Contract
+
+	case types.AccountTypeERC721Contract:
+		name, _ := repo.Erc20Name(addr)
+		log.Noticef("ERC721 NFT token detected by code at %s", addr.String())
+		return types.NewErcTokenContract(addr, name, block, trx, types.AccountTypeERC721Contract, contracts.ERC721MetaData.ABI), types.AccountTypeERC721Contract
+
+	case types.AccountTypeERC20Token:
+		// the token must be functional, the name is optional
+		if _, err := repo.Erc20TotalSupply(addr); err != nil {
+			return nil, ""
+		}
+		name, _ := repo.Erc20Name(addr)
+		log.Noticef("ERC20 token detected by code at %s", addr.String())
+		return types.NewErcTokenContract(addr, name, block, trx, types.AccountTypeERC20Token, contracts.ERCTwentyMetaData.ABI), types.AccountTypeERC20Token
+	}
+	return nil, ""
+}
+
 // detectErc20Token identifies ERC20 token contracts by trying to call specific contract methods.
 func (acd *accDispatcher) detectErc20Token(addr *common.Address) (isErc20 bool, name string) {
 	// try to get the token name
@@ -214,7 +259,7 @@ func (acd *accDispatcher) detectErc20Token(addr *common.Address) (isErc20 bool,
 	}
 
 	// try to detect balance of
-	if _, err := repo.Erc20BalanceOf(addr, &testAddress); err != nil {
+	if _, err := repo.Erc20BalanceOf(addr, &testAddress, nil); err != nil {
 		return false, ""
 	}
 
@@ -12,6 +12,9 @@ import (
 // trxBufferCapacity is the number of new packed transactions kept in the trx channel.
 const trxBufferCapacity = 50000
 
+// repairBufferCapacity is the number of blocks waiting for repeated indexing.
+const repairBufferCapacity = 500
+
 // eventTrx represents a packed transaction event
 // sent between block dispatcher and transaction dispatcher
 type eventTrx struct {
@@ -24,8 +27,10 @@ type blockDispatcher struct {
 	service
 	onBlock        chan *types.Block
 	inBlock        chan *types.Block
+	inRepair       chan *types.Block
 	outTransaction chan *eventTrx
 	outDispatched  chan uint64
+	verifier       *headerVerifier
 }
 
 // name returns the name of the service used by orchestrator.
@@ -38,6 +43,12 @@ func (bld *blockDispatcher) init() {
 	bld.sigStop = make(chan bool, 1)
 	bld.outTransaction = make(chan *eventTrx, trxBufferCapacity)
 	bld.outDispatched = make(chan uint64, blsBlockBufferCapacity)
+	bld.inRepair = make(chan *types.Block, repairBufferCapacity)
+
+	// verify received headers, if enabled
+	if cfg.Lachesis.VerifyHeaders {
+		bld.verifier = newHeaderVerifier(bld.mgr.integrity)
+	}
 }
 
 // run starts the block dispatcher
@@ -93,6 +104,11 @@ func (bld *blockDispatcher) execute() {
 
 			// add the block to the ring
 			repo.CacheBlock(blk)
+
+		case blk := <-bld.inRepair:
+			// repaired blocks are indexed again, but not broadcast as new
+			log.Noticef("re-indexing block #%d", uint64(blk.Number))
+			bld.process(blk)
 		}
 	}
 }
@@ -100,6 +116,15 @@ func (bld *blockDispatcher) execute() {
 // process the given block by loading its content and sending block transactions
 // into the trx dispatcher. Observe terminate signal.
 func (bld *blockDispatcher) process(blk *types.Block) bool {
+	// inconsistent blocks are not indexed, nor counted as dispatched,
+	// so the block scanner does not move past them
+	if bld.verifier != nil {
+		if err := bld.verifier.verify(blk); err != nil {
+			bld.verifier.rejected(blk, err, bld)
+			return false
+		}
+	}
+
 	// dispatched block number is used by the block scanner
 	// to keep track of the work done vs. work pending
 	select {
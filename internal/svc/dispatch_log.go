@@ -84,12 +84,30 @@ func (lgd *logDispatcher) init() {
 		/* SFC3::Withdrawn(address indexed delegator, uint256 indexed toValidatorID, uint256 indexed wrID, uint256 amount) */
 		common.HexToHash("0x75e161b3e824b114fc1a33274bd7091918dd4e639cede50b78b15a4eea956a21"): handleSfcWithdrawn,
 
+		/* SFC3::LockedUpStake(address indexed delegator, uint256 indexed validatorID, uint256 duration, uint256 amount) */
+		common.HexToHash("0x138940e95abffcd789b497bf6188bba3afa5fbd22fb5c42c2f6018d1bf0f4e78"): handleSfcLockChanged,
+
+		/* SFC3::UnlockedStake(address indexed delegator, uint256 indexed validatorID, uint256 amount, uint256 penalty) */
+		common.HexToHash("0xef6c0c14fe9aa51af36acd791464dec3badbde668b63189b47bfa4e25be9b2b9"): handleSfcLockChanged,
+
 		/* SFC3:: ClaimedRewards(address indexed delegator, uint256 indexed toValidatorID, uint256 lockupExtraReward, uint256 lockupBaseReward, uint256 unlockedReward) */
 		common.HexToHash("0xc1d8eb6e444b89fb8ff0991c19311c070df704ccb009e210d1462d5b2410bf45"): handleSfcClaimedRewards,
 
 		/* SFC3::RestakedRewards(address indexed delegator, uint256 indexed toValidatorID, uint256 lockupExtraReward, uint256 lockupBaseReward, uint256 unlockedReward) */
 		common.HexToHash("0x4119153d17a36f9597d40e3ab4148d03261a439dddbec4e91799ab7159608e26"): handleSfcRestakeRewards,
 
+		/* SFC3::CreatedValidator(uint256 indexed validatorID, address indexed auth, uint256 createdEpoch, uint256 createdTime) */
+		common.HexToHash("0x49bca1ed2666922f9f1690c26a569e1299c2a715fe57647d77e81adfabbf25bf"): handleSfcCreatedValidator,
+
+		/* SFC3::ChangedValidatorStatus(uint256 indexed validatorID, uint256 status) */
+		common.HexToHash("0xcd35267e7654194727477d6c78b541a553483cff7f92a055d17868d3da6e953e"): handleSfcChangedValidatorStatus,
+
+		/* SFC3::DeactivatedValidator(uint256 indexed validatorID, uint256 deactivatedEpoch, uint256 deactivatedTime) */
+		common.HexToHash("0xac4801c32a6067ff757446524ee4e7a373797278ac3c883eac5c693b4ad72e47"): handleSfcDeactivatedValidator,
+
+		/* STI::InfoUpdated(uint256 stakerID) */
+		common.HexToHash("0x3a668b70276c6b5af986be90ab9921c67bbef483987bb44cd5145c4984e59f24"): handleStiInfoUpdated,
+
 		/* ---------------- ERC20 and ERC721 contracts related event hooks below this line ---------------- */
 
 		/* ERC20::Approval(address indexed owner, address indexed spender, uint256 value) */
@@ -104,6 +122,14 @@ func (lgd *logDispatcher) init() {
 		/* ERC1155::TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values) */
 		common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"): handleErc1155TransferBatch,
 
+		/* ------------------- EIP-1967 proxy contracts related event hooks below this line ------------------ */
+
+		/* EIP1967Proxy::Upgraded(address indexed implementation) */
+		common.HexToHash("0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b"): handleProxyUpgraded,
+
+		/* EIP1967Proxy::BeaconUpgraded(address indexed beacon) */
+		common.HexToHash("0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e"): handleProxyBeaconUpgraded,
+
 		/* --------------------- Uniswap contract related event hooks below this line --------------------- */
 
 		/* UniswapPair::Swap(address indexed sender, uint256 amount0In, uint256 amount1In, uint256 amount0Out, uint256 amount1Out, address indexed to) */
@@ -134,6 +160,36 @@ func (lgd *logDispatcher) init() {
 
 		/* FantomMintRewardManager::RewardPaid(address indexed user, uint256 reward) */
 		common.HexToHash("0xe2403640ba68fed3a2f88b7557551d1993f84b99bb10ff833f0cf8db0c5e0486"): handleFMintReward,
+
+		/* FantomMintAddressProvider::MinterChanged(address newAddress) */
+		common.HexToHash("0xb6b8f1859c5c352e5ffad07d0f77e384ac725512c015bd3a3ffc885831c8a425"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::CollateralPoolChanged(address newAddress) */
+		common.HexToHash("0x9ee268d502b2200c71bbd3cba8222b4f501a7b505c684bd40423fd446bb29fad"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::DebtPoolChanged(address newAddress) */
+		common.HexToHash("0xf10b554a663200a2ae53269b5aeb591082984e03e47f76ec558f283c01b116d4"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::PriceOracleChanged(address newAddress) */
+		common.HexToHash("0xb36d86785c7d32b1ad714bb705e00e93eccc37b8cf47549043e61e10908ad251"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::RewardDistributionChanged(address newAddress) */
+		common.HexToHash("0xfe09426f22c44354b62f360c333309adadd6392ae248adc902f3006c7c4b9205"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::RewardTokenChanged(address newAddress) */
+		common.HexToHash("0xb74d956cf6ec7842d08ebf0ab19ec03a88c1efd4a50ea4349d30f9c4ce512e98"): handleFMintAddressChange,
+
+		/* FantomMintAddressProvider::TokenRegistryChanged(address newAddress) */
+		common.HexToHash("0xb6f925ec7d36d613e5d1aa87c0de3ee16a0167e6bdfa2ea254e5fee9870a941e"): handleFMintAddressChange,
+
+		/* FantomMint::MintFeeChanged(uint256 fee4) */
+		common.HexToHash("0xe427e272b122e738fd867ac5defcedb2bc9362341166a49d793d8b230f75670c"): handleFMintConfigChange,
+
+		/* FantomMint::CollateralLowestDebtRatioChanged(uint256 ratio4) */
+		common.HexToHash("0x03b166133cc99dd16eff1cc93a1a34996f2710564ca9563fe1ddd539293f3e68"): handleFMintConfigChange,
+
+		/* FantomMint::RewardEligibilityRatioChanged(uint256 ratio4) */
+		common.HexToHash("0x3ec85924f12f4be0739c4a0a45218af5f95364180a8e6650aedaad068db44b79"): handleFMintConfigChange,
 	}
 }
 
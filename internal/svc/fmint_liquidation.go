@@ -0,0 +1,160 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// collateralAlertEvent represents the name of the webhook event sent on collateral ratio threshold crossing.
+const collateralAlertEvent = "fmint.collateral"
+
+// collateralRatio represents the collateral ratio of an fMint account loaded in a single check round.
+type collateralRatio struct {
+	ratio4 *int64
+	err    error
+}
+
+// liquidationMonitor represents a service watching collateral to debt ratio
+// of fMint accounts with registered alerts. The ratio changes with oracle price updates;
+// each time it crosses the threshold of an alert, subscribers and the alert webhook are notified.
+type liquidationMonitor struct {
+	service
+	cfg     *config.Collateral
+	ticker  *time.Ticker
+	onAlert chan *types.CollateralAlertEvent
+}
+
+// name returns the name of the service used by orchestrator.
+func (lm *liquidationMonitor) name() string {
+	return "fMint liquidation monitor"
+}
+
+// init prepares the liquidation monitor to perform its function.
+func (lm *liquidationMonitor) init() {
+	lm.sigStop = make(chan bool, 1)
+}
+
+// run starts the liquidation monitor.
+func (lm *liquidationMonitor) run() {
+	// make sure we are orchestrated
+	if lm.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", lm.name()))
+	}
+
+	// signal orchestrator we started and go
+	lm.mgr.started(lm)
+	go lm.execute()
+}
+
+// close terminates the liquidation monitor.
+func (lm *liquidationMonitor) close() {
+	if lm.ticker != nil {
+		lm.ticker.Stop()
+	}
+	if lm.sigStop != nil {
+		lm.sigStop <- true
+	}
+}
+
+// execute runs the scheduled collateral ratio checks.
+func (lm *liquidationMonitor) execute() {
+	defer func() {
+		close(lm.sigStop)
+		lm.mgr.finished(lm)
+	}()
+
+	lm.ticker = time.NewTicker(lm.cfg.Interval)
+	for {
+		select {
+		case <-lm.sigStop:
+			return
+		case <-lm.ticker.C:
+			lm.check()
+		}
+	}
+}
+
+// check verifies collateral ratio of all the accounts with registered alerts.
+func (lm *liquidationMonitor) check() {
+	alerts, err := repo.CollateralAlerts(nil)
+	if err != nil {
+		log.Errorf("can not load collateral alerts; %s", err.Error())
+		return
+	}
+
+	// get the minimal collateral ratio so we can flag accounts in liquidation risk
+	var min int64
+	ds, err := repo.DefiConfiguration()
+	if err == nil && ds.MinCollateralRatio4.ToInt().IsInt64() {
+		min = ds.MinCollateralRatio4.ToInt().Int64()
+	}
+
+	// each account is loaded only once per round
+	ratios := make(map[string]*collateralRatio)
+	for _, ca := range alerts {
+		cr, ok := ratios[ca.Address]
+		if !ok {
+			adr := common.HexToAddress(ca.Address)
+			r, err := repo.FMintCollateralRatio(&adr)
+			cr = &collateralRatio{ratio4: r, err: err}
+			ratios[ca.Address] = cr
+		}
+
+		if cr.err != nil {
+			log.Errorf("can not check collateral ratio of %s; %s", ca.Address, cr.err.Error())
+			continue
+		}
+		lm.checkAlert(ca, cr.ratio4, min)
+	}
+}
+
+// checkAlert verifies if the given ratio crossed the threshold of the alert
+// and notifies about the crossing.
+func (lm *liquidationMonitor) checkAlert(ca *types.CollateralAlert, ratio4 *int64, min int64) {
+	below := ratio4 != nil && *ratio4 < ca.Threshold4
+	if below == ca.Below {
+		return
+	}
+
+	// update the alert state
+	now := time.Now().UTC()
+	ca.Below = below
+	ca.Ratio4 = ratio4
+	ca.Triggered = &now
+	if err := repo.StoreCollateralAlert(ca); err != nil {
+		log.Errorf("can not update collateral alert %s; %s", ca.ID, err.Error())
+	}
+
+	ev := types.CollateralAlertEvent{
+		AlertID:      ca.ID,
+		Owner:        ca.Owner,
+		Address:      common.HexToAddress(ca.Address),
+		Threshold4:   ca.Threshold4,
+		Ratio4:       ratio4,
+		Below:        below,
+		BelowMinimum: ratio4 != nil && *ratio4 < min,
+		Stamp:        now.Unix(),
+	}
+	log.Infof("collateral ratio of %s crossed alert %s threshold %d", ca.Address, ca.ID, ca.Threshold4)
+
+	// notify subscribers; we don't block the monitor if the broadcast is congested
+	if lm.onAlert != nil {
+		select {
+		case lm.onAlert <- &ev:
+		default:
+			log.Warningf("collateral alert %s broadcast skipped, channel full", ca.ID)
+		}
+	}
+
+	// send the webhook, if any
+	if ca.Webhook != nil {
+		if err := lm.mgr.whd.dispatch(*ca.Webhook, collateralAlertEvent, ev); err != nil {
+			log.Errorf("can not send collateral alert %s; %s", ca.ID, err.Error())
+		}
+	}
+}
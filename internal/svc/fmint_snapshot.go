@@ -0,0 +1,95 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// fMintSnapshotter represents a service taking periodic snapshots of open fMint positions,
+// so users can chart the health of their positions over time. The snapshots are taken
+// each interval, or on each sealed epoch checked for by the interval, if configured.
+type fMintSnapshotter struct {
+	service
+	cfg       *config.DeFiFMint
+	ticker    *time.Ticker
+	lastEpoch *hexutil.Uint64
+}
+
+// name returns the name of the service used by orchestrator.
+func (fs *fMintSnapshotter) name() string {
+	return "fMint position snapshotter"
+}
+
+// init prepares the fMint position snapshotter to perform its function.
+func (fs *fMintSnapshotter) init() {
+	fs.sigStop = make(chan bool, 1)
+}
+
+// run starts the fMint position snapshotter.
+func (fs *fMintSnapshotter) run() {
+	// make sure we are orchestrated
+	if fs.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", fs.name()))
+	}
+
+	// signal orchestrator we started and go
+	fs.mgr.started(fs)
+	go fs.execute()
+}
+
+// close terminates the fMint position snapshotter.
+func (fs *fMintSnapshotter) close() {
+	if fs.ticker != nil {
+		fs.ticker.Stop()
+	}
+	if fs.sigStop != nil {
+		fs.sigStop <- true
+	}
+}
+
+// execute runs the scheduled snapshots.
+func (fs *fMintSnapshotter) execute() {
+	defer func() {
+		close(fs.sigStop)
+		fs.mgr.finished(fs)
+	}()
+
+	fs.ticker = time.NewTicker(fs.cfg.SnapshotInterval)
+	for {
+		select {
+		case <-fs.sigStop:
+			return
+		case <-fs.ticker.C:
+			fs.snapshot()
+		}
+	}
+}
+
+// snapshot captures the open positions, if a new slot started. Time slots are aligned
+// to the interval, so API server instances sharing the database capture the same slots.
+func (fs *fMintSnapshotter) snapshot() {
+	slot := fmt.Sprintf("t%d", time.Now().UTC().Truncate(fs.cfg.SnapshotInterval).Unix())
+	if fs.cfg.SnapshotOnEpoch {
+		ep, err := repo.CurrentSealedEpoch()
+		if err != nil || ep == nil {
+			log.Errorf("sealed epoch not available for fMint snapshot")
+			return
+		}
+		if fs.lastEpoch != nil && *fs.lastEpoch == ep.Id {
+			return
+		}
+		fs.lastEpoch = &ep.Id
+		slot = fmt.Sprintf("e%d", uint64(ep.Id))
+	}
+
+	count, err := repo.SnapshotFMintPositions(slot)
+	if err != nil {
+		log.Errorf("can not snapshot fMint positions; %s", err.Error())
+		return
+	}
+	log.Infof("%d fMint positions captured in snapshot %s", count, slot)
+}
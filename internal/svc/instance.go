@@ -17,6 +17,9 @@ var cfg *config.Config
 // log represents the logger to be used by services to report state.
 var log logger.Logger
 
+// logObserver represents the logger to be used by services observing the blockchain.
+var logObserver logger.Logger
+
 // manager represents a singleton instance of the service manager.
 var manager *ServiceManager
 
@@ -35,6 +38,11 @@ func SetLogger(l logger.Logger) {
 	log = l
 }
 
+// SetObserverLogger sets the logger to be used by the blockchain observing services.
+func SetObserverLogger(l logger.Logger) {
+	logObserver = l
+}
+
 // Manager provides access to the singleton instance of the SVC manager.
 func Manager() *ServiceManager {
 	// make sure to instantiate the Repository only once
@@ -0,0 +1,87 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+	"sync"
+	"time"
+)
+
+// integrityIssuesCapacity represents the number of the most recent integrity issues we keep.
+const integrityIssuesCapacity = 500
+
+// integrityLog collects results of the data integrity checks.
+type integrityLog struct {
+	mu     sync.Mutex
+	checks map[string]*types.IntegrityCheckState
+	order  []string
+	issues []types.IntegrityIssue
+}
+
+// newIntegrityLog creates a new empty integrity log.
+func newIntegrityLog() *integrityLog {
+	return &integrityLog{
+		checks: make(map[string]*types.IntegrityCheckState),
+		order:  make([]string, 0),
+		issues: make([]types.IntegrityIssue, 0),
+	}
+}
+
+// state provides the state record of the given check; the caller must hold the lock.
+func (il *integrityLog) state(check string) *types.IntegrityCheckState {
+	st, ok := il.checks[check]
+	if !ok {
+		st = &types.IntegrityCheckState{Name: check}
+		il.checks[check] = st
+		il.order = append(il.order, check)
+	}
+	return st
+}
+
+// passed records a successful run of the given check.
+func (il *integrityLog) passed(check string) {
+	il.mu.Lock()
+	defer il.mu.Unlock()
+
+	st := il.state(check)
+	st.LastRun = time.Now().UTC()
+	st.Checked++
+}
+
+// failed records a failed run of the given check along with the issue found.
+func (il *integrityLog) failed(issue types.IntegrityIssue) {
+	il.mu.Lock()
+	defer il.mu.Unlock()
+
+	st := il.state(issue.Check)
+	st.LastRun = time.Now().UTC()
+	st.Checked++
+	st.Failed++
+
+	// keep only the most recent issues
+	issue.Detected = st.LastRun
+	il.issues = append(il.issues, issue)
+	if len(il.issues) > integrityIssuesCapacity {
+		il.issues = il.issues[len(il.issues)-integrityIssuesCapacity:]
+	}
+}
+
+// report provides a snapshot of the integrity checks state.
+func (il *integrityLog) report() *types.IntegrityReport {
+	il.mu.Lock()
+	defer il.mu.Unlock()
+
+	rep := types.IntegrityReport{
+		Checks: make([]types.IntegrityCheckState, len(il.order)),
+		Issues: make([]types.IntegrityIssue, len(il.issues)),
+	}
+	for i, name := range il.order {
+		rep.Checks[i] = *il.checks[name]
+	}
+
+	// the most recent issue goes first
+	for i, is := range il.issues {
+		rep.Issues[len(il.issues)-1-i] = is
+	}
+	return &rep
+}
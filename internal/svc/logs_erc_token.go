@@ -36,7 +36,19 @@ func handleErcTransaction(lr *types.LogRecord, trxType int32) {
 		to := common.BytesToAddress(lr.Topics[2].Bytes())
 		amount := new(big.Int).SetBytes(lr.Data[:])
 		tokenId := big.NewInt(0)
-		storeTokenTransaction(lr, types.AccountTypeERC20Token, tokenTrxType(trxType, from, to), from, to, *amount, *tokenId, 0)
+		tt := tokenTrxType(trxType, from, to)
+		storeTokenTransaction(lr, types.AccountTypeERC20Token, tt, from, to, *amount, *tokenId, 0)
+
+		// sAXIS mints and burns are tracked as SFC Tokenizer events
+		if (tt == types.TokenTrxTypeMint || tt == types.TokenTrxTypeBurn) && repo.IsSAXISToken(&lr.Address) {
+			storeTokenizerEvent(lr, tt, from, to, amount)
+		}
+
+		// token balances of both sides changed on transfer
+		if trxType == types.TokenTrxTypeTransfer {
+			manager.balanceTouched(from, &lr.Address, uint64(lr.BlockNumber))
+			manager.balanceTouched(to, &lr.Address, uint64(lr.BlockNumber))
+		}
 		return
 	}
 
@@ -82,18 +94,20 @@ func handleErc1155TransferBatch(lr *types.LogRecord) {
 		}
 		for i := range ids {
 			log.Infof("ERC1155 storing TransferBatch - trx %s - len %d", lr.TxHash.String(), len(ids))
-			storeTokenTransaction(lr, types.AccountTypeERC1155Contract, types.TokenTrxTypeTransfer, from, to, *values[i], *ids[i], uint16(i))
+			storeTokenTransaction(lr, types.AccountTypeERC1155Contract, tokenTrxType(types.TokenTrxTypeTransfer, from, to), from, to, *values[i], *ids[i], uint16(i))
 		}
 		return
 	}
 	log.Debugf("Unrecognized ERC-1155 TransferBatch from tx %s (%d data bytes, %d topics)", lr.TxHash.String(), len(lr.Data), len(lr.Topics))
 }
 
+// tokenTrxType classifies token transfers from the zero address as mints
+// and transfers into the zero address, or the burn address, as burns.
 func tokenTrxType(trxType int32, from common.Address, to common.Address) int32 {
 	if trxType == types.TokenTrxTypeTransfer && config.EmptyAddress == from.String() {
 		return types.TokenTrxTypeMint
 	}
-	if trxType == types.TokenTrxTypeTransfer && config.EmptyAddress == to.String() {
+	if trxType == types.TokenTrxTypeTransfer && (config.EmptyAddress == to.String() || types.TokenBurnAddress == to.String()) {
 		return types.TokenTrxTypeBurn
 	}
 	return trxType
@@ -85,15 +85,23 @@ func handleFMintRepay(lr *types.LogRecord) {
 	)
 }
 
-// handleNewFMintRecord creates an fMint record with the given data
-// and pushes it into the persistent storage for future reference.
+// handleNewFMintRecord creates an fMint record with the given data valued
+// at the current oracle price of the token and pushes it into the persistent
+// storage for future reference.
 func handleNewFMintRecord(lr *types.LogRecord, tp int32, user common.Address, token common.Address, amount *big.Int, fee *big.Int) {
-	err := repo.AddFMintTransaction(&types.FMintTransaction{
+	// get the current price of the token so we can value the position change
+	price, err := repo.DefiTokenPrice(&token)
+	if err != nil {
+		log.Errorf("fMint token %s price not available; %s", token.String(), err.Error())
+	}
+
+	err = repo.AddFMintTransaction(&types.FMintTransaction{
 		UserAddress:  user,
 		TokenAddress: token,
 		Type:         tp,
 		Amount:       (hexutil.Big)(*amount),
 		Fee:          (hexutil.Big)(*fee),
+		Price:        price,
 		TrxHash:      lr.TxHash,
 		TrxIndex:     int64(lr.TxIndex)<<8 ^ int64(lr.Index),
 		TimeStamp:    lr.Block.TimeStamp,
@@ -0,0 +1,38 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+	"bytes"
+)
+
+// handleFMintAddressChange handles a change of an fMint contract address
+// registered with the fMint AddressProvider contract.
+// event MinterChanged(address newAddress), CollateralPoolChanged(address newAddress), ...
+func handleFMintAddressChange(lr *types.LogRecord) {
+	// the event must come from the configured AddressProvider
+	if !bytes.Equal(lr.Address.Bytes(), cfg.DeFi.FMint.AddressProvider.Bytes()) {
+		return
+	}
+
+	log.Noticef("fMint contract address changed in %s", lr.TxHash.String())
+	repo.InvalidateDefiConfiguration(true)
+}
+
+// handleFMintConfigChange handles a change of the fMint Minter contract configuration.
+// event MintFeeChanged(uint256 fee4), CollateralLowestDebtRatioChanged(uint256 ratio4), ...
+func handleFMintConfigChange(lr *types.LogRecord) {
+	// the event must come from the current fMint Minter contract
+	ds, err := repo.DefiConfiguration()
+	if err != nil {
+		log.Errorf("can not verify fMint config change in %s; %s", lr.TxHash.String(), err.Error())
+		repo.InvalidateDefiConfiguration(false)
+		return
+	}
+	if !bytes.Equal(lr.Address.Bytes(), ds.FMintContract.Bytes()) {
+		return
+	}
+
+	log.Noticef("fMint configuration changed in %s", lr.TxHash.String())
+	repo.InvalidateDefiConfiguration(false)
+}
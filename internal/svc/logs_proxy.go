@@ -0,0 +1,46 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// handleProxyUpgraded handles an implementation upgrade event of an EIP-1967 proxy.
+// event Upgraded(address indexed implementation)
+func handleProxyUpgraded(lr *types.LogRecord) {
+	storeContractUpgrade(lr, false)
+}
+
+// handleProxyBeaconUpgraded handles a beacon upgrade event of an EIP-1967 beacon proxy.
+// event BeaconUpgraded(address indexed beacon)
+func handleProxyBeaconUpgraded(lr *types.LogRecord) {
+	storeContractUpgrade(lr, true)
+}
+
+// storeContractUpgrade records the upgrade of a proxy from the log record.
+// Only upgrades of contracts known to the API are tracked.
+func storeContractUpgrade(lr *types.LogRecord, beacon bool) {
+	if len(lr.Topics) != 2 {
+		return
+	}
+
+	// is this a known contract?
+	sc, err := repo.Contract(&lr.Address)
+	if err != nil || sc == nil {
+		return
+	}
+
+	if err := repo.StoreContractUpgrade(&types.ContractUpgrade{
+		Proxy:          lr.Address,
+		Implementation: common.BytesToAddress(lr.Topics[1].Bytes()),
+		IsBeacon:       beacon,
+		TrxHash:        lr.TxHash,
+		LogIndex:       lr.Index,
+		BlockNumber:    lr.Block.Number,
+		TimeStamp:      lr.Block.TimeStamp,
+	}); err != nil {
+		log.Errorf("can not store upgrade of proxy %s; %s", lr.Address.String(), err.Error())
+	}
+}
@@ -3,7 +3,9 @@ package svc
 
 import (
 	"axis-graphql/internal/types"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -19,7 +21,7 @@ func handleNewDelegation(lr *types.LogRecord, stakerID *big.Int, addr common.Add
 	}
 
 	// pull the current value of the stake
-	staked, err := repo.DelegationAmountStaked(&addr, (*hexutil.Big)(stakerID))
+	staked, err := repo.DelegationAmountStaked(&addr, (*hexutil.Big)(stakerID), nil)
 	if err != nil {
 		log.Errorf("delegation balance not available for %s to %d; %s", addr.String(), stakerID.Uint64(), err.Error())
 		return
@@ -40,6 +42,17 @@ func handleNewDelegation(lr *types.LogRecord, stakerID *big.Int, addr common.Add
 	if err := repo.StoreDelegation(&dl); err != nil {
 		log.Errorf("failed to store delegation; %s", err.Error())
 	}
+
+	// keep the event for stake flows tracking
+	if err := repo.AddDelegationEvent(&types.DelegationEvent{
+		ID:          fmt.Sprintf("%s/%d", lr.TxHash.String(), lr.Index),
+		Address:     addr,
+		ToValidator: stakerID.Uint64(),
+		Amount:      (*hexutil.Big)(amo).String(),
+		Stamp:       time.Unix(int64(lr.Block.TimeStamp), 0).UTC(),
+	}); err != nil {
+		log.Errorf("failed to store delegation event; %s", err.Error())
+	}
 }
 
 // handleSfcCreatedDelegation handles a new delegation event from SFC v1 and SFC v2 contract
@@ -279,6 +292,22 @@ func handleSfc1WithdrawnDelegation(lr *types.LogRecord) {
 	handleFinishedWithdrawRequest(addr, valID, zero, zero, lr)
 }
 
+// handleSfcLockChanged handles a stake lock change event from SFC v3 contract;
+// the current lock of the delegation is recorded so the locked delegations can be filtered.
+// event LockedUpStake(address indexed delegator, uint256 indexed validatorID, uint256 duration, uint256 amount)
+// event UnlockedStake(address indexed delegator, uint256 indexed validatorID, uint256 amount, uint256 penalty)
+func handleSfcLockChanged(lr *types.LogRecord) {
+	if !repo.IsSfcContract(&lr.Address) || len(lr.Topics) != 3 {
+		return
+	}
+
+	addr := common.BytesToAddress(lr.Topics[1].Bytes())
+	valID := (*hexutil.Big)(new(big.Int).SetBytes(lr.Topics[2].Bytes()))
+	if err := repo.UpdateDelegationLock(&addr, valID); err != nil {
+		log.Errorf("can not update lock of %s to #%d; %s", addr.String(), valID.ToInt().Uint64(), err.Error())
+	}
+}
+
 // makeAdHocDelegation creates a new delegation in case an expected existing delegation
 // could not be found on a new lr event processing.
 func makeAdHocDelegation(lr *types.LogRecord, addr *common.Address, stakerID *hexutil.Big, amo *big.Int) error {
@@ -0,0 +1,96 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// handleSfcCreatedValidator handles a new validator event from SFC v3 contract.
+// The public key of the validator is recorded with the creation since the SFC sets it here.
+// event CreatedValidator(uint256 indexed validatorID, address indexed auth, uint256 createdEpoch, uint256 createdTime)
+func handleSfcCreatedValidator(lr *types.LogRecord) {
+	if !repo.IsSfcContract(&lr.Address) || len(lr.Topics) != 3 {
+		return
+	}
+
+	valID := (*hexutil.Big)(new(big.Int).SetBytes(lr.Topics[1].Bytes()))
+	repo.InvalidateValidator(valID)
+	storeValidatorChange(lr, valID, types.ValidatorChangeCreated, common.BytesToAddress(lr.Topics[2].Bytes()).String())
+
+	// pull the public key
+	pk, err := repo.ValidatorPubkey(valID)
+	if err != nil {
+		log.Errorf("public key of validator #%d not available; %s", valID.ToInt().Uint64(), err.Error())
+		return
+	}
+	storeValidatorChange(lr, valID, types.ValidatorChangePubkey, hexutil.Encode(pk))
+}
+
+// handleSfcChangedValidatorStatus handles a validator status change event from SFC v3 contract.
+// event ChangedValidatorStatus(uint256 indexed validatorID, uint256 status)
+func handleSfcChangedValidatorStatus(lr *types.LogRecord) {
+	if !repo.IsSfcContract(&lr.Address) || len(lr.Topics) != 2 || len(lr.Data) != 32 {
+		return
+	}
+
+	valID := (*hexutil.Big)(new(big.Int).SetBytes(lr.Topics[1].Bytes()))
+	repo.InvalidateValidator(valID)
+	storeValidatorChange(lr, valID, types.ValidatorChangeStatus, hexutil.EncodeBig(new(big.Int).SetBytes(lr.Data)))
+}
+
+// handleSfcDeactivatedValidator handles a validator deactivation event from SFC v3 contract.
+// event DeactivatedValidator(uint256 indexed validatorID, uint256 deactivatedEpoch, uint256 deactivatedTime)
+func handleSfcDeactivatedValidator(lr *types.LogRecord) {
+	if !repo.IsSfcContract(&lr.Address) || len(lr.Topics) != 2 || len(lr.Data) != 64 {
+		return
+	}
+
+	valID := (*hexutil.Big)(new(big.Int).SetBytes(lr.Topics[1].Bytes()))
+	repo.InvalidateValidator(valID)
+	storeValidatorChange(lr, valID, types.ValidatorChangeDeactivated, hexutil.EncodeBig(new(big.Int).SetBytes(lr.Data[:32])))
+}
+
+// handleStiInfoUpdated handles a staker information update event from the STI contract.
+// The new metadata URL is recorded and the cached staker information is refreshed.
+// event InfoUpdated(uint256 stakerID)
+func handleStiInfoUpdated(lr *types.LogRecord) {
+	if !repo.IsStiContract(&lr.Address) || len(lr.Data) != 32 {
+		return
+	}
+
+	// get the new URL
+	valID := (*hexutil.Big)(new(big.Int).SetBytes(lr.Data))
+	url, err := repo.StakerInfoUrl(valID)
+	if err != nil {
+		log.Errorf("metadata URL of validator #%d not available; %s", valID.ToInt().Uint64(), err.Error())
+		return
+	}
+	storeValidatorChange(lr, valID, types.ValidatorChangeMetadata, url)
+
+	// refresh the staker info so we don't wait for the STI scanner
+	info, err := repo.PullStakerInfo(valID)
+	if err == nil && info != nil {
+		if err := repo.StoreStakerInfo(valID, info); err != nil {
+			log.Errorf("can not refresh info of validator #%d; %s", valID.ToInt().Uint64(), err.Error())
+		}
+	}
+}
+
+// storeValidatorChange records the validator change of the given type from the log record.
+func storeValidatorChange(lr *types.LogRecord, valID *hexutil.Big, typ string, value string) {
+	if err := repo.StoreValidatorChange(&types.ValidatorChange{
+		ValidatorId: *valID,
+		Type:        typ,
+		Value:       value,
+		TrxHash:     lr.TxHash,
+		LogIndex:    lr.Index,
+		BlockNumber: lr.Block.Number,
+		TimeStamp:   lr.Block.TimeStamp,
+	}); err != nil {
+		log.Errorf("can not store %s change of validator #%d; %s", typ, valID.ToInt().Uint64(), err.Error())
+	}
+}
@@ -0,0 +1,33 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/types"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// storeTokenizerEvent stores sAXIS mint, or burn as an SFC Tokenizer event.
+// The account is the recipient of minted tokens and the sender of burned tokens.
+func storeTokenizerEvent(lr *types.LogRecord, trxType int32, from common.Address, to common.Address, amount *big.Int) {
+	te := types.TokenizerEvent{
+		Type:        types.TokenizerEventMint,
+		Account:     to,
+		ValidatorId: repo.TokenizerCallValidator(lr.Trx),
+		Amount:      hexutil.Big(*amount),
+		TrxHash:     lr.TxHash,
+		LogIndex:    lr.Index,
+		BlockNumber: hexutil.Uint64(lr.BlockNumber),
+		TimeStamp:   lr.Block.TimeStamp,
+	}
+	if trxType == types.TokenTrxTypeBurn {
+		te.Type = types.TokenizerEventBurn
+		te.Account = from
+	}
+
+	if err := repo.StoreTokenizerEvent(&te); err != nil {
+		log.Errorf("can not store sAXIS %s of %s; %s", te.Type, lr.TxHash.String(), err.Error())
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"axis-graphql/internal/types"
 	"fmt"
 	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // ServiceManager implements service manager.
@@ -20,6 +23,12 @@ type ServiceManager struct {
 	acd *accDispatcher
 	lgd *logDispatcher
 	bls *blkScanner
+	whd *webhookDispatcher
+	lqm *liquidationMonitor
+	bam *balanceMonitor
+
+	// data integrity checks results
+	integrity *integrityLog
 
 	// collection of all the managed services
 	svc []Svc
@@ -37,8 +46,9 @@ func newServiceManager() *ServiceManager {
 
 	// create new orchestrator
 	sm := ServiceManager{
-		wg:  new(sync.WaitGroup),
-		svc: make([]Svc, 0, 15),
+		wg:        new(sync.WaitGroup),
+		svc:       make([]Svc, 0, 15),
+		integrity: newIntegrityLog(),
 	}
 
 	// init the orchestration
@@ -90,6 +100,52 @@ func (mgr *ServiceManager) SetTrxChannel(ch chan *types.Transaction) {
 	mgr.trd.onTransaction = ch
 }
 
+// SetCollateralAlertChannel registers a channel for notifying collateral ratio alert events.
+func (mgr *ServiceManager) SetCollateralAlertChannel(ch chan *types.CollateralAlertEvent) {
+	if mgr.lqm != nil {
+		mgr.lqm.onAlert = ch
+	}
+}
+
+// balanceTouched notifies the balance monitor, if any, about a balance touched by a processed block.
+func (mgr *ServiceManager) balanceTouched(addr common.Address, token *common.Address, block uint64) {
+	if mgr == nil || mgr.bam == nil {
+		return
+	}
+	mgr.bam.touch(addr, token, block)
+}
+
+// IntegrityReport provides the summary of data integrity checks performed.
+func (mgr *ServiceManager) IntegrityReport() *types.IntegrityReport {
+	return mgr.integrity.report()
+}
+
+// RecomputeDelegationRewards recomputes the recent reward claims of the delegation from the archive state
+// and compares them with the indexed values. Mismatches are recorded in the integrity log.
+func (mgr *ServiceManager) RecomputeDelegationRewards(addr *common.Address, valID *hexutil.Big, count int32) (*types.RewardRecomputationReport, error) {
+	rep, err := repo.RecomputeDelegationRewards(addr, valID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rr := range rep.Entries {
+		if rr.Match {
+			mgr.integrity.passed(integrityCheckDelegationRewards)
+			continue
+		}
+
+		log.Warningf("claim %s rewards mismatch; indexed %s, recomputed %s", rr.ClaimTrx.String(), rr.Indexed.ToInt().String(), rr.Recomputed.ToInt().String())
+		mgr.integrity.failed(types.IntegrityIssue{
+			Check:    integrityCheckDelegationRewards,
+			Subject:  fmt.Sprintf("claim %s", rr.ClaimTrx.String()),
+			Expected: rr.Recomputed.ToInt().String(),
+			Actual:   rr.Indexed.ToInt().String(),
+			Detail:   fmt.Sprintf("delegation of %s to #%d, block #%d", addr.String(), valID.ToInt().Uint64(), uint64(rr.BlockNumber)),
+		})
+	}
+	return rep, nil
+}
+
 // Init the svc manager.
 func (mgr *ServiceManager) init() {
 	// make the block dispatcher
@@ -120,12 +176,75 @@ func (mgr *ServiceManager) init() {
 		mgr.svc = append(mgr.svc, &stiScanner{service: service{mgr: mgr}})
 	}
 
+	// make delegation origin backfill
+	mgr.svc = append(mgr.svc, &dlgOriginBackfill{service: service{mgr: mgr}})
+
+	// make reindexer building shadow collections of index rebuilds
+	mgr.svc = append(mgr.svc, &reindexer{service: service{mgr: mgr}})
+
 	// make gas price suggestion monitor
 	mgr.svc = append(mgr.svc, &gpsMonitor{service: service{mgr: mgr}})
 
 	// make transaction flow monitor
 	mgr.svc = append(mgr.svc, &trxFlowMonitor{service: service{mgr: mgr}})
 
+	// make DeFi overview monitor
+	mgr.svc = append(mgr.svc, &defiOverviewMonitor{service: service{mgr: mgr}})
+
+	// make webhook dispatcher; it's added to the list after the services using it
+	// so it's closed only after they stop feeding it
+	mgr.whd = &webhookDispatcher{service: service{mgr: mgr}, cfg: &cfg.Notify.Webhooks, operator: operatorWebhooks(cfg)}
+
+	// make pending rewards reminder if the reminders are configured
+	if cfg.Notify.RewardReminder.Webhook != "" && cfg.Notify.RewardReminder.Interval > 0 {
+		mgr.svc = append(mgr.svc, &rewardReminder{service: service{mgr: mgr}, cfg: &cfg.Notify.RewardReminder})
+	}
+
+	// make fMint liquidation monitor watching collateral ratio alerts
+	if cfg.Notify.Collateral.Interval > 0 {
+		mgr.lqm = &liquidationMonitor{service: service{mgr: mgr}, cfg: &cfg.Notify.Collateral}
+		mgr.svc = append(mgr.svc, mgr.lqm)
+	}
+
+	// make fMint position snapshotter feeding the position health history
+	if cfg.DeFi.FMint.SnapshotInterval > 0 {
+		mgr.svc = append(mgr.svc, &fMintSnapshotter{service: service{mgr: mgr}, cfg: &cfg.DeFi.FMint})
+	}
+
+	// make balance monitor watching balance threshold alerts
+	if cfg.Notify.Balance.Refresh > 0 {
+		mgr.bam = &balanceMonitor{service: service{mgr: mgr}, cfg: &cfg.Notify.Balance}
+		mgr.svc = append(mgr.svc, mgr.bam)
+	}
+
+	// make node connection and block production alerter
+	if cfg.Notify.NodeAlert.Webhook != "" {
+		mgr.svc = append(mgr.svc, &nodeAlerter{service: service{mgr: mgr}, cfg: &cfg.Notify.NodeAlert})
+	}
+
+	// make registry monitor re-resolving the core contracts
+	if cfg.Registry.Address.String() != config.EmptyAddress && cfg.Registry.Interval > 0 {
+		mgr.svc = append(mgr.svc, &registryMonitor{service: service{mgr: mgr}, cfg: &cfg.Registry})
+	}
+
+	// make epoch rewards integrity checker
+	if cfg.Integrity.Interval > 0 {
+		mgr.svc = append(mgr.svc, &epochRewardsChecker{service: service{mgr: mgr}, cfg: &cfg.Integrity, il: mgr.integrity})
+	}
+
+	// make index sampler verifying indexed blocks against the node
+	if cfg.Integrity.SampleInterval > 0 && cfg.Integrity.SampleSize > 0 {
+		mgr.svc = append(mgr.svc, &indexSampler{service: service{mgr: mgr}, cfg: &cfg.Integrity, il: mgr.integrity})
+	}
+
+	// make account export janitor picking up abandoned exports and removing expired files
+	if cfg.AccountExport.Enabled {
+		mgr.svc = append(mgr.svc, &accountExportJanitor{service: service{mgr: mgr}})
+	}
+
+	// add the webhook dispatcher
+	mgr.svc = append(mgr.svc, mgr.whd)
+
 	// add orchestrator as the last service, so it can safely operate on all the other
 	mgr.ora = &orchestrator{service: service{mgr: mgr}}
 	mgr.svc = append(mgr.svc, mgr.ora)
@@ -0,0 +1,126 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"fmt"
+)
+
+// node connection and block production alert events sent to the operator
+const (
+	nodeAlertDisconnected = "node.disconnected"
+	nodeAlertReconnected  = "node.reconnected"
+	nodeAlertChainStalled = "chain.stalled"
+	nodeAlertChainResumed = "chain.resumed"
+)
+
+// nodeAlertPayload represents the webhook payload of a node connection alert.
+type nodeAlertPayload struct {
+	Event    string `json:"event"`
+	Reason   string `json:"reason,omitempty"`
+	Attempts int    `json:"attempts,omitempty"`
+	Downtime int64  `json:"downtime,omitempty"`
+	Block    uint64 `json:"block,omitempty"`
+	Gap      int64  `json:"gap,omitempty"`
+	Stamp    int64  `json:"stamp"`
+}
+
+// nodeAlerter represents a service notifying the operator
+// when the node connection is lost and when it's restored,
+// and when the chain stops producing blocks and when it resumes.
+type nodeAlerter struct {
+	service
+	cfg    *config.NodeAlert
+	events <-chan *types.NodeConnectionEvent
+	gaps   <-chan *types.BlockGapEvent
+}
+
+// name returns the name of the service used by orchestrator.
+func (na *nodeAlerter) name() string {
+	return "node alerter"
+}
+
+// init prepares the node alerter.
+func (na *nodeAlerter) init() {
+	na.sigStop = make(chan bool, 1)
+	na.events = repo.NodeConnectionEvents()
+	na.gaps = repo.BlockGapEvents()
+}
+
+// run starts the node connection alerter.
+func (na *nodeAlerter) run() {
+	// make sure we are orchestrated
+	if na.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", na.name()))
+	}
+
+	// signal orchestrator we started and go
+	na.mgr.started(na)
+	go na.execute()
+}
+
+// execute sends alerts on the node connection state changes.
+func (na *nodeAlerter) execute() {
+	defer func() {
+		close(na.sigStop)
+		na.mgr.finished(na)
+	}()
+
+	for {
+		select {
+		case <-na.sigStop:
+			return
+		case ev, ok := <-na.events:
+			if !ok {
+				return
+			}
+			na.alert(ev)
+		case ev, ok := <-na.gaps:
+			if !ok {
+				return
+			}
+			na.alertGap(ev)
+		}
+	}
+}
+
+// alert sends the alert of the node connection state change to the operator webhook.
+func (na *nodeAlerter) alert(ev *types.NodeConnectionEvent) {
+	pl := nodeAlertPayload{
+		Event:  nodeAlertDisconnected,
+		Reason: ev.Reason,
+		Stamp:  ev.Stamp.Unix(),
+	}
+	if ev.Connected {
+		pl = nodeAlertPayload{
+			Event:    nodeAlertReconnected,
+			Attempts: ev.Attempts,
+			Downtime: int64(ev.Downtime.Seconds()),
+			Stamp:    ev.Stamp.Unix(),
+		}
+	}
+
+	na.send(&pl)
+}
+
+// alertGap sends the alert of the block production state change to the operator webhook.
+func (na *nodeAlerter) alertGap(ev *types.BlockGapEvent) {
+	pl := nodeAlertPayload{
+		Event: nodeAlertChainStalled,
+		Block: ev.LastBlock,
+		Gap:   int64(ev.Gap.Seconds()),
+		Stamp: ev.Stamp.Unix(),
+	}
+	if !ev.Stalled {
+		pl.Event = nodeAlertChainResumed
+	}
+	na.send(&pl)
+}
+
+// send dispatches the alert to the operator webhook.
+func (na *nodeAlerter) send(pl *nodeAlertPayload) {
+	if err := na.mgr.whd.dispatch(na.cfg.Webhook, pl.Event, pl); err != nil {
+		log.Errorf("can not send node alert %s; %s", pl.Event, err.Error())
+	}
+}
@@ -0,0 +1,180 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// rewardReminderEvent represents the name of the webhook event sent by the reminder.
+const rewardReminderEvent = "rewards.pending"
+
+// rewardReminderPayload represents the webhook payload of a pending rewards reminder.
+type rewardReminderPayload struct {
+	Event       string         `json:"event"`
+	Address     common.Address `json:"address"`
+	ValidatorID hexutil.Uint64 `json:"validatorId"`
+	Pending     hexutil.Big    `json:"pending"`
+	Threshold   hexutil.Big    `json:"threshold"`
+	Stamp       int64          `json:"stamp"`
+}
+
+// rewardReminder represents a service checking pending rewards of watched delegations
+// and notifying an external service if the amount is worth claiming, or restaking.
+// The watch list is kept in the database; delegations of the configuration are added
+// on start, others are managed by admins. The last reminder of each delegation is recorded,
+// so the same rewards are reminded again only after another threshold amount accumulated,
+// at most once per epoch, and a restart does not repeat reminders.
+type rewardReminder struct {
+	service
+	cfg       *config.RewardReminder
+	threshold *big.Int
+}
+
+// name returns the name of the service used by orchestrator.
+func (rr *rewardReminder) name() string {
+	return "reward claim reminder"
+}
+
+// init prepares the reward reminder to perform its function.
+func (rr *rewardReminder) init() {
+	rr.sigStop = make(chan bool, 1)
+
+	// convert the configured threshold in AXIS units to WEI
+	rr.threshold, _ = new(big.Float).Mul(big.NewFloat(rr.cfg.Threshold), big.NewFloat(1e18)).Int(nil)
+
+	// the configured delegations are always watched
+	for i := range rr.cfg.Watch {
+		wd := &rr.cfg.Watch[i]
+		if err := repo.AddRewardWatch(&wd.Address, wd.ValidatorID, true, "config"); err != nil {
+			log.Errorf("can not watch pending rewards of %s to #%d; %s", wd.Address.String(), wd.ValidatorID, err.Error())
+		}
+	}
+}
+
+// run starts the reward reminder.
+func (rr *rewardReminder) run() {
+	// make sure we are orchestrated
+	if rr.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", rr.name()))
+	}
+
+	// signal orchestrator we started and go
+	rr.mgr.started(rr)
+	go rr.execute()
+}
+
+// close terminates the reward reminder.
+func (rr *rewardReminder) close() {
+	if rr.sigStop != nil {
+		rr.sigStop <- true
+	}
+}
+
+// execute runs the scheduled pending rewards checks.
+func (rr *rewardReminder) execute() {
+	ticker := time.NewTicker(rr.cfg.Interval)
+	defer func() {
+		ticker.Stop()
+		close(rr.sigStop)
+		rr.mgr.finished(rr)
+	}()
+
+	for {
+		select {
+		case <-rr.sigStop:
+			return
+		case <-ticker.C:
+			rr.check()
+		}
+	}
+}
+
+// check verifies pending rewards of all the watched delegations.
+func (rr *rewardReminder) check() {
+	list, err := repo.RewardWatches()
+	if err != nil {
+		log.Errorf("can not load watched delegations; %s", err.Error())
+		return
+	}
+
+	epoch, err := repo.CurrentEpoch()
+	if err != nil {
+		log.Errorf("can not check pending rewards, epoch unknown; %s", err.Error())
+		return
+	}
+
+	for _, rw := range list {
+		rr.checkDelegation(rw, uint64(epoch))
+	}
+}
+
+// checkDelegation verifies pending rewards of a single delegation
+// and sends the reminder if the amount crossed the configured threshold
+// since the last reminder, unless the delegation has been reminded in this epoch already.
+func (rr *rewardReminder) checkDelegation(rw *types.RewardWatch, epoch uint64) {
+	addr := common.HexToAddress(rw.Address)
+
+	// load the pending rewards
+	pr, err := repo.PendingRewards(&addr, (*hexutil.Big)(new(big.Int).SetUint64(rw.ValidatorID)), nil)
+	if err != nil {
+		log.Errorf("can not check pending rewards of %s to #%d; %s", rw.Address, rw.ValidatorID, err.Error())
+		return
+	}
+
+	// is it worth to remind?
+	pending := pr.Amount.ToInt()
+	if pending.Cmp(rr.threshold) < 0 {
+		// the rewards were claimed since the last reminder; start over
+		if rw.LastSent != nil {
+			if err := repo.StoreRewardReminder(&addr, rw.ValidatorID, epoch, nil); err != nil {
+				log.Errorf("can not reset pending rewards reminder of %s to #%d; %s", rw.Address, rw.ValidatorID, err.Error())
+			}
+		}
+		return
+	}
+	if !rr.isDue(rw, pending, epoch) {
+		return
+	}
+
+	// send the reminder
+	err = rr.mgr.whd.dispatch(rr.cfg.Webhook, rewardReminderEvent, rewardReminderPayload{
+		Event:       rewardReminderEvent,
+		Address:     addr,
+		ValidatorID: hexutil.Uint64(rw.ValidatorID),
+		Pending:     pr.Amount,
+		Threshold:   hexutil.Big(*rr.threshold),
+		Stamp:       time.Now().UTC().Unix(),
+	})
+	if err != nil {
+		log.Errorf("can not send pending rewards reminder of %s to #%d; %s", rw.Address, rw.ValidatorID, err.Error())
+		return
+	}
+
+	if err := repo.StoreRewardReminder(&addr, rw.ValidatorID, epoch, pending); err != nil {
+		log.Errorf("can not record pending rewards reminder of %s to #%d; %s", rw.Address, rw.ValidatorID, err.Error())
+	}
+	log.Infof("pending rewards reminder queued for %s to #%d", rw.Address, rw.ValidatorID)
+}
+
+// isDue checks if a reminder of the given pending amount should be sent for the watched delegation.
+func (rr *rewardReminder) isDue(rw *types.RewardWatch, pending *big.Int, epoch uint64) bool {
+	if rw.LastSent == nil {
+		return true
+	}
+	if epoch <= rw.LastEpoch {
+		return false
+	}
+
+	last, err := hexutil.DecodeBig(rw.LastAmount)
+	if err != nil {
+		return true
+	}
+	return new(big.Int).Sub(pending, last).Cmp(rr.threshold) >= 0
+}
@@ -97,7 +97,7 @@ func (or *orchestrator) handleNewHead(h *etc.Header) {
 	bn := h.Number.Uint64()
 	blk, err := repo.BlockByNumber((*hexutil.Uint64)(&bn))
 	if err != nil {
-		log.Errorf("block #%d not available; %s", bn, err.Error())
+		logObserver.Errorf("block #%d not available; %s", bn, err.Error())
 		return
 	}
 
@@ -118,7 +118,7 @@ func (or *orchestrator) handleNewHead(h *etc.Header) {
 // on block scanner full speed to idle transition (consistency feature, may not be needed).
 func (or *orchestrator) unloadCache() {
 	// inform about the cache unloading
-	log.Noticef("unloading block cache for processing")
+	logObserver.Noticef("unloading block cache for processing")
 
 	// pull all available cached blocks and reset the ring to make sure
 	// we don't re-send the same blocks again
@@ -127,7 +127,7 @@ func (or *orchestrator) unloadCache() {
 
 	// push them all to dispatcher for processing
 	for _, blk := range l {
-		log.Infof("cached block #%d sent for processing", (*types.Block)(blk).Number)
+		logObserver.Infof("cached block #%d sent for processing", (*types.Block)(blk).Number)
 		or.mgr.bld.inBlock <- (*types.Block)(blk)
 	}
 }
@@ -0,0 +1,106 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/metrics"
+	"fmt"
+	"time"
+)
+
+// registryAlertEvent represents the name of the alert sent when a core contract address changes.
+const registryAlertEvent = "registry.changed"
+
+// registryAlertPayload represents the webhook payload of a contract address change alert.
+type registryAlertPayload struct {
+	Event    string `json:"event"`
+	Contract string `json:"contract"`
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+	Stamp    int64  `json:"stamp"`
+}
+
+// registryMonitor represents a service periodically re-resolving addresses
+// of the core contracts from the on-chain address registry.
+type registryMonitor struct {
+	service
+	cfg    *config.Registry
+	ticker *time.Ticker
+}
+
+// name returns the name of the service used by orchestrator.
+func (rm *registryMonitor) name() string {
+	return "registry monitor"
+}
+
+// init prepares the registry monitor.
+func (rm *registryMonitor) init() {
+	rm.sigStop = make(chan bool, 1)
+}
+
+// run starts the registry monitor.
+func (rm *registryMonitor) run() {
+	// make sure we are orchestrated
+	if rm.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", rm.name()))
+	}
+
+	// signal orchestrator we started and go
+	rm.mgr.started(rm)
+	go rm.execute()
+}
+
+// close terminates the registry monitor.
+func (rm *registryMonitor) close() {
+	if rm.ticker != nil {
+		rm.ticker.Stop()
+	}
+	if rm.sigStop != nil {
+		rm.sigStop <- true
+	}
+}
+
+// execute runs the scheduled re-resolution of the contract addresses.
+func (rm *registryMonitor) execute() {
+	defer func() {
+		close(rm.sigStop)
+		rm.mgr.finished(rm)
+	}()
+
+	rm.ticker = time.NewTicker(rm.cfg.Interval)
+	for {
+		select {
+		case <-rm.sigStop:
+			return
+		case <-rm.ticker.C:
+			rm.resolve()
+		}
+	}
+}
+
+// resolve re-resolves the contract addresses and alerts the operator on changes.
+func (rm *registryMonitor) resolve() {
+	changes, err := repo.ResolveContractRegistry()
+	if err != nil {
+		log.Errorf("can not resolve contracts from registry %s; %s", rm.cfg.Address.String(), err.Error())
+		return
+	}
+
+	for _, ch := range changes {
+		log.Warningf("contract %s address changed from %s to %s", ch.Name, ch.Previous.String(), ch.Current.String())
+		metrics.Counter("registry/changes").Inc(1)
+
+		if rm.cfg.Webhook == "" {
+			continue
+		}
+		if err := rm.mgr.whd.dispatch(rm.cfg.Webhook, registryAlertEvent, &registryAlertPayload{
+			Event:    registryAlertEvent,
+			Contract: ch.Name,
+			Previous: ch.Previous.String(),
+			Current:  ch.Current.String(),
+			Stamp:    time.Now().Unix(),
+		}); err != nil {
+			log.Errorf("can not send registry alert of %s; %s", ch.Name, err.Error())
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
+	"fmt"
+	"os"
+	"time"
+)
+
+// reindexerInterval represents the delay between checks for active collection rebuilds.
+const reindexerInterval = 30 * time.Second
+
+// reindexer represents a service building shadow collections of active reindexes in the background,
+// so breaking changes of the index format do not require taking the API offline.
+// Only one API server instance sharing the database builds a reindex at a time.
+type reindexer struct {
+	service
+	owner  string
+	ticker *time.Ticker
+}
+
+// name returns the name of the service used by orchestrator.
+func (ri *reindexer) name() string {
+	return "reindexer"
+}
+
+// init prepares the reindexer.
+func (ri *reindexer) init() {
+	ri.sigStop = make(chan bool, 1)
+
+	host, _ := os.Hostname()
+	ri.owner = fmt.Sprintf("%s/%d/%d", host, os.Getpid(), time.Now().UnixNano())
+}
+
+// run starts the reindexer.
+func (ri *reindexer) run() {
+	// make sure we are orchestrated
+	if ri.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", ri.name()))
+	}
+
+	// signal orchestrator we started and go
+	ri.mgr.started(ri)
+	go ri.execute()
+}
+
+// close terminates the reindexer.
+func (ri *reindexer) close() {
+	if ri.ticker != nil {
+		ri.ticker.Stop()
+	}
+	if ri.sigStop != nil {
+		ri.sigStop <- true
+	}
+}
+
+// execute checks for active reindexes periodically and builds them.
+func (ri *reindexer) execute() {
+	defer func() {
+		close(ri.sigStop)
+		ri.mgr.finished(ri)
+	}()
+
+	ri.ticker = time.NewTicker(reindexerInterval)
+	for {
+		select {
+		case <-ri.sigStop:
+			return
+		case <-ri.ticker.C:
+			if !ri.build() {
+				return
+			}
+		}
+	}
+}
+
+// build processes the batches of the active reindex until it's finished, or none is available.
+// It returns false if the service has been requested to stop.
+func (ri *reindexer) build() bool {
+	for {
+		select {
+		case <-ri.sigStop:
+			return false
+		default:
+		}
+
+		rx, err := repo.ReindexStep(ri.owner)
+		if err != nil {
+			log.Errorf("reindex step failed; %s", err.Error())
+			return true
+		}
+		if rx == nil {
+			return true
+		}
+
+		metrics.Gauge(fmt.Sprintf("reindex/%s/copied", rx.Collection)).Update(rx.Copied)
+		switch rx.State {
+		case types.ReindexStateSwitched:
+			log.Noticef("reindex of %s finished", rx.Collection)
+		case types.ReindexStateFailed:
+			log.Errorf("reindex of %s failed", rx.Collection)
+		}
+	}
+}
@@ -71,12 +71,12 @@ func (bls *blkScanner) run() {
 	// get the scanner range
 	start, err := bls.boundaries()
 	if err != nil {
-		log.Errorf("scanner can not proceed; %s", err.Error())
+		logObserver.Errorf("scanner can not proceed; %s", err.Error())
 		return
 	}
 
 	// signal orchestrator we started and go
-	log.Noticef("block scan starts at #%d", start)
+	logObserver.Noticef("block scan starts at #%d", start)
 	bls.from = start
 	bls.next = start
 
@@ -100,13 +100,13 @@ func (bls *blkScanner) boundaries() (uint64, error) {
 	// get the newest known transaction
 	lnb, err := repo.LastKnownBlock()
 	if err != nil {
-		log.Critical("can not scan blockchain; %s", err.Error())
+		logObserver.Critical("can not scan blockchain; %s", err.Error())
 		return 0, err
 	}
 
 	// apply re-scan
 	if lnb > bls.cfg.BlockScanReScan {
-		log.Debugf("last known block is #%d, re-scanning %d blocks", lnb, bls.cfg.BlockScanReScan)
+		logObserver.Debugf("last known block is #%d, re-scanning %d blocks", lnb, bls.cfg.BlockScanReScan)
 		lnb = lnb - bls.cfg.BlockScanReScan
 	}
 	return lnb, nil
@@ -151,7 +151,7 @@ func (bls *blkScanner) observe() bool {
 	// try to get the block height
 	bh, err := repo.BlockHeight()
 	if err != nil {
-		log.Errorf("can not get current block height; %s", err.Error())
+		logObserver.Errorf("can not get current block height; %s", err.Error())
 		return false
 	}
 
@@ -162,13 +162,13 @@ func (bls *blkScanner) observe() bool {
 	if bls.onIdle && target < bls.done+blsReScanHysteresis {
 		bls.next = bls.done
 		bls.from = bls.done
-		log.Infof("block scanner idling at #%d, head at #%d", bls.next, target)
+		logObserver.Infof("block scanner idling at #%d, head at #%d", bls.next, target)
 		return true
 	}
 
 	// adjust target block number; log the progress of the scan
 	bls.to = target
-	log.Infof("block scanner at #%d of <#%d, #%d>, #%d dispatched", bls.next, bls.from, bls.to, bls.done)
+	logObserver.Infof("block scanner at #%d of <#%d, #%d>, #%d dispatched", bls.next, bls.from, bls.to, bls.done)
 	return bls.to < bls.next
 }
 
@@ -181,7 +181,7 @@ func (bls *blkScanner) updateState(target bool) {
 	}
 
 	// switch the state; advertise the transition
-	log.Noticef("block scanner idle state toggled to %t", target)
+	logObserver.Noticef("block scanner idle state toggled to %t", target)
 	bls.onIdle = target
 
 	select {
@@ -219,7 +219,7 @@ func (bls *blkScanner) shift() {
 	// pull the current block
 	block, err := repo.BlockByNumber((*hexutil.Uint64)(&bls.next))
 	if err != nil {
-		log.Errorf("block #%d not available; %s", bls.next, err.Error())
+		logObserver.Errorf("block #%d not available; %s", bls.next, err.Error())
 		return
 	}
 
@@ -0,0 +1,77 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"fmt"
+	"time"
+)
+
+// defiOverviewUpdaterPeriod represents the period in which we re-calculate DeFi aggregates.
+const defiOverviewUpdaterPeriod = 10 * time.Minute
+
+// defiOverviewMonitor represents a service refreshing network-wide aggregates
+// of the DeFi modules, e.g. total value locked and swap volumes.
+type defiOverviewMonitor struct {
+	service
+	ticker *time.Ticker
+}
+
+// name returns a human-readable name of the service used by the manager.
+func (dom *defiOverviewMonitor) name() string {
+	return "DeFi overview monitor"
+}
+
+// init prepares the DeFi overview monitor to perform its function.
+func (dom *defiOverviewMonitor) init() {
+	dom.sigStop = make(chan bool, 1)
+}
+
+// run starts the DeFi overview monitoring.
+func (dom *defiOverviewMonitor) run() {
+	// make sure we are orchestrated
+	if dom.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", dom.name()))
+	}
+
+	// start go routine for processing
+	dom.mgr.started(dom)
+	go dom.execute()
+}
+
+// close terminates the DeFi overview monitor.
+func (dom *defiOverviewMonitor) close() {
+	if dom.ticker != nil {
+		dom.ticker.Stop()
+	}
+	if dom.sigStop != nil {
+		dom.sigStop <- true
+	}
+}
+
+// execute performs regular updates of the DeFi aggregates.
+func (dom *defiOverviewMonitor) execute() {
+	defer func() {
+		close(dom.sigStop)
+		dom.mgr.finished(dom)
+	}()
+
+	// do initial update
+	dom.update()
+
+	dom.ticker = time.NewTicker(defiOverviewUpdaterPeriod)
+	for {
+		select {
+		case <-dom.sigStop:
+			return
+		case <-dom.ticker.C:
+			dom.update()
+		}
+	}
+}
+
+// update re-calculates the DeFi aggregates.
+func (dom *defiOverviewMonitor) update() {
+	if _, err := repo.UpdateDefiOverview(); err != nil {
+		log.Errorf("can not update DeFi overview; %s", err.Error())
+	}
+}
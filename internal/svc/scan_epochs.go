@@ -175,4 +175,9 @@ func (eps *epochScanner) store(ep *types.Epoch) {
 	if err != nil {
 		log.Errorf("can not store epoch #%d; %s", ep.Id, err.Error())
 	}
+
+	// capture the validator set of the epoch
+	if _, err := repo.SnapshotEpochValidators(ep.Id); err != nil {
+		log.Errorf("can not store validators of epoch #%d; %s", ep.Id, err.Error())
+	}
 }
@@ -58,8 +58,9 @@ func (tfm *trxFlowMonitor) execute() {
 		tfm.mgr.finished(tfm)
 	}()
 
-	// do initial trx count update
+	// do initial trx count update and fill gaps in the gas price history
 	go tfm.updateCount()
+	go repo.GasPriceHistoryUpdate(true)
 
 	// start to control the monitor
 	tfm.flowTicker = time.NewTicker(trxFlowUpdaterPeriod)
@@ -72,6 +73,7 @@ func (tfm *trxFlowMonitor) execute() {
 			return
 		case <-tfm.flowTicker.C:
 			repo.TrxFlowUpdate()
+			repo.GasPriceHistoryUpdate(false)
 		case <-tfm.countTicker.C:
 			go tfm.updateCount()
 		}
@@ -0,0 +1,234 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/metrics"
+	"axis-graphql/internal/types"
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// integrityCheckHeaders represents the name of the received block headers verification.
+const integrityCheckHeaders = "block headers"
+
+// hvRetryDelay represents the delay before a rejected block is pulled from the node again.
+const hvRetryDelay = 5 * time.Second
+
+// hvMaxRetries represents the max number of attempts to pull a consistent copy of a rejected block
+// before the block is parked for a longer time.
+const hvMaxRetries = 3
+
+// hvParkDelay represents the delay before a block rejected in all the attempts is tried again.
+const hvParkDelay = 5 * time.Minute
+
+// hvValidatorPubkeyType represents the type prefix of secp256k1 validator keys registered in the SFC.
+const hvValidatorPubkeyType = 0xc0
+
+// headerVerifier verifies the parent linkage, time stamps and validator signatures of blocks
+// received from the node before they are indexed.
+type headerVerifier struct {
+	il       *integrityLog
+	lastNum  uint64
+	lastHash common.Hash
+	lastTime hexutil.Uint64
+	retries  map[uint64]int
+	keys     map[uint64][]byte
+}
+
+// newHeaderVerifier creates a new block headers verifier.
+func newHeaderVerifier(il *integrityLog) *headerVerifier {
+	return &headerVerifier{
+		il:      il,
+		retries: make(map[uint64]int),
+		keys:    make(map[uint64][]byte),
+	}
+}
+
+// verify checks the block links to its parent, does not precede it in time and has been
+// sealed by an atropos event signed by a validator of the validator set.
+// The parent is the previous verified block, if available, or it's loaded from the node.
+func (hv *headerVerifier) verify(blk *types.Block) error {
+	num := uint64(blk.Number)
+	if num == 0 {
+		return nil
+	}
+
+	parentHash, parentTime := hv.lastHash, hv.lastTime
+	if hv.lastNum == 0 || hv.lastNum+1 != num {
+		pn := num - 1
+		parent, err := repo.BlockByNumber((*hexutil.Uint64)(&pn))
+		if err != nil {
+			return fmt.Errorf("parent block #%d not available; %s", pn, err.Error())
+		}
+		parentHash, parentTime = parent.Hash, parent.TimeStamp
+	}
+
+	if blk.ParentHash != parentHash {
+		hv.il.failed(types.IntegrityIssue{
+			Check:    integrityCheckHeaders,
+			Subject:  fmt.Sprintf("block #%d", num),
+			Expected: parentHash.String(),
+			Actual:   blk.ParentHash.String(),
+			Detail:   "parent hash",
+		})
+		return fmt.Errorf("parent hash %s does not match %s", blk.ParentHash.String(), parentHash.String())
+	}
+	if blk.TimeStamp < parentTime {
+		hv.il.failed(types.IntegrityIssue{
+			Check:    integrityCheckHeaders,
+			Subject:  fmt.Sprintf("block #%d", num),
+			Expected: fmt.Sprintf(">= %d", uint64(parentTime)),
+			Actual:   fmt.Sprintf("%d", uint64(blk.TimeStamp)),
+			Detail:   "time stamp",
+		})
+		return fmt.Errorf("time stamp %d precedes parent time stamp %d", uint64(blk.TimeStamp), uint64(parentTime))
+	}
+	if err := hv.verifySigner(blk); err != nil {
+		hv.il.failed(types.IntegrityIssue{
+			Check:    integrityCheckHeaders,
+			Subject:  fmt.Sprintf("block #%d", num),
+			Expected: "atropos signed by a validator",
+			Actual:   err.Error(),
+			Detail:   "signature",
+		})
+		return err
+	}
+
+	hv.il.passed(integrityCheckHeaders)
+	hv.lastNum, hv.lastHash, hv.lastTime = num, blk.Hash, blk.TimeStamp
+	delete(hv.retries, num)
+	return nil
+}
+
+// verifySigner checks the atropos event sealing the block has been signed by the key
+// of a validator which belonged to the validator set in the epoch of the event.
+func (hv *headerVerifier) verifySigner(blk *types.Block) error {
+	// the block is identified by its atropos event
+	ev, err := repo.DagEvent(&blk.Hash)
+	if err != nil {
+		return fmt.Errorf("atropos %s not available; %s", blk.Hash.String(), err.Error())
+	}
+	if ev.Id != blk.Hash {
+		return fmt.Errorf("atropos %s does not match block hash %s", ev.Id.String(), blk.Hash.String())
+	}
+
+	// the creator must be in the validator set of the epoch
+	val, err := hv.validator(uint64(ev.Creator))
+	if err != nil {
+		return err
+	}
+	if val.CreatedEpoch > ev.Epoch || (val.DeactivatedEpoch != 0 && val.DeactivatedEpoch < ev.Epoch) {
+		return fmt.Errorf("atropos creator #%d not a validator in epoch %d", uint64(ev.Creator), uint64(ev.Epoch))
+	}
+
+	// the signature must match the key of the validator
+	key, err := hv.validatorKey(uint64(ev.Creator))
+	if err != nil {
+		return err
+	}
+	if !signedBy(ev.Id, ev.Sig, key) {
+		return fmt.Errorf("atropos not signed by validator #%d", uint64(ev.Creator))
+	}
+	return nil
+}
+
+// signedBy checks the given signature of the hash has been made by the given public key.
+// Signatures without the recovery id, as made by Lachesis event creators, are accepted.
+func signedBy(hash common.Hash, sig []byte, key []byte) bool {
+	if len(sig) != crypto.SignatureLength-1 && len(sig) != crypto.SignatureLength {
+		return false
+	}
+
+	full := make([]byte, crypto.SignatureLength)
+	copy(full, sig)
+	for v := byte(0); v < 2; v++ {
+		if len(sig) == crypto.SignatureLength && sig[crypto.RecoveryIDOffset] != v {
+			continue
+		}
+		full[crypto.RecoveryIDOffset] = v
+
+		pub, err := crypto.Ecrecover(hash.Bytes(), full)
+		if err == nil && bytes.Equal(pub, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// validator provides the validator of the given ID from the validator set.
+func (hv *headerVerifier) validator(id uint64) (*types.Validator, error) {
+	list, err := repo.Validators()
+	if err != nil {
+		return nil, fmt.Errorf("validator set not available; %s", err.Error())
+	}
+	for _, val := range list {
+		if val.Id.ToInt().Uint64() == id {
+			return val, nil
+		}
+	}
+	return nil, fmt.Errorf("atropos creator #%d not in the validator set", id)
+}
+
+// validatorKey provides the uncompressed secp256k1 public key of the validator of the given ID.
+func (hv *headerVerifier) validatorKey(id uint64) ([]byte, error) {
+	if key, ok := hv.keys[id]; ok {
+		return key, nil
+	}
+
+	pk, err := repo.ValidatorPubkey((*hexutil.Big)(new(big.Int).SetUint64(id)))
+	if err != nil {
+		return nil, fmt.Errorf("key of validator #%d not available; %s", id, err.Error())
+	}
+	if len(pk) != 66 || pk[0] != hvValidatorPubkeyType {
+		return nil, fmt.Errorf("unknown key type of validator #%d", id)
+	}
+
+	hv.keys[id] = pk[1:]
+	return pk[1:], nil
+}
+
+// rejected handles a block which failed the verification. A fresh copy of the block
+// is pulled from the node after a while and sent for processing again. If the number
+// of attempts is exhausted, the block is parked and tried again after a longer delay,
+// so the block is not lost even if the node takes long to recover.
+func (hv *headerVerifier) rejected(blk *types.Block, err error, bld *blockDispatcher) {
+	num := uint64(blk.Number)
+	log.Criticalf("block #%d rejected; %s", num, err.Error())
+	metrics.Counter("blocks/rejected").Inc(1)
+
+	delay := hvRetryDelay
+	hv.retries[num]++
+	if hv.retries[num] > hvMaxRetries {
+		log.Criticalf("block #%d not indexed, no consistent copy received in %d attempts, parked for %s", num, hvMaxRetries, hvParkDelay)
+		metrics.Counter("blocks/parked").Inc(1)
+		delete(hv.retries, num)
+		delay = hvParkDelay
+	}
+	hv.requeue(num, delay, bld)
+}
+
+// requeue pulls a fresh copy of the block from the node after the given delay
+// and sends it to the repair queue of the dispatcher.
+func (hv *headerVerifier) requeue(num uint64, delay time.Duration, bld *blockDispatcher) {
+	time.AfterFunc(delay, func() {
+		fresh, err := repo.ReloadBlock((*hexutil.Uint64)(&num))
+		if err != nil {
+			log.Errorf("block #%d not available; %s", num, err.Error())
+			hv.requeue(num, hvParkDelay, bld)
+			return
+		}
+
+		select {
+		case bld.inRepair <- fresh:
+		case <-time.After(hvRetryDelay):
+			log.Errorf("block #%d can not be queued for processing again", num)
+			hv.requeue(num, hvParkDelay, bld)
+		}
+	})
+}
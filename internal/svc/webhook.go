@@ -0,0 +1,368 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"axis-graphql/internal/types"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// whdQueueLength represents the capacity of the webhook dispatcher queue.
+const whdQueueLength = 1000
+
+// whdRetryTickerDuration represents the frequency of checks for scheduled delivery retries.
+const whdRetryTickerDuration = time.Second
+
+// whdLeaseDuration represents the time a delivery is reserved for the dispatcher handling it;
+// other API server instances sharing the delivery log pick it up only after the lease expires.
+const whdLeaseDuration = 2 * time.Minute
+
+// whdRetryBatchSize represents the max number of due deliveries loaded from the delivery log at once.
+const whdRetryBatchSize = 100
+
+// webhook request headers sent along with the payload
+const (
+	whdHeaderEvent     = "X-Webhook-Event"
+	whdHeaderDelivery  = "X-Webhook-Delivery"
+	whdHeaderSignature = "X-Webhook-Signature"
+	whdHeaderTimestamp = "X-Webhook-Timestamp"
+)
+
+// webhookJob represents a single webhook notification being delivered.
+type webhookJob struct {
+	delivery *types.WebhookDelivery
+	body     []byte
+}
+
+// webhookDispatcher implements a service delivering webhook notifications
+// of other services. Payloads are signed using HMAC-SHA256 with the configured secret,
+// failed deliveries are retried with exponential back-off and dead-lettered
+// if all the attempts fail. Each delivery is recorded in the delivery log before
+// the first attempt; the log serves as the persistent retry queue, so pending
+// deliveries survive restarts of the server.
+type webhookDispatcher struct {
+	service
+	cfg    *config.Webhooks
+	queue  chan *webhookJob
+	ticker *time.Ticker
+
+	// operator configured webhooks may reach internal addresses,
+	// user registered webhooks are delivered by the guarded client
+	operator map[string]bool
+	client   *http.Client
+	guarded  *http.Client
+
+	// busy tracks deliveries queued, or being delivered, by this dispatcher
+	busyMu sync.Mutex
+	busy   map[string]bool
+}
+
+// name returns the name of the service used by orchestrator.
+func (whd *webhookDispatcher) name() string {
+	return "webhook dispatcher"
+}
+
+// init prepares the webhook dispatcher to perform its function.
+func (whd *webhookDispatcher) init() {
+	whd.sigStop = make(chan bool, 1)
+	whd.queue = make(chan *webhookJob, whdQueueLength)
+	whd.busy = make(map[string]bool)
+	whd.client = &http.Client{Timeout: whd.cfg.Timeout}
+	whd.guarded = newGuardedWebhookClient(whd.cfg.Timeout)
+}
+
+// run starts the webhook dispatcher.
+func (whd *webhookDispatcher) run() {
+	// make sure we are orchestrated
+	if whd.mgr == nil {
+		panic(fmt.Errorf("no svc manager set on %s", whd.name()))
+	}
+
+	// signal orchestrator we started and go
+	whd.mgr.started(whd)
+	go whd.execute()
+}
+
+// close terminates the webhook dispatcher.
+func (whd *webhookDispatcher) close() {
+	if whd.ticker != nil {
+		whd.ticker.Stop()
+	}
+	if whd.sigStop != nil {
+		whd.sigStop <- true
+	}
+}
+
+// dispatch queues the given payload for delivery to the webhook URL as the given event.
+func (whd *webhookDispatcher) dispatch(url string, event string, payload interface{}) error {
+	// encode the payload
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	// make the delivery record leased to us
+	now := time.Now().UTC()
+	lease := now.Add(whdLeaseDuration)
+	job := webhookJob{
+		delivery: &types.WebhookDelivery{
+			ID:          newWebhookDeliveryID(),
+			Url:         url,
+			Event:       event,
+			Payload:     string(body),
+			Status:      types.WebhookStatusPending,
+			Created:     now,
+			Updated:     now,
+			NextAttempt: &lease,
+		},
+		body: body,
+	}
+
+	// persist the delivery first so it is not lost if we go down before it's made
+	whd.acquire(job.delivery.ID)
+	if err := repo.StoreWebhookDelivery(job.delivery); err != nil {
+		whd.release(job.delivery.ID)
+		return err
+	}
+
+	// queue the delivery; we don't block the caller if the queue is full,
+	// the pending delivery will be picked up from the delivery log once the lease expires
+	if !whd.enqueue(&job) {
+		log.Warningf("webhook dispatcher queue full, %s %s postponed", event, job.delivery.ID)
+	}
+	return nil
+}
+
+// enqueue pushes the given job to the delivery queue without blocking.
+// The busy mark of the job is released if the queue is full.
+func (whd *webhookDispatcher) enqueue(job *webhookJob) bool {
+	select {
+	case whd.queue <- job:
+		return true
+	default:
+		whd.release(job.delivery.ID)
+		return false
+	}
+}
+
+// acquire marks the given delivery as being handled by the dispatcher.
+// It returns false if the delivery has already been marked.
+func (whd *webhookDispatcher) acquire(id string) bool {
+	whd.busyMu.Lock()
+	defer whd.busyMu.Unlock()
+
+	if whd.busy[id] {
+		return false
+	}
+	whd.busy[id] = true
+	return true
+}
+
+// release removes the busy mark of the given delivery.
+func (whd *webhookDispatcher) release(id string) {
+	whd.busyMu.Lock()
+	defer whd.busyMu.Unlock()
+	delete(whd.busy, id)
+}
+
+// execute starts the delivery workers and feeds them with deliveries due
+// in the delivery log until the dispatcher is terminated.
+func (whd *webhookDispatcher) execute() {
+	done := make(chan bool)
+	var wg sync.WaitGroup
+
+	defer func() {
+		close(done)
+		wg.Wait()
+
+		close(whd.sigStop)
+		whd.mgr.finished(whd)
+	}()
+
+	// start the workers
+	workers := whd.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go whd.work(done, &wg)
+	}
+
+	whd.ticker = time.NewTicker(whdRetryTickerDuration)
+	for {
+		select {
+		case <-whd.sigStop:
+			return
+		case <-whd.ticker.C:
+			whd.retry()
+		}
+	}
+}
+
+// work delivers queued notifications until the done signal is received.
+func (whd *webhookDispatcher) work(done chan bool, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-done:
+			return
+		case job := <-whd.queue:
+			whd.deliver(job)
+			whd.release(job.delivery.ID)
+		}
+	}
+}
+
+// retry queues deliveries of the delivery log waiting for the first attempt,
+// or scheduled for a retry now, or earlier. Deliveries left behind by a previous
+// run of the server are picked up the same way.
+func (whd *webhookDispatcher) retry() {
+	list, err := repo.WebhookDeliveriesDue(time.Now().UTC(), whdRetryBatchSize)
+	if err != nil {
+		log.Errorf("can not load due webhook deliveries; %s", err.Error())
+		return
+	}
+
+	for _, wd := range list {
+		// skip deliveries we already handle
+		if !whd.acquire(wd.ID) {
+			continue
+		}
+
+		// make sure no other instance delivers it in parallel
+		ok, err := repo.ClaimWebhookDelivery(wd, time.Now().UTC().Add(whdLeaseDuration))
+		if err != nil || !ok {
+			whd.release(wd.ID)
+			continue
+		}
+
+		if !whd.enqueue(&webhookJob{delivery: wd, body: []byte(wd.Payload)}) {
+			return
+		}
+	}
+}
+
+// deliver performs a single delivery attempt of the given job and updates the delivery log.
+func (whd *webhookDispatcher) deliver(job *webhookJob) {
+	job.delivery.Attempts++
+	job.delivery.Updated = time.Now().UTC()
+	job.delivery.NextAttempt = nil
+
+	// try to deliver
+	code, err := whd.post(job)
+	if code != 0 {
+		c := int32(code)
+		job.delivery.LastCode = &c
+	}
+	if err == nil {
+		job.delivery.Status = types.WebhookStatusDelivered
+		job.delivery.LastError = nil
+		whd.store(job)
+		log.Debugf("webhook %s %s delivered to %s", job.delivery.Event, job.delivery.ID, job.delivery.Url)
+		return
+	}
+
+	// out of attempts?
+	if int(job.delivery.Attempts) >= whd.cfg.MaxAttempts {
+		whd.deadLetter(job, err.Error())
+		return
+	}
+
+	// schedule the next attempt with exponential back-off
+	msg := err.Error()
+	next := job.delivery.Updated.Add(whd.cfg.RetryDelay << uint(job.delivery.Attempts-1))
+	job.delivery.Status = types.WebhookStatusRetrying
+	job.delivery.LastError = &msg
+	job.delivery.NextAttempt = &next
+	whd.store(job)
+
+	log.Warningf("webhook %s %s to %s failed, retry at %s; %s", job.delivery.Event, job.delivery.ID, job.delivery.Url, next.Format(time.RFC3339), msg)
+}
+
+// deadLetter marks the given delivery as failed permanently.
+func (whd *webhookDispatcher) deadLetter(job *webhookJob, reason string) {
+	job.delivery.Status = types.WebhookStatusDead
+	job.delivery.LastError = &reason
+	job.delivery.Updated = time.Now().UTC()
+	whd.store(job)
+
+	log.Errorf("webhook %s %s to %s dead-lettered after %d attempts; %s", job.delivery.Event, job.delivery.ID, job.delivery.Url, job.delivery.Attempts, reason)
+}
+
+// store records the current state of the delivery in the delivery log.
+func (whd *webhookDispatcher) store(job *webhookJob) {
+	if err := repo.StoreWebhookDelivery(job.delivery); err != nil {
+		log.Errorf("can not log webhook delivery %s; %s", job.delivery.ID, err.Error())
+	}
+}
+
+// post sends the signed payload of the given job to the webhook URL.
+func (whd *webhookDispatcher) post(job *webhookJob) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, job.delivery.Url, bytes.NewReader(job.body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(whdHeaderEvent, job.delivery.Event)
+	req.Header.Set(whdHeaderDelivery, job.delivery.ID)
+	if whd.cfg.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(whdHeaderTimestamp, ts)
+		req.Header.Set(whdHeaderSignature, "sha256="+webhookSignature(whd.cfg.Secret, ts, job.body))
+	}
+
+	// make the call
+	client := whd.guarded
+	if whd.operator[job.delivery.Url] {
+		client = whd.client
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	// make sure to close the body
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Errorf("can not close webhook response body; %s", err.Error())
+		}
+	}()
+
+	// any 2xx response is fine
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// webhookSignature calculates the hex encoded HMAC-SHA256 signature of the given body
+// sent at the given UNIX timestamp. The signed message is the timestamp and the body
+// joined by a dot, so receivers can reject replayed notifications.
+func webhookSignature(secret string, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookDeliveryID generates a random identifier of a webhook delivery.
+func newWebhookDeliveryID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(id)
+}
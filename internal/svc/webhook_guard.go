@@ -0,0 +1,128 @@
+// Package svc implements blockchain data processing services.
+package svc
+
+import (
+	"axis-graphql/internal/config"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// webhookBlockedNets represents the address ranges user registered webhooks can not reach;
+// they cover loopback, private, link-local, shared, multicast and reserved networks,
+// including cloud instance metadata endpoints.
+var webhookBlockedNets = parseWebhookBlockedNets(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+// parseWebhookBlockedNets parses the given list of CIDR address ranges.
+func parseWebhookBlockedNets(cidr ...string) []*net.IPNet {
+	list := make([]*net.IPNet, len(cidr))
+	for i, c := range cidr {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		list[i] = n
+	}
+	return list
+}
+
+// IsWebhookAddressAllowed checks if a user registered webhook may be delivered to the given IP address.
+func IsWebhookAddressAllowed(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	for _, n := range webhookBlockedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateWebhookURL checks if the given URL is acceptable as a user registered webhook.
+// The URL must be absolute HTTP(S) and its host must resolve to public addresses only.
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" || u.User != nil {
+		return fmt.Errorf("invalid webhook URL")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil || len(ips) == 0 {
+		return fmt.Errorf("can not resolve webhook host %s", u.Hostname())
+	}
+	for _, ip := range ips {
+		if !IsWebhookAddressAllowed(ip.IP) {
+			return fmt.Errorf("webhook host %s resolves to a non-public address", u.Hostname())
+		}
+	}
+	return nil
+}
+
+// newGuardedWebhookClient creates an HTTP client for user registered webhooks.
+// The address is checked at dial time, after the name resolution, so neither DNS
+// rebinding nor redirects can make the dispatcher reach a non-public address.
+func newGuardedWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsWebhookAddressAllowed(net.ParseIP(host)) {
+				return fmt.Errorf("webhook address %s not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+	}
+}
+
+// operatorWebhooks collects the webhook URLs configured by the server operator.
+func operatorWebhooks(cfg *config.Config) map[string]bool {
+	list := make(map[string]bool)
+	for _, u := range []string{cfg.Notify.RewardReminder.Webhook, cfg.Notify.NodeAlert.Webhook, cfg.Registry.Webhook} {
+		if u != "" {
+			list[u] = true
+		}
+	}
+	return list
+}
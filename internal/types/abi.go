@@ -0,0 +1,50 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RegisteredAbi represents a custom ABI registered by an integrator
+// for decoding calls of a contract without validated source code.
+// The ABI is used for decoding only after it has been approved by an admin.
+type RegisteredAbi struct {
+	Address    common.Address `bson:"-"`
+	Abi        string         `bson:"abi"`
+	Registrar  string         `bson:"by"`
+	Registered time.Time      `bson:"ts"`
+	Approved   bool           `bson:"ok"`
+}
+
+// DecodedArgument represents a single decoded argument of a contract call.
+type DecodedArgument struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+// DecodedCall represents a contract call decoded using the contract ABI.
+type DecodedCall struct {
+	Method    string
+	Signature string
+	Args      []DecodedArgument
+}
+
+// DecodedLog represents a contract event log record decoded using the contract ABI.
+type DecodedLog struct {
+	Address   common.Address
+	Index     uint
+	Event     string
+	Signature string
+	Args      []DecodedArgument
+}
+
+// ContractCallResult represents the output of a read-only contract call
+// decoded using the contract ABI, if known.
+type ContractCallResult struct {
+	Output  []byte
+	Call    *DecodedCall
+	Outputs []DecodedArgument
+}
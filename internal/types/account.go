@@ -32,6 +32,7 @@ type Account struct {
 	Address      common.Address `json:"address"`
 	ContractTx   *common.Hash   `json:"contract"`
 	Type         string         `json:"type"`
+	FirstSeen    hexutil.Uint64 `json:"fts"`
 	LastActivity hexutil.Uint64 `json:"ats"`
 	TrxCounter   hexutil.Uint64 `json:"trc"`
 }
@@ -0,0 +1,19 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+const (
+	// FiAccountActivityAddress is the name of the account address field of the daily account activity.
+	FiAccountActivityAddress = "adr"
+
+	// FiAccountActivityStamp is the name of the day midnight time stamp field of the daily account activity.
+	FiAccountActivityStamp = "stamp"
+)
+
+// AccountActivityDay represents the number of transactions of an account on specific day.
+type AccountActivityDay struct {
+	Day   string    `bson:"day"`
+	Stamp time.Time `bson:"stamp"`
+	Count int64     `bson:"cnt"`
+}
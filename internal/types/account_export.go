@@ -0,0 +1,54 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Account export job states.
+const (
+	AccountExportPending  = "PENDING"
+	AccountExportRunning  = "RUNNING"
+	AccountExportFinished = "FINISHED"
+	AccountExportFailed   = "FAILED"
+)
+
+// FiAccountExportOwner is the name of the owner field of the account export record.
+const FiAccountExportOwner = "owner"
+
+// FiAccountExportStatus is the name of the status field of the account export record.
+const FiAccountExportStatus = "status"
+
+// FiAccountExportLease is the name of the worker lease field of the account export record.
+const FiAccountExportLease = "lease"
+
+// FiAccountExportPurge is the name of the removal time field of the account export record.
+const FiAccountExportPurge = "purge"
+
+// AccountExport represents an asynchronous export of account transactions
+// requested by an API client. The export file is available for download
+// until the export expires. Unfinished exports are leased by the worker building
+// the file; exports with an expired lease are picked up by another worker.
+type AccountExport struct {
+	ID       string         `bson:"_id"`
+	Owner    string         `bson:"owner"`
+	Address  common.Address `bson:"addr"`
+	Status   string         `bson:"status"`
+	Rows     uint64         `bson:"rows"`
+	Error    string         `bson:"err"`
+	Created  time.Time      `bson:"created"`
+	Finished *time.Time     `bson:"finished"`
+	Expires  *time.Time     `bson:"expires"`
+	Lease    *time.Time     `bson:"lease"`
+	Purge    time.Time      `bson:"purge"`
+}
+
+// AccountExportFile represents the stored file of a finished account export opened for download.
+type AccountExportFile struct {
+	io.ReadCloser
+	Length   int64
+	Modified time.Time
+}
@@ -0,0 +1,23 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+)
+
+// FiAddressGroupOwner is the name of the owner field of the address group record.
+const FiAddressGroupOwner = "owner"
+
+// FiAddressGroupName is the name of the name field of the address group record.
+const FiAddressGroupName = "name"
+
+// AddressGroup represents a named group of addresses linked together by an API client,
+// e.g. the wallets of a single user, so their state can be queried as a single portfolio.
+type AddressGroup struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	Name      string    `bson:"name"`
+	Addresses []string  `bson:"adr"`
+	Created   time.Time `bson:"created"`
+	Updated   time.Time `bson:"updated"`
+}
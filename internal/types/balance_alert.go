@@ -0,0 +1,80 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// FiBalanceAlertOwner is the name of the owner field of the balance alert record.
+const FiBalanceAlertOwner = "owner"
+
+// FiBalanceAlertAddress is the name of the watched address field of the balance alert record.
+const FiBalanceAlertAddress = "adr"
+
+// Balance alert states relative to the thresholds.
+const (
+	BalanceAlertBelow  = -1
+	BalanceAlertWithin = 0
+	BalanceAlertAbove  = 1
+)
+
+// BalanceAlert represents min and/or max balance thresholds registered by an API client
+// for the native, or an ERC20 token balance of an address. The alert is triggered each time
+// the balance moves below the min, above the max, or back in between.
+// Amounts are stored as hex encoded strings.
+type BalanceAlert struct {
+	ID        string     `bson:"_id"`
+	Owner     string     `bson:"owner"`
+	Address   string     `bson:"adr"`
+	Token     *string    `bson:"token"`
+	Min       *string    `bson:"min"`
+	Max       *string    `bson:"max"`
+	Webhook   string     `bson:"hook"`
+	State     int        `bson:"state"`
+	Balance   string     `bson:"bal"`
+	Created   time.Time  `bson:"created"`
+	Triggered *time.Time `bson:"triggered"`
+}
+
+// BalanceAlertEvent represents a notification about a balance crossing
+// the thresholds of a registered alert.
+type BalanceAlertEvent struct {
+	AlertID     string          `json:"alertId"`
+	Owner       string          `json:"-"`
+	Address     common.Address  `json:"address"`
+	Token       *common.Address `json:"token"`
+	Balance     hexutil.Big     `json:"balance"`
+	Min         *hexutil.Big    `json:"min"`
+	Max         *hexutil.Big    `json:"max"`
+	State       string          `json:"state"`
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	Stamp       int64           `json:"stamp"`
+}
+
+// BalanceAlertStateName provides the name of the balance alert state.
+func BalanceAlertStateName(state int) string {
+	switch state {
+	case BalanceAlertBelow:
+		return "below"
+	case BalanceAlertAbove:
+		return "above"
+	default:
+		return "within"
+	}
+}
+
+// BalanceState derives the state of a balance relative to the optional min and max thresholds.
+func BalanceState(bal *big.Int, min *big.Int, max *big.Int) int {
+	switch {
+	case min != nil && bal.Cmp(min) < 0:
+		return BalanceAlertBelow
+	case max != nil && bal.Cmp(max) > 0:
+		return BalanceAlertAbove
+	default:
+		return BalanceAlertWithin
+	}
+}
@@ -37,6 +37,9 @@ type Block struct {
 	// GasUsed represents the actual total used gas by all transactions in this block.
 	GasUsed hexutil.Uint64 `json:"gasUsed"`
 
+	// BaseFee represents the base fee per gas of the block, if the chain provides it.
+	BaseFee *hexutil.Big `json:"baseFeePerGas,omitempty"`
+
 	// TimeStamp represents the unix timestamp for when the block was collated.
 	TimeStamp hexutil.Uint64 `json:"timestamp"`
 
@@ -0,0 +1,50 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockHeader represents raw block header as provided by the Lachesis node
+// including consensus specific fields.
+type BlockHeader struct {
+	// Number represents the block number.
+	Number hexutil.Uint64 `json:"number"`
+
+	// Hash represents hash of the block; on Lachesis it's the id of the Atropos event.
+	Hash common.Hash `json:"hash"`
+
+	// ParentHash represents hash of the parent block.
+	ParentHash common.Hash `json:"parentHash"`
+
+	// Miner represents the address of the beneficiary of the block.
+	Miner common.Address `json:"miner"`
+
+	// StateRoot represents the hash of the trie state root.
+	StateRoot common.Hash `json:"stateRoot"`
+
+	// TxRoot represents the hash of the block transactions trie.
+	TxRoot common.Hash `json:"transactionsRoot"`
+
+	// ReceiptsRoot represents the hash of the block receipts trie.
+	ReceiptsRoot common.Hash `json:"receiptsRoot"`
+
+	// GasLimit represents the maximum gas allowed in this block.
+	GasLimit hexutil.Uint64 `json:"gasLimit"`
+
+	// GasUsed represents the actual total used gas by all transactions in this block.
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+
+	// TimeStamp represents the unix timestamp of the block.
+	TimeStamp hexutil.Uint64 `json:"timestamp"`
+
+	// TimeStampNano represents the timestamp of the block in nanoseconds, if available.
+	TimeStampNano *hexutil.Uint64 `json:"timestampNano"`
+
+	// Epoch represents the consensus epoch of the block, if available.
+	Epoch *hexutil.Uint64 `json:"epoch"`
+
+	// ExtraData represents the extra data field of the block.
+	ExtraData hexutil.Bytes `json:"extraData"`
+}
@@ -0,0 +1,44 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// BlockProduction represents the observed production of new blocks by the network.
+type BlockProduction struct {
+	// LastBlock represents the number of the last observed block.
+	LastBlock uint64
+
+	// LastBlockTime represents the time the last block has been observed.
+	LastBlockTime time.Time
+
+	// Threshold represents the max expected gap between two blocks.
+	Threshold time.Duration
+
+	// Stalled signals no block has been observed for longer than the threshold.
+	Stalled bool
+
+	// Gaps represents the number of abnormal gaps detected.
+	Gaps uint64
+
+	// LastGap represents the duration of the last finished abnormal gap.
+	LastGap time.Duration
+
+	// LongestGap represents the duration of the longest finished abnormal gap.
+	LongestGap time.Duration
+}
+
+// BlockGapEvent represents a change of the block production state.
+type BlockGapEvent struct {
+	// Stalled signals no block has been observed for longer than the threshold;
+	// false if new blocks are being produced again.
+	Stalled bool
+
+	// LastBlock represents the number of the last observed block.
+	LastBlock uint64
+
+	// Gap represents the time since the previous block.
+	Gap time.Duration
+
+	// Stamp represents the time of the change.
+	Stamp time.Time
+}
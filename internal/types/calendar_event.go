@@ -0,0 +1,45 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Types of the upcoming time-based events.
+const (
+	CalendarEventLockExpiration = "LOCK_EXPIRATION"
+	CalendarEventWithdrawal     = "WITHDRAWAL_AVAILABLE"
+	CalendarEventVotingOpens    = "VOTING_OPENS"
+	CalendarEventVotingMayEnd   = "VOTING_MAY_END"
+	CalendarEventVotingMustEnd  = "VOTING_MUST_END"
+)
+
+// CalendarEvent represents a known future time-based event relevant to an address,
+// e.g. a stake lock expiration, or a governance voting deadline.
+type CalendarEvent struct {
+	// Type is the type of the event, e.g. CalendarEventLockExpiration.
+	Type string
+
+	// Time is the UNIX time stamp the event happens at.
+	Time hexutil.Uint64
+
+	// Address is the address the event relates to; nil for governance events shared by all addresses.
+	Address *common.Address
+
+	// ValidatorID is the validator of the delegation involved; delegation events only.
+	ValidatorID *hexutil.Big
+
+	// Amount is the amount of tokens involved; delegation events only.
+	Amount *hexutil.Big
+
+	// WithdrawRequestID is the identifier of the withdraw request; withdrawal only.
+	WithdrawRequestID *hexutil.Big
+
+	// Governance and ProposalID identify the proposal; governance events only.
+	Governance *common.Address
+	ProposalID *hexutil.Big
+
+	// Title is a human readable description of the event.
+	Title string
+}
@@ -0,0 +1,42 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FiCollateralAlertOwner is the name of the owner field of the collateral alert record.
+const FiCollateralAlertOwner = "owner"
+
+// FiCollateralAlertAddress is the name of the watched address field of the collateral alert record.
+const FiCollateralAlertAddress = "adr"
+
+// CollateralAlert represents a collateral ratio threshold registered by an API client
+// for an fMint account. The alert is triggered each time the collateral to debt ratio
+// of the account crosses the threshold in either direction.
+type CollateralAlert struct {
+	ID         string     `bson:"_id"`
+	Owner      string     `bson:"owner"`
+	Address    string     `bson:"adr"`
+	Threshold4 int64      `bson:"thr"`
+	Webhook    *string    `bson:"hook"`
+	Below      bool       `bson:"below"`
+	Ratio4     *int64     `bson:"ratio"`
+	Created    time.Time  `bson:"created"`
+	Triggered  *time.Time `bson:"triggered"`
+}
+
+// CollateralAlertEvent represents a notification about collateral ratio of an fMint account
+// crossing the threshold of a registered alert.
+type CollateralAlertEvent struct {
+	AlertID      string         `json:"alertId"`
+	Owner        string         `json:"-"`
+	Address      common.Address `json:"address"`
+	Threshold4   int64          `json:"threshold4"`
+	Ratio4       *int64         `json:"ratio4"`
+	Below        bool           `json:"below"`
+	BelowMinimum bool           `json:"belowMinimum"`
+	Stamp        int64          `json:"stamp"`
+}
@@ -0,0 +1,14 @@
+// Package types implements different core types of the API.
+package types
+
+// ContractMethodStat represents the usage statistics of a method of a contract
+// aggregated from calls indexed in a time period.
+type ContractMethodStat struct {
+	Selector    string  `bson:"_id"`
+	Name        *string `bson:"-"`
+	Calls       uint64  `bson:"calls"`
+	Failed      uint64  `bson:"failed"`
+	GasUsed     uint64  `bson:"gas"`
+	AvgDataSize float64 `bson:"size"`
+	MaxDataSize uint64  `bson:"max_size"`
+}
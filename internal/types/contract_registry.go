@@ -0,0 +1,12 @@
+// Package types implements different core types of the API.
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// ContractRegistryChange represents a change of a core contract address
+// resolved from the on-chain address registry.
+type ContractRegistryChange struct {
+	Name     string         `json:"name"`
+	Previous common.Address `json:"previous"`
+	Current  common.Address `json:"current"`
+}
@@ -0,0 +1,86 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// FiContractUpgradeProxy is the name of the proxy address field of the contract upgrade record.
+	FiContractUpgradeProxy = "proxy"
+
+	// FiContractUpgradeOrdinal is the name of the ordinal index field of the contract upgrade record.
+	FiContractUpgradeOrdinal = "orx"
+)
+
+// ContractUpgrade represents an upgrade of an EIP-1967 proxy contract
+// captured from the Upgraded, or BeaconUpgraded event of the proxy.
+type ContractUpgrade struct {
+	Proxy          common.Address
+	Implementation common.Address
+	IsBeacon       bool
+	TrxHash        common.Hash
+	LogIndex       uint
+	BlockNumber    hexutil.Uint64
+	TimeStamp      hexutil.Uint64
+}
+
+// BsonContractUpgrade represents the contract upgrade data structure for BSON formatting.
+type BsonContractUpgrade struct {
+	ID             string    `bson:"_id"`
+	Ordinal        int64     `bson:"orx"`
+	Proxy          string    `bson:"proxy"`
+	Implementation string    `bson:"impl"`
+	IsBeacon       bool      `bson:"beacon"`
+	Trx            string    `bson:"trx"`
+	LogIndex       int64     `bson:"lix"`
+	Block          int64     `bson:"blk"`
+	TimeStamp      time.Time `bson:"stamp"`
+}
+
+// Pk generates unique identifier of the contract upgrade record.
+func (cu *ContractUpgrade) Pk() string {
+	return fmt.Sprintf("%s:%d", cu.TrxHash.String(), cu.LogIndex)
+}
+
+// OrdinalIndex returns an ordinal index of the upgrade in the chain.
+func (cu *ContractUpgrade) OrdinalIndex() int64 {
+	return (int64(cu.BlockNumber)<<14)&0x7FFFFFFFFFFFFFFF | (int64(cu.LogIndex) & 0x3fff)
+}
+
+// MarshalBSON creates a BSON representation of the contract upgrade record.
+func (cu *ContractUpgrade) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(BsonContractUpgrade{
+		ID:             cu.Pk(),
+		Ordinal:        cu.OrdinalIndex(),
+		Proxy:          cu.Proxy.String(),
+		Implementation: cu.Implementation.String(),
+		IsBeacon:       cu.IsBeacon,
+		Trx:            cu.TrxHash.String(),
+		LogIndex:       int64(cu.LogIndex),
+		Block:          int64(cu.BlockNumber),
+		TimeStamp:      time.Unix(int64(cu.TimeStamp), 0).UTC(),
+	})
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (cu *ContractUpgrade) UnmarshalBSON(data []byte) (err error) {
+	var row BsonContractUpgrade
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	cu.Proxy = common.HexToAddress(row.Proxy)
+	cu.Implementation = common.HexToAddress(row.Implementation)
+	cu.IsBeacon = row.IsBeacon
+	cu.TrxHash = common.HexToHash(row.Trx)
+	cu.LogIndex = uint(row.LogIndex)
+	cu.BlockNumber = (hexutil.Uint64)(row.Block)
+	cu.TimeStamp = (hexutil.Uint64)(row.TimeStamp.Unix())
+	return nil
+}
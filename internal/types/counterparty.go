@@ -0,0 +1,40 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CounterpartyPeriods maps the supported counterparty periods to their length.
+// Zero length means the whole known history of the account.
+var CounterpartyPeriods = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+	"year":  365 * 24 * time.Hour,
+	"all":   0,
+}
+
+// CounterpartyAggregate represents a single row of the counterparties aggregation.
+type CounterpartyAggregate struct {
+	Address        *string `bson:"_id"`
+	Count          int64   `bson:"count"`
+	SentCount      int64   `bson:"sent"`
+	ReceivedCount  int64   `bson:"received"`
+	SentAmount     int64   `bson:"sent_amo"`
+	ReceivedAmount int64   `bson:"received_amo"`
+}
+
+// Counterparty represents an address an account interacted with
+// along with the number and volume of the interactions.
+type Counterparty struct {
+	Address        common.Address
+	TrxCount       uint64
+	SentCount      uint64
+	ReceivedCount  uint64
+	SentVolume     *big.Int
+	ReceivedVolume *big.Int
+}
@@ -0,0 +1,48 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DagEvent represents a consensus event of the Lachesis DAG.
+type DagEvent struct {
+	// Id represents the id (hash) of the event.
+	Id common.Hash `json:"id"`
+
+	// Epoch represents the epoch of the event.
+	Epoch hexutil.Uint64 `json:"epoch"`
+
+	// Seq represents the sequence number of the event within events of the creator.
+	Seq hexutil.Uint64 `json:"seq"`
+
+	// Frame represents the frame of the event.
+	Frame hexutil.Uint64 `json:"frame"`
+
+	// Creator represents the validator ID of the event creator.
+	Creator hexutil.Uint64 `json:"creator"`
+
+	// Lamport represents the Lamport time of the event.
+	Lamport hexutil.Uint64 `json:"lamport"`
+
+	// CreationTime represents the creation time of the event in nanoseconds.
+	CreationTime hexutil.Uint64 `json:"creationTime"`
+
+	// MedianTime represents the median time of the event in nanoseconds.
+	MedianTime hexutil.Uint64 `json:"medianTime"`
+
+	// Parents represents the list of ids of the parent events.
+	Parents []common.Hash `json:"parents"`
+
+	// Sig represents the signature of the event id made by the creator.
+	Sig hexutil.Bytes `json:"sig"`
+}
+
+// DagEventPayload represents a consensus event of the Lachesis DAG along with its payload.
+type DagEventPayload struct {
+	DagEvent
+
+	// Transactions represents the list of hashes of transactions included in the event.
+	Transactions []common.Hash `json:"transactions"`
+}
@@ -0,0 +1,24 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Decentralization represents stake distribution metrics of the validator set of an epoch.
+type Decentralization struct {
+	// Epoch is the identifier of the epoch.
+	Epoch hexutil.Uint64
+
+	// TotalStake is the total stake of the epoch validator set.
+	TotalStake hexutil.Big
+
+	// Stakes is the list of stakes received by the validators of the set sorted from the largest.
+	Stakes []*big.Int
+
+	// NakamotoCoefficient is the minimal number of validators controlling
+	// more than one third of the total stake, enough to halt the consensus.
+	NakamotoCoefficient int32
+}
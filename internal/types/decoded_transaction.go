@@ -0,0 +1,39 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DecodedTransaction represents a raw transaction decoded without broadcasting it.
+type DecodedTransaction struct {
+	// Hash is the hash of the transaction; nil for unsigned transactions.
+	Hash *common.Hash
+
+	// Type is the EIP-2718 type of the transaction envelope.
+	Type hexutil.Uint64
+
+	// ChainId is the id of the chain the transaction is valid for, if known.
+	ChainId *hexutil.Big
+
+	// Signed signals the transaction carries a signature.
+	Signed bool
+
+	// From is the sender recovered from the signature; nil for unsigned transactions.
+	From *common.Address
+
+	// To is the recipient of the transaction; nil for contract deployments.
+	To *common.Address
+
+	Nonce                hexutil.Uint64
+	Gas                  hexutil.Uint64
+	GasPrice             hexutil.Big
+	MaxFeePerGas         *hexutil.Big
+	MaxPriorityFeePerGas *hexutil.Big
+	Value                hexutil.Big
+	InputData            hexutil.Bytes
+
+	// Call is the input data decoded by a known ABI, if any.
+	Call *DecodedCall
+}
@@ -0,0 +1,30 @@
+// Package types implements different core types of the API.
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// DefiOverview represents network-wide aggregates of the DeFi modules
+// suitable for external aggregators. All the values are denominated in fUSD
+// with 18 decimals.
+type DefiOverview struct {
+	// StakingTvl represents the value of the native tokens staked in SFC.
+	StakingTvl hexutil.Big `json:"stakingTvl"`
+
+	// FMintTvl represents the value of the collateral locked in fMint.
+	FMintTvl hexutil.Big `json:"fMintTvl"`
+
+	// AmmTvl represents the value of the reserves of the known AMM pairs.
+	AmmTvl hexutil.Big `json:"ammTvl"`
+
+	// Tvl represents the total value locked across all the modules.
+	Tvl hexutil.Big `json:"tvl"`
+
+	// Volume24h represents the AMM swap volume of the last 24 hours.
+	Volume24h hexutil.Big `json:"volume24h"`
+
+	// Volume7d represents the AMM swap volume of the last 7 days.
+	Volume7d hexutil.Big `json:"volume7d"`
+
+	// Updated represents the UNIX time stamp of the aggregates calculation.
+	Updated hexutil.Uint64 `json:"updated"`
+}
@@ -0,0 +1,40 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DeFi token price sources
+const (
+	// DefiPriceSourceOracle represents a price provided by the on-chain price oracle.
+	DefiPriceSourceOracle = "ORACLE"
+
+	// DefiPriceSourceAmmTwap represents a time weighted average price calculated
+	// from the indexed reserves of an AMM pair.
+	DefiPriceSourceAmmTwap = "AMM_TWAP"
+
+	// DefiPriceSourceNone represents a price not available from any source.
+	DefiPriceSourceNone = "NONE"
+)
+
+// DefiTokenPrice represents the price of a DeFi token along with its provenance.
+type DefiTokenPrice struct {
+	// Price is the value of the token in the reference denomination.
+	Price hexutil.Big
+
+	// Source is the source of the price.
+	Source string
+}
+
+// DefiReserveSample represents reserves of an AMM pair observed at the given time.
+type DefiReserveSample struct {
+	// Time is the UNIX time stamp of the observation.
+	Time int64
+
+	// Reserve0 and Reserve1 are the reserves of the pair tokens.
+	Reserve0 *big.Int
+	Reserve1 *big.Int
+}
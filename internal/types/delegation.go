@@ -37,8 +37,18 @@ const (
 	// FiDelegationValue defines value of the delegation column of the delegation table.
 	FiDelegationValue = "val"
 
+	// FiDelegationCreated defines the creation time column of the delegation table.
+	FiDelegationCreated = "crt"
+
 	// FiDelegationStamp defines time stamp column of the delegation table.
 	FiDelegationStamp = "stamp"
+
+	// FiDelegationLockedUntil defines the lock end time stamp column of the delegation table.
+	FiDelegationLockedUntil = "lck"
+
+	// FiDelegationOrigin defines the flag of the delegation table signaling the creation
+	// time and transaction come from the original delegation event.
+	FiDelegationOrigin = "orig"
 )
 
 // Delegation represents a delegator in AXIS blockchain.
@@ -56,6 +66,10 @@ type Delegation struct {
 
 	// AmountDelegated is the original amount delegated
 	AmountDelegated *hexutil.Big `json:"amountDelegated"`
+
+	// OriginKnown signals the creation time and transaction come from
+	// the original delegation event, not from a later event on the delegation.
+	OriginKnown bool `json:"originKnown"`
 }
 
 // DelegationDecimalsCorrection is used to adjust decimal precision of a delegation active value.
@@ -85,6 +99,7 @@ func (dl *Delegation) MarshalBSON() ([]byte, error) {
 		Active string    `bson:"act"`
 		Value  uint64    `bson:"val"`
 		Stamp  time.Time `bson:"stamp"`
+		Origin bool      `bson:"orig"`
 	}{
 		Orx:    dl.OrdinalIndex(),
 		Trx:    dl.Transaction.String(),
@@ -96,6 +111,7 @@ func (dl *Delegation) MarshalBSON() ([]byte, error) {
 		Active: dl.AmountDelegated.String(),
 		Value:  val,
 		Stamp:  time.Unix(int64(dl.CreatedTime), 0),
+		Origin: dl.OriginKnown,
 	})
 }
 
@@ -120,6 +136,7 @@ func (dl *Delegation) UnmarshalBSON(data []byte) (err error) {
 		Active string    `bson:"act"`
 		Value  uint64    `bson:"val"`
 		Stamp  time.Time `bson:"stamp"`
+		Origin bool      `bson:"orig"`
 	}
 	if err = bson.Unmarshal(data, &row); err != nil {
 		return err
@@ -135,5 +152,6 @@ func (dl *Delegation) UnmarshalBSON(data []byte) (err error) {
 	dl.AmountStaked = (*hexutil.Big)(hexutil.MustDecodeBig(row.Staked))
 	dl.AmountDelegated = (*hexutil.Big)(hexutil.MustDecodeBig(row.Active))
 	dl.Index = row.Orx
+	dl.OriginKnown = row.Origin
 	return nil
 }
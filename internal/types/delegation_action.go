@@ -0,0 +1,33 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Delegation actions recommended to a delegator.
+const (
+	DelegationActionClaim          = "CLAIM"
+	DelegationActionWithdraw       = "WITHDRAW"
+	DelegationActionRelock         = "RELOCK"
+	DelegationActionUndelegateDust = "UNDELEGATE_DUST"
+)
+
+// DelegationAction represents an action a delegator should perform on one of its delegations,
+// e.g. claim pending rewards or withdraw un-delegated stake after the withdrawal period.
+type DelegationAction struct {
+	// Action is the type of the recommended action, e.g. DelegationActionClaim.
+	Action string
+
+	// ValidatorID is the identifier of the validator the delegation belongs to.
+	ValidatorID hexutil.Big
+
+	// Amount is the amount of tokens involved in the action.
+	Amount hexutil.Big
+
+	// WithdrawRequestID is the identifier of the withdraw request to be finalized; withdraw only.
+	WithdrawRequestID *hexutil.Big
+
+	// Reason is a human readable explanation of the recommendation.
+	Reason string
+}
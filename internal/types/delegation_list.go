@@ -3,6 +3,25 @@ package types
 
 import "go.mongodb.org/mongo-driver/bson"
 
+// Delegation list ordering options.
+const (
+	DelegationOrderCreatedTime    = "CREATED_TIME"
+	DelegationOrderAmount         = "AMOUNT"
+	DelegationOrderPendingRewards = "PENDING_REWARDS"
+)
+
+// DelegationListOptions represents the ordering and filtering of a list of delegations.
+type DelegationListOptions struct {
+	// OrderBy is the ordering of the list; the creation time is used if empty.
+	OrderBy string
+
+	// LockedOnly limits the list to delegations with locked stake.
+	LockedOnly bool
+
+	// ActiveOnly limits the list to delegations with non-zero active amount.
+	ActiveOnly bool
+}
+
 // DelegationList represents a list of delegations.
 type DelegationList struct {
 	// List keeps the actual Collection.
@@ -1,7 +1,11 @@
 // Package types implements different core types of the API.
 package types
 
-import "github.com/ethereum/go-ethereum/common/hexutil"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
 
 // DelegationLock represents a lock related to a delegation
 type DelegationLock struct {
@@ -10,3 +14,9 @@ type DelegationLock struct {
 	LockedUntil     hexutil.Uint64 `json:"endTime"`
 	Duration        hexutil.Uint64 `json:"duration"`
 }
+
+// IsExpired checks if the lock ended while the stake is still marked as locked.
+// Such a stake can be re-locked, or unlocked without any penalty.
+func (dl *DelegationLock) IsExpired() bool {
+	return dl != nil && dl.LockedAmount.ToInt().Sign() > 0 && uint64(dl.LockedUntil) <= uint64(time.Now().UTC().Unix())
+}
@@ -0,0 +1,15 @@
+// Package types implements different core types of the API.
+package types
+
+// EnrichmentTag represents a piece of off-chain data decorating an account,
+// or a contract, e.g. a KYC tag, a dApp name, or an audit status.
+type EnrichmentTag struct {
+	// Source is the name of the enricher which provided the tag.
+	Source string
+
+	// Key identifies the kind of the data, e.g. "dapp" or "audit".
+	Key string
+
+	// Value is the data itself.
+	Value string
+}
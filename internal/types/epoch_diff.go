@@ -0,0 +1,48 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EpochDiff represents the changes of the validator set and the supply between two sealed epochs.
+type EpochDiff struct {
+	// From is the identifier of the base epoch.
+	From hexutil.Uint64
+
+	// To is the identifier of the compared epoch.
+	To hexutil.Uint64
+
+	// TotalStakeFrom is the total stake of the validator set of the base epoch.
+	TotalStakeFrom hexutil.Big
+
+	// TotalStakeTo is the total stake of the validator set of the compared epoch.
+	TotalStakeTo hexutil.Big
+
+	// TotalSupplyFrom is the total supply at the end of the base epoch.
+	TotalSupplyFrom hexutil.Big
+
+	// TotalSupplyTo is the total supply at the end of the compared epoch.
+	TotalSupplyTo hexutil.Big
+
+	// Validators is the list of stake changes of the validators sorted from the largest absolute change.
+	Validators []*EpochValidatorDiff
+}
+
+// EpochValidatorDiff represents the change of the stake received by a validator between two sealed epochs.
+type EpochValidatorDiff struct {
+	// ValidatorId is the identifier of the validator.
+	ValidatorId hexutil.Big
+
+	// StakeFrom is the stake received by the validator in the base epoch; zero if not in the set.
+	StakeFrom hexutil.Big
+
+	// StakeTo is the stake received by the validator in the compared epoch; zero if not in the set.
+	StakeTo hexutil.Big
+
+	// IsNew signals the validator joined the set after the base epoch.
+	IsNew bool
+
+	// IsRemoved signals the validator left the set before the compared epoch.
+	IsRemoved bool
+}
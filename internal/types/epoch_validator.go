@@ -0,0 +1,78 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// FiEpochValidatorEpoch is the name of the epoch field of the epoch validator record.
+	FiEpochValidatorEpoch = "epoch"
+
+	// FiEpochValidatorID is the name of the validator id field of the epoch validator record.
+	FiEpochValidatorID = "vid"
+)
+
+// EpochValidator represents a member of the validator set of an epoch
+// as captured by the SFC epoch snapshot.
+type EpochValidator struct {
+	Epoch         hexutil.Uint64
+	ValidatorId   hexutil.Big
+	ReceivedStake hexutil.Big
+	OriginatedFee hexutil.Big
+	Uptime        hexutil.Uint64
+}
+
+// BsonEpochValidator represents the epoch validator data structure for BSON formatting.
+type BsonEpochValidator struct {
+	ID            string `bson:"_id"`
+	Epoch         int64  `bson:"epoch"`
+	ValidatorId   int64  `bson:"vid"`
+	ReceivedStake string `bson:"stake"`
+	OriginatedFee string `bson:"fee"`
+	Uptime        int64  `bson:"uptime"`
+}
+
+// Pk generates unique identifier of the epoch validator record.
+func (ev *EpochValidator) Pk() string {
+	return fmt.Sprintf("%d:%d", uint64(ev.Epoch), ev.ValidatorId.ToInt().Uint64())
+}
+
+// MarshalBSON creates a BSON representation of the epoch validator record.
+func (ev *EpochValidator) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(BsonEpochValidator{
+		ID:            ev.Pk(),
+		Epoch:         int64(ev.Epoch),
+		ValidatorId:   ev.ValidatorId.ToInt().Int64(),
+		ReceivedStake: ev.ReceivedStake.String(),
+		OriginatedFee: ev.OriginatedFee.String(),
+		Uptime:        int64(ev.Uptime),
+	})
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (ev *EpochValidator) UnmarshalBSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not decode stored epoch validator")
+		}
+	}()
+
+	// try to decode BSON data
+	var row BsonEpochValidator
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	// transfer the data points
+	ev.Epoch = (hexutil.Uint64)(row.Epoch)
+	ev.ValidatorId = (hexutil.Big)(*new(big.Int).SetInt64(row.ValidatorId))
+	ev.ReceivedStake = (hexutil.Big)(*hexutil.MustDecodeBig(row.ReceivedStake))
+	ev.OriginatedFee = (hexutil.Big)(*hexutil.MustDecodeBig(row.OriginatedFee))
+	ev.Uptime = (hexutil.Uint64)(row.Uptime)
+	return nil
+}
@@ -36,6 +36,10 @@ const (
 
 	// TokenTrxTypeApprovalForAll represents universal token transfer approval.
 	TokenTrxTypeApprovalForAll = 5
+
+	// TokenBurnAddress represents the conventional address tokens are sent to be burned
+	// on contracts not allowing transfers into the zero address.
+	TokenBurnAddress = "0x000000000000000000000000000000000000dEaD"
 )
 
 // TokenTransaction represents an operation with ERC20 token.
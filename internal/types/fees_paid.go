@@ -0,0 +1,52 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// FeesPaidResolutionDay represents daily periods of the fees paid report.
+	FeesPaidResolutionDay = "day"
+
+	// FeesPaidResolutionMonth represents monthly periods of the fees paid report.
+	FeesPaidResolutionMonth = "month"
+)
+
+// FeesPaidAggregate represents a single row of fees paid aggregation
+// keyed by the period and the recipient of the transactions.
+type FeesPaidAggregate struct {
+	Period    string
+	Recipient *string
+	GasFee    *big.Int
+	Count     int64
+}
+
+// FeesPaidContract represents the fees paid by an address to interact
+// with a specific contract, or recipient, in a period.
+type FeesPaidContract struct {
+	Address  *common.Address
+	Fee      *big.Int
+	TrxCount uint64
+}
+
+// FeesPaidPeriod represents the fees paid by an address in a period.
+type FeesPaidPeriod struct {
+	Period    string
+	Fee       *big.Int
+	TrxCount  uint64
+	Contracts []*FeesPaidContract
+}
+
+// FeesPaidReport represents the total fees paid by an address in a time range.
+type FeesPaidReport struct {
+	Address  common.Address
+	From     time.Time
+	To       time.Time
+	Fee      *big.Int
+	TrxCount uint64
+	Periods  []*FeesPaidPeriod
+}
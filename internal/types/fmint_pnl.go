@@ -0,0 +1,56 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// FMintTokenPnL represents the profit and loss of an fMint account position
+// on a single token. All the values are denominated in fUSD.
+type FMintTokenPnL struct {
+	// Token is the address of the token of the position.
+	Token common.Address
+
+	// Type represents the side of the position, either collateral, or debt.
+	Type DefiTokenType
+
+	// Amount is the amount of tokens in the open position
+	// as reconstructed from the position history.
+	Amount hexutil.Big
+
+	// CostBasis is the value of the open position at the average entry price.
+	CostBasis hexutil.Big
+
+	// Realized is the profit, or loss, of the closed part of the position.
+	Realized hexutil.Big
+
+	// Unrealized is the profit, or loss, of the open position at the current price.
+	Unrealized hexutil.Big
+
+	// Fees is the total amount of fees paid on the position.
+	Fees hexutil.Big
+}
+
+// FMintAccountPnL represents the profit and loss of an fMint account
+// aggregated over all its positions. All the values are denominated in fUSD.
+type FMintAccountPnL struct {
+	// Address of the fMint account.
+	Address common.Address
+
+	// Positions is the list of token positions of the account.
+	Positions []*FMintTokenPnL
+
+	// Realized is the total profit, or loss, of the closed positions.
+	Realized hexutil.Big
+
+	// Unrealized is the total profit, or loss, of the open positions.
+	Unrealized hexutil.Big
+
+	// Fees is the total amount of fees paid.
+	Fees hexutil.Big
+
+	// NativePrice is the current price of the native token in fUSD
+	// used to express the PnL in native terms; zero if not available.
+	NativePrice hexutil.Big
+}
@@ -0,0 +1,28 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// FiFMintSnapshotAddress is the name of the fMint account address field of the position snapshot.
+	FiFMintSnapshotAddress = "adr"
+
+	// FiFMintSnapshotStamp is the name of the time stamp field of the position snapshot.
+	FiFMintSnapshotStamp = "stamp"
+)
+
+// FMintPositionSnapshot represents the state of an open fMint position at a point in time.
+type FMintPositionSnapshot struct {
+	Address         common.Address
+	Epoch           hexutil.Uint64
+	Stamp           time.Time
+	CollateralValue hexutil.Big
+	DebtValue       hexutil.Big
+	Ratio4          *int64
+	MinRatio4       int64
+}
@@ -45,6 +45,7 @@ type FMintTransaction struct {
 	Type         int32
 	Amount       hexutil.Big
 	Fee          hexutil.Big
+	Price        hexutil.Big
 	TrxHash      common.Hash
 	TrxIndex     int64
 	TimeStamp    hexutil.Uint64
@@ -87,6 +88,7 @@ func (ftx *FMintTransaction) MarshalBSON() ([]byte, error) {
 		Token     string    `bson:"tok"`
 		Amount    string    `bson:"amo"`
 		Fee       string    `bson:"fee"`
+		Price     string    `bson:"prc"`
 		Trx       string    `bson:"trx"`
 		TrxIndex  int64     `bson:"tix"`
 		TimeStamp time.Time `bson:"stamp"`
@@ -102,6 +104,7 @@ func (ftx *FMintTransaction) MarshalBSON() ([]byte, error) {
 		TrxIndex:  ftx.TrxIndex,
 		Amount:    ftx.Amount.String(),
 		Fee:       ftx.Fee.String(),
+		Price:     ftx.Price.String(),
 		TimeStamp: time.Unix(int64(ftx.TimeStamp), 0),
 		Value:     val,
 		FeeValue:  fee,
@@ -125,6 +128,7 @@ func (ftx *FMintTransaction) UnmarshalBSON(data []byte) (err error) {
 		Token     string    `bson:"tok"`
 		Amount    string    `bson:"amo"`
 		Fee       string    `bson:"fee"`
+		Price     string    `bson:"prc"`
 		TrxHash   string    `bson:"trx"`
 		TrxIndex  int64     `bson:"tix"`
 		TimeStamp time.Time `bson:"stamp"`
@@ -142,5 +146,10 @@ func (ftx *FMintTransaction) UnmarshalBSON(data []byte) (err error) {
 	ftx.Amount = (hexutil.Big)(*hexutil.MustDecodeBig(row.Amount))
 	ftx.Fee = (hexutil.Big)(*hexutil.MustDecodeBig(row.Fee))
 	ftx.TimeStamp = (hexutil.Uint64)(uint64(row.TimeStamp.Unix()))
+
+	// the price is not available on records collected before it was introduced
+	if row.Price != "" {
+		ftx.Price = (hexutil.Big)(*hexutil.MustDecodeBig(row.Price))
+	}
 	return nil
 }
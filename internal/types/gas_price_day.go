@@ -0,0 +1,17 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// DailyGasPrice represents a distribution of gas prices paid by transactions on specific day.
+// The prices are kept in GWei x100 units, the same way the transactions keep them.
+// A closed day has been calculated after all its transactions were indexed and is not updated again.
+type DailyGasPrice struct {
+	Day    string    `bson:"_id"`
+	Stamp  time.Time `bson:"stamp"`
+	Count  int64     `bson:"cnt"`
+	P25    int64     `bson:"p25"`
+	P50    int64     `bson:"p50"`
+	P90    int64     `bson:"p90"`
+	Closed bool      `bson:"closed"`
+}
@@ -0,0 +1,28 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// IntegrityIssue represents a discrepancy found by a data integrity check.
+type IntegrityIssue struct {
+	Check    string
+	Subject  string
+	Expected string
+	Actual   string
+	Detail   string
+	Detected time.Time
+}
+
+// IntegrityCheckState represents the state of a data integrity check.
+type IntegrityCheckState struct {
+	Name    string
+	LastRun time.Time
+	Checked uint64
+	Failed  uint64
+}
+
+// IntegrityReport represents the summary of data integrity checks.
+type IntegrityReport struct {
+	Checks []IntegrityCheckState
+	Issues []IntegrityIssue
+}
@@ -0,0 +1,37 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NetworkIdentity represents the identity of the blockchain network served by the API.
+type NetworkIdentity struct {
+	// ChainID represents the id of the chain.
+	ChainID hexutil.Big
+
+	// Name represents the human readable name of the network.
+	Name string
+
+	// Symbol represents the symbol of the native token.
+	Symbol string
+
+	// Decimals represents the number of decimals of the native token.
+	Decimals int32
+
+	// SfcContract represents the address of the SFC contract.
+	SfcContract common.Address
+
+	// TokenizerContract represents the address of the stake tokenizer contract, if deployed.
+	TokenizerContract *common.Address
+
+	// FMintContract represents the address of the fMint core contract, if deployed.
+	FMintContract *common.Address
+
+	// GenesisHash represents the hash of the genesis block.
+	GenesisHash common.Hash
+
+	// GenesisTime represents the time stamp of the genesis block.
+	GenesisTime hexutil.Uint64
+}
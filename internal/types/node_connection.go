@@ -0,0 +1,22 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// NodeConnectionEvent represents a change of the blockchain node connection state.
+type NodeConnectionEvent struct {
+	// Connected signals the connection has been restored; false if it has been lost.
+	Connected bool
+
+	// Reason represents the error which broke the connection, if lost.
+	Reason string
+
+	// Attempts represents the number of reconnect attempts made before the connection was restored.
+	Attempts int
+
+	// Downtime represents the duration the connection was not available, if restored.
+	Downtime time.Duration
+
+	// Stamp represents the time of the change.
+	Stamp time.Time
+}
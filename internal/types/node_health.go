@@ -0,0 +1,28 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// NodeCallHealth represents the observed responsiveness of node calls.
+type NodeCallHealth struct {
+	// Latency represents the smoothed round trip time of the node calls.
+	Latency time.Duration
+
+	// ErrorRate represents the smoothed ratio of failed node calls in <0, 1> range.
+	ErrorRate float64
+
+	// Calls represents the total number of node calls observed.
+	Calls uint64
+}
+
+// NodeHealth represents the observed responsiveness of the connected blockchain node.
+type NodeHealth struct {
+	// NodeCallHealth represents the responsiveness of all the node calls.
+	NodeCallHealth
+
+	// Classes represents the responsiveness of the node calls by their class.
+	Classes map[string]NodeCallHealth
+
+	// Updated represents the time of the last update of the smoothed values.
+	Updated time.Time
+}
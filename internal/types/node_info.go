@@ -0,0 +1,25 @@
+// Package types implements different core types of the API.
+package types
+
+import "math/big"
+
+// NodeInfo represents diagnostic information about the connected blockchain node.
+type NodeInfo struct {
+	// Version represents the client version string of the node.
+	Version string
+
+	// ChainID represents the id of the chain the node is connected to.
+	ChainID *big.Int
+
+	// PeerCount represents the number of peers connected to the node.
+	PeerCount uint64
+
+	// Syncing signals if the node is catching up with the network.
+	Syncing bool
+
+	// CurrentBlock represents the block the node has processed.
+	CurrentBlock uint64
+
+	// HighestBlock represents the highest block known to the node; equals CurrentBlock if not syncing.
+	HighestBlock uint64
+}
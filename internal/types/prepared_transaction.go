@@ -0,0 +1,35 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PreparedTransaction represents an unsigned transaction built by the API
+// server for the client to review, sign and submit to the block chain.
+type PreparedTransaction struct {
+	// From represents the address of the sender expected to sign the transaction.
+	From common.Address
+
+	// To represents the address of the recipient contract.
+	To common.Address
+
+	// Data represents the ABI encoded call data of the transaction.
+	Data hexutil.Bytes
+
+	// Value represents the amount of native tokens sent with the transaction.
+	Value hexutil.Big
+
+	// Gas represents the estimated amount of gas required by the transaction.
+	Gas hexutil.Uint64
+
+	// GasPrice represents the suggested gas price in WEI.
+	GasPrice hexutil.Big
+
+	// Nonce represents the next nonce of the sender account.
+	Nonce hexutil.Uint64
+
+	// Amount represents the amount of tokens affected by the transaction.
+	Amount hexutil.Big
+}
@@ -0,0 +1,55 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// Reindex states.
+const (
+	// ReindexStateBuilding represents the copy of the live documents into the shadow collection.
+	ReindexStateBuilding = "building"
+
+	// ReindexStateCatchUp represents the replay of changes made to the live collection during the build.
+	ReindexStateCatchUp = "catch-up"
+
+	// ReindexStateSwitching represents the replay of the final changes while writes to the live collection are paused.
+	ReindexStateSwitching = "switching"
+
+	// ReindexStateSwitched represents the shadow collection replacing the live one.
+	ReindexStateSwitched = "switched"
+
+	// ReindexStateFailed represents a reindex terminated by an error.
+	ReindexStateFailed = "failed"
+)
+
+// Reindex represents the rebuild of an indexed collection in a shadow collection
+// while the API keeps serving from the live collection.
+type Reindex struct {
+	Collection string     `bson:"_id"`
+	State      string     `bson:"state"`
+	Copied     int64      `bson:"copied"`
+	Started    time.Time  `bson:"started"`
+	Updated    time.Time  `bson:"updated"`
+	Finished   *time.Time `bson:"finished"`
+	Error      *string    `bson:"error"`
+
+	// Cursor is the primary key of the last live document processed by the current pass.
+	Cursor interface{} `bson:"cursor"`
+
+	// Missed is the number of live collection changes replayed into the shadow collection.
+	Missed int64 `bson:"missed"`
+
+	// Resume is the change stream resume token of the last live collection change replayed.
+	Resume interface{} `bson:"resume"`
+
+	// Paused is the time writes to the live collection were paused for the switch, if paused.
+	Paused *time.Time `bson:"paused"`
+
+	// Owner is the API server instance building the shadow collection until the lease expires.
+	Owner string    `bson:"owner"`
+	Until time.Time `bson:"until"`
+}
+
+// IsActive checks if the reindex is still building the shadow collection.
+func (ri *Reindex) IsActive() bool {
+	return ri.State == ReindexStateBuilding || ri.State == ReindexStateCatchUp || ri.State == ReindexStateSwitching
+}
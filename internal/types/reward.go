@@ -18,6 +18,7 @@ const (
 	FiRewardClaimToValidator = "to"
 	FiRewardClaimedValue     = "value"
 	FiRewardClaimedTimeStamp = "stamp"
+	FiRewardClaimIsRestake   = "red"
 )
 
 // RewardDecimalsCorrection is used to manipulate precision of a rewards value,
@@ -36,6 +37,13 @@ type RewardClaim struct {
 	IsDelegated   bool
 }
 
+// RewardSplit represents the split of claimed rewards of a delegation
+// between the rewards paid to the delegator and the rewards re-staked.
+type RewardSplit struct {
+	Claimed  hexutil.Big
+	Restaked hexutil.Big
+}
+
 // BsonRewardClaim represents BSON rew structure of the reward claim.
 type BsonRewardClaim struct {
 	ID        string    `bson:"_id"`
@@ -0,0 +1,28 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RewardRecomputation represents a reward claim derived from the SFC events
+// compared with the rewards recomputed from the archive state before the claim.
+type RewardRecomputation struct {
+	ClaimTrx    common.Hash
+	BlockNumber hexutil.Uint64
+	IsDelegated bool
+	Indexed     hexutil.Big
+	Recomputed  hexutil.Big
+	Match       bool
+}
+
+// RewardRecomputationReport represents the result of the reward history
+// recomputation of a delegation.
+type RewardRecomputationReport struct {
+	Address     common.Address
+	ValidatorId hexutil.Big
+	Checked     int32
+	Mismatched  int32
+	Entries     []RewardRecomputation
+}
@@ -0,0 +1,31 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RewardWatch represents a delegation watched by the pending rewards reminder
+// together with the state of the last reminder sent for it, so a restarted
+// server does not remind the same rewards again.
+type RewardWatch struct {
+	Address     string `bson:"addr"`
+	ValidatorID uint64 `bson:"vid"`
+
+	// Static signals the watch comes from the server configuration.
+	Static bool `bson:"static"`
+
+	// LastEpoch, LastAmount and LastSent describe the last reminder sent;
+	// they are empty if no reminder has been sent since the rewards were claimed.
+	LastEpoch  uint64     `bson:"ep"`
+	LastAmount string     `bson:"amo"`
+	LastSent   *time.Time `bson:"sent"`
+}
+
+// RewardWatchID provides the identifier of the watch of the given delegation.
+func RewardWatchID(addr *common.Address, valID uint64) string {
+	return fmt.Sprintf("%s/%d", addr.String(), valID)
+}
@@ -0,0 +1,29 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RiskFlag represents the result of a compliance screening of an address.
+type RiskFlag struct {
+	// Address is the screened address.
+	Address common.Address `json:"address"`
+
+	// Flagged signals the address is sanctioned, or otherwise flagged by the screening source.
+	Flagged bool `json:"flagged"`
+
+	// Category is the risk category of a flagged address, e.g. "sanctions", "scam", "mixer".
+	Category string `json:"category,omitempty"`
+
+	// Reason is an optional human readable detail of the flag.
+	Reason string `json:"reason,omitempty"`
+
+	// Source identifies the screening source which provided the result.
+	Source string `json:"source"`
+
+	// Checked is the time of the screening.
+	Checked time.Time `json:"checked"`
+}
@@ -0,0 +1,20 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// FiSandboxKeyClient is the name of the client address field of the sandbox key record.
+const FiSandboxKeyClient = "client"
+
+// FiSandboxKeyExpires is the name of the expiration field of the sandbox key record.
+const FiSandboxKeyExpires = "expires"
+
+// SandboxKey represents a self-service API key with limited privileges minted by a developer.
+// Only the SHA256 hash of the key is kept, the key itself is provided to the client once.
+type SandboxKey struct {
+	ID      string    `bson:"_id"`
+	Name    string    `bson:"name"`
+	Client  string    `bson:"client"`
+	Created time.Time `bson:"created"`
+	Expires time.Time `bson:"expires"`
+}
@@ -0,0 +1,37 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// FiDelegationEventAddress is the name of the delegator address field of the delegation event record.
+	FiDelegationEventAddress = "adr"
+
+	// FiDelegationEventStamp is the name of the time stamp field of the delegation event record.
+	FiDelegationEventStamp = "stamp"
+)
+
+// DelegationEvent represents a single delegation of stake to a validator
+// captured from the SFC events; the history is used to track stake movements.
+type DelegationEvent struct {
+	ID          string         `bson:"_id"`
+	Address     common.Address `bson:"adr"`
+	ToValidator uint64         `bson:"to"`
+	Amount      string         `bson:"amo"`
+	Stamp       time.Time      `bson:"stamp"`
+}
+
+// StakeFlow represents the net movement of stake from one validator to another
+// inferred from undelegations followed by delegations of the same delegators.
+type StakeFlow struct {
+	FromValidator uint64
+	ToValidator   uint64
+	Amount        *big.Int
+	Moves         uint64
+	Delegators    uint64
+}
@@ -0,0 +1,46 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Stake actions recognized by the stake action validation.
+const (
+	StakeActionDelegate   = "DELEGATE"
+	StakeActionUndelegate = "UNDELEGATE"
+	StakeActionLock       = "LOCK"
+	StakeActionUnlock     = "UNLOCK"
+	StakeActionWithdraw   = "WITHDRAW"
+)
+
+// StakeAction represents a staking operation a user intends to perform on the SFC contract.
+type StakeAction struct {
+	// Action is the type of the operation, e.g. StakeActionDelegate.
+	Action string
+
+	// Address is the address of the delegator performing the operation.
+	Address common.Address
+
+	// ValidatorID is the identifier of the target validator.
+	ValidatorID hexutil.Big
+
+	// Amount is the amount of tokens involved in the operation.
+	Amount hexutil.Big
+
+	// Duration is the lock duration in seconds; used by lock only.
+	Duration hexutil.Uint64
+}
+
+// StakeValidationError represents a single SFC constraint the stake action would violate.
+type StakeValidationError struct {
+	// Code is the machine readable identifier of the violated constraint.
+	Code string
+
+	// Field is the name of the offending argument, if any.
+	Field *string
+
+	// Message is the human readable description of the violation.
+	Message string
+}
@@ -0,0 +1,24 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+// FiStatusIncidentStarted is the name of the start time field of the status incident record.
+const FiStatusIncidentStarted = "started"
+
+// Status incident severities.
+const (
+	StatusIncidentMinor    = "minor"
+	StatusIncidentMajor    = "major"
+	StatusIncidentCritical = "critical"
+)
+
+// StatusIncident represents an incident marker published by operators on the status page.
+type StatusIncident struct {
+	ID       string     `bson:"_id"`
+	Severity string     `bson:"severity"`
+	Title    string     `bson:"title"`
+	Message  *string    `bson:"msg"`
+	Started  time.Time  `bson:"started"`
+	Resolved *time.Time `bson:"resolved"`
+}
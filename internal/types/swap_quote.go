@@ -0,0 +1,37 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SwapQuote represents the best route of a token swap through Uniswap pairs.
+type SwapQuote struct {
+	// Path is the list of tokens the swap goes through, starting with the input token.
+	Path []common.Address
+
+	// Pairs is the list of pairs used by the swap hops.
+	Pairs []common.Address
+
+	// AmountIn is the input amount of the swap.
+	AmountIn hexutil.Big
+
+	// AmountOut is the expected output amount of the swap.
+	AmountOut hexutil.Big
+
+	// AmountOutMin is the minimal output amount accepted by the router call.
+	AmountOutMin hexutil.Big
+
+	// PriceImpact is the relative difference between the mid-price and the execution price.
+	PriceImpact float64
+
+	// Router is the address of the router contract to be called.
+	Router common.Address
+
+	// Deadline is the time stamp the router call is valid until.
+	Deadline hexutil.Uint64
+
+	// CallData are the encoded router call data; nil if the recipient is not known.
+	CallData *hexutil.Bytes
+}
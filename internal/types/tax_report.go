@@ -0,0 +1,39 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// TaxReportEntryClaim represents rewards claimed to the delegator account balance.
+	TaxReportEntryClaim = "CLAIM"
+
+	// TaxReportEntryRestake represents rewards re-staked into the delegation.
+	TaxReportEntryRestake = "RESTAKE"
+)
+
+// TaxReportEntry represents a single rewards claim, or restake of a tax report
+// with the fiat value of the rewards at the time of the claim.
+type TaxReportEntry struct {
+	Type        string
+	TimeStamp   hexutil.Uint64
+	ValidatorId hexutil.Big
+	TrxHash     common.Hash
+	Amount      hexutil.Big
+	Price       float64
+	FiatValue   float64
+}
+
+// TaxReport represents the staking rewards of a delegator realized in a calendar year.
+// A truncated report contains only the oldest claims of the year up to the entries limit.
+type TaxReport struct {
+	Address        common.Address
+	Year           int32
+	Currency       string
+	Entries        []TaxReportEntry
+	TotalAmount    hexutil.Big
+	TotalFiatValue float64
+	Truncated      bool
+}
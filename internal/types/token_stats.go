@@ -0,0 +1,37 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TokenStatsPeriods maps the supported token statistics periods to their length in days.
+var TokenStatsPeriods = map[string]int{
+	"day":   1,
+	"week":  7,
+	"month": 30,
+	"year":  365,
+}
+
+// TokenDailyStats represents the transfer activity of a token in a single day.
+type TokenDailyStats struct {
+	Day       time.Time
+	Transfers int32
+	Senders   int32
+	Receivers int32
+	Volume    hexutil.Big
+}
+
+// TokenStats represents the transfer activity of a token in a period of recent days.
+// Unique senders and receivers of the period are counted across all its days.
+type TokenStats struct {
+	Period    string
+	Since     time.Time
+	Transfers int32
+	Senders   int32
+	Receivers int32
+	Volume    hexutil.Big
+	Daily     []*TokenDailyStats
+}
@@ -0,0 +1,17 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TokenSupplyChange represents the amounts of a token minted and burned in a single day.
+type TokenSupplyChange struct {
+	Day    time.Time
+	Minted hexutil.Big
+	Burned hexutil.Big
+	Mints  int32
+	Burns  int32
+}
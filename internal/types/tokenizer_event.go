@@ -0,0 +1,151 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// FiTokenizerEventAccount is the name of the account field of the tokenizer event record.
+	FiTokenizerEventAccount = "acc"
+
+	// FiTokenizerEventValidator is the name of the validator id field of the tokenizer event record.
+	FiTokenizerEventValidator = "vid"
+
+	// FiTokenizerEventValue is the name of the signed value field of the tokenizer event record.
+	FiTokenizerEventValue = "val"
+
+	// FiTokenizerEventOrdinal is the name of the ordinal index field of the tokenizer event record.
+	FiTokenizerEventOrdinal = "orx"
+
+	// FiTokenizerEventTimeStamp is the name of the time stamp field of the tokenizer event record.
+	FiTokenizerEventTimeStamp = "stamp"
+)
+
+// define types of tokenizer events
+const (
+	// TokenizerEventMint represents sAXIS tokens minted for a locked delegation.
+	TokenizerEventMint = "MINT"
+
+	// TokenizerEventBurn represents sAXIS tokens burned to release a locked delegation.
+	TokenizerEventBurn = "BURN"
+)
+
+// TokenizerEvent represents a mint, or a burn of sAXIS tokens by the SFC Tokenizer.
+// The validator is known only for direct calls of the SFC Tokenizer contract.
+type TokenizerEvent struct {
+	Type        string
+	Account     common.Address
+	ValidatorId *hexutil.Big
+	Amount      hexutil.Big
+	TrxHash     common.Hash
+	LogIndex    uint
+	BlockNumber hexutil.Uint64
+	TimeStamp   hexutil.Uint64
+}
+
+// BsonTokenizerEvent represents the tokenizer event data structure for BSON formatting.
+// The value is the amount reduced by WithdrawDecimalsCorrection, negative for burns,
+// so the outstanding sAXIS can be aggregated directly.
+type BsonTokenizerEvent struct {
+	ID          string    `bson:"_id"`
+	Ordinal     int64     `bson:"orx"`
+	Type        string    `bson:"typ"`
+	Account     string    `bson:"acc"`
+	ValidatorId *int64    `bson:"vid"`
+	Amount      string    `bson:"amo"`
+	Value       int64     `bson:"val"`
+	Trx         string    `bson:"trx"`
+	LogIndex    int64     `bson:"lix"`
+	Block       int64     `bson:"blk"`
+	TimeStamp   time.Time `bson:"stamp"`
+}
+
+// Pk generates unique identifier of the tokenizer event record.
+func (te *TokenizerEvent) Pk() string {
+	return fmt.Sprintf("%s:%d", te.TrxHash.String(), te.LogIndex)
+}
+
+// OrdinalIndex returns an ordinal index of the event in the chain.
+func (te *TokenizerEvent) OrdinalIndex() int64 {
+	return (int64(te.BlockNumber)<<14)&0x7FFFFFFFFFFFFFFF | (int64(te.LogIndex) & 0x3fff)
+}
+
+// Value returns the signed amount of the event reduced by the decimals correction.
+func (te *TokenizerEvent) Value() int64 {
+	val := new(big.Int).Div(te.Amount.ToInt(), WithdrawDecimalsCorrection).Int64()
+	if te.Type == TokenizerEventBurn {
+		return -val
+	}
+	return val
+}
+
+// MarshalBSON creates a BSON representation of the tokenizer event record.
+func (te *TokenizerEvent) MarshalBSON() ([]byte, error) {
+	row := BsonTokenizerEvent{
+		ID:        te.Pk(),
+		Ordinal:   te.OrdinalIndex(),
+		Type:      te.Type,
+		Account:   te.Account.String(),
+		Amount:    te.Amount.String(),
+		Value:     te.Value(),
+		Trx:       te.TrxHash.String(),
+		LogIndex:  int64(te.LogIndex),
+		Block:     int64(te.BlockNumber),
+		TimeStamp: time.Unix(int64(te.TimeStamp), 0).UTC(),
+	}
+	if te.ValidatorId != nil {
+		vid := te.ValidatorId.ToInt().Int64()
+		row.ValidatorId = &vid
+	}
+	return bson.Marshal(row)
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (te *TokenizerEvent) UnmarshalBSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not decode stored tokenizer event")
+		}
+	}()
+
+	// try to decode BSON data
+	var row BsonTokenizerEvent
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	// transfer the data points
+	te.Type = row.Type
+	te.Account = common.HexToAddress(row.Account)
+	te.Amount = (hexutil.Big)(*hexutil.MustDecodeBig(row.Amount))
+	te.TrxHash = common.HexToHash(row.Trx)
+	te.LogIndex = uint(row.LogIndex)
+	te.BlockNumber = (hexutil.Uint64)(row.Block)
+	te.TimeStamp = (hexutil.Uint64)(row.TimeStamp.Unix())
+	if row.ValidatorId != nil {
+		te.ValidatorId = (*hexutil.Big)(new(big.Int).SetInt64(*row.ValidatorId))
+	}
+	return nil
+}
+
+// SAXISSupply represents the amount of sAXIS tokens outstanding at the end of a day,
+// and the amounts minted and burned during the day.
+type SAXISSupply struct {
+	Day         time.Time
+	Minted      hexutil.Big
+	Burned      hexutil.Big
+	Outstanding hexutil.Big
+}
+
+// SAXISCollateral represents the amount of sAXIS tokens outstanding against delegations to a validator.
+type SAXISCollateral struct {
+	ValidatorId hexutil.Big
+	Outstanding hexutil.Big
+}
@@ -107,6 +107,8 @@ type BsonTransaction struct {
 	Amount     int64     `bson:"amo"`
 	LargeInput bool      `bson:"large"`
 	Input      []byte    `bson:"input"`
+	InputSize  int       `bson:"isz"`
+	Selector   *string   `bson:"sel,omitempty"`
 	Gas        int64     `bson:"gas_lim"`
 	UsedGas    *uint64   `bson:"gas_use"`
 	CumGas     *uint64   `bson:"gas_cum"`
@@ -132,6 +134,16 @@ func (trx *Transaction) Uid() uint64 {
 	return binary.BigEndian.Uint64(trx.Hash[:8]) & 0x7FFFFFFFFFFFFFFF
 }
 
+// Selector provides the hex encoded method selector of the contract call,
+// nil if the input is too short to contain one.
+func (trx *Transaction) Selector() *string {
+	if len(trx.InputData) < 4 {
+		return nil
+	}
+	sel := hexutil.Encode(trx.InputData[:4])
+	return &sel
+}
+
 // Marshal returns the JSON encoding of transaction.
 func (trx *Transaction) Marshal() ([]byte, error) {
 	return json.Marshal(trx)
@@ -155,6 +167,8 @@ func (trx *Transaction) MarshalBSON() ([]byte, error) {
 		Value:      trx.Value.String(),
 		Amount:     val.Int64(),
 		LargeInput: len(trx.InputData) > trxLargeInputWall,
+		InputSize:  len(trx.InputData),
+		Selector:   trx.Selector(),
 		Stamp:      trx.TimeStamp,
 	}
 
@@ -1,7 +1,14 @@
 // Package types implements different core types of the API.
 package types
 
-import "go.mongodb.org/mongo-driver/bson"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+)
 
 // TransactionList represents a list of transactions.
 type TransactionList struct {
@@ -42,3 +49,60 @@ func (b *TransactionList) Reverse() {
 	// swap indexes
 	b.First, b.Last = b.Last, b.First
 }
+
+// TransactionCursor provides a stable list cursor of the given transaction.
+// The cursor is composed of the block number and the index of the transaction
+// in the block, i.e. "4567890:3", so it keeps its position in the list regardless
+// of new blocks being added, or the referenced transaction being dropped on reorg.
+// Pending transactions fall back to the transaction hash.
+func TransactionCursor(trx *Transaction) string {
+	if trx.BlockNumber == nil || trx.Index == nil {
+		return trx.Hash.String()
+	}
+	return fmt.Sprintf("%d:%d", uint64(*trx.BlockNumber), uint64(*trx.Index))
+}
+
+// trxCursorMaxBlock represents the first block number not representable
+// in the ordinal index of a transaction, see Transaction.Uid().
+const trxCursorMaxBlock = 1 << 49
+
+// TransactionCursorOrdinal decodes the ordinal index of the transaction position
+// from the given block number and index based cursor. It returns false if the cursor
+// is not a valid composite cursor, i.e. it's a legacy transaction hash cursor,
+// a cursor of a different list, or a forged cursor out of the ordinal index range.
+func TransactionCursorOrdinal(cursor string) (uint64, bool) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 2 {
+		return 0, false
+	}
+
+	blk, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || blk >= trxCursorMaxBlock {
+		return 0, false
+	}
+
+	ix, err := strconv.ParseUint(parts[1], 10, 14)
+	if err != nil {
+		return 0, false
+	}
+
+	// only the canonical form is accepted so each position has exactly one cursor
+	if strconv.FormatUint(blk, 10) != parts[0] || strconv.FormatUint(ix, 10) != parts[1] {
+		return 0, false
+	}
+	return (blk << 14) | ix, true
+}
+
+// IsTransactionHashCursor checks if the given cursor is a legacy transaction hash cursor,
+// i.e. a 0x prefixed hex encoded 32 bytes long hash.
+func IsTransactionHashCursor(cursor string) bool {
+	if len(cursor) != 2+2*common.HashLength || !strings.HasPrefix(cursor, "0x") {
+		return false
+	}
+	for _, c := range cursor[2:] {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') && !(c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/onsi/gomega"
+)
+
+func TestTransactionCursor(t *testing.T) {
+	hash := common.HexToHash("0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f")
+	blk, ix := hexutil.Uint64(4567890), hexutil.Uint64(3)
+
+	tests := []struct {
+		name   string
+		trx    Transaction
+		cursor string
+	}{
+		{name: "mined", trx: Transaction{Hash: hash, BlockNumber: &blk, Index: &ix}, cursor: "4567890:3"},
+		{name: "pending", trx: Transaction{Hash: hash}, cursor: hash.String()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(TransactionCursor(&tc.trx)).To(gomega.Equal(tc.cursor))
+
+			// mined transactions decode to their ordinal index, pending ones are looked up by hash
+			orx, ok := TransactionCursorOrdinal(tc.cursor)
+			g.Expect(ok).To(gomega.Equal(tc.trx.BlockNumber != nil))
+			if ok {
+				g.Expect(orx).To(gomega.Equal(tc.trx.Uid()))
+			}
+			g.Expect(IsTransactionHashCursor(tc.cursor)).To(gomega.Equal(!ok))
+		})
+	}
+}
+
+func TestTransactionCursorOrdinal(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		orx    uint64
+		ok     bool
+	}{
+		{name: "genesis", cursor: "0:0", orx: 0, ok: true},
+		{name: "regular", cursor: "4567890:3", orx: 4567890<<14 | 3, ok: true},
+		{name: "last index", cursor: "1:16383", orx: 1<<14 | 16383, ok: true},
+		{name: "last block", cursor: "562949953421311:0", orx: 562949953421311 << 14, ok: true},
+
+		// malformed
+		{name: "empty", cursor: ""},
+		{name: "separator only", cursor: ":"},
+		{name: "no index", cursor: "4567890:"},
+		{name: "no block", cursor: ":3"},
+		{name: "extra part", cursor: "4567890:3:1"},
+		{name: "hex block", cursor: "0x45b352:3"},
+		{name: "name", cursor: "block:3"},
+		{name: "white space", cursor: " 4567890:3"},
+		{name: "new line", cursor: "4567890:3\n"},
+
+		// forged
+		{name: "negative block", cursor: "-1:3"},
+		{name: "signed block", cursor: "+4567890:3"},
+		{name: "negative index", cursor: "4567890:-1"},
+		{name: "index overflow", cursor: "4567890:16384"},
+		{name: "block overflow", cursor: "562949953421312:0"},
+		{name: "uint64 block", cursor: "18446744073709551615:0"},
+		{name: "uint64 overflow", cursor: "18446744073709551616:0"},
+		{name: "padded block", cursor: "04567890:3"},
+		{name: "padded index", cursor: "4567890:03"},
+
+		// cross-collection
+		{name: "contract", cursor: "74836452"},
+		{name: "block", cursor: "0x45b352"},
+		{name: "token transaction", cursor: "0x000000000045b3520000000c0001"},
+		{name: "transaction hash", cursor: "0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			orx, ok := TransactionCursorOrdinal(tc.cursor)
+			g.Expect(ok).To(gomega.Equal(tc.ok), "cursor %q", tc.cursor)
+			g.Expect(orx).To(gomega.Equal(tc.orx), "cursor %q", tc.cursor)
+		})
+	}
+}
+
+func TestIsTransactionHashCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		ok     bool
+	}{
+		{name: "hash", cursor: "0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f", ok: true},
+		{name: "upper case", cursor: "0x5F2B9E8E7C3F1C2D4A6B8C0D1E2F3A4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0F", ok: true},
+
+		// malformed
+		{name: "empty", cursor: ""},
+		{name: "no prefix", cursor: "5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f00"},
+		{name: "short", cursor: "0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0"},
+		{name: "long", cursor: "0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f0"},
+		{name: "not hex", cursor: "0x5f2b9e8e7c3f1c2d4a6b8c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0g"},
+
+		// forged, e.g. a query operator smuggled into the primary key lookup
+		{name: "operator", cursor: `{"$gt":""}`},
+
+		// cross-collection
+		{name: "composite", cursor: "4567890:3"},
+		{name: "contract", cursor: "74836452"},
+		{name: "block", cursor: "0x45b352"},
+		{name: "token transaction", cursor: "0x000000000045b3520000000c0001"},
+		{name: "address", cursor: "0x8d8e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(IsTransactionHashCursor(tc.cursor)).To(gomega.Equal(tc.ok), "cursor %q", tc.cursor)
+		})
+	}
+}
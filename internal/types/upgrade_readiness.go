@@ -0,0 +1,72 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NodeVersionUnknown represents the node version of validators not publishing it.
+const NodeVersionUnknown = "unknown"
+
+// UpgradeReadiness represents the distribution of the active stake on validator node versions.
+type UpgradeReadiness struct {
+	// TotalStake is the total stake of active validators.
+	TotalStake hexutil.Big
+
+	// RequiredVersion is the node version the readiness is evaluated against, if any.
+	RequiredVersion *string
+
+	// ReadyStake is the stake of validators running the required version, or newer.
+	ReadyStake hexutil.Big
+
+	// Versions is the list of node versions sorted by the stake from the largest.
+	Versions []*NodeVersionStake
+}
+
+// NodeVersionStake represents the active stake of validators running a node version.
+type NodeVersionStake struct {
+	Version    string
+	Validators int32
+	Stake      hexutil.Big
+}
+
+// CompareNodeVersions compares two dotted node versions, i.e. "v1.1.2-rc.2", numerically
+// by their components; the leading "v" and any pre-release or build suffix are ignored.
+// The result is -1 if a < b, 0 if a == b, and +1 if a > b.
+func CompareNodeVersions(a string, b string) int {
+	pa, pb := nodeVersionParts(a), nodeVersionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// nodeVersionParts splits the node version into its numeric components.
+func nodeVersionParts(v string) []int {
+	v = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(v)), "v")
+	if i := strings.IndexAny(v, "-+ "); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	list := make([]int, len(parts))
+	for i, p := range parts {
+		list[i], _ = strconv.Atoi(p)
+	}
+	return list
+}
@@ -0,0 +1,121 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// FiValidatorChangeID is the name of the validator id field of the validator change record.
+	FiValidatorChangeID = "vid"
+
+	// FiValidatorChangeType is the name of the type field of the validator change record.
+	FiValidatorChangeType = "typ"
+
+	// FiValidatorChangeOrdinal is the name of the ordinal index field of the validator change record.
+	FiValidatorChangeOrdinal = "orx"
+)
+
+// define types of validator changes tracked by the API
+const (
+	// ValidatorChangeCreated represents the validator creation; the value is the validator auth address.
+	ValidatorChangeCreated = "CREATED"
+
+	// ValidatorChangePubkey represents the validator public key; the SFC sets it on validator creation.
+	ValidatorChangePubkey = "PUBKEY"
+
+	// ValidatorChangeStatus represents the validator status change; the value is the new status code.
+	ValidatorChangeStatus = "STATUS"
+
+	// ValidatorChangeDeactivated represents the validator deactivation; the value is the deactivation epoch.
+	ValidatorChangeDeactivated = "DEACTIVATED"
+
+	// ValidatorChangeMetadata represents the validator metadata update; the value is the new metadata URL.
+	ValidatorChangeMetadata = "METADATA"
+)
+
+// ValidatorChange represents a management change of a validator
+// captured from the SFC and the staker info contract events.
+type ValidatorChange struct {
+	ValidatorId hexutil.Big
+	Type        string
+	Value       string
+	Previous    *string
+	TrxHash     common.Hash
+	LogIndex    uint
+	BlockNumber hexutil.Uint64
+	TimeStamp   hexutil.Uint64
+}
+
+// BsonValidatorChange represents the validator change data structure for BSON formatting.
+type BsonValidatorChange struct {
+	ID          string    `bson:"_id"`
+	Ordinal     int64     `bson:"orx"`
+	ValidatorId int64     `bson:"vid"`
+	Type        string    `bson:"typ"`
+	Value       string    `bson:"val"`
+	Previous    *string   `bson:"prev"`
+	Trx         string    `bson:"trx"`
+	LogIndex    int64     `bson:"lix"`
+	Block       int64     `bson:"blk"`
+	TimeStamp   time.Time `bson:"stamp"`
+}
+
+// Pk generates unique identifier of the validator change record.
+// A single event may produce several changes, so the type is part of the key.
+func (vc *ValidatorChange) Pk() string {
+	return fmt.Sprintf("%s:%d:%s", vc.TrxHash.String(), vc.LogIndex, vc.Type)
+}
+
+// OrdinalIndex returns an ordinal index of the change in the chain.
+func (vc *ValidatorChange) OrdinalIndex() int64 {
+	return (int64(vc.BlockNumber)<<14)&0x7FFFFFFFFFFFFFFF | (int64(vc.LogIndex) & 0x3fff)
+}
+
+// MarshalBSON creates a BSON representation of the validator change record.
+func (vc *ValidatorChange) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(BsonValidatorChange{
+		ID:          vc.Pk(),
+		Ordinal:     vc.OrdinalIndex(),
+		ValidatorId: vc.ValidatorId.ToInt().Int64(),
+		Type:        vc.Type,
+		Value:       vc.Value,
+		Previous:    vc.Previous,
+		Trx:         vc.TrxHash.String(),
+		LogIndex:    int64(vc.LogIndex),
+		Block:       int64(vc.BlockNumber),
+		TimeStamp:   time.Unix(int64(vc.TimeStamp), 0).UTC(),
+	})
+}
+
+// UnmarshalBSON updates the value from BSON source.
+func (vc *ValidatorChange) UnmarshalBSON(data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not decode stored validator change")
+		}
+	}()
+
+	// try to decode BSON data
+	var row BsonValidatorChange
+	if err = bson.Unmarshal(data, &row); err != nil {
+		return err
+	}
+
+	// transfer the data points
+	vc.ValidatorId = (hexutil.Big)(*new(big.Int).SetInt64(row.ValidatorId))
+	vc.Type = row.Type
+	vc.Value = row.Value
+	vc.Previous = row.Previous
+	vc.TrxHash = common.HexToHash(row.Trx)
+	vc.LogIndex = uint(row.LogIndex)
+	vc.BlockNumber = (hexutil.Uint64)(row.Block)
+	vc.TimeStamp = (hexutil.Uint64)(row.TimeStamp.Unix())
+	return nil
+}
@@ -0,0 +1,62 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// ValidatorEarningsResolutionEpoch represents validator earnings reported per epoch.
+	ValidatorEarningsResolutionEpoch = "epoch"
+
+	// ValidatorEarningsResolutionDay represents validator earnings aggregated per day.
+	ValidatorEarningsResolutionDay = "day"
+)
+
+// ValidatorEarnings represents earnings of a validator in a range of sealed epochs.
+type ValidatorEarnings struct {
+	// ValidatorId is the identifier of the validator.
+	ValidatorId hexutil.Big
+
+	// FromEpoch and ToEpoch is the inclusive range of reported epochs.
+	FromEpoch hexutil.Uint64
+	ToEpoch   hexutil.Uint64
+
+	// Resolution is the aggregation period of the report.
+	Resolution string
+
+	// SelfStake is the self-stake of the validator the self-stake rewards are derived from.
+	SelfStake *big.Int
+
+	// Totals of the reported range.
+	SelfStakeRewards *big.Int
+	Commission       *big.Int
+	OriginatedFee    *big.Int
+
+	// Periods is the list of reported periods.
+	Periods []*ValidatorEarningsPeriod
+}
+
+// ValidatorEarningsPeriod represents earnings of a validator in a single period of the report.
+type ValidatorEarningsPeriod struct {
+	// Date is the date of the end of the period in YYYY-MM-DD format.
+	Date string
+
+	// FromEpoch and ToEpoch is the inclusive range of epochs sealed in the period.
+	FromEpoch hexutil.Uint64
+	ToEpoch   hexutil.Uint64
+
+	// End is the UNIX time stamp of the end of the last epoch of the period.
+	End hexutil.Uint64
+
+	// SelfStakeRewards is the amount of rewards of the validator self-stake.
+	SelfStakeRewards *big.Int
+
+	// Commission is the amount of commission earned from rewards of delegators.
+	Commission *big.Int
+
+	// OriginatedFee is the amount of fees of transactions originated by the validator.
+	OriginatedFee *big.Int
+}
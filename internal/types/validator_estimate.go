@@ -0,0 +1,40 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidatorEstimate represents the projected income of a prospective validator
+// with the given self-stake and the expected amount of delegations received.
+type ValidatorEstimate struct {
+	// Epoch is the sealed epoch the reward parameters of the estimate are taken from.
+	Epoch hexutil.Uint64
+
+	// EpochDuration is the duration of the epoch in seconds.
+	EpochDuration hexutil.Uint64
+
+	// SelfStake and ExpectedDelegation are the inputs of the estimate.
+	SelfStake          *big.Int
+	ExpectedDelegation *big.Int
+
+	// MinSelfStake is the minimal self-stake required by the SFC contract.
+	MinSelfStake *big.Int
+
+	// DelegationLimit is the max total stake of the validator, including the self-stake,
+	// allowed by the max delegated ratio of the SFC contract.
+	DelegationLimit *big.Int
+
+	// EffectiveDelegation is the expected delegation capped by the delegation limit.
+	EffectiveDelegation *big.Int
+
+	// CommissionRate is the share of delegator rewards taken by the validator
+	// in the fixed point decimal unit of the SFC contract.
+	CommissionRate *big.Int
+
+	// SelfStakeRewards and Commission are the projected earnings of a single epoch.
+	SelfStakeRewards *big.Int
+	Commission       *big.Int
+}
@@ -0,0 +1,36 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidatorExit represents the exit of a validator from the network
+// and the withdrawal timeline of the stake delegated to it.
+type ValidatorExit struct {
+	ValidatorID      hexutil.Big
+	Status           hexutil.Uint64
+	IsDeactivated    bool
+	DeactivatedEpoch hexutil.Uint64
+	DeactivatedTime  hexutil.Uint64
+
+	// the withdrawal period of the deactivated stake; nil for active validators
+	WithdrawableEpoch *hexutil.Uint64
+	WithdrawableTime  *hexutil.Uint64
+	IsWithdrawable    bool
+
+	// pending un-delegations against the validator
+	PendingCount  hexutil.Uint64
+	PendingAmount hexutil.Big
+	Timeline      []ValidatorExitStep
+}
+
+// ValidatorExitStep represents a pending un-delegation on the exit timeline of a validator.
+type ValidatorExitStep struct {
+	Address           common.Address
+	WithdrawRequestID hexutil.Big
+	Amount            hexutil.Big
+	RequestedTime     hexutil.Uint64
+	WithdrawableTime  hexutil.Uint64
+}
@@ -16,6 +16,9 @@ type StakerInfo struct {
 
 	// Contact represents a link to contact to the staker
 	Contact *string `json:"contact"`
+
+	// NodeVersion represents the client version the staker runs its validator node with
+	NodeVersion *string `json:"nodeVersion"`
 }
 
 // UnmarshalStakerInfo parses the JSON-encoded staker information data.
@@ -0,0 +1,45 @@
+// Package types implements different core types of the API.
+package types
+
+import "time"
+
+const (
+	// WebhookStatusPending represents a webhook notification waiting for the first delivery attempt.
+	WebhookStatusPending = "pending"
+
+	// WebhookStatusRetrying represents a webhook notification scheduled for another delivery attempt.
+	WebhookStatusRetrying = "retrying"
+
+	// WebhookStatusDelivered represents a successfully delivered webhook notification.
+	WebhookStatusDelivered = "delivered"
+
+	// WebhookStatusDead represents a webhook notification dead-lettered after all the attempts failed.
+	WebhookStatusDead = "dead"
+)
+
+// FiWebhookDeliveryStatus is the name of the status field of the webhook delivery record.
+const FiWebhookDeliveryStatus = "status"
+
+// FiWebhookDeliveryUpdated is the name of the last update field of the webhook delivery record.
+const FiWebhookDeliveryUpdated = "updated"
+
+// FiWebhookDeliveryNextAttempt is the name of the scheduled next attempt field of the webhook delivery record.
+const FiWebhookDeliveryNextAttempt = "next"
+
+// FiWebhookDeliveryCreated is the name of the creation time field of the webhook delivery record.
+const FiWebhookDeliveryCreated = "created"
+
+// WebhookDelivery represents the delivery log record of a webhook notification.
+type WebhookDelivery struct {
+	ID          string     `bson:"_id"`
+	Url         string     `bson:"url"`
+	Event       string     `bson:"event"`
+	Payload     string     `bson:"payload"`
+	Status      string     `bson:"status"`
+	Attempts    int32      `bson:"attempts"`
+	LastError   *string    `bson:"error"`
+	LastCode    *int32     `bson:"code"`
+	Created     time.Time  `bson:"created"`
+	Updated     time.Time  `bson:"updated"`
+	NextAttempt *time.Time `bson:"next"`
+}